@@ -135,6 +135,7 @@ import (
 	schedulertypes "github.com/Team-Kujira/core/x/scheduler/types"
 
 	"github.com/Team-Kujira/core/x/oracle"
+	oracleclient "github.com/Team-Kujira/core/x/oracle/client"
 	oraclekeeper "github.com/Team-Kujira/core/x/oracle/keeper"
 	oracletypes "github.com/Team-Kujira/core/x/oracle/types"
 
@@ -160,6 +161,9 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 		schedulerclient.CreateHookProposalHandler,
 		schedulerclient.UpdateHookProposalHandler,
 		schedulerclient.DeleteHookProposalHandler,
+		oracleclient.RenameDenomProposalHandler,
+		oracleclient.DelistDenomProposalHandler,
+		oracleclient.SetObserverProposalHandler,
 		paramsclient.ProposalHandler,
 		upgradeclient.LegacyProposalHandler,
 		upgradeclient.LegacyCancelProposalHandler,
@@ -360,7 +364,7 @@ func New(
 		AllianceStoreKey,
 	)
 
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, oracletypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	app := &App{
@@ -503,12 +507,6 @@ func New(
 		authority,
 	)
 
-	// register the staking hooks
-	app.StakingKeeper.SetHooks(
-		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks(),
-			app.AllianceKeeper.StakingHooks()),
-	)
-
 	// ... other modules keepers
 
 	// Create IBC Keeper
@@ -585,6 +583,7 @@ func New(
 	app.OracleKeeper = oraclekeeper.NewKeeper(
 		appCodec,
 		keys[oracletypes.StoreKey],
+		tkeys[oracletypes.TStoreKey],
 		app.GetSubspace(oracletypes.ModuleName),
 		app.AccountKeeper,
 		app.BankKeeper,
@@ -592,6 +591,13 @@ func New(
 		app.SlashingKeeper,
 		app.StakingKeeper,
 		distrtypes.ModuleName,
+		authtypes.FeeCollectorName,
+	)
+
+	// register the staking hooks, once the oracle keeper exists
+	app.StakingKeeper.SetHooks(
+		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks(),
+			app.AllianceKeeper.StakingHooks(), app.OracleKeeper.Hooks()),
 	)
 
 	denomKeeper := denomkeeper.NewKeeper(
@@ -652,6 +658,7 @@ func New(
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
 		AddRoute(schedulertypes.RouterKey, schedulerkeeper.NewSchedulerProposalHandler(app.SchedulerKeeper)).
+		AddRoute(oracletypes.RouterKey, oraclekeeper.NewOracleProposalHandler(app.OracleKeeper)).
 		AddRoute(alliancemoduletypes.RouterKey, alliancemodule.NewAllianceProposalHandler(app.AllianceKeeper))
 
 	// Create evidence Keeper for to register the IBC light client misbehaviour evidence route
@@ -886,6 +893,8 @@ func New(
 	app.ModuleManager.SetOrderBeginBlockers(
 		upgradetypes.ModuleName,
 		capabilitytypes.ModuleName,
+		// the oracle shares the collected fees before mint adds to them and distribution allocates them
+		oracletypes.ModuleName,
 		minttypes.ModuleName,
 		distrtypes.ModuleName,
 		slashingtypes.ModuleName,
@@ -909,7 +918,6 @@ func New(
 		wasmtypes.ModuleName,
 		denomtypes.ModuleName,
 		schedulertypes.ModuleName,
-		oracletypes.ModuleName,
 		alliancemoduletypes.ModuleName,
 	)
 
@@ -1098,6 +1106,9 @@ func BlockedAddresses() map[string]bool {
 	delete(modAccAddrs, authtypes.NewModuleAddress(alliancemoduletypes.ModuleName).String())
 	delete(modAccAddrs, authtypes.NewModuleAddress(authtypes.FeeCollectorName).String())
 
+	// the oracle reward pool is only funded with the fee share, a direct send would be paid out to the voters
+	modAccAddrs[authtypes.NewModuleAddress(oracletypes.ModuleName).String()] = true
+
 	return modAccAddrs
 }
 
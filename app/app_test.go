@@ -0,0 +1,65 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	oracletypes "github.com/Team-Kujira/core/x/oracle/types"
+)
+
+func TestOracleModuleAccountBlocked(t *testing.T) {
+	app := Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1, ChainID: "kujira-1", Time: time.Now().UTC()})
+
+	oracleAddr := authtypes.NewModuleAddress(oracletypes.ModuleName)
+	require.True(t, BlockedAddresses()[oracleAddr.String()])
+	require.True(t, app.BankKeeper.BlockedAddr(oracleAddr))
+
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	require.NoError(t, testutil.FundAccount(app.BankKeeper, ctx, sender, coins))
+
+	// Direct transfers into the reward pool are rejected
+	msgServer := bankkeeper.NewMsgServerImpl(app.BankKeeper)
+	_, err := msgServer.Send(sdk.WrapSDKContext(ctx), banktypes.NewMsgSend(sender, oracleAddr, coins))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.MultiSend(sdk.WrapSDKContext(ctx), banktypes.NewMsgMultiSend(
+		[]banktypes.Input{banktypes.NewInput(sender, coins)},
+		[]banktypes.Output{banktypes.NewOutput(oracleAddr, coins)},
+	))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, oracleAddr).IsZero())
+	require.Equal(t, coins, app.BankKeeper.GetAllBalances(ctx, sender))
+}
+
+func TestUpgradeHandlerRecordsUpgrade(t *testing.T) {
+	app := Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 10, ChainID: "kujira-1", Time: time.Now().UTC()})
+
+	// Any handler registered through setUpgradeHandler starts the post upgrade grace
+	app.setUpgradeHandler("test", func(_ sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return fromVM, nil
+	})
+	_, ok := app.OracleKeeper.GetLastUpgradeVotePeriod(ctx)
+	require.False(t, ok)
+
+	app.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: "test", Height: 10})
+	votePeriod, ok := app.OracleKeeper.GetLastUpgradeVotePeriod(ctx)
+	require.True(t, ok)
+	require.Equal(t, app.OracleKeeper.CurrentVotePeriod(ctx), votePeriod)
+}
@@ -9,13 +9,31 @@ import (
 const UpgradeName = "v0.9.3"
 
 func (app App) RegisterUpgradeHandlers() {
+	app.setUpgradeHandler(UpgradeName, app.runMigrations)
+}
+
+// setUpgradeHandler registers the handler of an upgrade. Every upgrade starts the post upgrade
+// grace of the oracle once the handler succeeds, so no handler has to remember doing it.
+func (app App) setUpgradeHandler(name string, handler upgradetypes.UpgradeHandler) {
 	app.UpgradeKeeper.SetUpgradeHandler(
-		UpgradeName,
+		name,
 		func(ctx sdk.Context,
-			_ upgradetypes.Plan,
+			plan upgradetypes.Plan,
 			fromVM module.VersionMap,
 		) (module.VersionMap, error) {
-			return app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+			versionMap, err := handler(ctx, plan, fromVM)
+			if err != nil {
+				return nil, err
+			}
+
+			// The feeders are given a few vote periods to catch up with the upgraded chain
+			app.OracleKeeper.RecordUpgrade(ctx)
+			return versionMap, nil
 		},
 	)
 }
+
+// runMigrations is the handler of an upgrade which only runs the module migrations
+func (app App) runMigrations(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+	return app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+}
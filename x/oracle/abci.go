@@ -1,6 +1,10 @@
 package oracle
 
 import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/Team-Kujira/core/x/oracle/keeper"
@@ -10,11 +14,29 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// BeginBlocker is called at the beginning of every block
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	// The fees are only moved if all of them can be, failing fees are left to the distribution module
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.ShareFees(cacheCtx); err != nil {
+		k.Logger(ctx).Error("failed to share the fees with the oracle reward pool", "err", err)
+		return
+	}
+	write()
+}
+
 // EndBlocker is called at the end of every block
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
-	params := k.GetParams(ctx)
-	if IsPeriodLastBlock(ctx, params.VotePeriod) {
+
+	// Blocks not closing a vote period or a slash window only read the params
+	// locating the boundaries, so the params are loaded past the boundary check
+	k.SyncVotePeriodClock(ctx)
+	if k.IsVotePeriodLastBlock(ctx) {
+		params := k.GetParams(ctx)
+
 		// Build claim map over all validators in active set
 		validatorClaimMap := make(map[string]types.Claim)
 
@@ -36,43 +58,269 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 			}
 		}
 
+		// Votes are weighted by the smoothed power of the voters, if enabled
+		smoothedPowers := k.UpdateSmoothedPowers(ctx, validatorClaimMap, params.PowerSmoothingWindows)
+
 		// voteTargets defines the symbol (ticker) denoms that we require votes on
-		var voteTargets []string
-		for _, v := range params.Whitelist {
-			voteTargets = append(voteTargets, v.Name)
+		voteTargets := k.VoteTargets(ctx)
+
+		// Newly whitelisted denoms are not required from the voters during their grace window
+		votePeriod := k.CurrentVotePeriod(ctx)
+		k.UpdateDenomGraceExits(ctx, voteTargets, votePeriod)
+		k.PruneWhitelistChanges(ctx, votePeriod, params.WhitelistChangeRetention)
+		graceDenoms := map[string]struct{}{}
+		for _, denom := range voteTargets {
+			if k.IsDenomInGrace(ctx, denom, votePeriod) {
+				graceDenoms[denom] = struct{}{}
+			}
+		}
+
+		// Denoms with a vote period multiplier are only tallied and required every few vote periods
+		restingDenoms := map[string]struct{}{}
+		for _, denom := range params.Whitelist {
+			if !denom.IsDue(votePeriod) {
+				restingDenoms[denom.Name] = struct{}{}
+			}
 		}
 
-		// Clear all exchange rates
-		k.IterateExchangeRates(ctx, func(denom string, _ sdk.Dec) (stop bool) {
+		// Denoms tracking another denom mirror its exchange rate, without a ballot of their own
+		trackingDenoms := map[string]string{}
+		for _, denom := range params.Whitelist {
+			if tracked := params.Whitelist.TrackedDenom(denom.Name); len(tracked) != 0 {
+				trackingDenoms[denom.Name] = tracked
+			}
+		}
+
+		// Clear all exchange rates, keeping them aside to carry forward the ones failing to tally
+		previousRates := map[string]sdk.Dec{}
+		k.IterateExchangeRates(ctx, func(denom string, exchangeRate sdk.Dec) (stop bool) {
+			previousRates[denom] = exchangeRate
 			k.DeleteExchangeRate(ctx, denom)
 			return false
 		})
 
+		// Only the submissions and tally bounds of the last vote period are kept
+		k.ClearLastVotePeriod(ctx)
+
 		// Organize votes to ballot by denom
 		voteMap := k.OrganizeBallotByDenom(ctx, validatorClaimMap)
 
 		// Keep track, if a voter submitted a price deviating too much
 		missMap := map[string]sdk.ValAddress{}
 
+		// Power against which the vote threshold of every ballot is measured
+		totalBondedPower := sdk.TokensToConsensusPower(k.StakingKeeper.TotalBondedTokens(ctx), powerReduction)
+		if params.ExcludeJailedFromThreshold {
+			totalBondedPower -= k.JailedBondedPower(ctx)
+		}
+		thresholdVotes := params.VoteThreshold.MulInt64(totalBondedPower).RoundInt()
+
 		// Iterate through ballots and update exchange rates; drop if not enough votes have been achieved.
+		talliedDenoms := map[string]struct{}{}
+		outcomes := map[string]types.DenomTallyOutcome{}
+		tallyStats := types.TallyStats{VotePeriod: votePeriod, Denoms: []types.DenomTallyStats{}}
+		var updatedRates types.ExchangeRateTuples
+		accuracyCounters := map[string]types.ValidatorAccuracyCounter{}
 		for denom, ballot := range voteMap {
-			totalBondedPower := sdk.TokensToConsensusPower(k.StakingKeeper.TotalBondedTokens(ctx), k.StakingKeeper.PowerReduction(ctx))
-			voteThreshold := k.VoteThreshold(ctx)
-			thresholdVotes := voteThreshold.MulInt64(totalBondedPower).RoundInt()
+			if _, ok := restingDenoms[denom]; ok {
+				continue
+			}
+			if _, ok := trackingDenoms[denom]; ok {
+				continue
+			}
+
 			ballotPower := sdk.NewInt(ballot.Power())
 
+			// Count the votes processed for the tally stats, along with the ones trimmed by the power cap
+			stats := types.DenomTallyStats{Denom: denom, Votes: uint64(len(ballot))}
+			for _, vote := range ballot {
+				if !vote.ExchangeRate.IsPositive() {
+					stats.AbstainVotes++
+				}
+			}
+
+			// Keep the share of the power which voted for the tally diagnosis
+			outcome := types.DenomTallyOutcome{
+				Reason:           types.TallyOutcomeBelowThreshold,
+				VotePeriod:       votePeriod,
+				AchievedFraction: sdk.ZeroDec(),
+				RequiredFraction: params.VoteThreshold,
+			}
+			if totalBondedPower > 0 {
+				outcome.AchievedFraction = sdk.NewDecFromInt(ballotPower).QuoInt64(totalBondedPower)
+			}
+
 			if !ballotPower.IsZero() && ballotPower.GTE(thresholdVotes) {
+				// Deviating votes on a denom in grace are not counted as misses either
+				ballotMissMap := missMap
+				if _, ok := graceDenoms[denom]; ok {
+					ballotMissMap = map[string]sdk.ValAddress{}
+				}
+
+				// The vote threshold is met with the actual power, only the weighting is smoothed
+				if smoothedPowers != nil {
+					for i := range ballot {
+						ballot[i].Power = smoothedPowers[ballot[i].Voter.String()]
+					}
+				}
+
+				// Limit the weight of the largest voters in the ballot, if enabled
+				stats.CappedVotes = uint64(ballot.CapPower(params.MaxPowerShare))
+
 				exchangeRate, err := Tally(
-					ctx, ballot, params.RewardBand, validatorClaimMap, missMap,
+					ctx, ballot, params.RewardBand, params.AggregationMethod, params.ModeBucketPrecision, params.EvenMedianRule,
+					validatorClaimMap, ballotMissMap,
 				)
+
+				// Keep the reward band boundaries and the power within them for the deviation
+				// and band membership queries
+				var lowerBound, upperBound sdk.Dec
+				if err == nil {
+					lowerBound, upperBound, err = RewardBounds(ballot, exchangeRate, params.RewardBand, params.EvenMedianRule)
+				}
+
+				// A ballot failing to tally, e.g. with rates overflowing sdk.Dec, fails the denom alone
+				if err != nil {
+					k.Logger(ctx).Error("failed to tally the exchange rate", "denom", denom, "err", err)
+					outcome.Reason = types.TallyOutcomeFailed
+					outcomes[denom] = outcome
+					tallyStats.Denoms = append(tallyStats.Denoms, stats)
+					continue
+				}
+				ratedPower, inBandPower := ballot.BandPower(lowerBound, upperBound)
+				k.SetTallyBounds(ctx, denom, types.TallyBounds{
+					LowerBound:  lowerBound,
+					UpperBound:  upperBound,
+					RatedPower:  ratedPower,
+					InBandPower: inBandPower,
+				})
+
+				// Count the rated exchange rates of each voter within the bounds for the accuracy ranking
+				for _, vote := range ballot {
+					if !vote.ExchangeRate.IsPositive() {
+						continue
+					}
+
+					counter := accuracyCounters[vote.Voter.String()]
+					counter.Submissions++
+					if vote.ExchangeRate.GTE(lowerBound) && vote.ExchangeRate.LTE(upperBound) {
+						counter.InBandSubmissions++
+					}
+					accuracyCounters[vote.Voter.String()] = counter
+				}
+
+				// Set the exchange rate, the ABCI events are emitted once all ballots are tallied.
+				// The voter count is kept along with it, while the rate is carried forward, and
+				// the move from the previous rate is counted for the max move query.
+				k.SetExchangeRate(ctx, denom, exchangeRate)
+				k.SetDenomVoterCount(ctx, denom, stats.Votes-stats.AbstainVotes)
+				if previousRate, ok := previousRates[denom]; ok {
+					k.CountDenomMove(ctx, denom, previousRate, exchangeRate, votePeriod, params.MaxMoveWindow)
+				}
+				updatedRates = append(updatedRates, types.NewExchangeRateTuple(denom, exchangeRate))
+				talliedDenoms[denom] = struct{}{}
+				outcome.Reason = types.TallyOutcomeSuccess
+				stats.Tallied = true
+			}
+			outcomes[denom] = outcome
+			tallyStats.Denoms = append(tallyStats.Denoms, stats)
+		}
+		// The rates of the tracking denoms are set once the tracked ones are carried forward too,
+		// but the ones tracking a tallied denom are updated along with it
+		for _, denom := range params.Whitelist {
+			if _, ok := talliedDenoms[trackingDenoms[denom.Name]]; ok {
+				exchangeRate, err := k.GetExchangeRate(ctx, trackingDenoms[denom.Name])
 				if err != nil {
 					return err
 				}
+				updatedRates = append(updatedRates, types.NewExchangeRateTuple(denom.Name, exchangeRate))
+			}
+		}
+		sort.Slice(tallyStats.Denoms, func(i, j int) bool {
+			return tallyStats.Denoms[i].Denom < tallyStats.Denoms[j].Denom
+		})
+		k.SetLastTallyStats(ctx, tallyStats)
+		if params.LegacyRateEvents {
+			emitExchangeRateUpdates(ctx, updatedRates, params.MaxEventDenomsPerBlock)
+		}
+		if err := emitOracleUpdate(ctx, updatedRates, votePeriod); err != nil {
+			return err
+		}
+
+		for key, counter := range accuracyCounters {
+			k.CountValidatorAccuracy(ctx, validatorClaimMap[key].Recipient, counter.Submissions, counter.InBandSubmissions)
+		}
+
+		// Count the tally outcomes of the slash window and the consecutive vote periods
+		// each vote target failed to tally, and delist the ones stale for longer than allowed
+		var delistings []autoDelisting
+		for _, denom := range voteTargets {
+			// Denoms without a ballot were resting or had no votes at all
+			outcome, ok := outcomes[denom]
+			if !ok {
+				outcome = types.DenomTallyOutcome{
+					Reason:           types.TallyOutcomeStale,
+					VotePeriod:       votePeriod,
+					AchievedFraction: sdk.ZeroDec(),
+					RequiredFraction: params.VoteThreshold,
+				}
+				if _, ok := restingDenoms[denom]; ok {
+					outcome.Reason = types.TallyOutcomeResting
+				}
+				if _, ok := trackingDenoms[denom]; ok {
+					outcome.Reason = types.TallyOutcomeTracking
+				}
+			}
+			k.SetDenomTallyOutcome(ctx, denom, outcome)
+
+			// The state of a tracking denom follows the tracked denom
+			if _, ok := trackingDenoms[denom]; ok {
+				continue
+			}
+
+			// The exchange rate of a resting denom stays fresh until its next tally
+			if _, ok := restingDenoms[denom]; ok {
+				if exchangeRate, ok := previousRates[denom]; ok {
+					k.SetExchangeRate(ctx, denom, exchangeRate)
+				}
+				continue
+			}
+
+			_, tallied := talliedDenoms[denom]
+			k.CountDenomTally(ctx, denom, tallied)
+			if tallied {
+				k.DeleteStaleCounter(ctx, denom)
+				continue
+			}
+
+			// A denom required by another module, quoting or tracked by other denoms is never delisted automatically
+			staleCounter := k.GetStaleCounter(ctx, denom) + 1
+			if params.AutoDelistAfterStaleWindows == 0 || staleCounter < params.AutoDelistAfterStaleWindows ||
+				k.IsRequiredDenom(ctx, denom) || len(params.Whitelist.QuotedIn(denom)) > 0 ||
+				len(params.Whitelist.TrackedBy(denom)) > 0 {
+				k.SetStaleCounter(ctx, denom, staleCounter)
+
+				// The stale counter doubles as the number of periods the rate was carried forward
+				if exchangeRate, ok := previousRates[denom]; ok && staleCounter <= params.MaxCarryForwardPeriods {
+					k.SetExchangeRate(ctx, denom, exchangeRate)
+				}
+				continue
+			}
 
-				// Set the exchange rate, emit ABCI event
-				k.SetExchangeRateWithEvent(ctx, denom, exchangeRate)
+			k.DelistDenom(ctx, denom)
+			delistings = append(delistings, autoDelisting{denom: denom, staleWindows: staleCounter})
+		}
+		emitDenomsAutoDelisted(ctx, delistings, params.MaxEventDenomsPerBlock)
+		k.MirrorTrackedDenoms(ctx, params.Whitelist)
+
+		// Record the power of the ballot winners for reward estimation
+		winningPower := int64(0)
+		for _, claim := range validatorClaimMap {
+			if claim.WinCount > 0 {
+				winningPower += claim.Power
 			}
 		}
+		k.SetWinningPower(ctx, winningPower)
 
 		//---------------------------
 		// Do miss counting & slashing
@@ -97,6 +345,16 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 		// Check if each validator is missing a required denom price
 		for _, claim := range validatorClaimMap {
 			for _, denom := range voteTargets {
+				if _, ok := graceDenoms[denom]; ok {
+					continue
+				}
+				if _, ok := restingDenoms[denom]; ok {
+					continue
+				}
+				if _, ok := trackingDenoms[denom]; ok {
+					continue
+				}
+
 				_, ok := denomMap[denom][claim.Recipient.String()]
 				if !ok {
 					missMap[claim.Recipient.String()] = claim.Recipient
@@ -105,27 +363,97 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 			}
 		}
 
+		// Misses are not counted right after a chain upgrade, as the feeders may lag behind
+		if k.IsPostUpgradeGrace(ctx, votePeriod) {
+			missMap = map[string]sdk.ValAddress{}
+		}
+
+		// Misses of a validator which prevoted but did not reveal are also counted
+		// apart, so they can be weighted by RevealMissWeight. A validator whose miss
+		// rate crosses the one it declared to accept is alerted on. The misses of an
+		// exempt observer are not counted, so they are never slashed once it expires.
+		// The missing validators are walked in address order, so the alerts are emitted
+		// in the same order on every node.
+		missAddrs := make([]sdk.ValAddress, 0, len(missMap))
 		for _, valAddr := range missMap {
-			k.SetMissCounter(ctx, valAddr, k.GetMissCounter(ctx, valAddr)+1)
+			missAddrs = append(missAddrs, valAddr)
+		}
+		sort.Slice(missAddrs, func(i, j int) bool {
+			return bytes.Compare(missAddrs[i], missAddrs[j]) < 0
+		})
+		for _, valAddr := range missAddrs {
+			if k.IsExemptObserver(ctx, valAddr) {
+				continue
+			}
+
+			config, hasAlert := k.GetOracleAlertConfig(ctx, valAddr)
+			var previousMissRate sdk.Dec
+			if hasAlert {
+				previousMissRate = k.MissRate(ctx, valAddr)
+			}
+
+			k.IncrementMissCounter(ctx, valAddr)
+			if k.IsRevealMiss(ctx, valAddr) {
+				k.IncrementRevealMissCounter(ctx, valAddr)
+			}
+
+			if hasAlert {
+				missRate := k.MissRate(ctx, valAddr)
+				if previousMissRate.LTE(config.MaxMissRate) && missRate.GT(config.MaxMissRate) {
+					emitOracleAlertCrossed(ctx, valAddr, missRate, config.MaxMissRate)
+				}
+			}
 		}
 
-		// // Distribute rewards to ballot winners
-		// k.RewardBallotWinners(
-		// 	ctx,
-		// 	(int64)(params.VotePeriod),
-		// 	(int64)(params.RewardDistributionWindow),
-		// 	voteTargets,
-		// 	validatorClaimMap,
-		// )
+		// Distribute rewards to ballot winners, the reward pool is left untouched if it cannot be.
+		// The rewards stay disabled on chains which do not share fees with the oracle.
+		if params.OracleFeeShare.IsPositive() {
+			rewardCtx, writeRewards := ctx.CacheContext()
+			if err := k.RewardBallotWinners(
+				rewardCtx,
+				(int64)(params.VotePeriod),
+				(int64)(params.RewardDistributionWindow),
+				validatorClaimMap,
+			); err != nil {
+				k.Logger(ctx).Error("failed to distribute the oracle rewards", "err", err)
+			} else {
+				writeRewards()
+			}
+		}
 
-		// Clear the ballot
-		k.ClearBallots(ctx, params.VotePeriod)
+		// Keep the submissions for the deviation query and the participation of the bonded
+		// validators for the participation summary, and clear the ballot
+		participation := types.VotePeriodParticipation{BondedValidators: uint64(len(validatorClaimMap))}
+		for _, claim := range validatorClaimMap {
+			participation.BondedPower += claim.Power
+		}
+		k.IterateAggregateExchangeRateVotes(ctx, func(voterAddr sdk.ValAddress, vote types.AggregateExchangeRateVote) (stop bool) {
+			k.SetLastSubmission(ctx, voterAddr, vote)
+			if claim, ok := validatorClaimMap[voterAddr.String()]; ok {
+				participation.VotedPower += claim.Power
+				participation.Voters++
+			}
+			return false
+		})
+		k.SetVotePeriodParticipation(ctx, participation)
+		k.SetParticipationPoint(ctx, types.ParticipationPoint{
+			VotePeriod:         votePeriod,
+			ParticipationRatio: participation.Ratio(),
+		})
+		k.ClearBallots(ctx, k.PrevoteRetentionBlocks(ctx))
+
+		// A change of the commitment hash algorithm takes effect with the next vote period
+		k.SwitchCommitmentHashAlgo(ctx)
+
+		k.EndVotePeriod(ctx)
 	}
 
 	// Do slash who did miss voting over threshold and
 	// reset miss counters of all validators at the last block of slash window
-	if IsPeriodLastBlock(ctx, params.SlashWindow) {
+	if IsPeriodLastBlock(ctx, k.SlashWindow(ctx)) {
 		k.SlashAndResetMissCounters(ctx)
+		k.ClearDenomTallyCounters(ctx)
+		k.RollValidatorAccuracyCounters(ctx)
 	}
 
 	return nil
@@ -134,3 +462,116 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) error {
 func IsPeriodLastBlock(ctx sdk.Context, blocksPerPeriod uint64) bool {
 	return (uint64(ctx.BlockHeight())+1)%blocksPerPeriod == 0
 }
+
+// exceedsEventDenoms returns whether per-denom events for that many denoms are to be coalesced
+func exceedsEventDenoms(count int, maxDenoms uint64) bool {
+	return maxDenoms != 0 && uint64(count) > maxDenoms
+}
+
+// emitExchangeRateUpdates emits an exchange_rate_update event per updated denom, or a single
+// exchange_rate_updates event listing all of them once there are more than maxDenoms
+func emitExchangeRateUpdates(ctx sdk.Context, rates types.ExchangeRateTuples, maxDenoms uint64) {
+	if len(rates) == 0 {
+		return
+	}
+	sort.Slice(rates, func(i, j int) bool {
+		return rates[i].Denom < rates[j].Denom
+	})
+
+	if !exceedsEventDenoms(len(rates), maxDenoms) {
+		for _, rate := range rates {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(types.EventTypeExchangeRateUpdate,
+					sdk.NewAttribute(types.AttributeKeyDenom, rate.Denom),
+					sdk.NewAttribute(types.AttributeKeyExchangeRate, rate.ExchangeRate.String()),
+				),
+			)
+		}
+		return
+	}
+
+	// Same format as the exchange rates of a vote, so it parses with ParseExchangeRateTuples
+	entries := make([]string, len(rates))
+	for i, rate := range rates {
+		entries[i] = rate.ExchangeRate.String() + rate.Denom
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeExchangeRateUpdates,
+			sdk.NewAttribute(types.AttributeKeyExchangeRates, strings.Join(entries, ",")),
+			sdk.NewAttribute(types.AttributeKeyCount, fmt.Sprint(len(rates))),
+		),
+	)
+}
+
+// emitOracleUpdate emits the typed EventOracleUpdate listing the exchange rates set by the tally
+// of the vote period, none if no denom tallied
+func emitOracleUpdate(ctx sdk.Context, rates types.ExchangeRateTuples, votePeriod uint64) error {
+	sort.Slice(rates, func(i, j int) bool {
+		return rates[i].Denom < rates[j].Denom
+	})
+
+	entries := make([]types.OracleUpdateEntry, len(rates))
+	for i, rate := range rates {
+		entries[i] = types.OracleUpdateEntry{
+			Denom:        rate.Denom,
+			ExchangeRate: rate.ExchangeRate,
+			VotePeriod:   votePeriod,
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventOracleUpdate{
+		Version:    types.EventOracleUpdateVersion,
+		VotePeriod: votePeriod,
+		Entries:    entries,
+	})
+}
+
+// emitOracleAlertCrossed emits an oracle_alert_crossed event for a validator whose miss rate in the
+// slash window went past the one it declared to accept
+func emitOracleAlertCrossed(ctx sdk.Context, operator sdk.ValAddress, missRate, maxMissRate sdk.Dec) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeOracleAlertCrossed,
+			sdk.NewAttribute(types.AttributeKeyOperator, operator.String()),
+			sdk.NewAttribute(types.AttributeKeyMissRate, missRate.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxMissRate, maxMissRate.String()),
+		),
+	)
+}
+
+// autoDelisting is a denom delisted for being stale for staleWindows vote periods
+type autoDelisting struct {
+	denom        string
+	staleWindows uint64
+}
+
+// emitDenomsAutoDelisted emits a denom_auto_delisted event per delisted denom, or a single
+// denoms_auto_delisted event listing all of them once there are more than maxDenoms
+func emitDenomsAutoDelisted(ctx sdk.Context, delistings []autoDelisting, maxDenoms uint64) {
+	if len(delistings) == 0 {
+		return
+	}
+
+	if !exceedsEventDenoms(len(delistings), maxDenoms) {
+		for _, delisting := range delistings {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(types.EventTypeDenomAutoDelisted,
+					sdk.NewAttribute(types.AttributeKeyDenom, delisting.denom),
+					sdk.NewAttribute(types.AttributeKeyStaleWindows, fmt.Sprint(delisting.staleWindows)),
+				),
+			)
+		}
+		return
+	}
+
+	denoms := make([]string, len(delistings))
+	for i, delisting := range delistings {
+		denoms[i] = delisting.denom
+	}
+	sort.Strings(denoms)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeDenomsAutoDelisted,
+			sdk.NewAttribute(types.AttributeKeyDenoms, strings.Join(denoms, ",")),
+			sdk.NewAttribute(types.AttributeKeyCount, fmt.Sprint(len(denoms))),
+		),
+	)
+}
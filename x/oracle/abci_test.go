@@ -1,15 +1,21 @@
 package oracle_test
 
 import (
+	"bytes"
 	"fmt"
 	"math"
+	"math/big"
 	"sort"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/cometbft/cometbft/libs/rand"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/Team-Kujira/core/x/oracle"
@@ -107,19 +113,1043 @@ func TestOracleThreshold(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestOracleThresholdExcludeJailed(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		excludeJailed bool
+		expectTally   bool
+	}{
+		{"jailed power counted", false, false},
+		{"jailed power excluded", true, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			input, h := setupVal5(t)
+			params := input.OracleKeeper.GetParams(input.Ctx)
+			params.ExcludeJailedFromThreshold = tc.excludeJailed
+			input.OracleKeeper.SetParams(input.Ctx, params)
+
+			// Jail 2 of the 5 validators; their tokens stay bonded until the staking end blocker runs
+			for _, i := range []int{3, 4} {
+				input.StakingKeeper.Jail(input.Ctx, sdk.ConsAddress(keeper.ValPubKeys[i].Address()))
+			}
+			require.Equal(t, int64(20), input.OracleKeeper.JailedBondedPower(input.Ctx))
+
+			// 2 of the 3 remaining validators vote: 20 of 50 total power, 20 of 30 excluding jailed power
+			for _, i := range []int{0, 1} {
+				makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomD, Amount: randomExchangeRate}}, i)
+			}
+
+			oracle.EndBlocker(input.Ctx.WithBlockHeight(1), input.OracleKeeper)
+
+			rate, err := input.OracleKeeper.GetExchangeRate(input.Ctx, types.TestDenomD)
+			if tc.expectTally {
+				require.NoError(t, err)
+				require.Equal(t, randomExchangeRate, rate)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestOracleDrop(t *testing.T) {
 	input, h := setup(t)
 
-	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomC, randomExchangeRate)
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomC, randomExchangeRate)
+
+	// Account 1, DenomC
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: randomExchangeRate}}, 0)
+
+	// Immediately swap halt after an illiquid oracle vote
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	_, err := input.OracleKeeper.GetExchangeRate(input.Ctx, types.TestDenomC)
+	require.Error(t, err)
+}
+
+func TestOracleAutoDelistStaleDenom(t *testing.T) {
+	input, h := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomC}, {Name: types.TestDenomD}}
+	params.AutoDelistAfterStaleWindows = 3
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// Nobody feeds DenomD anymore
+	tallyPeriod := func() {
+		for i := 0; i < 3; i++ {
+			makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: randomExchangeRate}}, i)
+		}
+		input.Ctx = input.Ctx.WithEventManager(sdk.NewEventManager())
+		oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	}
+
+	for i := uint64(1); i < params.AutoDelistAfterStaleWindows; i++ {
+		tallyPeriod()
+		require.Equal(t, i, input.OracleKeeper.GetStaleCounter(input.Ctx, types.TestDenomD))
+		require.Equal(t, uint64(0), input.OracleKeeper.GetStaleCounter(input.Ctx, types.TestDenomC))
+		require.Len(t, input.OracleKeeper.GetParams(input.Ctx).Whitelist, 2)
+	}
+
+	tallyPeriod()
+	require.Equal(t, types.DenomList{{Name: types.TestDenomC}}, input.OracleKeeper.GetParams(input.Ctx).Whitelist)
+	require.Equal(t, uint64(0), input.OracleKeeper.GetStaleCounter(input.Ctx, types.TestDenomD))
+	_, err := input.OracleKeeper.GetExchangeRate(input.Ctx, types.TestDenomD)
+	require.Error(t, err)
+
+	delisted := false
+	for _, event := range input.Ctx.EventManager().Events() {
+		if event.Type == types.EventTypeDenomAutoDelisted {
+			delisted = true
+			require.Equal(t, types.TestDenomD, event.Attributes[0].Value)
+			require.Equal(t, "3", event.Attributes[1].Value)
+		}
+	}
+	require.True(t, delisted)
+
+	// DenomC keeps being tallied
+	rate, err := input.OracleKeeper.GetExchangeRate(input.Ctx, types.TestDenomC)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, rate)
+}
+
+func TestOracleEventCoalescing(t *testing.T) {
+	input, h := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomC}, {Name: types.TestDenomD}}
+	params.MaxEventDenomsPerBlock = 2
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	tallyPeriod := func() sdk.Events {
+		for i := 0; i < 3; i++ {
+			makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{
+				{Denom: types.TestDenomC, Amount: randomExchangeRate},
+				{Denom: types.TestDenomD, Amount: sdk.NewDec(2)},
+			}, i)
+		}
+		input.Ctx = input.Ctx.WithEventManager(sdk.NewEventManager())
+		oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+		return input.Ctx.EventManager().Events()
+	}
+
+	// At the threshold, an event is emitted per denom
+	var updated []string
+	for _, event := range tallyPeriod() {
+		require.NotEqual(t, types.EventTypeExchangeRateUpdates, event.Type)
+		if event.Type == types.EventTypeExchangeRateUpdate {
+			updated = append(updated, event.Attributes[0].Value)
+		}
+	}
+	require.Equal(t, []string{types.TestDenomC, types.TestDenomD}, updated)
+
+	// Above it, a single summary event lists all of them
+	params.MaxEventDenomsPerBlock = 1
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	summaries := 0
+	for _, event := range tallyPeriod() {
+		require.NotEqual(t, types.EventTypeExchangeRateUpdate, event.Type)
+		if event.Type == types.EventTypeExchangeRateUpdates {
+			summaries++
+			require.Equal(t, types.AttributeKeyExchangeRates, event.Attributes[0].Key)
+			rates, err := types.ParseExchangeRateTuples(event.Attributes[0].Value)
+			require.NoError(t, err)
+			require.Equal(t, types.ExchangeRateTuples{
+				types.NewExchangeRateTuple(types.TestDenomC, randomExchangeRate),
+				types.NewExchangeRateTuple(types.TestDenomD, sdk.NewDec(2)),
+			}, rates)
+			require.Equal(t, "2", event.Attributes[1].Value)
+		}
+	}
+	require.Equal(t, 1, summaries)
+}
+
+func TestOracleUpdateTypedEvent(t *testing.T) {
+	input, h := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomD}, {Name: types.TestDenomC}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	tallyPeriod := func(vote bool) (*types.EventOracleUpdate, int) {
+		if vote {
+			for i := 0; i < 3; i++ {
+				makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{
+					{Denom: types.TestDenomC, Amount: randomExchangeRate},
+					{Denom: types.TestDenomD, Amount: sdk.NewDec(2)},
+				}, i)
+			}
+		}
+		input.Ctx = input.Ctx.WithEventManager(sdk.NewEventManager())
+		require.NoError(t, oracle.EndBlocker(input.Ctx, input.OracleKeeper))
+
+		var update *types.EventOracleUpdate
+		legacy := 0
+		for _, event := range input.Ctx.EventManager().ABCIEvents() {
+			switch event.Type {
+			case types.EventTypeExchangeRateUpdate:
+				legacy++
+			case proto.MessageName(&types.EventOracleUpdate{}):
+				require.Nil(t, update, "more than one typed event")
+				msg, err := sdk.ParseTypedEvent(event)
+				require.NoError(t, err)
+				update = msg.(*types.EventOracleUpdate)
+			}
+		}
+		require.NotNil(t, update)
+		return update, legacy
+	}
+
+	// The typed event lists the rates sorted by denom, along with the legacy events
+	update, legacy := tallyPeriod(true)
+	require.Equal(t, 2, legacy)
+	votePeriod := input.OracleKeeper.CurrentVotePeriod(input.Ctx)
+	require.Equal(t, &types.EventOracleUpdate{
+		Version:    types.EventOracleUpdateVersion,
+		VotePeriod: votePeriod,
+		Entries: []types.OracleUpdateEntry{
+			{Denom: types.TestDenomC, ExchangeRate: randomExchangeRate, VotePeriod: votePeriod},
+			{Denom: types.TestDenomD, ExchangeRate: sdk.NewDec(2), VotePeriod: votePeriod},
+		},
+	}, update)
+
+	// Without the legacy events only the typed event is emitted
+	params.LegacyRateEvents = false
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	update, legacy = tallyPeriod(true)
+	require.Zero(t, legacy)
+	require.Len(t, update.Entries, 2)
+
+	// A tally without votes still emits it, without entries
+	update, _ = tallyPeriod(false)
+	require.Empty(t, update.Entries)
+}
+
+func TestOracleAlertCrossed(t *testing.T) {
+	input, h := setup(t)
+
+	// A slash window of 100 vote periods, the third validator accepting to miss 1 of them
+	_, err := h.SetOracleAlertConfig(sdk.WrapSDKContext(input.Ctx), types.NewMsgSetOracleAlertConfig(keeper.ValAddrs[2], sdk.NewDecWithPrec(1, 2)))
+	require.NoError(t, err)
+
+	tallyPeriod := func() []sdk.Event {
+		for i := 0; i < 2; i++ {
+			makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{
+				{Denom: types.TestDenomA, Amount: randomExchangeRate},
+				{Denom: types.TestDenomC, Amount: randomExchangeRate},
+				{Denom: types.TestDenomD, Amount: randomExchangeRate},
+			}, i)
+		}
+		input.Ctx = input.Ctx.WithEventManager(sdk.NewEventManager())
+		require.NoError(t, oracle.EndBlocker(input.Ctx, input.OracleKeeper))
+
+		var crossed []sdk.Event
+		for _, event := range input.Ctx.EventManager().Events() {
+			if event.Type == types.EventTypeOracleAlertCrossed {
+				crossed = append(crossed, event)
+			}
+		}
+		return crossed
+	}
+
+	// The first miss reaches the declared rate without going past it
+	require.Empty(t, tallyPeriod())
+
+	// The second one crosses it
+	crossed := tallyPeriod()
+	require.Len(t, crossed, 1)
+	attributes := map[string]string{}
+	for _, attribute := range crossed[0].Attributes {
+		attributes[attribute.Key] = attribute.Value
+	}
+	require.Equal(t, map[string]string{
+		types.AttributeKeyOperator:    keeper.ValAddrs[2].String(),
+		types.AttributeKeyMissRate:    sdk.NewDecWithPrec(2, 2).String(),
+		types.AttributeKeyMaxMissRate: sdk.NewDecWithPrec(1, 2).String(),
+	}, attributes)
+
+	// Once past it, further misses are not alerted on again within the window
+	require.Empty(t, tallyPeriod())
+	require.Equal(t, uint64(3), input.OracleKeeper.GetMissCounter(input.Ctx, keeper.ValAddrs[2]))
+}
+
+func TestOracleAlertCrossedOrder(t *testing.T) {
+	input, h := setup(t)
+
+	for _, valAddr := range keeper.ValAddrs[:3] {
+		_, err := h.SetOracleAlertConfig(sdk.WrapSDKContext(input.Ctx), types.NewMsgSetOracleAlertConfig(valAddr, sdk.NewDecWithPrec(1, 2)))
+		require.NoError(t, err)
+	}
+
+	// Nobody votes, so all of them cross their rate on the second miss
+	require.NoError(t, oracle.EndBlocker(input.Ctx, input.OracleKeeper))
+	input.Ctx = input.Ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, oracle.EndBlocker(input.Ctx, input.OracleKeeper))
+
+	var operators []string
+	for _, event := range input.Ctx.EventManager().Events() {
+		if event.Type != types.EventTypeOracleAlertCrossed {
+			continue
+		}
+		for _, attribute := range event.Attributes {
+			if attribute.Key == types.AttributeKeyOperator {
+				operators = append(operators, attribute.Value)
+			}
+		}
+	}
+
+	// The alerts are emitted in validator address order
+	expected := []sdk.ValAddress{keeper.ValAddrs[0], keeper.ValAddrs[1], keeper.ValAddrs[2]}
+	sort.Slice(expected, func(i, j int) bool {
+		return bytes.Compare(expected[i], expected[j]) < 0
+	})
+	require.Equal(t, []string{expected[0].String(), expected[1].String(), expected[2].String()}, operators)
+}
+
+func TestOracleAutoDelistEventCoalescing(t *testing.T) {
+	input, _ := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomD}, {Name: types.TestDenomC}}
+	params.AutoDelistAfterStaleWindows = 1
+	params.MaxEventDenomsPerBlock = 1
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	require.Empty(t, input.OracleKeeper.GetParams(input.Ctx).Whitelist)
+
+	summaries := 0
+	for _, event := range input.Ctx.EventManager().Events() {
+		require.NotEqual(t, types.EventTypeDenomAutoDelisted, event.Type)
+		if event.Type == types.EventTypeDenomsAutoDelisted {
+			summaries++
+			require.Equal(t, types.TestDenomC+","+types.TestDenomD, event.Attributes[0].Value)
+			require.Equal(t, "2", event.Attributes[1].Value)
+		}
+	}
+	require.Equal(t, 1, summaries)
+}
+
+func TestOracleQuoteDenom(t *testing.T) {
+	input, h := setup(t)
+
+	// DenomC is quoted in DenomD
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomC, QuoteDenom: types.TestDenomD}, {Name: types.TestDenomD}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	for i := 0; i < 3; i++ {
+		makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{
+			{Denom: types.TestDenomC, Amount: sdk.NewDecWithPrec(25, 2)},
+			{Denom: types.TestDenomD, Amount: sdk.NewDec(8)},
+		}, i)
+	}
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	// The tallied rate is kept in the quote denom and converted to USD through it
+	rate, err := input.OracleKeeper.GetExchangeRate(input.Ctx, types.TestDenomC)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(25, 2), rate)
+	rate, err = input.OracleKeeper.GetUSDExchangeRate(input.Ctx, types.TestDenomC)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(2), rate)
+}
+
+func TestOracleAutoDelistQuoteDenom(t *testing.T) {
+	input, _ := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomC, QuoteDenom: types.TestDenomD}, {Name: types.TestDenomD}}
+	params.AutoDelistAfterStaleWindows = 1
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// The quote denom is only delisted after the denoms quoted in it
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	require.Equal(t, types.DenomList{{Name: types.TestDenomD}}, input.OracleKeeper.GetParams(input.Ctx).Whitelist)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	require.Empty(t, input.OracleKeeper.GetParams(input.Ctx).Whitelist)
+}
+
+func TestOracleTrackedDenom(t *testing.T) {
+	input, h := setup(t)
+
+	// DenomC tracks DenomD
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomC, Tracks: types.TestDenomD}, {Name: types.TestDenomD}}
+	params.MaxCarryForwardPeriods = 1
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// Only DenomD is voted on, and a vote on DenomC is ignored
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{
+		{Denom: types.TestDenomC, Amount: sdk.NewDec(100)},
+		{Denom: types.TestDenomD, Amount: sdk.NewDec(8)},
+	}, 0)
+	for i := 1; i < 3; i++ {
+		makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomD, Amount: sdk.NewDec(8)}}, i)
+	}
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	// The tracking denom mirrors the tallied rate, and nobody missed it
+	rate, err := input.OracleKeeper.GetExchangeRate(input.Ctx, types.TestDenomC)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(8), rate)
+	require.Equal(t, uint64(3), input.OracleKeeper.GetDenomVoterCount(input.Ctx, types.TestDenomC))
+	outcome, ok := input.OracleKeeper.GetDenomTallyOutcome(input.Ctx, types.TestDenomC)
+	require.True(t, ok)
+	require.Equal(t, types.TallyOutcomeTracking, outcome.Reason)
+	for _, valAddr := range keeper.ValAddrs[:3] {
+		require.Equal(t, uint64(0), input.OracleKeeper.GetMissCounter(input.Ctx, valAddr))
+	}
+
+	// The rate carried forward is mirrored along with its age
+	input.Ctx = input.Ctx.WithBlockHeight(input.Ctx.BlockHeight() + 1)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	rate, err = input.OracleKeeper.GetExchangeRate(input.Ctx, types.TestDenomC)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(8), rate)
+	require.Equal(t, uint64(1), input.OracleKeeper.GetStaleCounter(input.Ctx, types.TestDenomC))
+
+	// Without a rate to track, the tracking denom has none either
+	input.Ctx = input.Ctx.WithBlockHeight(input.Ctx.BlockHeight() + 1)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	_, err = input.OracleKeeper.GetExchangeRate(input.Ctx, types.TestDenomC)
+	require.Error(t, err)
+	require.Equal(t, uint64(2), input.OracleKeeper.GetStaleCounter(input.Ctx, types.TestDenomC))
+}
+
+func TestOracleAutoDelistTrackedDenom(t *testing.T) {
+	input, _ := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomC, Tracks: types.TestDenomD}, {Name: types.TestDenomD}}
+	params.AutoDelistAfterStaleWindows = 1
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// Neither the tracked denom nor the tracking one is delisted automatically
+	for i := 0; i < 3; i++ {
+		oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	}
+	require.Equal(t, params.Whitelist, input.OracleKeeper.GetParams(input.Ctx).Whitelist)
+}
+
+func TestOracleAutoDelistDisabled(t *testing.T) {
+	input, _ := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomD}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	for i := 0; i < 10; i++ {
+		oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	}
+	require.Equal(t, uint64(10), input.OracleKeeper.GetStaleCounter(input.Ctx, types.TestDenomD))
+	require.Len(t, input.OracleKeeper.GetParams(input.Ctx).Whitelist, 1)
+}
+
+func TestOracleAutoDelistRequiredDenom(t *testing.T) {
+	input, _ := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomD}}
+	params.AutoDelistAfterStaleWindows = 3
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	require.NoError(t, input.OracleKeeper.RegisterRequiredDenom(input.Ctx, types.TestDenomD, "lending"))
+
+	for i := 0; i < 5; i++ {
+		oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	}
+	require.Equal(t, uint64(5), input.OracleKeeper.GetStaleCounter(input.Ctx, types.TestDenomD))
+	require.Len(t, input.OracleKeeper.GetParams(input.Ctx).Whitelist, 1)
+}
+
+func TestOracleDenomGrace(t *testing.T) {
+	input, h := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomC}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// Everybody feeds DenomC only
+	tallyPeriod := func() {
+		for i := 0; i < 3; i++ {
+			makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: randomExchangeRate}}, i)
+		}
+		oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+		input.Ctx = input.Ctx.WithBlockHeight(input.Ctx.BlockHeight() + 1)
+	}
+
+	tallyPeriod()
+
+	// DenomD is listed with a grace window of two vote periods
+	params.Whitelist = types.DenomList{{Name: types.TestDenomC}, {Name: types.TestDenomD}}
+	params.DenomGracePeriods = 2
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	for i := 0; i < 2; i++ {
+		tallyPeriod()
+		for _, valAddr := range keeper.ValAddrs[:3] {
+			require.Equal(t, uint64(0), input.OracleKeeper.GetMissCounter(input.Ctx, valAddr))
+		}
+	}
+
+	// DenomC was listed before the window was configured
+	require.False(t, input.OracleKeeper.IsDenomInGrace(input.Ctx, types.TestDenomC, uint64(input.Ctx.BlockHeight())))
+
+	// Missing DenomD is counted once the window is over
+	require.False(t, input.OracleKeeper.IsDenomInGrace(input.Ctx, types.TestDenomD, uint64(input.Ctx.BlockHeight())))
+	tallyPeriod()
+	for _, valAddr := range keeper.ValAddrs[:3] {
+		require.Equal(t, uint64(1), input.OracleKeeper.GetMissCounter(input.Ctx, valAddr))
+	}
+}
+
+func TestOraclePostUpgradeGrace(t *testing.T) {
+	input, h := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomC}}
+	params.PostUpgradeGracePeriods = 2
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// Validator 2 lags behind the upgraded chain and does not vote
+	tallyPeriod := func() {
+		for i := 0; i < 2; i++ {
+			makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: randomExchangeRate}}, i)
+		}
+		oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+		input.Ctx = input.Ctx.WithBlockHeight(input.Ctx.BlockHeight() + 1)
+	}
+
+	// Without an upgrade recorded, the miss is counted
+	tallyPeriod()
+	require.Equal(t, uint64(1), input.OracleKeeper.GetMissCounter(input.Ctx, keeper.ValAddrs[2]))
+
+	// The upgrade handler starts the grace with the current vote period
+	input.OracleKeeper.RecordUpgrade(input.Ctx)
+	for i := 0; i < 2; i++ {
+		tallyPeriod()
+		require.Equal(t, uint64(1), input.OracleKeeper.GetMissCounter(input.Ctx, keeper.ValAddrs[2]))
+	}
+
+	// Missing is counted again once the grace is over
+	tallyPeriod()
+	require.Equal(t, uint64(2), input.OracleKeeper.GetMissCounter(input.Ctx, keeper.ValAddrs[2]))
+	require.Equal(t, uint64(0), input.OracleKeeper.GetMissCounter(input.Ctx, keeper.ValAddrs[0]))
+
+	// Zero disables the grace
+	params.PostUpgradeGracePeriods = 0
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	input.OracleKeeper.RecordUpgrade(input.Ctx)
+	tallyPeriod()
+	require.Equal(t, uint64(3), input.OracleKeeper.GetMissCounter(input.Ctx, keeper.ValAddrs[2]))
+}
+
+func TestOracleUpgradeGraceSlashing(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomC}}
+	params.PostUpgradeGracePeriods = params.SlashWindow
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	input.OracleKeeper.RecordUpgrade(input.Ctx)
+
+	// Validator 0 misses every vote period of the slash window right after the upgrade
+	votePeriodsPerWindow := int64(params.SlashWindow / params.VotePeriod)
+	for i := int64(0); i < votePeriodsPerWindow; i++ {
+		input.Ctx = input.Ctx.WithBlockHeight(i)
+		makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: randomExchangeRate}}, 1)
+		makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: randomExchangeRate}}, 2)
+		oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	}
+
+	validator := input.StakingKeeper.Validator(input.Ctx, keeper.ValAddrs[0])
+	require.Equal(t, stakingAmt, validator.GetBondedTokens())
+	require.False(t, validator.IsJailed())
+}
+
+func TestOracleCarryForward(t *testing.T) {
+	input, h := setup(t)
+	querier := keeper.NewQuerier(input.OracleKeeper)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomC}}
+	params.MaxCarryForwardPeriods = 2
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	tallyPeriod := func(rate *sdk.Dec) {
+		if rate != nil {
+			for i := 0; i < 3; i++ {
+				makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: *rate}}, i)
+			}
+		}
+		oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	}
+
+	requireRate := func(rate sdk.Dec, carriedPeriods uint64) {
+		res, err := querier.ExchangeRate(sdk.WrapSDKContext(input.Ctx), &types.QueryExchangeRateRequest{Denom: types.TestDenomC})
+		require.NoError(t, err)
+		require.Equal(t, rate, res.ExchangeRate)
+		require.Equal(t, carriedPeriods > 0, res.CarriedForward)
+		require.Equal(t, carriedPeriods, res.CarriedPeriods)
+	}
+
+	rate1, rate2 := sdk.NewDec(10), sdk.NewDec(20)
+	tallyPeriod(&rate1)
+	requireRate(rate1, 0)
+
+	// The rate is carried forward for up to two failed periods
+	tallyPeriod(nil)
+	requireRate(rate1, 1)
+	tallyPeriod(nil)
+	requireRate(rate1, 2)
+	tallyPeriod(nil)
+	_, err := input.OracleKeeper.GetExchangeRate(input.Ctx, types.TestDenomC)
+	require.Error(t, err)
+
+	// A successful tally resets the count
+	tallyPeriod(&rate2)
+	requireRate(rate2, 0)
+	tallyPeriod(nil)
+	requireRate(rate2, 1)
+	tallyPeriod(&rate1)
+	requireRate(rate1, 0)
+
+	// Nothing is carried forward when disabled
+	params.MaxCarryForwardPeriods = 0
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	tallyPeriod(nil)
+	_, err = input.OracleKeeper.GetExchangeRate(input.Ctx, types.TestDenomC)
+	require.Error(t, err)
+}
+
+func TestOracleRateDeviation(t *testing.T) {
+	input, h := setup(t)
+	querier := keeper.NewQuerier(input.OracleKeeper)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomC}, {Name: types.TestDenomD}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	queryDeviation := func(i int) (*types.QueryValidatorRateDeviationResponse, error) {
+		return querier.ValidatorRateDeviation(sdk.WrapSDKContext(input.Ctx), &types.QueryValidatorRateDeviationRequest{
+			ValidatorAddr: keeper.ValAddrs[i].String(),
+		})
+	}
+
+	// DenomD only gets a vote from validator 2 and does not tally
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: sdk.NewDec(100)}}, 0)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: sdk.NewDec(100)}}, 1)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{
+		{Denom: types.TestDenomC, Amount: sdk.NewDec(125)},
+		{Denom: types.TestDenomD, Amount: sdk.NewDec(1)},
+	}, 2)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	res, err := queryDeviation(0)
+	require.NoError(t, err)
+	require.Len(t, res.Deviations, 1)
+	require.Equal(t, types.RateDeviation{
+		Denom:        types.TestDenomC,
+		Submitted:    sdk.NewDec(100),
+		Finalized:    sdk.NewDec(100),
+		PercentDiff:  sdk.ZeroDec(),
+		InRewardBand: true,
+	}, res.Deviations[0])
+
+	// The spread of the ballot is below the 25% difference
+	res, err = queryDeviation(2)
+	require.NoError(t, err)
+	require.Equal(t, []types.RateDeviation{{
+		Denom:        types.TestDenomC,
+		Submitted:    sdk.NewDec(125),
+		Finalized:    sdk.NewDec(100),
+		PercentDiff:  sdk.NewDec(25),
+		InRewardBand: false,
+	}}, res.Deviations)
+
+	// Only the last vote period is kept
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	_, err = queryDeviation(0)
+	require.Error(t, err)
+}
+
+func TestOracleBandMembershipStats(t *testing.T) {
+	input, h := setup(t)
+	querier := keeper.NewQuerier(input.OracleKeeper)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomC}, {Name: types.TestDenomD}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// Validator 2 votes outside the reward band of DenomC, DenomD does not tally
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: sdk.NewDec(100)}}, 0)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: sdk.NewDec(100)}}, 1)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{
+		{Denom: types.TestDenomC, Amount: sdk.NewDec(125)},
+		{Denom: types.TestDenomD, Amount: sdk.NewDec(1)},
+	}, 2)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	power := stakingAmt.Quo(sdk.DefaultPowerReduction).Int64()
+	res, err := querier.BandMembershipStats(sdk.WrapSDKContext(input.Ctx), &types.QueryBandMembershipStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.BandMembershipStats{{
+		Denom:          types.TestDenomC,
+		RatedPower:     3 * power,
+		InBandPower:    2 * power,
+		InBandFraction: sdk.NewDec(2).QuoInt64(3),
+	}}, res.Stats)
+
+	// Only the last vote period is kept
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	res, err = querier.BandMembershipStats(sdk.WrapSDKContext(input.Ctx), &types.QueryBandMembershipStatsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Stats)
+}
+
+func TestOracleValidatorAccuracy(t *testing.T) {
+	input, h := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomC}, {Name: types.TestDenomD}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// Validator 2 is off on DenomC and abstains on DenomD
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{
+		{Denom: types.TestDenomC, Amount: sdk.NewDec(100)},
+		{Denom: types.TestDenomD, Amount: sdk.NewDec(1)},
+	}, 0)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{
+		{Denom: types.TestDenomC, Amount: sdk.NewDec(100)},
+		{Denom: types.TestDenomD, Amount: sdk.NewDec(1)},
+	}, 1)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{
+		{Denom: types.TestDenomC, Amount: sdk.NewDec(125)},
+		{Denom: types.TestDenomD, Amount: sdk.ZeroDec()},
+	}, 2)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	require.Equal(t, types.ValidatorAccuracyCounter{Submissions: 2, InBandSubmissions: 2}, input.OracleKeeper.GetValidatorAccuracyCounter(input.Ctx, keeper.ValAddrs[0]))
+	require.Equal(t, types.ValidatorAccuracyCounter{Submissions: 1}, input.OracleKeeper.GetValidatorAccuracyCounter(input.Ctx, keeper.ValAddrs[2]))
+
+	// The counts are kept as the previous slash window at the end of the slash window
+	input.Ctx = input.Ctx.WithBlockHeight(int64(params.SlashWindow) - 1)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	require.Equal(t, types.ValidatorAccuracyCounter{PreviousSubmissions: 1}, input.OracleKeeper.GetValidatorAccuracyCounter(input.Ctx, keeper.ValAddrs[2]))
+}
+
+func TestOracleDenomTallyDiagnosis(t *testing.T) {
+	input, h := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{
+		{Name: types.TestDenomB},
+		{Name: types.TestDenomC},
+		{Name: types.TestDenomD},
+		{Name: types.TestDenomE, VotePeriodMultiplier: 2},
+	}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// Everyone votes on DenomC, one validator on DenomD, nobody on DenomB, and DenomE is not due
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{
+		{Denom: types.TestDenomC, Amount: randomExchangeRate},
+		{Denom: types.TestDenomD, Amount: randomExchangeRate},
+	}, 0)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: randomExchangeRate}}, 1)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: randomExchangeRate}}, 2)
+	ctx := input.Ctx.WithBlockHeight(1)
+	oracle.EndBlocker(ctx, input.OracleKeeper)
+
+	outcome := func(denom string) types.DenomTallyOutcome {
+		outcome, ok := input.OracleKeeper.GetDenomTallyOutcome(ctx, denom)
+		require.True(t, ok)
+		require.Equal(t, uint64(1), outcome.VotePeriod)
+		require.Equal(t, params.VoteThreshold, outcome.RequiredFraction)
+		return outcome
+	}
+
+	require.Equal(t, types.TallyOutcomeSuccess, outcome(types.TestDenomC).Reason)
+	require.Equal(t, sdk.OneDec(), outcome(types.TestDenomC).AchievedFraction)
+	require.Equal(t, types.TallyOutcomeBelowThreshold, outcome(types.TestDenomD).Reason)
+	require.Equal(t, sdk.OneDec().QuoInt64(3), outcome(types.TestDenomD).AchievedFraction)
+	require.Equal(t, types.TallyOutcomeStale, outcome(types.TestDenomB).Reason)
+	require.Equal(t, sdk.ZeroDec(), outcome(types.TestDenomB).AchievedFraction)
+	require.Equal(t, types.TallyOutcomeResting, outcome(types.TestDenomE).Reason)
+}
+
+func TestOracleMaxMove(t *testing.T) {
+	input, h := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.MaxMoveWindow = 100
+	input.OracleKeeper.SetParams(input.Ctx, params)
 
-	// Account 1, DenomC
-	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: randomExchangeRate}}, 0)
+	// The first tally has no previous rate to move from
+	for _, rate := range []int64{8, 10} {
+		for i := 0; i < 3; i++ {
+			makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: sdk.NewDec(rate)}}, i)
+		}
+		oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+		input.Ctx = input.Ctx.WithBlockHeight(input.Ctx.BlockHeight() + int64(params.VotePeriod))
+	}
 
-	// Immediately swap halt after an illiquid oracle vote
+	maxMove, ok := input.OracleKeeper.GetDenomMaxMove(input.Ctx, types.TestDenomC)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewDec(25), maxMove.MaxMovePercent)
+}
+
+func TestOracleParticipation(t *testing.T) {
+	input, h := setup(t)
+
+	// Validator 2 does not vote
+	for i := 0; i < 2; i++ {
+		makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: randomExchangeRate}}, i)
+	}
 	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
 
-	_, err := input.OracleKeeper.GetExchangeRate(input.Ctx, types.TestDenomC)
+	power := stakingAmt.Quo(sdk.DefaultPowerReduction).Int64()
+	require.Equal(t, types.VotePeriodParticipation{
+		BondedPower:      3 * power,
+		BondedValidators: 3,
+		VotedPower:       2 * power,
+		Voters:           2,
+	}, input.OracleKeeper.GetVotePeriodParticipation(input.Ctx))
+
+	// Only the last vote period counts
+	input.Ctx = input.Ctx.WithBlockHeight(input.Ctx.BlockHeight() + 1)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	require.Equal(t, types.VotePeriodParticipation{
+		BondedPower:      3 * power,
+		BondedValidators: 3,
+	}, input.OracleKeeper.GetVotePeriodParticipation(input.Ctx))
+
+	// The series keeps the ratio of each vote period
+	votePeriod := input.OracleKeeper.CurrentVotePeriod(input.Ctx)
+	require.Equal(t, []types.ParticipationPoint{
+		{VotePeriod: votePeriod - 1, ParticipationRatio: sdk.NewDec(2).QuoInt64(3)},
+		{VotePeriod: votePeriod, ParticipationRatio: sdk.ZeroDec()},
+	}, input.OracleKeeper.GetParticipationSeries(input.Ctx, types.DefaultParticipationSeriesLimit))
+}
+
+func TestOracleTallyStats(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomC}, {Name: types.TestDenomD}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// Validator 1 abstains on denom C, only validator 0 votes on denom D
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{
+		{Denom: types.TestDenomC, Amount: randomExchangeRate},
+		{Denom: types.TestDenomD, Amount: randomExchangeRate},
+	}, 0)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: sdk.ZeroDec()}}, 1)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: randomExchangeRate}}, 2)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+	require.Equal(t, types.TallyStats{
+		VotePeriod: input.OracleKeeper.CurrentVotePeriod(input.Ctx),
+		Denoms: []types.DenomTallyStats{
+			{Denom: types.TestDenomC, Votes: 3, AbstainVotes: 1, Tallied: true},
+			{Denom: types.TestDenomD, Votes: 1},
+		},
+	}, input.OracleKeeper.GetLastTallyStats(input.Ctx))
+
+	// The voter count only counts the rated votes of a successful tally
+	require.Equal(t, uint64(2), input.OracleKeeper.GetDenomVoterCount(input.Ctx, types.TestDenomC))
+	require.Equal(t, uint64(0), input.OracleKeeper.GetDenomVoterCount(input.Ctx, types.TestDenomD))
+
+	// Only the last tally is kept, while the voter count is the one of the last successful tally
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	require.Empty(t, input.OracleKeeper.GetLastTallyStats(input.Ctx).Denoms)
+	require.Equal(t, uint64(2), input.OracleKeeper.GetDenomVoterCount(input.Ctx, types.TestDenomC))
+}
+
+func TestOracleCommitmentHashAlgoSwitch(t *testing.T) {
+	input, h := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.VotePeriod = 10
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	salt := strings.Repeat("1", types.SaltLength)
+	rates := sdk.DecCoins{{Denom: types.TestDenomD, Amount: randomExchangeRate}}
+	prevote := func(height int64, algo string) error {
+		hash, err := types.GetAggregateVoteHashWithAlgo(algo, salt, rates.String(), keeper.ValAddrs[0])
+		require.NoError(t, err)
+		msg := types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[0], keeper.ValAddrs[0])
+		_, err = h.AggregateExchangeRatePrevote(input.Ctx.WithBlockHeight(height), msg)
+		return err
+	}
+
+	// Governance switches to sha256 in the middle of a vote period, the old algorithm stays in effect
+	params.CommitmentHashAlgo = types.CommitmentHashAlgoSHA256
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	require.Equal(t, types.CommitmentHashAlgoSHA256Truncated, input.OracleKeeper.GetCommitmentHashAlgo(input.Ctx))
+	require.ErrorIs(t, prevote(5, types.CommitmentHashAlgoSHA256), types.ErrInvalidHashLength)
+	require.NoError(t, prevote(5, types.CommitmentHashAlgoSHA256Truncated))
+
+	// The switch happens at the end of the vote period and drops the prevote in flight
+	oracle.EndBlocker(input.Ctx.WithBlockHeight(9), input.OracleKeeper)
+	require.Equal(t, types.CommitmentHashAlgoSHA256, input.OracleKeeper.GetCommitmentHashAlgo(input.Ctx))
+	_, err := input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, keeper.ValAddrs[0])
+	require.Error(t, err)
+
+	// Commitments of the old algorithm are rejected, the new ones are revealed as usual
+	require.ErrorIs(t, prevote(10, types.CommitmentHashAlgoSHA256Truncated), types.ErrInvalidHashLength)
+	require.NoError(t, prevote(10, types.CommitmentHashAlgoSHA256))
+
+	voteMsg := types.NewMsgAggregateExchangeRateVote(salt, rates.String(), keeper.Addrs[0], keeper.ValAddrs[0])
+	_, err = h.AggregateExchangeRateVote(input.Ctx.WithBlockHeight(20), voteMsg)
+	require.NoError(t, err)
+}
+
+func TestOracleTallyPowerSmoothing(t *testing.T) {
+	input, h := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomC}}
+	params.PowerSmoothingWindows = 19
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	tallyPeriod := func() sdk.Dec {
+		for i := 0; i < 3; i++ {
+			makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: sdk.NewDec(int64(i + 1))}}, i)
+		}
+		oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+		rate, err := input.OracleKeeper.GetExchangeRate(input.Ctx, types.TestDenomC)
+		require.NoError(t, err)
+		return rate
+	}
+
+	// Equal powers, the median vote wins
+	require.Equal(t, sdk.NewDec(2), tallyPeriod())
+
+	// The validator voting 3 grows from 10 to 100 power at once, but only
+	// a tenth of the change is applied to its smoothed power of 19
+	val, _ := input.StakingKeeper.GetValidator(input.Ctx, keeper.ValAddrs[2])
+	_, err := input.StakingKeeper.Delegate(input.Ctx, keeper.Addrs[2], stakingAmt.MulRaw(9), stakingtypes.Unbonded, val, true)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(2), tallyPeriod())
+	power, _ := input.OracleKeeper.GetSmoothedPower(input.Ctx, keeper.ValAddrs[2])
+	require.Equal(t, sdk.NewDec(19), power)
+
+	// The instant power outweighs the others without smoothing
+	params.PowerSmoothingWindows = 0
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	require.Equal(t, sdk.NewDec(3), tallyPeriod())
+}
+
+func TestOracleTallyMaxPowerShare(t *testing.T) {
+	input, h := setup(t)
+
+	// The validator voting 3 dominates the others with 100 of 120 power
+	val, _ := input.StakingKeeper.GetValidator(input.Ctx, keeper.ValAddrs[2])
+	_, err := input.StakingKeeper.Delegate(input.Ctx, keeper.Addrs[2], stakingAmt.MulRaw(9), stakingtypes.Unbonded, val, true)
+	require.NoError(t, err)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomC}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	tallyPeriod := func() sdk.Dec {
+		for i := 0; i < 3; i++ {
+			makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: sdk.NewDec(int64(i + 1))}}, i)
+		}
+		oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+
+		rate, err := input.OracleKeeper.GetExchangeRate(input.Ctx, types.TestDenomC)
+		require.NoError(t, err)
+		return rate
+	}
+
+	// Uncapped, the dominant validator sets the median
+	require.Equal(t, sdk.NewDec(3), tallyPeriod())
+
+	// Capped at 12 power, the dominant validator no longer outweighs the others
+	params.MaxPowerShare = sdk.NewDecWithPrec(1, 1)
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	require.Equal(t, sdk.NewDec(2), tallyPeriod())
+	require.Equal(t, []types.DenomTallyStats{
+		{Denom: types.TestDenomC, Votes: 3, Tallied: true, CappedVotes: 1},
+	}, input.OracleKeeper.GetLastTallyStats(input.Ctx).Denoms)
+}
+
+func TestOracleTimedVotePeriod(t *testing.T) {
+	input, h := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomC}}
+	params.VotePeriodDuration = 30 * time.Second
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// Blocks come at irregular intervals, the boundaries are at multiples of 30s
+	start := time.Unix(300, 0)
+	block := func(height int64, elapsed time.Duration) sdk.Context {
+		return input.Ctx.WithBlockHeight(height).WithBlockTime(start.Add(elapsed))
+	}
+
+	salt := strings.Repeat("1", types.SaltLength)
+	rates := sdk.DecCoins{{Denom: types.TestDenomC, Amount: sdk.NewDec(7)}}
+	reveal := func(ctx sdk.Context, i int) error {
+		_, err := h.AggregateExchangeRateVote(ctx, types.NewMsgAggregateExchangeRateVote(salt, rates.String(), keeper.Addrs[i], keeper.ValAddrs[i]))
+		return err
+	}
+
+	// The clock continues from the vote period counted in blocks
+	ctx := block(10, 0)
+	oracle.EndBlocker(ctx, input.OracleKeeper)
+	require.Equal(t, uint64(10), input.OracleKeeper.CurrentVotePeriod(ctx))
+
+	ctx = block(11, 5*time.Second)
+	for i := 0; i < 3; i++ {
+		hash := types.GetAggregateVoteHash(salt, rates.String(), keeper.ValAddrs[i])
+		_, err := h.AggregateExchangeRatePrevote(ctx, types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[i], keeper.ValAddrs[i]))
+		require.NoError(t, err)
+	}
+	require.False(t, input.OracleKeeper.IsVotePeriodLastBlock(ctx))
+	oracle.EndBlocker(ctx, input.OracleKeeper)
+
+	// Far more blocks than VotePeriod pass, but the period only closes past the boundary
+	ctx = block(12, 12*time.Second)
+	require.ErrorIs(t, reveal(ctx, 0), types.ErrRevealPeriodMissMatch)
+	require.False(t, input.OracleKeeper.IsVotePeriodLastBlock(ctx))
+	oracle.EndBlocker(ctx, input.OracleKeeper)
+	require.Equal(t, uint64(10), input.OracleKeeper.CurrentVotePeriod(ctx))
+
+	ctx = block(13, 31*time.Second)
+	require.True(t, input.OracleKeeper.IsVotePeriodLastBlock(ctx))
+	oracle.EndBlocker(ctx, input.OracleKeeper)
+	require.Equal(t, uint64(11), input.OracleKeeper.CurrentVotePeriod(ctx))
+
+	// The prevotes of the closed period are revealed in the next one
+	ctx = block(14, 33*time.Second)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, reveal(ctx, i))
+	}
+	oracle.EndBlocker(ctx, input.OracleKeeper)
+	_, err := input.OracleKeeper.GetExchangeRate(ctx, types.TestDenomC)
 	require.Error(t, err)
+
+	ctx = block(15, 61*time.Second)
+	oracle.EndBlocker(ctx, input.OracleKeeper)
+	rate, err := input.OracleKeeper.GetExchangeRate(ctx, types.TestDenomC)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(7), rate)
+
+	// A block time leaping over several boundaries closes a single period
+	ctx = block(16, 200*time.Second)
+	oracle.EndBlocker(ctx, input.OracleKeeper)
+	require.Equal(t, uint64(13), input.OracleKeeper.CurrentVotePeriod(ctx))
+	require.False(t, input.OracleKeeper.IsVotePeriodLastBlock(block(17, 205*time.Second)))
+
+	// Back to counting in blocks
+	params.VotePeriodDuration = 0
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	ctx = block(20, 300*time.Second)
+	oracle.EndBlocker(ctx, input.OracleKeeper)
+	require.Equal(t, uint64(20), input.OracleKeeper.CurrentVotePeriod(ctx))
+	require.True(t, input.OracleKeeper.IsVotePeriodLastBlock(ctx))
 }
 
 func TestOracleTally(t *testing.T) {
@@ -195,18 +1225,163 @@ func TestOracleTally(t *testing.T) {
 			claim := expectedValidatorClaimMap[key]
 			claim.Weight += vote.Power
 			claim.WinCount++
+			if claim.AccuracyWeight.IsNil() {
+				claim.AccuracyWeight = sdk.ZeroDec()
+			}
+			if vote.ExchangeRate.IsPositive() {
+				accuracy, err := types.VoteAccuracy(vote.ExchangeRate, weightedMedian, maxSpread)
+				require.NoError(t, err)
+				claim.AccuracyWeight = claim.AccuracyWeight.Add(accuracy.MulInt64(vote.Power))
+			}
 			expectedValidatorClaimMap[key] = claim
 		}
 	}
 
 	missMap := map[string]sdk.ValAddress{}
 
-	tallyMedian, _ := oracle.Tally(input.Ctx, ballot, input.OracleKeeper.RewardBand(input.Ctx), validatorClaimMap, missMap)
+	tallyMedian, _ := oracle.Tally(input.Ctx, ballot, input.OracleKeeper.RewardBand(input.Ctx), types.AggregationMethodMedian, types.DefaultModeBucketPrecision, types.DefaultEvenMedianRule, validatorClaimMap, missMap)
 
 	require.Equal(t, validatorClaimMap, expectedValidatorClaimMap)
 	require.Equal(t, tallyMedian.MulInt64(100).TruncateInt(), weightedMedian.MulInt64(100).TruncateInt())
 }
 
+func TestOracleTallyOverflow(t *testing.T) {
+	input, _ := setup(t)
+
+	// near max sdk.Dec rate; the upper bound of the reward spread does not fit into sdk.Dec
+	maxRate := sdk.NewDecFromBigIntWithPrec(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 315), big.NewInt(1)), sdk.Precision)
+
+	validatorClaimMap := make(map[string]types.Claim)
+	ballot := types.ExchangeRateBallot{}
+	for i := 0; i < 3; i++ {
+		validatorClaimMap[keeper.ValAddrs[i].String()] = types.NewClaim(10, 0, 0, keeper.ValAddrs[i])
+		ballot = append(ballot, types.NewVoteForTally(maxRate, types.TestDenomD, keeper.ValAddrs[i], 10))
+	}
+
+	missMap := map[string]sdk.ValAddress{}
+	require.NotPanics(t, func() {
+		_, err := oracle.Tally(input.Ctx, ballot, input.OracleKeeper.RewardBand(input.Ctx), types.AggregationMethodMedian, types.DefaultModeBucketPrecision, types.DefaultEvenMedianRule, validatorClaimMap, missMap)
+		require.ErrorIs(t, err, types.ErrDecOverflow)
+	})
+	for _, claim := range validatorClaimMap {
+		require.Equal(t, int64(0), claim.Weight)
+	}
+
+	// a single outlier at the max rate is tallied normally
+	ballot = types.ExchangeRateBallot{
+		types.NewVoteForTally(randomExchangeRate, types.TestDenomD, keeper.ValAddrs[0], 10),
+		types.NewVoteForTally(randomExchangeRate, types.TestDenomD, keeper.ValAddrs[1], 10),
+		types.NewVoteForTally(maxRate, types.TestDenomD, keeper.ValAddrs[2], 10),
+	}
+	tallyMedian, err := oracle.Tally(input.Ctx, ballot, input.OracleKeeper.RewardBand(input.Ctx), types.AggregationMethodMedian, types.DefaultModeBucketPrecision, types.DefaultEvenMedianRule, validatorClaimMap, missMap)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, tallyMedian)
+	require.Contains(t, missMap, keeper.ValAddrs[2].String())
+}
+
+func TestOracleTallyOverflowEndBlocker(t *testing.T) {
+	input, h := setup(t)
+
+	// Everyone votes a rate whose reward band overflows sdk.Dec on DenomD
+	maxRate := sdk.NewDecFromBigIntWithPrec(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 315), big.NewInt(1)), sdk.Precision)
+	for i := 0; i < 3; i++ {
+		makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{
+			{Denom: types.TestDenomA, Amount: randomExchangeRate},
+			{Denom: types.TestDenomC, Amount: randomExchangeRate},
+			{Denom: types.TestDenomD, Amount: maxRate},
+		}, i)
+	}
+	require.NoError(t, oracle.EndBlocker(input.Ctx, input.OracleKeeper))
+
+	// Only DenomD fails to tally
+	for _, denom := range []string{types.TestDenomA, types.TestDenomC} {
+		rate, err := input.OracleKeeper.GetExchangeRate(input.Ctx, denom)
+		require.NoError(t, err)
+		require.Equal(t, randomExchangeRate, rate)
+	}
+	_, err := input.OracleKeeper.GetExchangeRate(input.Ctx, types.TestDenomD)
+	require.Error(t, err)
+	outcome, ok := input.OracleKeeper.GetDenomTallyOutcome(input.Ctx, types.TestDenomD)
+	require.True(t, ok)
+	require.Equal(t, types.TallyOutcomeFailed, outcome.Reason)
+	require.Equal(t, uint64(1), input.OracleKeeper.GetStaleCounter(input.Ctx, types.TestDenomD))
+
+	// The rest of the vote period is processed, without counting the voters of DenomD as missing
+	for i := 0; i < 3; i++ {
+		require.Equal(t, uint64(0), input.OracleKeeper.GetMissCounter(input.Ctx, keeper.ValAddrs[i]))
+		_, err := input.OracleKeeper.GetAggregateExchangeRateVote(input.Ctx, keeper.ValAddrs[i])
+		require.Error(t, err)
+	}
+}
+
+func TestOracleTallyMode(t *testing.T) {
+	input, _ := setup(t)
+
+	// two feeders share a source around 1.00, the others are spread out
+	rates := []sdk.Dec{
+		sdk.MustNewDecFromStr("1.000"),
+		sdk.MustNewDecFromStr("1.001"),
+		sdk.MustNewDecFromStr("1.100"),
+		sdk.MustNewDecFromStr("1.200"),
+		sdk.MustNewDecFromStr("1.300"),
+	}
+	powers := []int64{6, 6, 8, 8, 8}
+
+	tally := func(aggregationMethod string) (sdk.Dec, map[string]sdk.ValAddress) {
+		validatorClaimMap := make(map[string]types.Claim)
+		ballot := types.ExchangeRateBallot{}
+		for i, rate := range rates {
+			validatorClaimMap[keeper.ValAddrs[i].String()] = types.NewClaim(powers[i], 0, 0, keeper.ValAddrs[i])
+			ballot = append(ballot, types.NewVoteForTally(rate, types.TestDenomD, keeper.ValAddrs[i], powers[i]))
+		}
+
+		missMap := map[string]sdk.ValAddress{}
+		exchangeRate, err := oracle.Tally(input.Ctx, ballot, input.OracleKeeper.RewardBand(input.Ctx), aggregationMethod, 2, types.DefaultEvenMedianRule, validatorClaimMap, missMap)
+		require.NoError(t, err)
+		return exchangeRate, missMap
+	}
+
+	median, missMap := tally(types.AggregationMethodMedian)
+	require.Equal(t, sdk.MustNewDecFromStr("1.1"), median)
+	require.NotContains(t, missMap, keeper.ValAddrs[3].String())
+	require.Contains(t, missMap, keeper.ValAddrs[4].String())
+
+	// the 1.00 bucket holds the most power
+	mode, missMap := tally(types.AggregationMethodMode)
+	require.Equal(t, sdk.OneDec(), mode)
+	require.Contains(t, missMap, keeper.ValAddrs[3].String())
+	require.Contains(t, missMap, keeper.ValAddrs[4].String())
+}
+
+func TestOracleTallyEvenMedianRule(t *testing.T) {
+	input, _ := setup(t)
+
+	// four feeders of equal power split the ballot in half between 1.1 and 1.2
+	rates := []sdk.Dec{
+		sdk.MustNewDecFromStr("1.0"),
+		sdk.MustNewDecFromStr("1.1"),
+		sdk.MustNewDecFromStr("1.2"),
+		sdk.MustNewDecFromStr("1.3"),
+	}
+
+	for rule, expected := range map[string]sdk.Dec{
+		types.EvenMedianRuleLower:   sdk.MustNewDecFromStr("1.1"),
+		types.EvenMedianRuleUpper:   sdk.MustNewDecFromStr("1.2"),
+		types.EvenMedianRuleAverage: sdk.MustNewDecFromStr("1.15"),
+	} {
+		validatorClaimMap := make(map[string]types.Claim)
+		ballot := types.ExchangeRateBallot{}
+		for i, rate := range rates {
+			validatorClaimMap[keeper.ValAddrs[i].String()] = types.NewClaim(10, 0, 0, keeper.ValAddrs[i])
+			ballot = append(ballot, types.NewVoteForTally(rate, types.TestDenomD, keeper.ValAddrs[i], 10))
+		}
+
+		exchangeRate, err := oracle.Tally(input.Ctx, ballot, input.OracleKeeper.RewardBand(input.Ctx), types.AggregationMethodMedian, types.DefaultModeBucketPrecision, rule, validatorClaimMap, map[string]sdk.ValAddress{})
+		require.NoError(t, err)
+		require.Equal(t, expected, exchangeRate, rule)
+	}
+}
+
 func TestOracleTallyTiming(t *testing.T) {
 	input, h := setup(t)
 
@@ -313,6 +1488,153 @@ func TestOracleRewardBand(t *testing.T) {
 	require.Equal(t, uint64(0), input.OracleKeeper.GetMissCounter(input.Ctx, keeper.ValAddrs[2]))
 }
 
+func TestOracleRewardDistribution(t *testing.T) {
+	input, h := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.OracleFeeShare = sdk.NewDecWithPrec(5, 1)
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// Half the fees of the block are moved to the reward pool, including the ones paid
+	// in a denom which is not a vote target
+	feeCollector := input.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.NoError(t, keeper.FundAccount(input, feeCollector, sdk.NewCoins(
+		sdk.NewInt64Coin(types.TestDenomC, 1000000),
+		sdk.NewInt64Coin(types.TestDenomB, 1000000),
+	)))
+	oracle.BeginBlocker(input.Ctx, input.OracleKeeper)
+	pool := input.OracleKeeper.GetRewardPool(input.Ctx, types.TestDenomC)
+	require.Equal(t, sdk.NewInt(500000), pool.Amount)
+	require.Equal(t, sdk.NewInt(500000), input.OracleKeeper.GetRewardPool(input.Ctx, types.TestDenomB).Amount)
+
+	// The third validator votes outside the reward band and wins nothing
+	tallyPeriod := func() {
+		for i, rate := range []sdk.Dec{randomExchangeRate, randomExchangeRate, randomExchangeRate.MulInt64(2)} {
+			makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: rate}}, i)
+		}
+		require.NoError(t, oracle.EndBlocker(input.Ctx, input.OracleKeeper))
+	}
+
+	// The pool drains by the share of the reward distribution window of each vote period
+	for i := 0; i < 2; i++ {
+		tallyPeriod()
+
+		periodReward := sdk.NewInt(int64(params.VotePeriod)).Mul(pool.Amount).QuoRaw(int64(params.RewardDistributionWindow))
+		remaining := input.OracleKeeper.GetRewardPool(input.Ctx, types.TestDenomC)
+		require.True(t, remaining.Amount.LT(pool.Amount))
+		require.True(t, pool.Amount.Sub(remaining.Amount).LTE(periodReward))
+		pool = remaining
+	}
+
+	// The drained rewards are split between the winners
+	distributed := sdk.NewInt(500000).Sub(pool.Amount)
+	rewards, _ := input.DistrKeeper.GetValidatorOutstandingRewardsCoins(input.Ctx, keeper.ValAddrs[0]).TruncateDecimal()
+	rewards1, _ := input.DistrKeeper.GetValidatorOutstandingRewardsCoins(input.Ctx, keeper.ValAddrs[1]).TruncateDecimal()
+	rewards2 := input.DistrKeeper.GetValidatorOutstandingRewardsCoins(input.Ctx, keeper.ValAddrs[2])
+	require.True(t, rewards.AmountOf(types.TestDenomC).IsPositive())
+	require.Equal(t, rewards.AmountOf(types.TestDenomC), rewards1.AmountOf(types.TestDenomC))
+	require.Equal(t, distributed, rewards.AmountOf(types.TestDenomC).Add(rewards1.AmountOf(types.TestDenomC)))
+	require.True(t, rewards2.IsZero())
+
+	// The fees in the denom which is not a vote target are given out as well
+	require.True(t, input.OracleKeeper.GetRewardPool(input.Ctx, types.TestDenomB).Amount.LT(sdk.NewInt(500000)))
+	require.True(t, rewards.AmountOf(types.TestDenomB).IsPositive())
+	require.Equal(t, rewards.AmountOf(types.TestDenomB), rewards1.AmountOf(types.TestDenomB))
+}
+
+func TestOracleRewardDistributionDisabled(t *testing.T) {
+	input, h := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.OracleFeeShare = sdk.ZeroDec()
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	oracleAcc := input.AccountKeeper.GetModuleAddress(types.ModuleName)
+	require.NoError(t, keeper.FundAccount(input, oracleAcc, sdk.NewCoins(sdk.NewInt64Coin(types.TestDenomC, 1000000))))
+
+	// Without a fee share, the pool is left untouched
+	for i := 0; i < 3; i++ {
+		makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: randomExchangeRate}}, i)
+	}
+	require.NoError(t, oracle.EndBlocker(input.Ctx, input.OracleKeeper))
+
+	require.Equal(t, sdk.NewInt(1000000), input.OracleKeeper.GetRewardPool(input.Ctx, types.TestDenomC).Amount)
+	require.True(t, input.DistrKeeper.GetValidatorOutstandingRewardsCoins(input.Ctx, keeper.ValAddrs[0]).IsZero())
+}
+
+func TestOracleRewardDistributionAccuracyWeighted(t *testing.T) {
+	for _, tc := range []struct {
+		name             string
+		accuracyWeighted bool
+	}{
+		{"power weighted", false},
+		{"accuracy weighted", true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			input, h := setup(t)
+
+			params := input.OracleKeeper.GetParams(input.Ctx)
+			params.AccuracyWeightedRewards = tc.accuracyWeighted
+			params.OracleFeeShare = sdk.NewDecWithPrec(5, 1)
+			input.OracleKeeper.SetParams(input.Ctx, params)
+
+			oracleAcc := input.AccountKeeper.GetModuleAddress(types.ModuleName)
+			require.NoError(t, keeper.FundAccount(input, oracleAcc, sdk.NewCoins(sdk.NewInt64Coin(types.TestDenomC, 1000000))))
+
+			// All three win, the second one voting further from the median within the reward band
+			for i, rate := range []sdk.Dec{randomExchangeRate, randomExchangeRate.Add(sdk.NewDec(10)), randomExchangeRate} {
+				makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: rate}}, i)
+			}
+			require.NoError(t, oracle.EndBlocker(input.Ctx, input.OracleKeeper))
+
+			rewards, _ := input.DistrKeeper.GetValidatorOutstandingRewardsCoins(input.Ctx, keeper.ValAddrs[0]).TruncateDecimal()
+			rewards1, _ := input.DistrKeeper.GetValidatorOutstandingRewardsCoins(input.Ctx, keeper.ValAddrs[1]).TruncateDecimal()
+			require.True(t, rewards1.AmountOf(types.TestDenomC).IsPositive())
+			if tc.accuracyWeighted {
+				require.True(t, rewards.AmountOf(types.TestDenomC).GT(rewards1.AmountOf(types.TestDenomC)))
+			} else {
+				require.Equal(t, rewards.AmountOf(types.TestDenomC), rewards1.AmountOf(types.TestDenomC))
+			}
+		})
+	}
+}
+
+func TestOracleRewardEstimate(t *testing.T) {
+	input, h := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.OracleFeeShare = sdk.NewDecWithPrec(5, 1)
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	oracleAcc := input.AccountKeeper.GetModuleAddress(types.ModuleName)
+	require.NoError(t, keeper.FundAccount(input, oracleAcc, sdk.NewCoins(sdk.NewInt64Coin(types.TestDenomC, 1000000))))
+
+	// Everyone wins all ballots
+	tallyPeriod := func() {
+		for i := 0; i < 3; i++ {
+			makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{
+				{Denom: types.TestDenomA, Amount: randomExchangeRate},
+				{Denom: types.TestDenomC, Amount: randomExchangeRate},
+				{Denom: types.TestDenomD, Amount: randomExchangeRate},
+			}, i)
+		}
+		require.NoError(t, oracle.EndBlocker(input.Ctx, input.OracleKeeper))
+	}
+	tallyPeriod()
+
+	rewardPerPower, winningPower, err := input.OracleKeeper.GetRewardEstimate(input.Ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(30), winningPower)
+	require.True(t, rewardPerPower.AmountOf(types.TestDenomC).IsPositive())
+
+	// The estimate is what the next vote period pays out, up to truncation
+	before := input.DistrKeeper.GetValidatorOutstandingRewardsCoins(input.Ctx, keeper.ValAddrs[0])
+	tallyPeriod()
+	paid := input.DistrKeeper.GetValidatorOutstandingRewardsCoins(input.Ctx, keeper.ValAddrs[0]).Sub(before)
+	estimate := rewardPerPower.AmountOf(types.TestDenomC).MulInt64(10)
+	require.True(t, estimate.Sub(paid.AmountOf(types.TestDenomC)).Abs().LTE(sdk.OneDec()), "paid %s, estimated %s", paid, estimate)
+}
+
 func TestOracleEnsureSorted(t *testing.T) {
 	input, h := setup(t)
 
@@ -421,6 +1743,76 @@ func TestWhitelistSlashing(t *testing.T) {
 	require.Equal(t, sdk.OneDec().Sub(slashFraction).MulInt(stakingAmt).TruncateInt(), validator.GetBondedTokens())
 }
 
+func TestObserverSlashing(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomC}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	input.OracleKeeper.SetObserverExemption(input.Ctx, keeper.ValAddrs[0], int64(2*input.OracleKeeper.SlashWindow(input.Ctx)))
+
+	votePeriodsPerWindow := sdk.NewDec(int64(input.OracleKeeper.SlashWindow(input.Ctx))).QuoInt64(int64(input.OracleKeeper.VotePeriod(input.Ctx))).TruncateInt64()
+	minValidPerWindow := input.OracleKeeper.MinValidPerWindow(input.Ctx)
+
+	// The vote of the observer counts towards the threshold, which a single voter misses
+	input.Ctx = input.Ctx.WithBlockHeight(input.Ctx.BlockHeight() + 1)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: randomExchangeRate}}, 0)
+	makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: randomExchangeRate}}, 1)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	rate, err := input.OracleKeeper.GetExchangeRate(input.Ctx, types.TestDenomC)
+	require.NoError(t, err)
+	require.Equal(t, randomExchangeRate, rate)
+
+	// Missing enough vote periods to be slashed otherwise, the observer is not slashed
+	for i := uint64(0); i <= uint64(sdk.OneDec().Sub(minValidPerWindow).MulInt64(votePeriodsPerWindow).TruncateInt64()); i++ {
+		input.Ctx = input.Ctx.WithBlockHeight(input.Ctx.BlockHeight() + 1)
+
+		makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: randomExchangeRate}}, 1)
+		makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: randomExchangeRate}}, 2)
+
+		oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+		require.Equal(t, uint64(0), input.OracleKeeper.GetMissCounter(input.Ctx, keeper.ValAddrs[0]))
+	}
+
+	input.Ctx = input.Ctx.WithBlockHeight(votePeriodsPerWindow - 1)
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	validator := input.StakingKeeper.Validator(input.Ctx, keeper.ValAddrs[0])
+	require.Equal(t, stakingAmt, validator.GetBondedTokens())
+	require.False(t, validator.IsJailed())
+	require.Equal(t, uint64(0), input.OracleKeeper.GetMissCounter(input.Ctx, keeper.ValAddrs[0]))
+	require.True(t, input.OracleKeeper.IsExemptObserver(input.Ctx, keeper.ValAddrs[0]))
+}
+
+func TestObserverExemptionExpirySlashing(t *testing.T) {
+	input, h := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomC}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	votePeriodsPerWindow := sdk.NewDec(int64(input.OracleKeeper.SlashWindow(input.Ctx))).QuoInt64(int64(input.OracleKeeper.VotePeriod(input.Ctx))).TruncateInt64()
+	minValidPerWindow := input.OracleKeeper.MinValidPerWindow(input.Ctx)
+	slashingMisses := sdk.OneDec().Sub(minValidPerWindow).MulInt64(votePeriodsPerWindow).TruncateInt64() + 1
+
+	// The exemption expires within the slash window, after enough misses to be slashed otherwise
+	input.OracleKeeper.SetObserverExemption(input.Ctx, keeper.ValAddrs[0], slashingMisses+1)
+	for height := int64(1); height <= slashingMisses; height++ {
+		input.Ctx = input.Ctx.WithBlockHeight(height)
+		makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: randomExchangeRate}}, 1)
+		makeAggregatePrevoteAndVote(t, input, h, 0, sdk.DecCoins{{Denom: types.TestDenomC, Amount: randomExchangeRate}}, 2)
+		oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+		require.Equal(t, uint64(0), input.OracleKeeper.GetMissCounter(input.Ctx, keeper.ValAddrs[0]))
+	}
+
+	// The misses while exempt are not slashed once it expired
+	input.Ctx = input.Ctx.WithBlockHeight(votePeriodsPerWindow - 1)
+	require.False(t, input.OracleKeeper.IsExemptObserver(input.Ctx, keeper.ValAddrs[0]))
+	oracle.EndBlocker(input.Ctx, input.OracleKeeper)
+	validator := input.StakingKeeper.Validator(input.Ctx, keeper.ValAddrs[0])
+	require.Equal(t, stakingAmt, validator.GetBondedTokens())
+	require.False(t, validator.IsJailed())
+	_, ok := input.OracleKeeper.GetObserverExemption(input.Ctx, keeper.ValAddrs[0])
+	require.False(t, ok)
+}
+
 func TestNotPassedBallotSlashing(t *testing.T) {
 	input, h := setup(t)
 	params := input.OracleKeeper.GetParams(input.Ctx)
@@ -536,3 +1928,31 @@ func makeAggregatePrevoteAndVote(t *testing.T, input keeper.TestInput, h types.M
 	_, err = h.AggregateExchangeRateVote(input.Ctx.WithBlockHeight(height+1), voteMsg)
 	require.NoError(t, err)
 }
+
+func TestEndBlockerNonBoundaryReads(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	votePeriod := input.OracleKeeper.VotePeriod(input.Ctx)
+
+	paramsCtx := input.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	input.OracleKeeper.GetParams(paramsCtx)
+
+	// A block within a vote period reads less than the params tallying needs
+	ctx := input.Ctx.WithBlockHeight(int64(votePeriod)).WithGasMeter(sdk.NewInfiniteGasMeter())
+	require.NoError(t, oracle.EndBlocker(ctx, input.OracleKeeper))
+	require.Less(t, ctx.GasMeter().GasConsumed(), paramsCtx.GasMeter().GasConsumed())
+}
+
+func BenchmarkEndBlockerNonBoundary(b *testing.B) {
+	input := keeper.CreateTestInput(b)
+	votePeriod := input.OracleKeeper.VotePeriod(input.Ctx)
+
+	// Gas consumed reflects the store reads
+	ctx := input.Ctx.WithBlockHeight(int64(votePeriod)).WithGasMeter(sdk.NewInfiniteGasMeter())
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := oracle.EndBlocker(ctx, input.OracleKeeper); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(ctx.GasMeter().GasConsumed())/float64(b.N), "gas/op")
+}
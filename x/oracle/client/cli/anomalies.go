@@ -0,0 +1,158 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Team-Kujira/core/x/oracle/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// FlagReference is the path of the JSON file with the reference exchange rates
+	FlagReference = "reference"
+	// FlagThreshold is the relative deviation from the reference exchange rate a denom is flagged at
+	FlagThreshold = "threshold"
+)
+
+// anomaly is an exchange rate deviating from its reference
+type anomaly struct {
+	Denom        string  `json:"denom"`
+	ExchangeRate sdk.Dec `json:"exchange_rate"`
+	Reference    sdk.Dec `json:"reference"`
+	Deviation    sdk.Dec `json:"deviation"`
+}
+
+// anomalies is the output of the anomalies query
+type anomalies struct {
+	Anomalies []anomaly `json:"anomalies"`
+	// Missing lists the reference denoms without an exchange rate
+	Missing []string `json:"missing,omitempty"`
+}
+
+// GetCmdQueryAnomalies implements the query anomalies command.
+func GetCmdQueryAnomalies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "anomalies",
+		Args:  cobra.NoArgs,
+		Short: "Compare the current exchange rates against reference exchange rates",
+		Long: strings.TrimSpace(`
+Compare the current exchange rates against reference exchange rates and list the
+denoms whose exchange rate deviates from the reference by more than the threshold,
+sorted by deviation. The deviation is relative to the reference exchange rate. The
+reference file maps denoms to exchange rates:
+
+{"ukuji": "0.75", "uatom": "9.1"}
+
+$ kujirad query oracle anomalies --reference ref.json --threshold 0.1
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			referencePath, err := cmd.Flags().GetString(FlagReference)
+			if err != nil {
+				return err
+			}
+			reference, err := readRatesFile(referencePath)
+			if err != nil {
+				return err
+			}
+
+			thresholdStr, err := cmd.Flags().GetString(FlagThreshold)
+			if err != nil {
+				return err
+			}
+			threshold, err := sdk.NewDecFromStr(thresholdStr)
+			if err != nil || threshold.IsNegative() {
+				return fmt.Errorf("invalid threshold %q: must be a non-negative decimal", thresholdStr)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ExchangeRates(context.Background(), &types.QueryExchangeRatesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(findAnomalies(res.ExchangeRates, reference, threshold))
+		},
+	}
+
+	cmd.Flags().String(FlagReference, "", "Path of the JSON file with the reference exchange rates")
+	cmd.Flags().String(FlagThreshold, "0.1", "Relative deviation from the reference exchange rate to flag a denom at")
+	_ = cmd.MarkFlagRequired(FlagReference)
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// readRatesFile reads the exchange rates of a JSON file mapping denoms to rates
+func readRatesFile(path string) (map[string]sdk.Dec, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var rates map[string]string
+	if err := json.Unmarshal(bz, &rates); err != nil {
+		return nil, fmt.Errorf("invalid exchange rates file %s: %w", path, err)
+	}
+
+	reference := make(map[string]sdk.Dec, len(rates))
+	for denom, rateStr := range rates {
+		rate, err := sdk.NewDecFromStr(rateStr)
+		if err != nil || !rate.IsPositive() {
+			return nil, fmt.Errorf("invalid exchange rate %q of %s: must be a positive decimal", rateStr, denom)
+		}
+		reference[denom] = rate
+	}
+
+	return reference, nil
+}
+
+// findAnomalies flags the exchange rates deviating from their reference by more than the
+// threshold, the largest deviation first. Denoms without reference are not compared.
+func findAnomalies(exchangeRates sdk.DecCoins, reference map[string]sdk.Dec, threshold sdk.Dec) anomalies {
+	out := anomalies{Anomalies: []anomaly{}}
+	rated := make(map[string]struct{}, len(exchangeRates))
+	for _, rate := range exchangeRates {
+		rated[rate.Denom] = struct{}{}
+		ref, ok := reference[rate.Denom]
+		if !ok {
+			continue
+		}
+
+		deviation := rate.Amount.Sub(ref).Abs().Quo(ref)
+		if deviation.GT(threshold) {
+			out.Anomalies = append(out.Anomalies, anomaly{
+				Denom:        rate.Denom,
+				ExchangeRate: rate.Amount,
+				Reference:    ref,
+				Deviation:    deviation,
+			})
+		}
+	}
+
+	for denom := range reference {
+		if _, ok := rated[denom]; !ok {
+			out.Missing = append(out.Missing, denom)
+		}
+	}
+	sort.Strings(out.Missing)
+
+	sort.SliceStable(out.Anomalies, func(i, j int) bool {
+		return out.Anomalies[i].Deviation.GT(out.Anomalies[j].Deviation)
+	})
+
+	return out
+}
@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Team-Kujira/core/x/oracle/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+// configCheck is the output of the check config query
+type configCheck struct {
+	// Missing lists the active denoms the feeder does not report, which count as misses
+	Missing []string `json:"missing"`
+	// Unused lists the denoms the feeder reports that are not active
+	Unused []string `json:"unused"`
+}
+
+// GetCmdQueryCheckConfig implements the query check config command.
+func GetCmdQueryCheckConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-config [config.json]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Compare the denoms of a feeder config against the active denoms",
+		Long: strings.TrimSpace(`
+Compare the denoms a feeder intends to report against the active denoms of the
+oracle. The command lists the active denoms missing from the config, which the
+validator would miss votes and be slashed for, and the denoms of the config that
+are not active, which are ignored. It exits with an error if any active denom is
+missing, so it can gate feeder deployments in CI. The config file lists the denoms:
+
+["ukuji", "uatom"]
+
+$ kujirad query oracle check-config config.json
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denoms, err := readFeederConfig(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Actives(context.Background(), &types.QueryActivesRequest{})
+			if err != nil {
+				return err
+			}
+
+			out := checkFeederConfig(res.Actives, denoms)
+			if err := clientCtx.PrintObjectLegacy(out); err != nil {
+				return err
+			}
+			if len(out.Missing) > 0 {
+				return fmt.Errorf("feeder config misses active denoms: %s", strings.Join(out.Missing, ", "))
+			}
+
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// readFeederConfig reads the denoms of a JSON file listing the denoms a feeder reports
+func readFeederConfig(path string) ([]string, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var denoms []string
+	if err := json.Unmarshal(bz, &denoms); err != nil {
+		return nil, fmt.Errorf("invalid feeder config %s: %w", path, err)
+	}
+
+	return denoms, nil
+}
+
+// checkFeederConfig diffs the denoms of a feeder config against the active denoms
+func checkFeederConfig(actives []string, denoms []string) configCheck {
+	out := configCheck{Missing: []string{}, Unused: []string{}}
+
+	reported := make(map[string]struct{}, len(denoms))
+	for _, denom := range denoms {
+		reported[denom] = struct{}{}
+	}
+
+	active := make(map[string]struct{}, len(actives))
+	for _, denom := range actives {
+		active[denom] = struct{}{}
+		if _, ok := reported[denom]; !ok {
+			out.Missing = append(out.Missing, denom)
+		}
+	}
+
+	for _, denom := range denoms {
+		if _, ok := active[denom]; !ok {
+			out.Unused = append(out.Unused, denom)
+		}
+	}
+
+	sort.Strings(out.Missing)
+	sort.Strings(out.Unused)
+
+	return out
+}
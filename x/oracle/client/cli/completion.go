@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Team-Kujira/core/x/oracle/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// completionTimeout bounds the query made for a shell completion, so that an unreachable node does not block the shell
+const completionTimeout = 3 * time.Second
+
+// completeActiveDenoms completes the first argument of a command with the active denoms.
+// Nothing is completed when the node can not be queried.
+func completeActiveDenoms(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) != 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	if cmd.Context() == nil {
+		cmd.SetContext(context.Background())
+	}
+
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+	defer cancel()
+
+	res, err := types.NewQueryClient(clientCtx).Actives(ctx, &types.QueryActivesRequest{})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var denoms []string
+	for _, denom := range res.Actives {
+		if strings.HasPrefix(denom, toComplete) {
+			denoms = append(denoms, denom)
+		}
+	}
+
+	return denoms, cobra.ShellCompDirectiveNoFileComp
+}
@@ -0,0 +1,273 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/spf13/cobra"
+
+	"github.com/Team-Kujira/core/x/oracle/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// dumpPageLimit is the number of validators requested per page
+const dumpPageLimit = 100
+
+// dumpValidator is the oracle state kept per validator
+type dumpValidator struct {
+	ValidatorAddr string `json:"validator_addr"`
+	FeederAddr    string `json:"feeder_addr"`
+	MissCounter   uint64 `json:"miss_counter,string"`
+}
+
+// GetCmdQueryDump implements the query dump command.
+func GetCmdQueryDump() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump",
+		Args:  cobra.NoArgs,
+		Short: "Export the whole oracle state at a single height as JSON",
+		Long: strings.TrimSpace(`
+Export params, active denoms, exchange rates, feeder delegations and miss counters
+of all validators, and the outstanding aggregate prevotes and votes into a single
+JSON document. All queries are made at the same height, which defaults to the
+latest height of the node. The document is written while the validators are paged
+through, so it is not kept in memory.
+
+$ kujirad query oracle dump --height 1000000 > oracle.json
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Pin the height, so that all queries see the same state
+			clientCtx, err = pinHeight(clientCtx)
+			if err != nil {
+				return err
+			}
+
+			out := bufio.NewWriter(cmd.OutOrStdout())
+			if err := writeDump(clientCtx, out); err != nil {
+				return err
+			}
+
+			return out.Flush()
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// pinHeight sets the height of the client context to the latest height of the node, unless set
+func pinHeight(clientCtx client.Context) (client.Context, error) {
+	if clientCtx.Height != 0 {
+		return clientCtx, nil
+	}
+
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return clientCtx, err
+	}
+
+	status, err := node.Status(context.Background())
+	if err != nil {
+		return clientCtx, err
+	}
+
+	return clientCtx.WithHeight(status.SyncInfo.LatestBlockHeight), nil
+}
+
+func writeDump(clientCtx client.Context, out io.Writer) error {
+	ctx := context.Background()
+	queryClient := types.NewQueryClient(clientCtx)
+	w := &dumpWriter{out: out, cdc: clientCtx.Codec}
+
+	w.beginObject()
+	w.key("height")
+	w.json(fmt.Sprint(clientCtx.Height))
+
+	params, err := queryClient.Params(ctx, &types.QueryParamsRequest{})
+	if err != nil {
+		return err
+	}
+	w.key("params")
+	w.proto(&params.Params)
+
+	actives, err := queryClient.Actives(ctx, &types.QueryActivesRequest{})
+	if err != nil {
+		return err
+	}
+	w.key("actives")
+	w.json(actives.Actives)
+
+	exchangeRates, err := queryClient.ExchangeRates(ctx, &types.QueryExchangeRatesRequest{})
+	if err != nil {
+		return err
+	}
+	w.key("exchange_rates")
+	w.beginArray()
+	for i := range exchangeRates.ExchangeRates {
+		w.element()
+		w.proto(&exchangeRates.ExchangeRates[i])
+	}
+	w.endArray()
+
+	// Feeder delegations and miss counters are kept per validator
+	w.key("validators")
+	w.beginArray()
+	stakingClient := stakingtypes.NewQueryClient(clientCtx)
+	var nextKey []byte
+	for {
+		validators, err := stakingClient.Validators(ctx, &stakingtypes.QueryValidatorsRequest{
+			Pagination: &query.PageRequest{Key: nextKey, Limit: dumpPageLimit},
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, validator := range validators.Validators {
+			feeder, err := queryClient.FeederDelegation(ctx, &types.QueryFeederDelegationRequest{ValidatorAddr: validator.OperatorAddress})
+			if err != nil {
+				return err
+			}
+
+			missCounter, err := queryClient.MissCounter(ctx, &types.QueryMissCounterRequest{ValidatorAddr: validator.OperatorAddress})
+			if err != nil {
+				return err
+			}
+
+			w.element()
+			w.json(dumpValidator{
+				ValidatorAddr: validator.OperatorAddress,
+				FeederAddr:    feeder.FeederAddr,
+				MissCounter:   missCounter.MissCounter,
+			})
+		}
+
+		if validators.Pagination == nil || len(validators.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = validators.Pagination.NextKey
+	}
+	w.endArray()
+
+	prevotes, err := queryClient.AggregatePrevotes(ctx, &types.QueryAggregatePrevotesRequest{})
+	if err != nil {
+		return err
+	}
+	w.key("aggregate_prevotes")
+	w.beginArray()
+	for i := range prevotes.AggregatePrevotes {
+		w.element()
+		w.proto(&prevotes.AggregatePrevotes[i])
+	}
+	w.endArray()
+
+	votes, err := queryClient.AggregateVotes(ctx, &types.QueryAggregateVotesRequest{})
+	if err != nil {
+		return err
+	}
+	w.key("aggregate_votes")
+	w.beginArray()
+	for i := range votes.AggregateVotes {
+		w.element()
+		w.proto(&votes.AggregateVotes[i])
+	}
+	w.endArray()
+
+	w.endObject()
+	w.write("\n")
+	return w.err
+}
+
+// dumpWriter writes a JSON document piece by piece and keeps the first error
+type dumpWriter struct {
+	out io.Writer
+	cdc codec.JSONCodec
+	err error
+
+	// first tracks whether the next key or element opens its object or array
+	first bool
+}
+
+func (w *dumpWriter) write(s string) {
+	if w.err != nil {
+		return
+	}
+
+	_, w.err = io.WriteString(w.out, s)
+}
+
+func (w *dumpWriter) separate() {
+	if !w.first {
+		w.write(",")
+	}
+	w.first = false
+}
+
+func (w *dumpWriter) key(name string) {
+	w.separate()
+	w.write(fmt.Sprintf("%q:", name))
+}
+
+func (w *dumpWriter) beginObject() {
+	w.write("{")
+	w.first = true
+}
+
+func (w *dumpWriter) endObject() {
+	w.write("}")
+	w.first = false
+}
+
+func (w *dumpWriter) beginArray() {
+	w.write("[")
+	w.first = true
+}
+
+func (w *dumpWriter) element() {
+	w.separate()
+}
+
+func (w *dumpWriter) endArray() {
+	w.write("]")
+	w.first = false
+}
+
+func (w *dumpWriter) proto(msg proto.Message) {
+	if w.err != nil {
+		return
+	}
+
+	bz, err := w.cdc.MarshalJSON(msg)
+	if err != nil {
+		w.err = err
+		return
+	}
+	w.write(string(bz))
+}
+
+func (w *dumpWriter) json(v interface{}) {
+	if w.err != nil {
+		return
+	}
+
+	bz, err := json.Marshal(v)
+	if err != nil {
+		w.err = err
+		return
+	}
+	w.write(string(bz))
+}
@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/Team-Kujira/core/x/oracle/types"
+)
+
+// queryErrorHints explains the errors of the query server on the command line
+var queryErrorHints = []struct {
+	err  *errors.Error
+	hint string
+}{
+	{types.ErrInvalidDenom, "a denom is required"},
+	{types.ErrUnknownDenom, "the denom has no exchange rate, list the active denoms with 'query oracle actives'"},
+	{types.ErrInvalidValidator, "expected the bech32 operator address of a validator"},
+	{types.ErrUnknownValidator, "no validator exists with this operator address"},
+	{types.ErrInvalidFeeder, "expected the bech32 address of the feeder account"},
+	{types.ErrNoAggregatePrevote, "the validator has no prevote in the current vote period"},
+	{types.ErrNoAggregateVote, "the validator has no vote in the current vote period"},
+}
+
+// friendlyQueryError prefixes the errors of the query server with a hint. Queries
+// through the node only keep the description of the error, so it is matched by it.
+func friendlyQueryError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	for _, h := range queryErrorHints {
+		if errors.IsOf(err, h.err) || strings.Contains(err.Error(), h.err.Error()) {
+			return fmt.Errorf("%s: %w", h.hint, err)
+		}
+	}
+
+	return err
+}
+
+// withFriendlyQueryErrors makes the query commands return friendly errors
+func withFriendlyQueryErrors(cmds ...*cobra.Command) {
+	for _, cmd := range cmds {
+		runE := cmd.RunE
+		if runE == nil {
+			continue
+		}
+
+		cmd.RunE = func(cmd *cobra.Command, args []string) error {
+			return friendlyQueryError(runE(cmd, args))
+		}
+	}
+}
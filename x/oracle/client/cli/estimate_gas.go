@@ -0,0 +1,189 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Team-Kujira/core/x/oracle/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// FlagDenoms is the number of denoms of the vote to estimate the gas of
+const FlagDenoms = "denoms"
+
+// dummyExchangeRate is the rate of the dummy denoms, with the digits of a large rate at full precision
+var dummyExchangeRate = sdk.MustNewDecFromStr("123456.123456789012345678")
+
+// voteGasEstimate is the output of the estimate-gas command
+type voteGasEstimate struct {
+	Denoms     int    `json:"denoms"`
+	PrevoteGas uint64 `json:"prevote_gas"`
+	VoteGas    uint64 `json:"vote_gas"`
+	VoteBytes  int    `json:"vote_bytes"`
+}
+
+// GetCmdEstimateGas implements the estimate gas command.
+func GetCmdEstimateGas() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-gas [validator]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Estimate the gas of the prevote and the vote on the exchange rates of N denoms",
+		Long: strings.TrimSpace(`
+Estimate the gas of the prevote and the vote of a feeder on the exchange rates of
+--denoms dummy denoms, e.g. to size the gas limit and budget of a feeder. The messages
+are built the same way the feeder builds them. The prevote is simulated against the
+node, which requires --from to be the validator or its delegated feeder. A vote can
+only be simulated against a prevote of the previous vote period, so its gas is the one
+of the prevote plus the cost of its larger transaction and stored vote. Nothing is
+broadcast. Set the gas limit with some margin over the estimate, e.g. --gas-adjustment.
+
+$ kujirad tx oracle estimate-gas --denoms 20 --from feeder
+
+If voting from a voting delegate, set "validator" to the address of the validator to vote on behalf of:
+$ kujirad tx oracle estimate-gas kujiravaloper1... --denoms 20 --from feeder
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denoms, err := cmd.Flags().GetInt(FlagDenoms)
+			if err != nil {
+				return err
+			}
+			if denoms <= 0 {
+				return fmt.Errorf("--%s must be positive", FlagDenoms)
+			}
+
+			// By default the feeder is voting on behalf of itself
+			feeder := clientCtx.GetFromAddress()
+			validator := sdk.ValAddress(feeder)
+			if len(args) == 1 {
+				validator, err = sdk.ValAddressFromBech32(args[0])
+				if err != nil {
+					return fmt.Errorf("validator address is invalid: %w", err)
+				}
+			}
+
+			spec, err := types.NewQueryClient(clientCtx).VoteHashSpec(cmd.Context(), &types.QueryVoteHashSpecRequest{})
+			if err != nil {
+				return err
+			}
+			salt, err := newSalt()
+			if err != nil {
+				return err
+			}
+			exchangeRates := dummyExchangeRates(denoms)
+			hash, err := types.GetAggregateVoteHashWithAlgo(spec.CommitmentHashAlgo, salt, exchangeRates, validator)
+			if err != nil {
+				return err
+			}
+
+			prevote := types.NewMsgAggregateExchangeRatePrevote(hash, feeder, validator)
+			vote := types.NewMsgAggregateExchangeRateVote(salt, exchangeRates, feeder, validator)
+			for _, msg := range []sdk.Msg{prevote, vote} {
+				if err := msg.ValidateBasic(); err != nil {
+					return err
+				}
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf, err = txf.Prepare(clientCtx)
+			if err != nil {
+				return err
+			}
+			simRes, _, err := tx.CalculateGas(clientCtx, txf, prevote)
+			if err != nil {
+				return err
+			}
+
+			authParams, err := authtypes.NewQueryClient(clientCtx).Params(cmd.Context(), &authtypes.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			prevoteTxBytes, err := txSize(clientCtx, txf, prevote)
+			if err != nil {
+				return err
+			}
+			voteTxBytes, err := txSize(clientCtx, txf, vote)
+			if err != nil {
+				return err
+			}
+			tuples, err := types.ParseExchangeRateTuples(exchangeRates)
+			if err != nil {
+				return err
+			}
+			storedPrevote := types.NewAggregateExchangeRatePrevote(hash, validator, 0)
+			storedVote := types.NewAggregateExchangeRateVote(tuples, validator)
+
+			prevoteGas := simRes.GasInfo.GasUsed
+			return clientCtx.PrintObjectLegacy(voteGasEstimate{
+				Denoms:     denoms,
+				PrevoteGas: prevoteGas,
+				VoteGas: prevoteGas + voteExtraGas(
+					voteTxBytes-prevoteTxBytes,
+					storedVote.Size()-storedPrevote.Size(),
+					authParams.Params.TxSizeCostPerByte,
+				),
+				VoteBytes: voteTxBytes,
+			})
+		},
+	}
+
+	cmd.Flags().Int(FlagDenoms, 1, "Number of denoms to vote on")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// dummyExchangeRates returns the canonical exchange rates of n dummy denoms
+func dummyExchangeRates(n int) string {
+	tuples := make(types.ExchangeRateTuples, n)
+	for i := range tuples {
+		tuples[i] = types.NewExchangeRateTuple(fmt.Sprintf("denom%04d", i), dummyExchangeRate)
+	}
+
+	return types.CanonicalExchangeRates(tuples)
+}
+
+// txSize returns the size of the unsigned transaction of the msg. The signatures add the same
+// size to all transactions of the feeder.
+func txSize(clientCtx client.Context, txf tx.Factory, msg sdk.Msg) (int, error) {
+	txb, err := txf.BuildUnsignedTx(msg)
+	if err != nil {
+		return 0, err
+	}
+	bz, err := clientCtx.TxConfig.TxEncoder()(txb.GetTx())
+	if err != nil {
+		return 0, err
+	}
+
+	return len(bz), nil
+}
+
+// voteExtraGas returns the gas a vote costs on top of its prevote, for the extra bytes of its
+// transaction and the extra bytes of the vote stored over the prevote. The reads, writes and
+// deletes of both messages are about the same otherwise.
+func voteExtraGas(extraTxBytes, extraStoreBytes int, txSizeCostPerByte uint64) uint64 {
+	gas := uint64(0)
+	if extraTxBytes > 0 {
+		gas += uint64(extraTxBytes) * txSizeCostPerByte
+	}
+	if extraStoreBytes > 0 {
+		gas += uint64(extraStoreBytes) * storetypes.KVGasConfig().WriteCostPerByte
+	}
+
+	return gas
+}
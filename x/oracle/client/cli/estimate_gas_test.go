@@ -0,0 +1,33 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Team-Kujira/core/x/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDummyExchangeRates(t *testing.T) {
+	exchangeRates := dummyExchangeRates(3)
+	require.Equal(t, "123456.123456789012345678denom0000,123456.123456789012345678denom0001,123456.123456789012345678denom0002", exchangeRates)
+
+	// The vote on them is a valid one
+	tuples, err := types.ParseExchangeRateTuples(exchangeRates)
+	require.NoError(t, err)
+	require.Len(t, tuples, 3)
+	salt, err := newSalt()
+	require.NoError(t, err)
+	vote := types.NewMsgAggregateExchangeRateVote(salt, exchangeRates, sdk.AccAddress("feeder"), sdk.ValAddress("validator"))
+	require.NoError(t, vote.ValidateBasic())
+}
+
+func TestVoteExtraGas(t *testing.T) {
+	// 10 gas per tx byte and 30 gas per stored byte
+	require.Equal(t, uint64(100*10+50*30), voteExtraGas(100, 50, 10))
+
+	// A vote smaller than the prevote costs about the same
+	require.Equal(t, uint64(0), voteExtraGas(-10, -5, 10))
+}
@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/spf13/cobra"
+
+	"github.com/Team-Kujira/core/x/oracle/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// exchangeRateProof is the output of the exchange rate proof command
+type exchangeRateProof struct {
+	Denom        string             `json:"denom"`
+	ExchangeRate sdk.Dec            `json:"exchange_rate"`
+	Height       int64              `json:"height"`
+	Key          string             `json:"key"`
+	KeyPath      string             `json:"key_path"`
+	Proof        *tmcrypto.ProofOps `json:"proof"`
+}
+
+// GetCmdQueryExchangeRateProof implements the query exchange rate proof command.
+func GetCmdQueryExchangeRateProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "exchange-rate-proof [denom]",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeActiveDenoms,
+		Short:             "Query the exchange rate of a denom along with its Merkle proof",
+		Long: strings.TrimSpace(`
+Query the exchange rate of a denom as stored, along with the Merkle proof of its store
+key, for a counterparty verifying it through a light client of the chain. The proof of
+the state at --height verifies against the app hash of the block at the next height,
+e.g. with types.VerifyExchangeRateProof. The latest height is used if not given.
+
+$ kujirad query oracle exchange-rate-proof KUJI --height 1000
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			denom := args[0]
+			key := types.GetExchangeRateKey(denom)
+			res, err := clientCtx.QueryABCI(abci.RequestQuery{
+				Path:   types.ExchangeRateProofPath,
+				Data:   key,
+				Height: clientCtx.Height,
+				Prove:  true,
+			})
+			if err != nil {
+				return err
+			}
+			if len(res.Value) == 0 {
+				return fmt.Errorf("no exchange rate of %s at height %d", denom, res.Height)
+			}
+
+			var exchangeRate sdk.DecProto
+			if err := clientCtx.Codec.Unmarshal(res.Value, &exchangeRate); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintObjectLegacy(exchangeRateProof{
+				Denom:        denom,
+				ExchangeRate: exchangeRate.Dec,
+				Height:       res.Height,
+				Key:          hex.EncodeToString(key),
+				KeyPath:      types.ExchangeRateProofKeyPath(denom),
+				Proof:        res.ProofOps,
+			})
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,343 @@
+package cli
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"google.golang.org/grpc"
+
+	"github.com/Team-Kujira/core/x/oracle/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// FlagPollInterval is the interval the feeder polls the vote period at
+	FlagPollInterval = "poll-interval"
+
+	// DefaultFeederPollInterval is the default interval the feeder polls the vote period at
+	DefaultFeederPollInterval = 2 * time.Second
+	// DefaultFeederMaxRevealRetries is the default number of failed attempts to reveal a prevote
+	// after which the feeder gives up on it and only prevotes
+	DefaultFeederMaxRevealRetries = 3
+)
+
+// FeederQueryClient is the part of the oracle query client the feeder polls
+type FeederQueryClient interface {
+	LightClientState(ctx context.Context, in *types.QueryLightClientStateRequest, opts ...grpc.CallOption) (*types.QueryLightClientStateResponse, error)
+	VoteHashSpec(ctx context.Context, in *types.QueryVoteHashSpecRequest, opts ...grpc.CallOption) (*types.QueryVoteHashSpecResponse, error)
+}
+
+// PriceSource returns the exchange rates to vote for in the current vote period
+type PriceSource func(ctx context.Context) (sdk.DecCoins, error)
+
+// Broadcaster signs the messages and broadcasts them in a single transaction. It fails if the
+// transaction is not accepted.
+type Broadcaster func(ctx context.Context, msgs ...sdk.Msg) error
+
+// FeederConfig configures the feeder loop run by RunFeeder
+type FeederConfig struct {
+	// Feeder is the account signing the votes, the validator itself or its delegated feeder
+	Feeder sdk.AccAddress
+	// Validator is the validator voted on behalf of
+	Validator sdk.ValAddress
+	// Prices returns the exchange rates to vote for
+	Prices PriceSource
+	// Query polls the vote period and the commitment hash algorithm
+	Query FeederQueryClient
+	// Broadcast signs and broadcasts the votes
+	Broadcast Broadcaster
+	// PollInterval is the interval the vote period is polled at, DefaultFeederPollInterval if zero
+	PollInterval time.Duration
+	// MaxRevealRetries is the number of failed attempts to reveal a prevote after which it is
+	// given up on, DefaultFeederMaxRevealRetries if zero
+	MaxRevealRetries int
+	// Logger logs the votes and failures, nothing if nil
+	Logger log.Logger
+}
+
+// pendingPrevote is a prevote submitted by the feeder, to be revealed in the next vote period
+type pendingPrevote struct {
+	period        uint64
+	salt          string
+	exchangeRates string
+}
+
+// feeder holds the state of the feeder loop across vote periods
+type feeder struct {
+	cfg           FeederConfig
+	pending       *pendingPrevote
+	revealRetries int
+}
+
+// RunFeeder votes on the exchange rates of the price source every vote period until the context
+// is done. In every new vote period, it reveals the prevote of the previous vote period, if any,
+// and prevotes the current exchange rates with a new random salt in the same transaction. A
+// failed transaction is retried at the next poll. After MaxRevealRetries failed attempts to
+// reveal, the reveal is given up on, so that at least the prevote of the current vote period is
+// submitted.
+func RunFeeder(ctx context.Context, cfg FeederConfig) error {
+	f, err := newFeeder(cfg)
+	if err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(f.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		if err := f.step(ctx); err != nil {
+			f.cfg.Logger.Error("failed to vote", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// newFeeder validates the config and fills in the defaults
+func newFeeder(cfg FeederConfig) (*feeder, error) {
+	if cfg.Feeder.Empty() || cfg.Validator.Empty() {
+		return nil, fmt.Errorf("feeder and validator addresses must be set")
+	}
+	if cfg.Prices == nil || cfg.Query == nil || cfg.Broadcast == nil {
+		return nil, fmt.Errorf("price source, query client and broadcaster must be set")
+	}
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = DefaultFeederPollInterval
+	}
+	if cfg.MaxRevealRetries <= 0 {
+		cfg.MaxRevealRetries = DefaultFeederMaxRevealRetries
+	}
+	if cfg.Logger == nil {
+		cfg.Logger = log.NewNopLogger()
+	}
+
+	return &feeder{cfg: cfg}, nil
+}
+
+// step submits the votes of the current vote period, unless they were submitted already
+func (f *feeder) step(ctx context.Context) error {
+	state, err := f.cfg.Query.LightClientState(ctx, &types.QueryLightClientStateRequest{})
+	if err != nil {
+		return err
+	}
+
+	period := state.VotePeriod
+	if f.pending != nil && f.pending.period == period {
+		return nil
+	}
+
+	// Only the prevote of the previous vote period can be revealed, bound to the current one
+	var msgs []sdk.Msg
+	reveal := f.pending != nil && f.pending.period+1 == period && f.revealRetries < f.cfg.MaxRevealRetries
+	if reveal {
+		vote := types.NewMsgAggregateExchangeRateVote(f.pending.salt, f.pending.exchangeRates, f.cfg.Feeder, f.cfg.Validator)
+		vote.VotePeriod = period
+		msgs = append(msgs, vote)
+	}
+
+	prices, err := f.cfg.Prices(ctx)
+	if err != nil {
+		return err
+	}
+	if prices.Empty() {
+		return fmt.Errorf("price source returned no exchange rates")
+	}
+	// Reveal the canonical form, so that the hash does not depend on the order of the price source
+	tuples := make(types.ExchangeRateTuples, len(prices))
+	for i, price := range prices {
+		tuples[i] = types.NewExchangeRateTuple(price.Denom, price.Amount)
+	}
+	exchangeRates := types.CanonicalExchangeRates(tuples)
+
+	salt, err := newSalt()
+	if err != nil {
+		return err
+	}
+	spec, err := f.cfg.Query.VoteHashSpec(ctx, &types.QueryVoteHashSpecRequest{})
+	if err != nil {
+		return err
+	}
+	hash, err := types.GetAggregateVoteHashWithAlgo(spec.CommitmentHashAlgo, salt, exchangeRates, f.cfg.Validator)
+	if err != nil {
+		return err
+	}
+	msgs = append(msgs, types.NewMsgAggregateExchangeRatePrevote(hash, f.cfg.Feeder, f.cfg.Validator))
+
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	if err := f.cfg.Broadcast(ctx, msgs...); err != nil {
+		if reveal {
+			f.revealRetries++
+		}
+		return err
+	}
+
+	f.cfg.Logger.Info("voted", "vote_period", period, "revealed", reveal, "exchange_rates", exchangeRates)
+	f.pending = &pendingPrevote{period: period, salt: salt, exchangeRates: exchangeRates}
+	f.revealRetries = 0
+	return nil
+}
+
+// newSalt returns a random hex encoded salt of SaltLength
+func newSalt() (string, error) {
+	bz := make([]byte, types.SaltLength/2)
+	if _, err := rand.Read(bz); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(bz), nil
+}
+
+// GetCmdFeeder implements the feeder command.
+func GetCmdFeeder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feeder [prices.json] [validator]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Run a feeder voting on the exchange rates of a file every vote period",
+		Long: strings.TrimSpace(`
+Run a reference feeder, which votes on the exchange rates of a file every vote period
+until interrupted. In every vote period it reveals the prevote of the previous one and
+prevotes the exchange rates with a new random salt. The file is read again in every
+vote period, so another process can keep it up to date. It maps denoms to exchange rates:
+
+{"ukuji": "0.75", "uatom": "9.1"}
+
+$ kujirad tx oracle feeder prices.json --from feeder
+
+If voting from a voting delegate, set "validator" to the address of the validator to vote on behalf of:
+$ kujirad tx oracle feeder prices.json kujiravaloper1... --from feeder
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// By default the feeder is voting on behalf of itself
+			validator := sdk.ValAddress(clientCtx.GetFromAddress())
+			if len(args) == 2 {
+				validator, err = sdk.ValAddressFromBech32(args[1])
+				if err != nil {
+					return fmt.Errorf("validator address is invalid: %w", err)
+				}
+			}
+
+			pollInterval, err := cmd.Flags().GetDuration(FlagPollInterval)
+			if err != nil {
+				return err
+			}
+
+			broadcast, err := txBroadcaster(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			return RunFeeder(ctx, FeederConfig{
+				Feeder:       clientCtx.GetFromAddress(),
+				Validator:    validator,
+				Prices:       filePriceSource(args[0]),
+				Query:        types.NewQueryClient(clientCtx),
+				Broadcast:    broadcast,
+				PollInterval: pollInterval,
+				Logger:       log.NewTMLogger(log.NewSyncWriter(os.Stderr)),
+			})
+		},
+	}
+
+	cmd.Flags().Duration(FlagPollInterval, DefaultFeederPollInterval, "Interval to poll the vote period at")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// filePriceSource reads the exchange rates of a JSON file mapping denoms to rates
+func filePriceSource(path string) PriceSource {
+	return func(_ context.Context) (sdk.DecCoins, error) {
+		rates, err := readRatesFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		// Denoms of the whitelist need not be valid coin denoms, so the coins are not validated
+		prices := make(sdk.DecCoins, 0, len(rates))
+		for denom, rate := range rates {
+			prices = append(prices, sdk.DecCoin{Denom: denom, Amount: rate})
+		}
+		sort.Slice(prices, func(i, j int) bool {
+			return prices[i].Denom < prices[j].Denom
+		})
+
+		return prices, nil
+	}
+}
+
+// txBroadcaster returns a Broadcaster signing the messages with the key of the client context.
+// It fails if the transaction is rejected, unlike the broadcast of the other tx commands.
+func txBroadcaster(clientCtx client.Context, flagSet *pflag.FlagSet) (Broadcaster, error) {
+	txf, err := tx.NewFactoryCLI(clientCtx, flagSet)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(_ context.Context, msgs ...sdk.Msg) error {
+		// The account sequence is queried again for every transaction
+		txf, err := txf.Prepare(clientCtx)
+		if err != nil {
+			return err
+		}
+
+		if txf.SimulateAndExecute() {
+			_, adjusted, err := tx.CalculateGas(clientCtx, txf, msgs...)
+			if err != nil {
+				return err
+			}
+			txf = txf.WithGas(adjusted)
+		}
+
+		txb, err := txf.BuildUnsignedTx(msgs...)
+		if err != nil {
+			return err
+		}
+		if err := tx.Sign(txf, clientCtx.GetFromName(), txb, true); err != nil {
+			return err
+		}
+		txBytes, err := clientCtx.TxConfig.TxEncoder()(txb.GetTx())
+		if err != nil {
+			return err
+		}
+
+		res, err := clientCtx.BroadcastTx(txBytes)
+		if err != nil {
+			return err
+		}
+		if res.Code != 0 {
+			return fmt.Errorf("transaction %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+		}
+
+		return nil
+	}, nil
+}
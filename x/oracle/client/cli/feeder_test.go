@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/Team-Kujira/core/x/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// mockFeederQueryClient reports a settable vote period
+type mockFeederQueryClient struct {
+	period uint64
+}
+
+func (q *mockFeederQueryClient) LightClientState(context.Context, *types.QueryLightClientStateRequest, ...grpc.CallOption) (*types.QueryLightClientStateResponse, error) {
+	return &types.QueryLightClientStateResponse{VotePeriod: q.period}, nil
+}
+
+func (q *mockFeederQueryClient) VoteHashSpec(context.Context, *types.QueryVoteHashSpecRequest, ...grpc.CallOption) (*types.QueryVoteHashSpecResponse, error) {
+	return &types.QueryVoteHashSpecResponse{CommitmentHashAlgo: types.CommitmentHashAlgoSHA256}, nil
+}
+
+// mockBroadcaster records the broadcast transactions, failing while fail is set
+type mockBroadcaster struct {
+	txs  [][]sdk.Msg
+	fail bool
+}
+
+func (b *mockBroadcaster) broadcast(_ context.Context, msgs ...sdk.Msg) error {
+	b.txs = append(b.txs, msgs)
+	if b.fail {
+		return errors.New("rejected")
+	}
+	return nil
+}
+
+func (b *mockBroadcaster) last() []sdk.Msg {
+	return b.txs[len(b.txs)-1]
+}
+
+func newTestFeeder(t *testing.T) (*feeder, *mockFeederQueryClient, *mockBroadcaster) {
+	query := &mockFeederQueryClient{period: 1}
+	broadcaster := &mockBroadcaster{}
+	f, err := newFeeder(FeederConfig{
+		Feeder:    sdk.AccAddress("feeder"),
+		Validator: sdk.ValAddress("validator"),
+		Prices: func(context.Context) (sdk.DecCoins, error) {
+			return sdk.DecCoins{{Denom: "ukuji", Amount: sdk.NewDecWithPrec(75, 2)}}, nil
+		},
+		Query:            query,
+		Broadcast:        broadcaster.broadcast,
+		MaxRevealRetries: 2,
+	})
+	require.NoError(t, err)
+	return f, query, broadcaster
+}
+
+func TestFeederPrevoteAndReveal(t *testing.T) {
+	f, query, broadcaster := newTestFeeder(t)
+	ctx := context.Background()
+
+	// The first vote period only prevotes, once
+	require.NoError(t, f.step(ctx))
+	require.NoError(t, f.step(ctx))
+	require.Len(t, broadcaster.txs, 1)
+	require.Len(t, broadcaster.last(), 1)
+	prevote, ok := broadcaster.last()[0].(*types.MsgAggregateExchangeRatePrevote)
+	require.True(t, ok)
+
+	// The next vote period reveals the prevote and prevotes again
+	query.period = 2
+	require.NoError(t, f.step(ctx))
+	require.Len(t, broadcaster.txs, 2)
+	require.Len(t, broadcaster.last(), 2)
+	vote, ok := broadcaster.last()[0].(*types.MsgAggregateExchangeRateVote)
+	require.True(t, ok)
+	require.Equal(t, "0.750000000000000000ukuji", vote.ExchangeRates)
+	require.Equal(t, uint64(2), vote.VotePeriod)
+	require.Len(t, vote.Salt, types.SaltLength)
+	hash, err := types.GetAggregateVoteHashWithAlgo(types.CommitmentHashAlgoSHA256, vote.Salt, vote.ExchangeRates, f.cfg.Validator)
+	require.NoError(t, err)
+	require.Equal(t, prevote.Hash, hash.String())
+
+	// Each prevote has a new salt
+	nextVote := broadcaster.last()[1].(*types.MsgAggregateExchangeRatePrevote)
+	require.NotEqual(t, prevote.Hash, nextVote.Hash)
+
+	// A prevote older than the previous vote period cannot be revealed anymore
+	query.period = 4
+	require.NoError(t, f.step(ctx))
+	require.Len(t, broadcaster.last(), 1)
+	require.IsType(t, &types.MsgAggregateExchangeRatePrevote{}, broadcaster.last()[0])
+}
+
+func TestFeederRevealRetry(t *testing.T) {
+	f, query, broadcaster := newTestFeeder(t)
+	ctx := context.Background()
+	require.NoError(t, f.step(ctx))
+
+	// A failed reveal is retried at the next poll, up to MaxRevealRetries times
+	query.period = 2
+	broadcaster.fail = true
+	require.Error(t, f.step(ctx))
+	require.Len(t, broadcaster.last(), 2)
+	require.Error(t, f.step(ctx))
+	require.Len(t, broadcaster.last(), 2)
+
+	// Then it is given up on, so that the prevote gets through
+	broadcaster.fail = false
+	require.NoError(t, f.step(ctx))
+	require.Len(t, broadcaster.txs, 4)
+	require.Len(t, broadcaster.last(), 1)
+	require.IsType(t, &types.MsgAggregateExchangeRatePrevote{}, broadcaster.last()[0])
+
+	// The retries are counted again for the next reveal
+	query.period = 3
+	require.NoError(t, f.step(ctx))
+	require.Len(t, broadcaster.last(), 2)
+}
+
+func TestFeederPriceSourceFailure(t *testing.T) {
+	f, _, broadcaster := newTestFeeder(t)
+	f.cfg.Prices = func(context.Context) (sdk.DecCoins, error) {
+		return sdk.DecCoins{}, nil
+	}
+
+	require.Error(t, f.step(context.Background()))
+	require.Empty(t, broadcaster.txs)
+}
+
+func TestRunFeeder(t *testing.T) {
+	f, _, broadcaster := newTestFeeder(t)
+
+	cfg := f.cfg
+	cfg.Broadcast = nil
+	require.Error(t, RunFeeder(context.Background(), cfg))
+
+	// The feeder runs until the context is done
+	cfg = f.cfg
+	cfg.PollInterval = time.Millisecond
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.NoError(t, RunFeeder(ctx, cfg))
+	require.Len(t, broadcaster.txs, 1)
+}
+
+func TestFilePriceSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prices.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"uatom": "9.1", "KUJI": "0.75"}`), 0o600))
+
+	prices, err := filePriceSource(path)(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "0.750000000000000000KUJI,9.100000000000000000uatom", prices.String())
+
+	_, err = types.ParseExchangeRateTuples(prices.String())
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"uatom": "-1"}`), 0o600))
+	_, err = filePriceSource(path)(context.Background())
+	require.Error(t, err)
+}
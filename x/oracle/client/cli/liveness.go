@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/Team-Kujira/core/x/oracle/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+const (
+	// FlagMaxStalePeriods is the number of vote periods an exchange rate may be old
+	FlagMaxStalePeriods = "max-stale-periods"
+	// FlagMaxPeriodBlocks is the number of blocks the vote period has to advance within
+	FlagMaxPeriodBlocks = "max-period-blocks"
+)
+
+// liveness is the output of the liveness query
+type liveness struct {
+	Height     int64                   `json:"height"`
+	VotePeriod uint64                  `json:"vote_period"`
+	AgePeriods []types.ExchangeRateAge `json:"age_periods"`
+	Problems   []string                `json:"problems,omitempty"`
+}
+
+// GetCmdQueryLiveness implements the query liveness command.
+func GetCmdQueryLiveness() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liveness",
+		Args:  cobra.NoArgs,
+		Short: "Check that the oracle keeps advancing and tallying all whitelisted denoms",
+		Long: strings.TrimSpace(`
+Check that the vote period advanced within the last --max-period-blocks blocks, and
+that every whitelisted denom has an exchange rate no older than --max-stale-periods
+vote periods. The command prints the state it checked and exits with an error
+describing each problem found, so it can be run from cron or a systemd timer for
+alerting. --max-period-blocks defaults to twice the VotePeriod; set it explicitly
+when the vote periods are timed.
+
+$ kujirad query oracle liveness --max-stale-periods 2
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxStalePeriods, err := cmd.Flags().GetUint64(FlagMaxStalePeriods)
+			if err != nil {
+				return err
+			}
+			maxPeriodBlocks, err := cmd.Flags().GetInt64(FlagMaxPeriodBlocks)
+			if err != nil {
+				return err
+			}
+
+			// Pin the height, so that all queries see the same state
+			clientCtx, err = pinHeight(clientCtx)
+			if err != nil {
+				return err
+			}
+
+			out, err := checkLiveness(clientCtx, maxStalePeriods, maxPeriodBlocks)
+			if err != nil {
+				return err
+			}
+
+			if err := clientCtx.PrintObjectLegacy(out); err != nil {
+				return err
+			}
+			if len(out.Problems) > 0 {
+				return fmt.Errorf("oracle is not live: %s", strings.Join(out.Problems, "; "))
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagMaxStalePeriods, 1, "Number of vote periods an exchange rate may be old")
+	cmd.Flags().Int64(FlagMaxPeriodBlocks, 0, "Number of blocks the vote period has to advance within, twice the VotePeriod if zero")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// checkLiveness compares the oracle state at the height of the client context with the
+// state max period blocks earlier
+func checkLiveness(clientCtx client.Context, maxStalePeriods uint64, maxPeriodBlocks int64) (liveness, error) {
+	ctx := context.Background()
+	queryClient := types.NewQueryClient(clientCtx)
+
+	state, err := queryClient.LightClientState(ctx, &types.QueryLightClientStateRequest{})
+	if err != nil {
+		return liveness{}, err
+	}
+
+	exchangeRates, err := queryClient.ExchangeRates(ctx, &types.QueryExchangeRatesRequest{})
+	if err != nil {
+		return liveness{}, err
+	}
+
+	out := liveness{
+		Height:     clientCtx.Height,
+		VotePeriod: state.VotePeriod,
+		AgePeriods: exchangeRates.AgePeriods,
+	}
+
+	ages := map[string]uint64{}
+	for _, age := range exchangeRates.AgePeriods {
+		ages[age.Denom] = age.AgePeriods
+	}
+	for _, denom := range state.Params.Whitelist {
+		age, ok := ages[denom.Name]
+		if !ok {
+			out.Problems = append(out.Problems, fmt.Sprintf("%s has no exchange rate", denom.Name))
+			continue
+		}
+		if age > maxStalePeriods {
+			out.Problems = append(out.Problems, fmt.Sprintf("exchange rate of %s is %d vote periods old, exceeding %d", denom.Name, age, maxStalePeriods))
+		}
+	}
+
+	if maxPeriodBlocks == 0 {
+		maxPeriodBlocks = 2 * int64(state.Params.VotePeriod)
+	}
+
+	// Nothing to compare with before the chain is old enough
+	pastHeight := clientCtx.Height - maxPeriodBlocks
+	if pastHeight < 1 {
+		return out, nil
+	}
+
+	past, err := types.NewQueryClient(clientCtx.WithHeight(pastHeight)).LightClientState(ctx, &types.QueryLightClientStateRequest{})
+	if err != nil {
+		return liveness{}, err
+	}
+	if past.VotePeriod == state.VotePeriod {
+		out.Problems = append(out.Problems, fmt.Sprintf("vote period %d has not advanced in %d blocks", state.VotePeriod, maxPeriodBlocks))
+	}
+
+	return out, nil
+}
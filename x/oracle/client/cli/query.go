@@ -2,8 +2,10 @@ package cli
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/spf13/cobra"
 
 	"github.com/Team-Kujira/core/x/oracle/types"
@@ -11,8 +13,34 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
+// FlagWide extends the output of a query with related details
+const FlagWide = "wide"
+
+// FlagAddressOnly reduces the output of a query to the queried address
+const FlagAddressOnly = "address-only"
+
+// FlagMaxAge fails a query when an exchange rate is older than the given number of vote periods
+const FlagMaxAge = "max-age"
+
+// noActiveDenoms is printed in place of an empty list of active denoms, e.g. on a fresh chain
+const noActiveDenoms = "no active denoms"
+
+// FlagOrder sorts the output of a ranking query, either "asc" or "desc"
+const FlagOrder = "order"
+
+// FlagMove is the relative move of the median to estimate the cost of
+const FlagMove = "move"
+
+// FlagWindows is the number of vote periods to look back over
+const FlagWindows = "windows"
+
+// FlagBuckets is the number of buckets to split the exchange rates of a ballot into
+const FlagBuckets = "buckets"
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	oracleQueryCmd := &cobra.Command{
@@ -29,9 +57,48 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryFeederDelegation(),
 		GetCmdQueryMissCounter(),
+		GetCmdQueryMissCounters(),
 		GetCmdQueryAggregatePrevote(),
+		GetCmdQueryPendingReveals(),
 		GetCmdQueryAggregateVote(),
+		GetCmdQueryRewardEstimate(),
+		GetCmdQueryVoteHashSpec(),
+		GetCmdQueryDump(),
+		GetCmdQueryIsFeederAuthorized(),
+		GetCmdQueryDenomBackingPower(),
+		GetCmdQueryDenomTallySuccessRate(),
+		GetCmdQueryBandMembershipStats(),
+		GetCmdQueryDenomTallyDiagnosis(),
+		GetCmdQueryRequiredDenoms(),
+		GetCmdQueryObservers(),
+		GetCmdQueryValidatorAccuracyRanking(),
+		GetCmdQueryParticipationSummary(),
+		GetCmdQueryRawDenomState(),
+		GetCmdQueryMedianFlipCost(),
+		GetCmdQueryOracleAlertConfig(),
+		GetCmdQueryModuleInfo(),
+		GetCmdQueryFreshExchangeRate(),
+		GetCmdQueryWhitelistChanges(),
+		GetCmdQueryRejectedTuples(),
+		GetCmdQueryRevealWindowStatus(),
+		GetCmdQueryBallotHistogram(),
+		GetCmdQuerySharedFeeders(),
+		GetCmdQueryLastTallyStats(),
+		GetCmdQueryRecommendedDenoms(),
+		GetCmdQueryDenomStatuses(),
+		GetCmdQueryExchangeRateProof(),
+		GetCmdQueryParticipationSeries(),
+		GetCmdQueryMaxPeriodMove(),
+		GetCmdQueryDenomSchedule(),
+		GetCmdQueryUpcomingGraceExits(),
+		GetCmdQueryLightClientState(),
+		GetCmdQueryValidatorRateDeviation(),
+		GetCmdQueryValidatorMissingDenoms(),
+		GetCmdQueryAnomalies(),
+		GetCmdQueryLiveness(),
+		GetCmdQueryCheckConfig(),
 	)
+	withFriendlyQueryErrors(oracleQueryCmd.Commands()...)
 
 	return oracleQueryCmd
 }
@@ -39,18 +106,31 @@ func GetQueryCmd() *cobra.Command {
 // GetCmdQueryExchangeRates implements the query rate command.
 func GetCmdQueryExchangeRates() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "exchange-rates [denom]",
-		Args:  cobra.RangeArgs(0, 1),
-		Short: "Query the current exchange rate of an asset",
+		Use:               "exchange-rates [denom]",
+		Args:              cobra.RangeArgs(0, 1),
+		ValidArgsFunction: completeActiveDenoms,
+		Short:             "Query the current exchange rate of an asset",
 		Long: strings.TrimSpace(`
 Query the current exchange rate of USD with an asset. 
 You can find the current list of active denoms by running
 
 $ kujirad query oracle exchange-rates 
 
+Before any denom has an exchange rate, e.g. on a fresh chain, "no active denoms" is
+printed instead, unless the output is JSON.
+
 Or, can filter with denom
 
 $ kujirad query oracle exchange-rates KUJI
+
+The exchange rate of a single denom is reported along with the number of validators
+which rated it in its last successful tally, as voter_count. A denom mirroring the rate
+of another one reports that denom as tracks.
+
+With --max-age, the command exits with an error if any of the exchange rates was
+last tallied more than the given number of vote periods ago, e.g. for health checks
+
+$ kujirad query oracle exchange-rates KUJI --max-age 2
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -59,13 +139,31 @@ $ kujirad query oracle exchange-rates KUJI
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
+			maxAge, err := cmd.Flags().GetUint64(FlagMaxAge)
+			if err != nil {
+				return err
+			}
+			checkAge := cmd.Flags().Changed(FlagMaxAge)
+
 			if len(args) == 0 {
 				res, err := queryClient.ExchangeRates(context.Background(), &types.QueryExchangeRatesRequest{})
 				if err != nil {
 					return err
 				}
 
-				return clientCtx.PrintProto(res)
+				if err := printActiveDenoms(clientCtx, res, len(res.ExchangeRates)); err != nil {
+					return err
+				}
+				if !checkAge {
+					return nil
+				}
+
+				for _, age := range res.AgePeriods {
+					if err := checkMaxAge(age.Denom, age.AgePeriods, maxAge); err != nil {
+						return err
+					}
+				}
+				return nil
 			}
 
 			denom := args[0]
@@ -77,14 +175,31 @@ $ kujirad query oracle exchange-rates KUJI
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			if err := clientCtx.PrintProto(res); err != nil {
+				return err
+			}
+			if !checkAge {
+				return nil
+			}
+
+			return checkMaxAge(denom, res.AgePeriods, maxAge)
 		},
 	}
 
+	cmd.Flags().Uint64(FlagMaxAge, 0, "Exit with an error if an exchange rate is older than the given number of vote periods")
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
 
+// checkMaxAge returns an error if the exchange rate of the denom exceeds the max age
+func checkMaxAge(denom string, agePeriods, maxAge uint64) error {
+	if agePeriods > maxAge {
+		return fmt.Errorf("exchange rate of %s is %d vote periods old, exceeding the max age of %d", denom, agePeriods, maxAge)
+	}
+
+	return nil
+}
+
 // GetCmdQueryActives implements the query actives command.
 func GetCmdQueryActives() *cobra.Command {
 	cmd := &cobra.Command{
@@ -95,6 +210,9 @@ func GetCmdQueryActives() *cobra.Command {
 Query the active list of assets recognized by the types.
 
 $ kujirad query oracle actives
+
+Before any denom has an exchange rate, e.g. on a fresh chain, "no active denoms" is
+printed instead, unless the output is JSON.
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -108,7 +226,7 @@ $ kujirad query oracle actives
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			return printActiveDenoms(clientCtx, res, len(res.Actives))
 		},
 	}
 
@@ -116,6 +234,16 @@ $ kujirad query oracle actives
 	return cmd
 }
 
+// printActiveDenoms prints the response listing the count active denoms, or a notice in place of
+// an empty list with the text output. The JSON output is kept as is for scripts.
+func printActiveDenoms(clientCtx client.Context, res proto.Message, count int) error {
+	if count == 0 && clientCtx.OutputFormat != "json" {
+		return clientCtx.PrintString(noActiveDenoms + "\n")
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
 // GetCmdQueryParams implements the query params command.
 func GetCmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -152,6 +280,16 @@ func GetCmdQueryFeederDelegation() *cobra.Command {
 Query the account the validator's oracle voting right is delegated to.
 
 $ kujirad query oracle feeder kujiravaloper...
+
+With --wide, the validator's moniker, whether the feeder is the validator itself
+or a delegated account, and the feeder's balance of the bond denom are shown too.
+
+$ kujirad query oracle feeder kujiravaloper... --wide
+
+With --address-only, only the feeder address is printed, e.g. for command substitution
+in scripts, or {"feeder":"kujira1..."} with --output json.
+
+$ kujirad query oracle feeder kujiravaloper... --address-only
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -163,7 +301,7 @@ $ kujirad query oracle feeder kujiravaloper...
 			valString := args[0]
 			validator, err := sdk.ValAddressFromBech32(valString)
 			if err != nil {
-				return err
+				return fmt.Errorf("invalid validator address %q: %w", valString, err)
 			}
 
 			res, err := queryClient.FeederDelegation(
@@ -174,14 +312,92 @@ $ kujirad query oracle feeder kujiravaloper...
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			addressOnly, err := cmd.Flags().GetBool(FlagAddressOnly)
+			if err != nil {
+				return err
+			}
+			if addressOnly {
+				if clientCtx.OutputFormat == "json" {
+					return clientCtx.PrintObjectLegacy(feederAddress{Feeder: res.FeederAddr})
+				}
+				return clientCtx.PrintString(res.FeederAddr + "\n")
+			}
+
+			wide, err := cmd.Flags().GetBool(FlagWide)
+			if err != nil {
+				return err
+			}
+			if !wide {
+				return clientCtx.PrintProto(res)
+			}
+
+			return clientCtx.PrintObjectLegacy(queryFeederDelegationWide(clientCtx, validator, res.FeederAddr))
 		},
 	}
 
+	cmd.Flags().Bool(FlagWide, false, "Show the validator moniker, feeder type and feeder balance")
+	cmd.Flags().Bool(FlagAddressOnly, false, "Print the feeder address only")
+	cmd.MarkFlagsMutuallyExclusive(FlagWide, FlagAddressOnly)
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
 
+// feederAddress is the output of the feeder query with the address only flag and JSON output
+type feederAddress struct {
+	Feeder string `json:"feeder"`
+}
+
+// feederDelegationWide is the output of the feeder query with the wide flag
+type feederDelegationWide struct {
+	ValidatorAddr string   `json:"validator_addr"`
+	Moniker       string   `json:"moniker,omitempty"`
+	FeederAddr    string   `json:"feeder_addr"`
+	FeederType    string   `json:"feeder_type"`
+	FeederBalance string   `json:"feeder_balance,omitempty"`
+	Errors        []string `json:"errors,omitempty"`
+}
+
+// queryFeederDelegationWide composes the staking and bank details of a feeder delegation.
+// Failing sub-queries leave their fields empty and are reported in Errors.
+func queryFeederDelegationWide(clientCtx client.Context, validator sdk.ValAddress, feederAddr string) feederDelegationWide {
+	ctx := context.Background()
+	out := feederDelegationWide{
+		ValidatorAddr: validator.String(),
+		FeederAddr:    feederAddr,
+		FeederType:    "delegated",
+	}
+
+	if feederAddr == sdk.AccAddress(validator).String() {
+		out.FeederType = "self"
+	}
+
+	stakingClient := stakingtypes.NewQueryClient(clientCtx)
+	valRes, err := stakingClient.Validator(ctx, &stakingtypes.QueryValidatorRequest{ValidatorAddr: validator.String()})
+	if err != nil {
+		out.Errors = append(out.Errors, fmt.Sprintf("moniker: %s", err))
+	} else {
+		out.Moniker = valRes.Validator.Description.Moniker
+	}
+
+	stakingParams, err := stakingClient.Params(ctx, &stakingtypes.QueryParamsRequest{})
+	if err != nil {
+		out.Errors = append(out.Errors, fmt.Sprintf("feeder balance: %s", err))
+		return out
+	}
+
+	balance, err := banktypes.NewQueryClient(clientCtx).Balance(ctx, &banktypes.QueryBalanceRequest{
+		Address: feederAddr,
+		Denom:   stakingParams.Params.BondDenom,
+	})
+	if err != nil {
+		out.Errors = append(out.Errors, fmt.Sprintf("feeder balance: %s", err))
+		return out
+	}
+	out.FeederBalance = balance.Balance.String()
+
+	return out
+}
+
 // GetCmdQueryMissCounter implements the query miss counter of the validator command
 func GetCmdQueryMissCounter() *cobra.Command {
 	cmd := &cobra.Command{
@@ -222,6 +438,48 @@ $ kujirad query oracle miss kujiravaloper...
 	return cmd
 }
 
+// GetCmdQueryMissCounters implements the query miss counters of all validators command.
+func GetCmdQueryMissCounters() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "miss-all",
+		Args:  cobra.NoArgs,
+		Short: "Query the miss counts of all validators",
+		Long: strings.TrimSpace(`
+Query the # of vote periods missed in this oracle slash window by every validator
+with missed votes, and whether it is already at risk of being slashed at the end
+of the window.
+
+$ kujirad query oracle miss-all --limit 100
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.MissCounters(
+				context.Background(),
+				&types.QueryMissCountersRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "miss counters")
+	return cmd
+}
+
 // GetCmdQueryAggregatePrevote implements the query aggregate prevote of the validator command
 func GetCmdQueryAggregatePrevote() *cobra.Command {
 	cmd := &cobra.Command{
@@ -278,20 +536,51 @@ $ kujirad query oracle aggregate-prevotes kujiravaloper...
 	return cmd
 }
 
-// GetCmdQueryAggregateVote implements the query aggregate prevote of the validator command
-func GetCmdQueryAggregateVote() *cobra.Command {
+// GetCmdQueryPendingReveals implements the query pending reveals command
+func GetCmdQueryPendingReveals() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "aggregate-votes [validator]",
-		Args:  cobra.RangeArgs(0, 1),
-		Short: "Query outstanding oracle aggregate votes.",
+		Use:   "pending-reveals",
+		Args:  cobra.NoArgs,
+		Short: "Query the prevotes still awaiting their vote",
 		Long: strings.TrimSpace(`
-Query outstanding oracle aggregate vote.
+Query the aggregate prevotes of the previous vote period which have not been revealed
+yet, with the number of blocks left to reveal them in the current vote period. A
+feeder listed here has submitted its prevote, but failed to reveal it so far.
 
-$ kujirad query oracle aggregate-votes 
+$ kujirad query oracle pending-reveals
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
 
-Or, can filter with voter address
+			res, err := queryClient.PendingReveals(context.Background(), &types.QueryPendingRevealsRequest{})
+			if err != nil {
+				return err
+			}
 
-$ kujirad query oracle aggregate-votes kujiravaloper...
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryRequiredDenoms implements the query required denoms command
+func GetCmdQueryRequiredDenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "required-denoms",
+		Args:  cobra.NoArgs,
+		Short: "Query the denoms other modules require exchange rates of",
+		Long: strings.TrimSpace(`
+Query the denoms other modules registered as required, with the names of the modules
+requiring them. A required denom is never delisted automatically, and a proposal
+delisting or renaming it fails.
+
+$ kujirad query oracle required-denoms
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -300,25 +589,698 @@ $ kujirad query oracle aggregate-votes kujiravaloper...
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			if len(args) == 0 {
-				res, err := queryClient.AggregateVotes(
-					context.Background(),
-					&types.QueryAggregateVotesRequest{},
-				)
-				if err != nil {
-					return err
-				}
+			res, err := queryClient.RequiredDenoms(context.Background(), &types.QueryRequiredDenomsRequest{})
+			if err != nil {
+				return err
+			}
 
-				return clientCtx.PrintProto(res)
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryObservers implements the query observers command
+func GetCmdQueryObservers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "observers",
+		Args:  cobra.NoArgs,
+		Short: "Query the validators exempt from slashing as observers",
+		Long: strings.TrimSpace(`
+Query the validators governance registered as observers, with the height their
+exemption expires at. The votes of an observer count towards the ballots, but it
+is not slashed for missing votes until then.
+
+$ kujirad query oracle observers
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
 			}
+			queryClient := types.NewQueryClient(clientCtx)
 
-			valString := args[0]
-			validator, err := sdk.ValAddressFromBech32(valString)
+			res, err := queryClient.Observers(context.Background(), &types.QueryObserversRequest{})
 			if err != nil {
 				return err
 			}
 
-			res, err := queryClient.AggregateVote(
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryValidatorAccuracyRanking implements the query accuracy ranking command.
+func GetCmdQueryValidatorAccuracyRanking() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accuracy-ranking",
+		Args:  cobra.NoArgs,
+		Short: "Query the validators ranked by the share of their submissions within the reward band",
+		Long: strings.TrimSpace(`
+Query the validators ranked by the share of the exchange rates they submitted on
+tallied denoms in the current and the previous slash window, which were within the
+reward band. Unlike the miss counters, which only tell whether a validator voted,
+it tells how accurately. Abstaining votes are not counted.
+
+$ kujirad query oracle accuracy-ranking
+
+Or, list the 10 least accurate validators:
+
+$ kujirad query oracle accuracy-ranking --limit 10 --order asc
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			limit, err := cmd.Flags().GetUint64(flags.FlagLimit)
+			if err != nil {
+				return err
+			}
+			order, err := cmd.Flags().GetString(FlagOrder)
+			if err != nil {
+				return err
+			}
+			if order != "asc" && order != "desc" {
+				return fmt.Errorf("order must be asc or desc, is %s", order)
+			}
+
+			res, err := queryClient.ValidatorAccuracyRanking(context.Background(), &types.QueryValidatorAccuracyRankingRequest{
+				Limit:     limit,
+				Ascending: order == "asc",
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flags.FlagLimit, 0, "Number of validators to list, all if zero")
+	cmd.Flags().String(FlagOrder, "desc", "Rank the most (desc) or the least (asc) accurate validators first")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryMedianFlipCost implements the query flip-cost command.
+func GetCmdQueryMedianFlipCost() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "flip-cost [denom]",
+		Args:              cobra.RangeArgs(0, 1),
+		ValidArgsFunction: completeActiveDenoms,
+		Short:             "Query the voting power needed to move the median of the denoms",
+		Long: strings.TrimSpace(`
+Query the additional voting power an adversary would need to move the median of a denom
+by a relative amount, up and down, estimated from the votes of the last vote period weighted
+by the current power of the voters. The lower the power compared to the power of the ballot,
+the easier the exchange rate of the denom is to manipulate.
+
+$ kujirad query oracle flip-cost
+
+Or, for a single denom and a move of 10%:
+
+$ kujirad query oracle flip-cost KUJI --move 0.1
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			move, err := cmd.Flags().GetString(FlagMove)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryMedianFlipCostRequest{Move: move}
+			if len(args) == 1 {
+				req.Denom = args[0]
+			}
+
+			res, err := queryClient.MedianFlipCost(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagMove, "0.05", "Relative move of the median, between 0 and 1")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryOracleAlertConfig implements the query alert-config command.
+func GetCmdQueryOracleAlertConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "alert-config [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the miss rate a validator declared to accept",
+		Long: strings.TrimSpace(`
+Query the miss rate a validator declared to accept within a slash window, for monitoring
+services to alert on, along with its miss rate in the current slash window.
+
+$ kujirad query oracle alert-config kujiravaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.OracleAlertConfig(
+				context.Background(),
+				&types.QueryOracleAlertConfigRequest{ValidatorAddr: validator.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryModuleInfo implements the query info command.
+func GetCmdQueryModuleInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "info",
+		Args:  cobra.NoArgs,
+		Short: "Query the consensus version of the oracle module and its enabled features",
+		Long: strings.TrimSpace(`
+Query the consensus version of the oracle module and which of its optional features
+the params enable on this chain, or their setting where it is not a switch. Features
+the module does not support are not listed.
+
+$ kujirad query oracle info
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ModuleInfo(context.Background(), &types.QueryModuleInfoRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryFreshExchangeRate implements the query fresh exchange rate command.
+func GetCmdQueryFreshExchangeRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fresh [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the exchange rate of a denom, failing if it is stale",
+		Long: strings.TrimSpace(`
+Query the exchange rate of a denom, only if it was last tallied at most the given
+number of vote periods ago. Otherwise the query fails with a stale exchange rate
+error reporting the actual age. With the default --max-age of 0, the rate must
+have been tallied in the last vote period.
+
+$ kujirad query oracle fresh KUJI --max-age 3
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			maxAge, err := cmd.Flags().GetUint64(FlagMaxAge)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FreshExchangeRate(
+				context.Background(),
+				&types.QueryFreshExchangeRateRequest{Denom: args[0], MaxAgePeriods: maxAge},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagMaxAge, 0, "Max number of vote periods since the exchange rate was last tallied")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryWhitelistChanges implements the query whitelist changes command.
+func GetCmdQueryWhitelistChanges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "whitelist-changes",
+		Args:  cobra.NoArgs,
+		Short: "Query the denoms added to or removed from the whitelist in the last vote periods",
+		Long: strings.TrimSpace(`
+Query the denoms added to or removed from the whitelist, by governance or by
+auto-delisting, in the last given number of vote periods, along with the vote
+period of each change. Changes are only logged while the WhitelistChangeRetention
+param is set, and for that many vote periods. Without --windows, all the changes
+retained are returned.
+
+$ kujirad query oracle whitelist-changes --windows 5
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			windows, err := cmd.Flags().GetUint64(FlagWindows)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.WhitelistChanges(context.Background(), &types.QueryWhitelistChangesRequest{Windows: windows})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagWindows, 0, "Number of vote periods to look back over, all the retained ones if zero")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryRejectedTuples implements the query rejected tuples command.
+func GetCmdQueryRejectedTuples() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rejected-tuples [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the exchange rates of the last vote of a validator left out of the tally",
+		Long: strings.TrimSpace(`
+Query the exchange rates of the last vote of a validator which are left out of the
+tally, e.g. for a misspelled denom which is not a vote target, along with the reason
+and the vote period the vote was revealed in. The validator misses the denoms it
+meant to vote for, so a non-empty result explains missed votes.
+
+$ kujirad query oracle rejected-tuples kujiravaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RejectedTuples(
+				context.Background(),
+				&types.QueryRejectedTuplesRequest{ValidatorAddr: validator.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryRevealWindowStatus implements the query reveal status command.
+func GetCmdQueryRevealWindowStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-status [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the prevote of a validator and the blocks its vote can be revealed in",
+		Long: strings.TrimSpace(`
+Query whether a validator has a prevote awaiting its vote, the height it was submitted
+at, the first and last heights the vote can be revealed at and whether it can be
+revealed now, along with whether the validator already revealed a vote in the current
+vote period. It lets a feeder confirm it is on schedule before the reveal window
+closes. With timed vote periods, the heights not known in advance are zero.
+
+$ kujirad query oracle reveal-status kujiravaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RevealWindowStatus(
+				context.Background(),
+				&types.QueryRevealWindowStatusRequest{ValidatorAddr: validator.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryBallotHistogram implements the query histogram command.
+func GetCmdQueryBallotHistogram() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "histogram [denom]",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeActiveDenoms,
+		Short:             "Query the distribution of the exchange rates voted on a denom",
+		Long: strings.TrimSpace(`
+Query the votes of the last vote period on a denom, weighted by the current power of the
+voters, bucketed into buckets of equal width between the lowest and the highest exchange
+rate voted. Abstaining votes are left out. The edges of the buckets are returned along
+with the power and the number of votes of each, e.g. to chart how closely the feeders agree.
+
+$ kujirad query oracle histogram KUJI --buckets 20
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			buckets, err := cmd.Flags().GetUint32(FlagBuckets)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BallotHistogram(
+				context.Background(),
+				&types.QueryBallotHistogramRequest{Denom: args[0], Buckets: buckets},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32(FlagBuckets, types.DefaultHistogramBuckets, "Number of buckets")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQuerySharedFeeders implements the query shared-feeders command.
+func GetCmdQuerySharedFeeders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "shared-feeders",
+		Args:  cobra.NoArgs,
+		Short: "Query the validators voting through the same feeder account",
+		Long: strings.TrimSpace(`
+Query the feeder accounts voting for more than one validator, along with the validators
+each of them votes for, the largest groups first. A validator voting by itself counts
+towards the group of its own account. Validators sharing a feeder likely share their
+infrastructure, which reduces the real decentralization of the oracle.
+
+$ kujirad query oracle shared-feeders
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.SharedFeeders(context.Background(), &types.QuerySharedFeedersRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryLastTallyStats implements the query tally-stats command.
+func GetCmdQueryLastTallyStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tally-stats",
+		Args:  cobra.NoArgs,
+		Short: "Query the structural counts of the ballots of the last tally",
+		Long: strings.TrimSpace(`
+Query the counts of the ballot of each denom in the last tally: the votes processed,
+the abstaining ones among them, whether the ballot passed the vote threshold and was
+tallied, and the votes whose power was capped by the max power share. The counts are
+deterministic, unlike timings, and complement the telemetry of the module.
+
+$ kujirad query oracle tally-stats
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LastTallyStats(context.Background(), &types.QueryLastTallyStatsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryRecommendedDenoms implements the query recommended denoms command.
+func GetCmdQueryRecommendedDenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recommended-denoms",
+		Args:  cobra.NoArgs,
+		Short: "Query the denoms a feeder must report to avoid being counted as missing",
+		Long: strings.TrimSpace(`
+Query the whitelisted denoms a feeder must report to avoid being counted as missing,
+i.e. the whitelist less the denoms still in their activation grace, which are listed
+separately as they have to be reported once their grace ends. Denoms with a vote period
+multiplier are included, even while resting. The list can be used to generate the
+configuration of a feeder.
+
+$ kujirad query oracle recommended-denoms --output json
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RecommendedDenoms(context.Background(), &types.QueryRecommendedDenomsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDenomStatuses implements the query denom statuses command.
+func GetCmdQueryDenomStatuses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "statuses",
+		Args:  cobra.NoArgs,
+		Short: "Query the whitelisted denoms grouped by the state of their feed",
+		Long: strings.TrimSpace(`
+Query the whitelisted denoms grouped by the state of their feed, with the number of
+denoms in each status:
+
+  active:  the exchange rate is fresh, tallied in the last vote period or resting since
+  stale:   the exchange rate is carried forward, as the denom failed to tally
+  pending: the denom is in its activation grace
+  failing: the denom has no exchange rate
+
+$ kujirad query oracle statuses
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomStatuses(context.Background(), &types.QueryDenomStatusesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryParticipationSeries implements the query participation series command.
+func GetCmdQueryParticipationSeries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "participation-series",
+		Args:  cobra.NoArgs,
+		Short: "Query the participation ratio of the last vote periods",
+		Long: strings.TrimSpace(`
+Query the share of the bonded power which voted in each of the last tallied vote periods,
+oldest first, e.g. to chart the participation and spot feeder attrition early. The last
+100 vote periods are returned by default, and at most the last 1000 are kept.
+
+$ kujirad query oracle participation-series --limit 200
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			limit, err := cmd.Flags().GetUint64(flags.FlagLimit)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ParticipationSeries(context.Background(), &types.QueryParticipationSeriesRequest{Limit: limit})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flags.FlagLimit, types.DefaultParticipationSeriesLimit, "Number of vote periods to list")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryMaxPeriodMove implements the query max move command.
+func GetCmdQueryMaxPeriodMove() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "max-move [denom]",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeActiveDenoms,
+		Short:             "Query the largest change of the exchange rate of a denom between vote periods",
+		Long: strings.TrimSpace(`
+Query the largest absolute change in percent of the exchange rate of a denom from one
+vote period to the tally of the next, in the current window of MaxMoveWindow vote periods,
+along with the vote period it happened in, e.g. to calibrate the reward band. The windows
+start at multiples of MaxMoveWindow, and nothing is tracked while it is zero.
+
+$ kujirad query oracle max-move KUJI
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MaxPeriodMove(context.Background(), &types.QueryMaxPeriodMoveRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryAggregateVote implements the query aggregate prevote of the validator command
+func GetCmdQueryAggregateVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "aggregate-votes [validator]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query outstanding oracle aggregate votes.",
+		Long: strings.TrimSpace(`
+Query outstanding oracle aggregate vote.
+
+$ kujirad query oracle aggregate-votes 
+
+Or, can filter with voter address
+
+$ kujirad query oracle aggregate-votes kujiravaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 0 {
+				res, err := queryClient.AggregateVotes(
+					context.Background(),
+					&types.QueryAggregateVotesRequest{},
+				)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			valString := args[0]
+			validator, err := sdk.ValAddressFromBech32(valString)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AggregateVote(
 				context.Background(),
 				&types.QueryAggregateVoteRequest{ValidatorAddr: validator.String()},
 			)
@@ -333,3 +1295,557 @@ $ kujirad query oracle aggregate-votes kujiravaloper...
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryRewardEstimate implements the query reward estimate command.
+func GetCmdQueryRewardEstimate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reward-estimate [validator]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the estimated reward per unit of power for the next vote period",
+		Long: strings.TrimSpace(`
+Query the estimated oracle reward per unit of voting power for the next vote period.
+The estimate is based on the current reward pool and the power of the last ballot winners,
+it is not a guarantee of the actual reward. The reward is paid out to the ballot winners at
+the end of the vote period, and weighted by the accuracy of their votes if
+AccuracyWeightedRewards is set, which the estimate does not account for.
+
+$ kujirad query oracle reward-estimate
+
+To also estimate the reward of a specific validator, run:
+
+$ kujirad query oracle reward-estimate kujiravaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			query := types.QueryRewardEstimateRequest{}
+			if len(args) != 0 {
+				validator, err := sdk.ValAddressFromBech32(args[0])
+				if err != nil {
+					return err
+				}
+				query.ValidatorAddr = validator.String()
+			}
+
+			res, err := queryClient.RewardEstimate(context.Background(), &query)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryVoteHashSpec implements the query vote hash spec command.
+func GetCmdQueryVoteHashSpec() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hash-spec",
+		Args:  cobra.NoArgs,
+		Short: "Query the format of the aggregate vote hash preimage",
+		Long: strings.TrimSpace(`
+Query how the hash of an aggregate prevote is computed, i.e. the field order
+and separators of the preimage, the salt requirements and the hash algorithm.
+
+$ kujirad query oracle hash-spec
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VoteHashSpec(context.Background(), &types.QueryVoteHashSpecRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryIsFeederAuthorized implements the query is feeder authorized command.
+func GetCmdQueryIsFeederAuthorized() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "is-feeder [validator] [feeder]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query whether an account may submit oracle votes for a validator",
+		Long: strings.TrimSpace(`
+Query whether votes of the feeder would be accepted for the validator, together with
+the feeder currently delegated by the validator.
+
+$ kujirad query oracle is-feeder kujiravaloper... kujira...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			feeder, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.IsFeederAuthorized(
+				context.Background(),
+				&types.QueryIsFeederAuthorizedRequest{ValidatorAddr: validator.String(), FeederAddr: feeder.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDenomBackingPower implements the query backing power command.
+func GetCmdQueryDenomBackingPower() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "backing [denom]",
+		Args:              cobra.RangeArgs(0, 1),
+		ValidArgsFunction: completeActiveDenoms,
+		Short:             "Query the voting power backing each denom in the current vote period",
+		Long: strings.TrimSpace(`
+Query the summed voting power of the bonded validators that have submitted a vote for each
+denom in the current vote period. No tally is run, so the figure shows the coverage of a denom
+before the period closes.
+
+$ kujirad query oracle backing
+
+Or, can filter with a specific denom:
+
+$ kujirad query oracle backing KUJI
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			query := types.QueryDenomBackingPowerRequest{}
+			if len(args) != 0 {
+				query.Denom = args[0]
+			}
+
+			res, err := queryClient.DenomBackingPower(context.Background(), &query)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDenomTallySuccessRate implements the query success rate command.
+func GetCmdQueryDenomTallySuccessRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "success-rate [denom]",
+		Args:              cobra.RangeArgs(0, 1),
+		ValidArgsFunction: completeActiveDenoms,
+		Short:             "Query the number of vote periods each denom tallied in the current slash window",
+		Long: strings.TrimSpace(`
+Query the number of vote periods each denom tallied and failed to tally in the current
+slash window. The counts are reset at the end of each slash window.
+
+$ kujirad query oracle success-rate
+
+Or, can filter with a specific denom:
+
+$ kujirad query oracle success-rate KUJI
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			query := types.QueryDenomTallySuccessRateRequest{}
+			if len(args) != 0 {
+				query.Denom = args[0]
+			}
+
+			res, err := queryClient.DenomTallySuccessRate(context.Background(), &query)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryBandMembershipStats implements the query band stats command.
+func GetCmdQueryBandMembershipStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "band-stats [denom]",
+		Args:              cobra.RangeArgs(0, 1),
+		ValidArgsFunction: completeActiveDenoms,
+		Short:             "Query the share of the voting power within the reward band of each denom",
+		Long: strings.TrimSpace(`
+Query the share of the power of the votes rating each denom in the last vote period,
+which was within its reward band. Abstaining votes are not counted. A consistently
+low share signals feeders disagreeing on a price, or a reward band too tight.
+
+$ kujirad query oracle band-stats
+
+Or, can filter with a specific denom:
+
+$ kujirad query oracle band-stats KUJI
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			query := types.QueryBandMembershipStatsRequest{}
+			if len(args) != 0 {
+				query.Denom = args[0]
+			}
+
+			res, err := queryClient.BandMembershipStats(context.Background(), &query)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDenomTallyDiagnosis implements the query diagnose command.
+func GetCmdQueryDenomTallyDiagnosis() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "diagnose [denom]",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeActiveDenoms,
+		Short:             "Query why a denom did or did not tally in the last vote period",
+		Long: strings.TrimSpace(`
+Query the outcome of the last vote period of a whitelisted denom, with its reason:
+
+success:         the denom tallied
+below_threshold: the power voting on it did not reach the vote threshold
+stale:           nobody voted on it
+resting:         it was not due, because of its vote period multiplier
+
+Along with it, the share of the power which voted on the denom and the vote threshold
+it is required to reach are reported.
+
+$ kujirad query oracle diagnose KUJI
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomTallyDiagnosis(context.Background(), &types.QueryDenomTallyDiagnosisRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryParticipationSummary implements the query summary command.
+func GetCmdQueryParticipationSummary() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "summary",
+		Args:  cobra.NoArgs,
+		Short: "Query how much of the bonded power voted in the last vote period",
+		Long: strings.TrimSpace(`
+Query the total power and the number of the bonded validators, and the power and the
+number of those which actually voted in the last vote period, all measured when it was
+tallied. The participation ratio is the share of the bonded power which voted.
+
+$ kujirad query oracle summary
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ParticipationSummary(context.Background(), &types.QueryParticipationSummaryRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryRawDenomState implements the query raw command.
+func GetCmdQueryRawDenomState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "raw [denom]",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeActiveDenoms,
+		Short:             "Query the raw store entries of a denom, for debugging",
+		Long: strings.TrimSpace(`
+Query the store entries of a denom exactly as persisted, its exchange rate and counters,
+with the hex encoded store key and value of each. Entries not stored are left out.
+
+This is a debugging tool for encoding and migration issues, its output format is not
+stable and may change with any release.
+
+$ kujirad query oracle raw KUJI
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RawDenomState(context.Background(), &types.QueryRawDenomStateRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDenomSchedule implements the query denom schedule command.
+func GetCmdQueryDenomSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:               "schedule [denom]",
+		Args:              cobra.RangeArgs(0, 1),
+		ValidArgsFunction: completeActiveDenoms,
+		Short:             "Query the vote period each denom next requires votes in",
+		Long: strings.TrimSpace(`
+Query the vote period each whitelisted denom is next tallied in with missing it counted,
+taking its vote period multiplier and grace window into account, and the blocks or, if
+the vote periods are timed, the time left until the end of that vote period. The votes
+on a denom must be revealed within that vote period, so prevoted in the one before.
+The soonest due denom is listed first.
+
+$ kujirad query oracle schedule
+
+Or, can filter with a specific denom:
+
+$ kujirad query oracle schedule KUJI
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			query := types.QueryDenomScheduleRequest{}
+			if len(args) != 0 {
+				query.Denom = args[0]
+			}
+
+			res, err := queryClient.DenomSchedule(context.Background(), &query)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryUpcomingGraceExits implements the query grace exits command.
+func GetCmdQueryUpcomingGraceExits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grace-exits",
+		Args:  cobra.NoArgs,
+		Short: "Query the newly whitelisted denoms still in their grace window",
+		Long: strings.TrimSpace(`
+Query the newly whitelisted denoms that are not yet counted as missed when a validator
+does not vote for them, along with the vote period and height from which they are.
+The soonest exit is listed first.
+
+$ kujirad query oracle grace-exits
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.UpcomingGraceExits(context.Background(), &types.QueryUpcomingGraceExitsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryLightClientState implements the query light client state command.
+func GetCmdQueryLightClientState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "light-client-state",
+		Args:  cobra.NoArgs,
+		Short: "Query the params, exchange rates and current vote period in a single query",
+		Long: strings.TrimSpace(`
+Query the params, the exchange rates of the active denoms and the current vote period,
+all read at the same height so that they can be proven against a single app hash.
+
+$ kujirad query oracle light-client-state
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LightClientState(context.Background(), &types.QueryLightClientStateRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryValidatorRateDeviation implements the query rate deviation of the validator command
+func GetCmdQueryValidatorRateDeviation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deviation [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query how far the rates of a validator were from the tallied rates in the last vote period",
+		Long: strings.TrimSpace(`
+Query, for each denom the validator submitted in the last vote period and which tallied, the
+submitted and the tallied exchange rate, the difference in percent and whether the submitted
+rate was within the reward band.
+
+$ kujirad query oracle deviation kujiravaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorRateDeviation(
+				context.Background(),
+				&types.QueryValidatorRateDeviationRequest{ValidatorAddr: validator.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryValidatorMissingDenoms implements the query missing denoms command.
+func GetCmdQueryValidatorMissingDenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "missing [validator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the active denoms a validator has not voted on in the current vote period",
+		Long: strings.TrimSpace(`
+Query the active denoms missing from the vote the validator revealed in the current
+vote period. Abstain votes count as voted. Until the vote is revealed, all active
+denoms are missing; an empty list means the validator covered all of them.
+
+$ kujirad query oracle missing kujiravaloper...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ValidatorMissingDenoms(
+				context.Background(),
+				&types.QueryValidatorMissingDenomsRequest{ValidatorAddr: validator.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
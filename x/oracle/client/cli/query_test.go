@@ -0,0 +1,32 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Team-Kujira/core/x/oracle/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+func TestPrintActiveDenoms(t *testing.T) {
+	printed := func(outputFormat string, actives []string) string {
+		var out bytes.Buffer
+		clientCtx := client.Context{}.
+			WithCodec(codec.NewProtoCodec(codectypes.NewInterfaceRegistry())).
+			WithOutput(&out).
+			WithOutputFormat(outputFormat)
+		require.NoError(t, printActiveDenoms(clientCtx, &types.QueryActivesResponse{Actives: actives}, len(actives)))
+		return out.String()
+	}
+
+	// An empty list reads as a notice, but stays parseable as JSON
+	require.Equal(t, "no active denoms\n", printed("text", []string{}))
+	require.JSONEq(t, `{"actives":[]}`, printed("json", []string{}))
+
+	require.Equal(t, "actives:\n- ukuji\n", printed("text", []string{types.TestDenomA}))
+}
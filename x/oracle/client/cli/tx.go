@@ -1,7 +1,9 @@
 package cli
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -12,10 +14,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 
 	"github.com/spf13/cobra"
 )
 
+// FlagAttestation is the optional detached signature over the vote payload
+const FlagAttestation = "attestation"
+
+// FlagVotePeriod is the optional vote period the vote is bound to
+const FlagVotePeriod = "vote-period"
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	oracleTxCmd := &cobra.Command{
@@ -30,6 +40,9 @@ func GetTxCmd() *cobra.Command {
 		GetCmdDelegateFeederPermission(),
 		GetCmdAggregateExchangeRatePrevote(),
 		GetCmdAggregateExchangeRateVote(),
+		GetCmdFeeder(),
+		GetCmdSetOracleAlertConfig(),
+		GetCmdEstimateGas(),
 	)
 
 	return oracleTxCmd
@@ -93,7 +106,8 @@ func GetCmdAggregateExchangeRatePrevote() *cobra.Command {
 		Long: strings.TrimSpace(`
 Submit an oracle aggregate prevote for the exchange rates of multiple denoms.
 The purpose of aggregate prevote is to hide aggregate exchange rate vote with hash which is formatted 
-as hex string in SHA256("{salt}:{exchange_rate}{denom},...,{exchange_rate}{denom}:{voter}")
+as hex string in SHA256("{salt}:{exchange_rate}{denom},...,{exchange_rate}{denom}:{voter}"),
+using the commitment hash algorithm in effect on chain (see "kujirad query oracle hash-spec").
 
 # Aggregate Prevote
 $ kujirad tx oracle aggregate-prevote 1234 0.1ATOM,1.001USDT
@@ -131,7 +145,20 @@ $ kujirad tx oracle aggregate-prevote 1234 0.1ATOM,1.001USDT kujiravaloper1...
 				validator = parsedVal
 			}
 
-			hash := types.GetAggregateVoteHash(salt, exchangeRatesStr, validator)
+			// Commit with the hash algorithm in effect on chain
+			algo := types.DefaultCommitmentHashAlgo
+			if !clientCtx.Offline {
+				spec, err := types.NewQueryClient(clientCtx).VoteHashSpec(context.Background(), &types.QueryVoteHashSpecRequest{})
+				if err != nil {
+					return err
+				}
+				algo = spec.CommitmentHashAlgo
+			}
+
+			hash, err := types.GetAggregateVoteHashWithAlgo(algo, salt, exchangeRatesStr, validator)
+			if err != nil {
+				return err
+			}
 			msgs := []sdk.Msg{types.NewMsgAggregateExchangeRatePrevote(hash, voter, validator)}
 			for _, msg := range msgs {
 				if err := msg.ValidateBasic(); err != nil {
@@ -165,6 +192,13 @@ where "ATOM,USDT" is the denominating currencies, and "0.1.0,1.001" is the excha
 
 If voting from a voting delegate, set "validator" to the address of the validator to vote on behalf of:
 $ kujirad tx oracle aggregate-vote 1234 0.1ATOM,1.001USDT kujiravaloper1....
+
+An optional detached signature over the vote payload can be given with --attestation.
+It is logged in the aggregate_vote event for auditing, but not verified nor tallied.
+
+The vote can be bound to the vote period it is revealed in with --vote-period. Once
+MaxVoteFutureDrift is set, the vote has to be bound, to a period at most MaxVoteFutureDrift
+periods ahead of the current one, or to the previous one within its reveal grace.
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -194,7 +228,21 @@ $ kujirad tx oracle aggregate-vote 1234 0.1ATOM,1.001USDT kujiravaloper1....
 				validator = parsedVal
 			}
 
-			msgs := []sdk.Msg{types.NewMsgAggregateExchangeRateVote(salt, exchangeRatesStr, voter, validator)}
+			attestation, err := cmd.Flags().GetString(FlagAttestation)
+			if err != nil {
+				return err
+			}
+
+			votePeriod, err := cmd.Flags().GetUint64(FlagVotePeriod)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAggregateExchangeRateVote(salt, exchangeRatesStr, voter, validator)
+			msg.Attestation = attestation
+			msg.VotePeriod = votePeriod
+
+			msgs := []sdk.Msg{msg}
 			for _, msg := range msgs {
 				if err := msg.ValidateBasic(); err != nil {
 					return err
@@ -205,6 +253,239 @@ $ kujirad tx oracle aggregate-vote 1234 0.1ATOM,1.001USDT kujiravaloper1....
 		},
 	}
 
+	cmd.Flags().String(FlagAttestation, "", "Optional detached signature over the vote payload, logged for auditing")
+	cmd.Flags().Uint64(FlagVotePeriod, 0, "Vote period the vote is bound to, unbound if zero, required once MaxVoteFutureDrift is set")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// RenameDenomProposalCmd implements the submit rename denom proposal command
+func RenameDenomProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rename-oracle-denom [old-denom] [new-denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to rename a whitelisted oracle denom",
+		Long: strings.TrimSpace(`
+Submit a proposal to move the whitelist entry and all oracle state of a denom, such as
+its exchange rate and counters, to a new canonical name, e.g. after the IBC path of the
+denom changed. The proposal fails if the new denom is already in use.
+
+$ kujirad tx gov submit-legacy-proposal rename-oracle-denom OLD NEW --title "..." --description "..." --deposit 1000ukuji
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription) //nolint:staticcheck
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewRenameDenomProposal(title, description, args[0], args[1])
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "Description of proposal") //nolint:staticcheck
+	cmd.Flags().String(govcli.FlagDeposit, "", "Deposit of proposal")
+	return cmd
+}
+
+// DelistDenomProposalCmd implements the submit delist denom proposal command
+func DelistDenomProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delist-oracle-denom [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to delist a whitelisted oracle denom",
+		Long: strings.TrimSpace(`
+Submit a proposal to remove a denom from the whitelist and clear all oracle state of
+it, such as its exchange rate and counters. The proposal fails while another module
+requires the denom, see "kujirad query oracle required-denoms".
+
+$ kujirad tx gov submit-legacy-proposal delist-oracle-denom DENOM --title "..." --description "..." --deposit 1000ukuji
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription) //nolint:staticcheck
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewDelistDenomProposal(title, description, args[0])
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "Description of proposal") //nolint:staticcheck
+	cmd.Flags().String(govcli.FlagDeposit, "", "Deposit of proposal")
+	return cmd
+}
+
+// SetObserverProposalCmd implements the submit set observer proposal command
+func SetObserverProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-oracle-observer [validator] [exemption-blocks]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to exempt an oracle observer from slashing",
+		Long: strings.TrimSpace(`
+Submit a proposal to register a validator as an oracle observer. The votes of an
+observer count towards the ballots, but it is not slashed for missing votes for the
+given number of blocks from the execution of the proposal, e.g. while it onboards its
+feeder. An exemption of zero blocks removes the observer.
+
+$ kujirad tx gov submit-legacy-proposal set-oracle-observer kujiravaloper1... 100000 --title "..." --description "..." --deposit 1000ukuji
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			validator, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			exemptionBlocks, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid exemption blocks %q: %w", args[1], err)
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription) //nolint:staticcheck
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetObserverProposal(title, description, validator, exemptionBlocks)
+			msg, err := govv1beta1.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "Description of proposal") //nolint:staticcheck
+	cmd.Flags().String(govcli.FlagDeposit, "", "Deposit of proposal")
+	return cmd
+}
+
+// GetCmdSetOracleAlertConfig will create a tx declaring the miss rate the validator accepts and sign it with the given key.
+func GetCmdSetOracleAlertConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-alert-config [max-miss-rate]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Declare the miss rate your validator accepts within a slash window",
+		Long: strings.TrimSpace(`
+Declare the share of the vote periods of a slash window your validator accepts to miss. It is
+purely informational: monitoring services read it to alert you, and an event is emitted when
+the miss rate of your validator crosses it. It does not change how your validator is slashed.
+
+$ kujirad tx oracle set-alert-config 0.1
+
+A max miss rate of 0 removes the declaration.
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// The validator declaring it
+			validator := sdk.ValAddress(clientCtx.GetFromAddress())
+
+			maxMissRate, err := sdk.NewDecFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetOracleAlertConfig(validator, maxMissRate)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,16 @@
+package client
+
+import (
+	"github.com/Team-Kujira/core/x/oracle/client/cli"
+
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+// RenameDenomProposalHandler is the rename denom proposal command handler
+var RenameDenomProposalHandler = govclient.NewProposalHandler(cli.RenameDenomProposalCmd)
+
+// DelistDenomProposalHandler is the delist denom proposal command handler
+var DelistDenomProposalHandler = govclient.NewProposalHandler(cli.DelistDenomProposalCmd)
+
+// SetObserverProposalHandler is the set observer proposal command handler
+var SetObserverProposalHandler = govclient.NewProposalHandler(cli.SetObserverProposalCmd)
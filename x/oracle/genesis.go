@@ -57,7 +57,32 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 		keeper.SetAggregateExchangeRateVote(ctx, valAddr, av)
 	}
 
+	for _, oe := range data.ObserverExemptions {
+		operator, err := sdk.ValAddressFromBech32(oe.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetObserverExemption(ctx, operator, oe.ExemptUntil)
+	}
+
+	for _, ac := range data.ValidatorAlertConfigs {
+		operator, err := sdk.ValAddressFromBech32(ac.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		keeper.SetOracleAlertConfig(ctx, operator, ac.Config)
+	}
+
 	keeper.SetParams(ctx, data.Params)
+	keeper.SetCommitmentHashAlgo(ctx, data.Params.CommitmentHashAlgo)
+
+	// The denoms whitelisted at genesis are required right away
+	votePeriod := keeper.CurrentVotePeriod(ctx)
+	for _, denom := range data.Params.Whitelist {
+		keeper.SetDenomGraceExit(ctx, denom.Name, votePeriod)
+	}
 
 	// check if the module account exists
 	moduleAcc := keeper.GetOracleAccount(ctx)
@@ -107,10 +132,30 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		return false
 	})
 
+	observerExemptions := []types.ObserverExemption{}
+	keeper.IterateObserverExemptions(ctx, func(operator sdk.ValAddress, exemptUntil int64) (stop bool) {
+		observerExemptions = append(observerExemptions, types.ObserverExemption{
+			ValidatorAddress: operator.String(),
+			ExemptUntil:      exemptUntil,
+		})
+		return false
+	})
+
+	validatorAlertConfigs := []types.ValidatorAlertConfig{}
+	keeper.IterateOracleAlertConfigs(ctx, func(operator sdk.ValAddress, config types.OracleAlertConfig) (stop bool) {
+		validatorAlertConfigs = append(validatorAlertConfigs, types.ValidatorAlertConfig{
+			ValidatorAddress: operator.String(),
+			Config:           config,
+		})
+		return false
+	})
+
 	return types.NewGenesisState(params,
 		exchangeRates,
 		feederDelegations,
 		missCounters,
 		aggregateExchangeRatePrevotes,
-		aggregateExchangeRateVotes)
+		aggregateExchangeRateVotes,
+		observerExemptions,
+		validatorAlertConfigs)
 }
@@ -20,13 +20,24 @@ func TestExportInitGenesis(t *testing.T) {
 	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, keeper.ValAddrs[0], types.NewAggregateExchangeRatePrevote(types.AggregateVoteHash{123}, keeper.ValAddrs[0], uint64(2)))
 	input.OracleKeeper.SetAggregateExchangeRateVote(input.Ctx, keeper.ValAddrs[0], types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{{Denom: "foo", ExchangeRate: sdk.NewDec(123)}}, keeper.ValAddrs[0]))
 	input.OracleKeeper.SetMissCounter(input.Ctx, keeper.ValAddrs[0], 10)
+	input.OracleKeeper.SetObserverExemption(input.Ctx, keeper.ValAddrs[1], 1000)
+	input.OracleKeeper.SetOracleAlertConfig(input.Ctx, keeper.ValAddrs[2], types.OracleAlertConfig{MaxMissRate: sdk.NewDecWithPrec(5, 2)})
 	genesis := oracle.ExportGenesis(input.Ctx, input.OracleKeeper)
+	require.Equal(t, []types.ObserverExemption{{ValidatorAddress: keeper.ValAddrs[1].String(), ExemptUntil: 1000}}, genesis.ObserverExemptions)
+	require.Equal(t, []types.ValidatorAlertConfig{{
+		ValidatorAddress: keeper.ValAddrs[2].String(),
+		Config:           types.OracleAlertConfig{MaxMissRate: sdk.NewDecWithPrec(5, 2)},
+	}}, genesis.ValidatorAlertConfigs)
 
 	newInput := keeper.CreateTestInput(t)
 	oracle.InitGenesis(newInput.Ctx, newInput.OracleKeeper, genesis)
 	newGenesis := oracle.ExportGenesis(newInput.Ctx, newInput.OracleKeeper)
 
 	require.Equal(t, genesis, newGenesis)
+	require.True(t, newInput.OracleKeeper.IsExemptObserver(newInput.Ctx, keeper.ValAddrs[1]))
+	config, ok := newInput.OracleKeeper.GetOracleAlertConfig(newInput.Ctx, keeper.ValAddrs[2])
+	require.True(t, ok)
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), config.MaxMissRate)
 }
 
 func TestInitGenesis(t *testing.T) {
@@ -125,6 +136,42 @@ func TestInitGenesis(t *testing.T) {
 		},
 	}
 
+	genesis.ObserverExemptions = []types.ObserverExemption{
+		{
+			ValidatorAddress: "invalid",
+			ExemptUntil:      1000,
+		},
+	}
+
+	require.Panics(t, func() {
+		oracle.InitGenesis(input.Ctx, input.OracleKeeper, genesis)
+	})
+
+	genesis.ObserverExemptions = []types.ObserverExemption{
+		{
+			ValidatorAddress: keeper.ValAddrs[0].String(),
+			ExemptUntil:      1000,
+		},
+	}
+
+	genesis.ValidatorAlertConfigs = []types.ValidatorAlertConfig{
+		{
+			ValidatorAddress: "invalid",
+			Config:           types.OracleAlertConfig{MaxMissRate: sdk.NewDecWithPrec(5, 2)},
+		},
+	}
+
+	require.Panics(t, func() {
+		oracle.InitGenesis(input.Ctx, input.OracleKeeper, genesis)
+	})
+
+	genesis.ValidatorAlertConfigs = []types.ValidatorAlertConfig{
+		{
+			ValidatorAddress: keeper.ValAddrs[0].String(),
+			Config:           types.OracleAlertConfig{MaxMissRate: sdk.NewDecWithPrec(5, 2)},
+		},
+	}
+
 	require.NotPanics(t, func() {
 		oracle.InitGenesis(input.Ctx, input.OracleKeeper, genesis)
 	})
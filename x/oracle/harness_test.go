@@ -0,0 +1,431 @@
+package oracle_test
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+
+	"github.com/Team-Kujira/core/x/oracle"
+	"github.com/Team-Kujira/core/x/oracle/keeper"
+	"github.com/Team-Kujira/core/x/oracle/types"
+)
+
+// voteHarness runs full vote periods through the message server and the end blocker.
+// Votes queued during a vote period are prevoted at its first block, revealed at the
+// first block of the next vote period and tallied at the last block of that one, the
+// way a price feeder pipelines its submissions.
+type voteHarness struct {
+	t      *testing.T
+	input  keeper.TestInput
+	msgs   types.MsgServer
+	height int64
+
+	// queued are the votes to prevote, pending the prevoted votes to reveal
+	queued  map[int]sdk.DecCoins
+	pending map[int]sdk.DecCoins
+
+	// withheld are the validators which prevote, but never reveal
+	withheld map[int]bool
+
+	// attestations are attached to the revealed votes of the validators
+	attestations map[int]string
+}
+
+// newVoteHarness creates a validator with the given consensus power for each entry of
+// powers, and lets configure adjust the params before the first vote period starts.
+// By default a vote period lasts 2 blocks, a slash window 10 vote periods, and
+// TestDenomA and TestDenomC are whitelisted.
+func newVoteHarness(t *testing.T, powers []int64, configure func(params *types.Params)) *voteHarness {
+	require.LessOrEqual(t, len(powers), len(keeper.ValAddrs))
+
+	input := keeper.CreateTestInput(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.VotePeriod = 2
+	params.SlashWindow = 20
+	params.RewardDistributionWindow = 20
+	params.Whitelist = types.DenomList{{Name: types.TestDenomA}, {Name: types.TestDenomC}}
+	if configure != nil {
+		configure(&params)
+	}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	sh := stakingkeeper.NewMsgServerImpl(&input.StakingKeeper)
+	for i, power := range powers {
+		_, err := sh.CreateValidator(input.Ctx, keeper.NewTestMsgCreateValidator(
+			keeper.ValAddrs[i], keeper.ValPubKeys[i], sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction),
+		))
+		require.NoError(t, err)
+	}
+	staking.EndBlocker(input.Ctx, &input.StakingKeeper)
+
+	return &voteHarness{
+		t:            t,
+		input:        input,
+		msgs:         keeper.NewMsgServerImpl(input.OracleKeeper),
+		queued:       map[int]sdk.DecCoins{},
+		pending:      map[int]sdk.DecCoins{},
+		withheld:     map[int]bool{},
+		attestations: map[int]string{},
+	}
+}
+
+// ctx returns the context of the current block
+func (h *voteHarness) ctx() sdk.Context {
+	return h.input.Ctx.WithBlockHeight(h.height)
+}
+
+// vote queues the exchange rates of validator idx for the current vote period.
+// A zero exchange rate abstains from the denom, so abstaining votes must not be
+// built with sdk.NewDecCoins, which drops zero coins.
+func (h *voteHarness) vote(idx int, rates sdk.DecCoins) {
+	h.queued[idx] = rates
+}
+
+// voteAll queues the same exchange rates for each of the validators
+func (h *voteHarness) voteAll(rates sdk.DecCoins, idxs ...int) {
+	for _, idx := range idxs {
+		h.vote(idx, rates)
+	}
+}
+
+// withholdReveals stops revealing the prevoted votes of the validators
+func (h *voteHarness) withholdReveals(idxs ...int) {
+	for _, idx := range idxs {
+		h.withheld[idx] = true
+	}
+}
+
+// attest attaches the attestation to the votes revealed by validator idx
+func (h *voteHarness) attest(idx int, attestation string) {
+	h.attestations[idx] = attestation
+}
+
+// endPeriod reveals the votes prevoted in the previous vote period, prevotes the
+// queued votes, and runs the end blocker at the last block of the vote period
+func (h *voteHarness) endPeriod() {
+	salt := strings.Repeat("1", types.SaltLength)
+	ctx := h.ctx()
+
+	for _, idx := range sortedIdxs(h.pending) {
+		if h.withheld[idx] {
+			continue
+		}
+
+		msg := types.NewMsgAggregateExchangeRateVote(salt, h.pending[idx].String(), keeper.Addrs[idx], keeper.ValAddrs[idx])
+		msg.Attestation = h.attestations[idx]
+		_, err := h.msgs.AggregateExchangeRateVote(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(h.t, err)
+	}
+
+	for _, idx := range sortedIdxs(h.queued) {
+		hash := types.GetAggregateVoteHash(salt, h.queued[idx].String(), keeper.ValAddrs[idx])
+		msg := types.NewMsgAggregateExchangeRatePrevote(hash, keeper.Addrs[idx], keeper.ValAddrs[idx])
+		_, err := h.msgs.AggregateExchangeRatePrevote(sdk.WrapSDKContext(ctx), msg)
+		require.NoError(h.t, err)
+	}
+
+	h.pending, h.queued = h.queued, map[int]sdk.DecCoins{}
+
+	h.height += int64(h.input.OracleKeeper.VotePeriod(ctx)) - 1
+	require.NoError(h.t, oracle.EndBlocker(h.ctx(), h.input.OracleKeeper))
+	h.height++
+}
+
+// endPeriods ends n vote periods, queueing the same votes in each of them
+func (h *voteHarness) endPeriods(n int, votes map[int]sdk.DecCoins) {
+	for i := 0; i < n; i++ {
+		for idx, rates := range votes {
+			h.vote(idx, rates)
+		}
+		h.endPeriod()
+	}
+}
+
+func (h *voteHarness) requireRate(denom string, expected sdk.Dec) {
+	rate, err := h.input.OracleKeeper.GetExchangeRate(h.ctx(), denom)
+	require.NoError(h.t, err, denom)
+	require.Equal(h.t, expected, rate, denom)
+}
+
+func (h *voteHarness) requireNoRate(denom string) {
+	_, err := h.input.OracleKeeper.GetExchangeRate(h.ctx(), denom)
+	require.ErrorIs(h.t, err, types.ErrUnknownDenom, denom)
+}
+
+func (h *voteHarness) requireMissCounters(expected ...uint64) {
+	for idx, missCounter := range expected {
+		require.Equal(h.t, missCounter, h.input.OracleKeeper.GetMissCounter(h.ctx(), keeper.ValAddrs[idx]), "validator %d", idx)
+	}
+}
+
+func (h *voteHarness) requireRevealMissCounters(expected ...uint64) {
+	for idx, revealMissCounter := range expected {
+		require.Equal(h.t, revealMissCounter, h.input.OracleKeeper.GetRevealMissCounter(h.ctx(), keeper.ValAddrs[idx]), "validator %d", idx)
+	}
+}
+
+// requireWinningPower checks the power of the ballot winners the rewards of the last
+// vote period are shared by
+func (h *voteHarness) requireWinningPower(expected int64) {
+	require.Equal(h.t, expected, h.input.OracleKeeper.GetWinningPower(h.ctx()))
+}
+
+// requireSlashed checks whether validator idx was slashed from its initial consensus power and jailed
+func (h *voteHarness) requireSlashed(idx int, power int64, slashed bool) {
+	validator := h.input.StakingKeeper.Validator(h.ctx(), keeper.ValAddrs[idx])
+	tokens := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
+	if slashed {
+		fraction := h.input.OracleKeeper.SlashFraction(h.ctx())
+		tokens = sdk.OneDec().Sub(fraction).MulInt(tokens).TruncateInt()
+	}
+
+	require.Equal(h.t, tokens, validator.GetTokens(), "validator %d", idx)
+	require.Equal(h.t, slashed, validator.IsJailed(), "validator %d", idx)
+}
+
+func sortedIdxs(votes map[int]sdk.DecCoins) []int {
+	idxs := make([]int, 0, len(votes))
+	for idx := range votes {
+		idxs = append(idxs, idx)
+	}
+	sort.Ints(idxs)
+	return idxs
+}
+
+func TestHarnessConsensus(t *testing.T) {
+	h := newVoteHarness(t, []int64{10, 20, 30}, nil)
+	rates := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec(types.TestDenomA, sdk.NewDecWithPrec(15, 1)),
+		sdk.NewDecCoinFromDec(types.TestDenomC, randomExchangeRate),
+	)
+
+	// Prevotes are only revealed and tallied in the next vote period
+	h.voteAll(rates, 0, 1, 2)
+	h.endPeriod()
+	h.requireNoRate(types.TestDenomA)
+	h.requireMissCounters(1, 1, 1)
+
+	h.voteAll(rates, 0, 1, 2)
+	h.endPeriod()
+	h.requireRate(types.TestDenomA, sdk.NewDecWithPrec(15, 1))
+	h.requireRate(types.TestDenomC, randomExchangeRate)
+	h.requireMissCounters(1, 1, 1)
+	h.requireWinningPower(60)
+
+	// The votes prevoted last are revealed in the next vote period
+	h.endPeriod()
+	h.requireRate(types.TestDenomC, randomExchangeRate)
+	h.requireMissCounters(1, 1, 1)
+
+	// Without new votes, the rates are cleared again
+	h.endPeriod()
+	h.requireNoRate(types.TestDenomA)
+	h.requireNoRate(types.TestDenomC)
+	h.requireMissCounters(2, 2, 2)
+	h.requireWinningPower(0)
+}
+
+func TestHarnessOutlierAndThreshold(t *testing.T) {
+	h := newVoteHarness(t, []int64{20, 20, 20, 10}, nil)
+	rates := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec(types.TestDenomA, sdk.OneDec()),
+		sdk.NewDecCoinFromDec(types.TestDenomC, randomExchangeRate),
+	)
+	outlier := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec(types.TestDenomA, sdk.NewDec(10)),
+		sdk.NewDecCoinFromDec(types.TestDenomC, randomExchangeRate),
+	)
+
+	// Validator 3 votes far off the others on DenomA and misses the vote,
+	// but still wins the DenomC ballot
+	h.endPeriods(3, map[int]sdk.DecCoins{0: rates, 1: rates, 2: rates, 3: outlier})
+	h.requireRate(types.TestDenomA, sdk.OneDec())
+	h.requireRate(types.TestDenomC, randomExchangeRate)
+	h.requireMissCounters(1, 1, 1, 3)
+	h.requireWinningPower(70)
+
+	// Once validators 1 and 2 stop voting, the ballots fall short of the vote threshold
+	h.endPeriods(2, map[int]sdk.DecCoins{0: rates, 3: rates})
+	h.requireNoRate(types.TestDenomA)
+	h.requireNoRate(types.TestDenomC)
+	h.requireMissCounters(1, 2, 2, 4)
+	h.requireWinningPower(0)
+}
+
+func TestHarnessSlashing(t *testing.T) {
+	h := newVoteHarness(t, []int64{10, 10, 10}, func(params *types.Params) {
+		params.MinValidPerWindow = sdk.NewDecWithPrec(5, 1)
+	})
+	rates := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec(types.TestDenomA, sdk.OneDec()),
+		sdk.NewDecCoinFromDec(types.TestDenomC, randomExchangeRate),
+	)
+	abstain := sdk.DecCoins{
+		{Denom: types.TestDenomC, Amount: sdk.ZeroDec()},
+		{Denom: types.TestDenomA, Amount: sdk.ZeroDec()},
+	}
+
+	// Validator 1 abstains, validator 2 only votes in half of the slash window
+	h.endPeriods(4, map[int]sdk.DecCoins{0: rates, 1: abstain, 2: rates})
+	h.endPeriods(5, map[int]sdk.DecCoins{0: rates, 1: abstain})
+	h.requireMissCounters(1, 1, 5)
+	h.requireSlashed(2, 10, false)
+
+	// The miss counters are reset once the slash window ends
+	h.endPeriod()
+	h.requireMissCounters(0, 0, 0)
+	h.requireSlashed(0, 10, false)
+	h.requireSlashed(1, 10, false)
+	h.requireSlashed(2, 10, true)
+}
+
+func TestHarnessDenomTallyCounters(t *testing.T) {
+	h := newVoteHarness(t, []int64{10, 10, 10}, func(params *types.Params) {
+		params.MinValidPerWindow = sdk.ZeroDec()
+	})
+	rates := sdk.NewDecCoins(sdk.NewDecCoinFromDec(types.TestDenomA, sdk.OneDec()))
+
+	// DenomC is never voted on, DenomA tallies from the second vote period on.
+	// Missing DenomC does not get the validators jailed at the end of the slash window.
+	h.endPeriods(4, map[int]sdk.DecCoins{0: rates, 1: rates, 2: rates})
+	require.Equal(t, types.DenomTallyCounter{SuccessPeriods: 3, FailedPeriods: 1}, h.input.OracleKeeper.GetDenomTallyCounter(h.ctx(), types.TestDenomA))
+	require.Equal(t, types.DenomTallyCounter{FailedPeriods: 4}, h.input.OracleKeeper.GetDenomTallyCounter(h.ctx(), types.TestDenomC))
+
+	// The counters are reset once the slash window ends
+	h.endPeriods(6, map[int]sdk.DecCoins{0: rates, 1: rates, 2: rates})
+	require.Equal(t, types.DenomTallyCounter{}, h.input.OracleKeeper.GetDenomTallyCounter(h.ctx(), types.TestDenomA))
+	require.Equal(t, types.DenomTallyCounter{}, h.input.OracleKeeper.GetDenomTallyCounter(h.ctx(), types.TestDenomC))
+
+	h.endPeriod()
+	require.Equal(t, types.DenomTallyCounter{SuccessPeriods: 1}, h.input.OracleKeeper.GetDenomTallyCounter(h.ctx(), types.TestDenomA))
+}
+
+func TestHarnessRevealMisses(t *testing.T) {
+	rates := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec(types.TestDenomA, sdk.OneDec()),
+		sdk.NewDecCoinFromDec(types.TestDenomC, randomExchangeRate),
+	)
+
+	for _, tc := range []struct {
+		revealMissWeight   sdk.Dec
+		prevoteOnlySlashed bool
+	}{
+		{sdk.OneDec(), true},
+		{sdk.NewDecWithPrec(4, 1), false},
+		{sdk.ZeroDec(), false},
+	} {
+		h := newVoteHarness(t, []int64{10, 10, 10}, func(params *types.Params) {
+			params.MinValidPerWindow = sdk.NewDecWithPrec(5, 1)
+			params.RevealMissWeight = tc.revealMissWeight
+		})
+
+		// Validator 1 prevotes without revealing, validator 2 does not submit at all.
+		// The first vote period is a reveal miss of validator 0 as well.
+		h.withholdReveals(1)
+		h.endPeriods(9, map[int]sdk.DecCoins{0: rates, 1: rates})
+		h.requireMissCounters(1, 9, 9)
+		h.requireRevealMissCounters(1, 9, 0)
+
+		// Only full misses are certain to be slashed once the slash window ends
+		h.endPeriod()
+		h.requireMissCounters(0, 0, 0)
+		h.requireRevealMissCounters(0, 0, 0)
+		h.requireSlashed(0, 10, false)
+		h.requireSlashed(1, 10, tc.prevoteOnlySlashed)
+		h.requireSlashed(2, 10, true)
+	}
+}
+
+func TestHarnessVotePeriodMultiplier(t *testing.T) {
+	h := newVoteHarness(t, []int64{10, 10, 10}, func(params *types.Params) {
+		params.Whitelist = types.DenomList{{Name: types.TestDenomA}, {Name: types.TestDenomC, VotePeriodMultiplier: 4}}
+		params.SlashWindow = 40
+	})
+	rates := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec(types.TestDenomA, sdk.OneDec()),
+		sdk.NewDecCoinFromDec(types.TestDenomC, randomExchangeRate),
+	)
+	ratesA := sdk.NewDecCoins(sdk.NewDecCoinFromDec(types.TestDenomA, sdk.OneDec()))
+	updated := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec(types.TestDenomA, sdk.OneDec()),
+		sdk.NewDecCoinFromDec(types.TestDenomC, sdk.NewDec(2)),
+	)
+
+	// Vote periods 0 and 1: the prevotes of period 0 are revealed in period 1, in which DenomC rests
+	h.endPeriods(2, map[int]sdk.DecCoins{0: rates, 1: rates, 2: rates})
+	h.requireRate(types.TestDenomA, sdk.OneDec())
+	h.requireNoRate(types.TestDenomC)
+	h.requireMissCounters(1, 1, 1)
+
+	// DenomC is tallied in vote period 4, but not required in between
+	h.endPeriods(2, map[int]sdk.DecCoins{0: ratesA, 1: ratesA, 2: ratesA})
+	h.requireNoRate(types.TestDenomC)
+	h.requireMissCounters(1, 1, 1)
+
+	h.endPeriods(1, map[int]sdk.DecCoins{0: rates, 1: rates, 2: rates})
+	h.requireNoRate(types.TestDenomC)
+	h.requireMissCounters(2, 2, 2)
+
+	// Votes on DenomC are ignored while it rests, its rate stays until the next tally in vote period 8
+	h.endPeriods(4, map[int]sdk.DecCoins{0: rates, 1: rates, 2: rates})
+	h.requireRate(types.TestDenomC, randomExchangeRate)
+	h.requireMissCounters(2, 2, 2)
+
+	h.endPeriods(3, map[int]sdk.DecCoins{0: updated, 1: updated, 2: updated})
+	h.requireRate(types.TestDenomC, randomExchangeRate)
+	h.requireMissCounters(2, 2, 2)
+
+	h.endPeriod()
+	h.requireRate(types.TestDenomC, sdk.NewDec(2))
+	h.requireMissCounters(2, 2, 2)
+}
+
+func TestHarnessAttestation(t *testing.T) {
+	votes := map[int]sdk.DecCoins{
+		0: sdk.NewDecCoins(sdk.NewDecCoinFromDec(types.TestDenomA, sdk.NewDecWithPrec(15, 1)), sdk.NewDecCoinFromDec(types.TestDenomC, randomExchangeRate)),
+		1: sdk.NewDecCoins(sdk.NewDecCoinFromDec(types.TestDenomA, sdk.NewDecWithPrec(16, 1)), sdk.NewDecCoinFromDec(types.TestDenomC, randomExchangeRate)),
+		2: sdk.NewDecCoins(sdk.NewDecCoinFromDec(types.TestDenomA, sdk.NewDecWithPrec(17, 1)), sdk.NewDecCoinFromDec(types.TestDenomC, randomExchangeRate)),
+	}
+
+	plain := newVoteHarness(t, []int64{10, 20, 30}, nil)
+	plain.endPeriods(2, votes)
+
+	attested := newVoteHarness(t, []int64{10, 20, 30}, nil)
+	attested.attest(1, "attestation-1")
+	attested.endPeriods(2, votes)
+
+	// The attestation is logged with the vote of validator 1 only
+	attestations := map[string]string{}
+	for _, event := range attested.input.Ctx.EventManager().Events() {
+		if event.Type != types.EventTypeAggregateVote {
+			continue
+		}
+		attributes := map[string]string{}
+		for _, attribute := range event.Attributes {
+			attributes[attribute.Key] = attribute.Value
+		}
+		if attestation, ok := attributes[types.AttributeKeyAttestation]; ok {
+			attestations[attributes[types.AttributeKeyVoter]] = attestation
+		}
+	}
+	require.Equal(t, map[string]string{keeper.ValAddrs[1].String(): "attestation-1"}, attestations)
+
+	// and does not change the outcome of the tally
+	for _, h := range []*voteHarness{plain, attested} {
+		h.requireRate(types.TestDenomA, sdk.NewDecWithPrec(16, 1))
+		h.requireRate(types.TestDenomC, randomExchangeRate)
+	}
+	for idx := range votes {
+		require.Equal(t,
+			plain.input.OracleKeeper.GetMissCounter(plain.ctx(), keeper.ValAddrs[idx]),
+			attested.input.OracleKeeper.GetMissCounter(attested.ctx(), keeper.ValAddrs[idx]),
+		)
+	}
+	require.Equal(t, plain.input.OracleKeeper.GetWinningPower(plain.ctx()), attested.input.OracleKeeper.GetWinningPower(attested.ctx()))
+}
@@ -17,3 +17,15 @@ func (k Keeper) GetRewardPool(ctx sdk.Context, denom string) sdk.Coin {
 	acc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
 	return k.bankKeeper.GetBalance(ctx, acc.GetAddress(), denom)
 }
+
+// GetRewardPoolDenoms retrieves the denoms held by the oracle module account
+func (k Keeper) GetRewardPoolDenoms(ctx sdk.Context) []string {
+	acc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	balances := k.bankKeeper.GetAllBalances(ctx, acc.GetAddress())
+
+	denoms := make([]string, len(balances))
+	for i, balance := range balances {
+		denoms[i] = balance.Denom
+	}
+	return denoms
+}
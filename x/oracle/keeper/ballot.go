@@ -6,12 +6,19 @@ import (
 	"github.com/Team-Kujira/core/x/oracle/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// OrganizeBallotByDenom collects all oracle votes for the period, categorized by the votes' denom parameter
+// OrganizeBallotByDenom collects all oracle votes for the period, categorized by the votes' denom parameter.
+// A validator is counted at most once per denom. Aggregate votes carry no submit height, so when a validator
+// shows up more than once for a denom, the entry seen last wins: votes are iterated in store key order and
+// tuples in their stored order, which keeps the choice deterministic across nodes.
 func (k Keeper) OrganizeBallotByDenom(ctx sdk.Context, validatorClaimMap map[string]types.Claim) (votes map[string]types.ExchangeRateBallot) {
 	votes = map[string]types.ExchangeRateBallot{}
 
+	// position of each validator's vote in the ballot of a denom
+	voteIndex := map[string]map[string]int{}
+
 	// Organize aggregate votes
 	aggregateHandler := func(voterAddr sdk.ValAddress, vote types.AggregateExchangeRateVote) (stop bool) {
 		// organize ballot only for the active validators
@@ -26,14 +33,25 @@ func (k Keeper) OrganizeBallotByDenom(ctx sdk.Context, validatorClaimMap map[str
 					tmpPower = 0
 				}
 
-				votes[tuple.Denom] = append(votes[tuple.Denom],
-					types.NewVoteForTally(
-						tuple.ExchangeRate,
-						tuple.Denom,
-						voterAddr,
-						tmpPower,
-					),
+				tallyVote := types.NewVoteForTally(
+					tuple.ExchangeRate,
+					tuple.Denom,
+					voterAddr,
+					tmpPower,
 				)
+
+				if voteIndex[tuple.Denom] == nil {
+					voteIndex[tuple.Denom] = map[string]int{}
+				}
+
+				// replace a duplicate vote instead of counting its power twice
+				if i, ok := voteIndex[tuple.Denom][vote.Voter]; ok {
+					votes[tuple.Denom][i] = tallyVote
+					continue
+				}
+
+				voteIndex[tuple.Denom][vote.Voter] = len(votes[tuple.Denom])
+				votes[tuple.Denom] = append(votes[tuple.Denom], tallyVote)
 			}
 		}
 
@@ -68,3 +86,72 @@ func (k Keeper) ClearBallots(ctx sdk.Context, votePeriod uint64) {
 		return false
 	})
 }
+
+// JailedBondedPower returns the total consensus power of validators in the last
+// validator set which are jailed but whose tokens are still bonded
+func (k Keeper) JailedBondedPower(ctx sdk.Context) int64 {
+	powerReduction := k.StakingKeeper.PowerReduction(ctx)
+
+	jailedPower := int64(0)
+	k.StakingKeeper.IterateLastValidators(ctx, func(_ int64, validator stakingtypes.ValidatorI) (stop bool) {
+		if validator.IsBonded() && validator.IsJailed() {
+			jailedPower += validator.GetConsensusPower(powerReduction)
+		}
+
+		return false
+	})
+
+	return jailedPower
+}
+
+// GetDenomBackingPower returns the voting power of the bonded validators that submitted a vote for each denom
+// in the current vote period. Abstain votes do not back a denom.
+func (k Keeper) GetDenomBackingPower(ctx sdk.Context) map[string]int64 {
+	powerReduction := k.StakingKeeper.PowerReduction(ctx)
+
+	validatorClaimMap := map[string]types.Claim{}
+	k.StakingKeeper.IterateLastValidators(ctx, func(_ int64, validator stakingtypes.ValidatorI) (stop bool) {
+		if validator.IsBonded() {
+			valAddr := validator.GetOperator()
+			validatorClaimMap[valAddr.String()] = types.NewClaim(validator.GetConsensusPower(powerReduction), 0, 0, valAddr)
+		}
+
+		return false
+	})
+
+	backingPower := map[string]int64{}
+	for denom, ballot := range k.OrganizeBallotByDenom(ctx, validatorClaimMap) {
+		backingPower[denom] = ballot.Power()
+	}
+
+	return backingPower
+}
+
+// GetLastBallots returns the ballots of the votes submitted in the last vote period, weighted by
+// the current power of the bonded voters, sorted by exchange rate. Abstain votes are left out.
+func (k Keeper) GetLastBallots(ctx sdk.Context) map[string]types.ExchangeRateBallot {
+	powerReduction := k.StakingKeeper.PowerReduction(ctx)
+
+	ballots := map[string]types.ExchangeRateBallot{}
+	k.IterateLastSubmissions(ctx, func(voterAddr sdk.ValAddress, vote types.AggregateExchangeRateVote) (stop bool) {
+		validator := k.StakingKeeper.Validator(ctx, voterAddr)
+		if validator == nil || !validator.IsBonded() {
+			return false
+		}
+
+		power := validator.GetConsensusPower(powerReduction)
+		for _, tuple := range vote.ExchangeRateTuples {
+			if tuple.ExchangeRate.IsPositive() {
+				ballots[tuple.Denom] = append(ballots[tuple.Denom], types.NewVoteForTally(tuple.ExchangeRate, tuple.Denom, voterAddr, power))
+			}
+		}
+
+		return false
+	})
+
+	for _, ballot := range ballots {
+		sort.Sort(ballot)
+	}
+
+	return ballots
+}
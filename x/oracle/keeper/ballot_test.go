@@ -78,6 +78,54 @@ func TestOrganizeAggregate(t *testing.T) {
 	require.Equal(t, krwBallot, ballotMap[types.TestDenomC])
 }
 
+func TestOrganizeAggregateDuplicateVotes(t *testing.T) {
+	input := CreateTestInput(t)
+
+	power := int64(100)
+	amt := sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
+	sh := stakingkeeper.NewMsgServerImpl(&input.StakingKeeper)
+	ctx := input.Ctx
+
+	_, err := sh.CreateValidator(ctx, NewTestMsgCreateValidator(ValAddrs[0], ValPubKeys[0], amt))
+	require.NoError(t, err)
+	staking.EndBlocker(ctx, &input.StakingKeeper)
+
+	claimMap := map[string]types.Claim{
+		ValAddrs[0].String(): {
+			Power:     power,
+			WinCount:  0,
+			Recipient: ValAddrs[0],
+		},
+	}
+
+	// the same denom twice in one aggregate vote, the later tuple wins
+	input.OracleKeeper.SetAggregateExchangeRateVote(input.Ctx, ValAddrs[0],
+		types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{
+			{Denom: types.TestDenomD, ExchangeRate: sdk.NewDec(17)},
+			{Denom: types.TestDenomC, ExchangeRate: sdk.NewDec(1000)},
+			{Denom: types.TestDenomD, ExchangeRate: sdk.NewDec(18)},
+		}, ValAddrs[0]))
+
+	ballotMap := input.OracleKeeper.OrganizeBallotByDenom(input.Ctx, claimMap)
+	require.Equal(t, types.ExchangeRateBallot{
+		types.NewVoteForTally(sdk.NewDec(18), types.TestDenomD, ValAddrs[0], power),
+	}, ballotMap[types.TestDenomD])
+	require.Equal(t, power, ballotMap[types.TestDenomD].Power())
+	require.Equal(t, power, ballotMap[types.TestDenomC].Power())
+
+	// a second aggregate vote claiming the same voter must not count its power again
+	input.OracleKeeper.SetAggregateExchangeRateVote(input.Ctx, ValAddrs[1],
+		types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{
+			{Denom: types.TestDenomD, ExchangeRate: sdk.NewDec(20)},
+		}, ValAddrs[0]))
+
+	ballotMap = input.OracleKeeper.OrganizeBallotByDenom(input.Ctx, claimMap)
+	require.Len(t, ballotMap[types.TestDenomD], 1)
+	require.Equal(t, power, ballotMap[types.TestDenomD].Power())
+	require.Equal(t, power, ballotMap[types.TestDenomC].Power())
+	require.Equal(t, ballotMap, input.OracleKeeper.OrganizeBallotByDenom(input.Ctx, claimMap))
+}
+
 func TestClearBallots(t *testing.T) {
 	input := CreateTestInput(t)
 
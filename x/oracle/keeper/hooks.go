@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var _ stakingtypes.StakingHooks = Hooks{}
+
+// Hooks wrapper struct for oracle keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the staking hooks of the oracle keeper
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterValidatorCreated clears the feeder delegation left behind by a removed validator of the
+// same operator, so that a validator recreated with a new consensus key feeds by itself until it
+// delegates again.
+func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	h.k.ClearFeederDelegation(ctx, valAddr)
+	return nil
+}
+
+// AfterValidatorRemoved clears the feeder delegation of the removed validator, which could
+// otherwise be resolved again by a later validator of the same operator, its alert config and
+// its rejected exchange rates.
+func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	h.k.ClearFeederDelegation(ctx, valAddr)
+	h.k.DeleteOracleAlertConfig(ctx, valAddr)
+	h.k.DeleteRejectedTuples(ctx, valAddr)
+	return nil
+}
+
+func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationRemoved(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterDelegationModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeValidatorSlashed(_ sdk.Context, _ sdk.ValAddress, _ sdk.Dec) error {
+	return nil
+}
+
+func (h Hooks) AfterUnbondingInitiated(_ sdk.Context, _ uint64) error {
+	return nil
+}
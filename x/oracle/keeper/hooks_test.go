@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Team-Kujira/core/x/oracle/types"
+)
+
+func TestHooksClearFeederDelegation(t *testing.T) {
+	input, msgServer := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.FeederChangeCooldownBlocks = 100
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	hooks := input.OracleKeeper.Hooks()
+
+	delegate := func() {
+		_, err := msgServer.DelegateFeedConsent(sdk.WrapSDKContext(input.Ctx), types.NewMsgDelegateFeedConsent(ValAddrs[0], Addrs[1]))
+		require.NoError(t, err)
+		require.Equal(t, Addrs[1], input.OracleKeeper.GetFeederDelegation(input.Ctx, ValAddrs[0]))
+	}
+	clearedEvents := func(ctx sdk.Context) []map[string]string {
+		var events []map[string]string
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeFeederDelegationCleared {
+				attributes := map[string]string{}
+				for _, attribute := range event.Attributes {
+					attributes[attribute.Key] = attribute.Value
+				}
+				events = append(events, attributes)
+			}
+		}
+		return events
+	}
+
+	// Removing the validator clears its delegation, cooldown, alert config and rejected exchange
+	// rates, and the old feeder loses its right
+	delegate()
+	input.OracleKeeper.SetOracleAlertConfig(input.Ctx, ValAddrs[0], types.OracleAlertConfig{MaxMissRate: sdk.NewDecWithPrec(1, 1)})
+	input.OracleKeeper.SetRejectedTuples(input.Ctx, ValAddrs[0], types.RejectedTuples{VotePeriod: 1})
+	ctx := input.Ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, hooks.AfterValidatorRemoved(ctx, sdk.ConsAddress(ValAddrs[0]), ValAddrs[0]))
+	_, found := input.OracleKeeper.GetOracleAlertConfig(input.Ctx, ValAddrs[0])
+	require.False(t, found)
+	_, found = input.OracleKeeper.GetRejectedTuples(input.Ctx, ValAddrs[0])
+	require.False(t, found)
+	require.Equal(t, sdk.AccAddress(ValAddrs[0]), input.OracleKeeper.GetFeederDelegation(input.Ctx, ValAddrs[0]))
+	_, found = input.OracleKeeper.GetFeederChangeHeight(input.Ctx, ValAddrs[0])
+	require.False(t, found)
+	require.ErrorIs(t, input.OracleKeeper.ValidateFeeder(input.Ctx, Addrs[1], ValAddrs[0]), types.ErrNoVotingPermission)
+	require.Equal(t, []map[string]string{{
+		types.AttributeKeyOperator:  ValAddrs[0].String(),
+		types.AttributeKeyOldFeeder: Addrs[1].String(),
+		types.AttributeKeyHeight:    fmt.Sprint(input.Ctx.BlockHeight()),
+	}}, clearedEvents(ctx))
+
+	// The operator can delegate again right away
+	delegate()
+
+	// A delegation left behind is cleared when a validator of the same operator is created
+	// again, e.g. with a rotated consensus key
+	ctx = input.Ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, hooks.AfterValidatorCreated(ctx, ValAddrs[0]))
+	require.Equal(t, sdk.AccAddress(ValAddrs[0]), input.OracleKeeper.GetFeederDelegation(input.Ctx, ValAddrs[0]))
+	require.Len(t, clearedEvents(ctx), 1)
+
+	// Without a delegation nothing is emitted
+	ctx = input.Ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, hooks.AfterValidatorRemoved(ctx, sdk.ConsAddress(ValAddrs[1]), ValAddrs[1]))
+	require.Empty(t, clearedEvents(ctx))
+
+	// The other staking changes leave the delegation alone
+	delegate()
+	require.NoError(t, hooks.BeforeValidatorModified(input.Ctx, ValAddrs[0]))
+	require.NoError(t, hooks.AfterValidatorBeginUnbonding(input.Ctx, sdk.ConsAddress(ValAddrs[0]), ValAddrs[0]))
+	require.NoError(t, hooks.AfterValidatorBonded(input.Ctx, sdk.ConsAddress(ValAddrs[0]), ValAddrs[0]))
+	require.Equal(t, Addrs[1], input.OracleKeeper.GetFeederDelegation(input.Ctx, ValAddrs[0]))
+}
@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/cometbft/cometbft/libs/log"
 
@@ -12,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -20,6 +24,7 @@ import (
 type Keeper struct {
 	cdc        codec.BinaryCodec
 	storeKey   storetypes.StoreKey
+	tStoreKey  storetypes.StoreKey
 	paramSpace paramstypes.Subspace
 
 	accountKeeper  types.AccountKeeper
@@ -28,15 +33,21 @@ type Keeper struct {
 	SlashingKeeper types.SlashingKeeper
 	StakingKeeper  types.StakingKeeper
 
-	distrName   string
-	rewardDenom string
+	distrName        string
+	feeCollectorName string
+	rewardDenom      string
+
+	// whitelistObservers is shared by the copies of the keeper, so that the observers
+	// registered after the keeper was passed to other modules are called as well
+	whitelistObservers *[]WhitelistObserver
 }
 
 // NewKeeper constructs a new keeper for oracle
-func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey,
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, tStoreKey storetypes.StoreKey,
 	paramspace paramstypes.Subspace, accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper, distrKeeper types.DistributionKeeper,
 	slashingkeeper types.SlashingKeeper, stakingKeeper types.StakingKeeper, distrName string,
+	feeCollectorName string,
 ) Keeper {
 	// ensure oracle module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -49,16 +60,19 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey,
 	}
 
 	return Keeper{
-		cdc:            cdc,
-		storeKey:       storeKey,
-		paramSpace:     paramspace,
-		accountKeeper:  accountKeeper,
-		bankKeeper:     bankKeeper,
-		distrKeeper:    distrKeeper,
-		SlashingKeeper: slashingkeeper,
-		StakingKeeper:  stakingKeeper,
-		distrName:      distrName,
-		rewardDenom:    "ukuji",
+		cdc:                cdc,
+		storeKey:           storeKey,
+		tStoreKey:          tStoreKey,
+		paramSpace:         paramspace,
+		accountKeeper:      accountKeeper,
+		bankKeeper:         bankKeeper,
+		distrKeeper:        distrKeeper,
+		SlashingKeeper:     slashingkeeper,
+		StakingKeeper:      stakingKeeper,
+		distrName:          distrName,
+		feeCollectorName:   feeCollectorName,
+		rewardDenom:        "ukuji",
+		whitelistObservers: &[]WhitelistObserver{},
 	}
 }
 
@@ -83,6 +97,31 @@ func (k Keeper) GetExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, error)
 	return dp.Dec, nil
 }
 
+// GetUSDExchangeRate gets the consensus exchange rate of the denom converted to USD. The exchange rate of a denom
+// with a quote denom is quoted in that denom, so it is multiplied with the exchange rates along its quote denoms.
+func (k Keeper) GetUSDExchangeRate(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	exchangeRate, err := k.GetExchangeRate(ctx, denom)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	// The whitelist validation rules out cycles of quote denoms
+	whitelist := k.Whitelist(ctx)
+	for quote := whitelist.QuoteDenom(denom); len(quote) != 0; quote = whitelist.QuoteDenom(quote) {
+		quoteRate, err := k.GetExchangeRate(ctx, quote)
+		if err != nil {
+			return sdk.ZeroDec(), errors.Wrapf(err, "quote denom of %s", denom)
+		}
+
+		exchangeRate, err = types.SafeMul(exchangeRate, quoteRate)
+		if err != nil {
+			return sdk.ZeroDec(), err
+		}
+	}
+
+	return exchangeRate, nil
+}
+
 // SetExchangeRate sets the consensus exchange rate of the denom asset to the store.
 func (k Keeper) SetExchangeRate(ctx sdk.Context, denom string, exchangeRate sdk.Dec) {
 	store := ctx.KVStore(k.storeKey)
@@ -143,6 +182,48 @@ func (k Keeper) SetFeederDelegation(ctx sdk.Context, operator sdk.ValAddress, de
 	store.Set(types.GetFeederDelegationKey(operator), delegatedFeeder.Bytes())
 }
 
+// ClearFeederDelegation deletes the feeder delegation of the validator along with its change
+// height, emitting an event if there was one
+func (k Keeper) ClearFeederDelegation(ctx sdk.Context, operator sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFeederDelegationKey(operator))
+	store.Delete(types.GetFeederChangeHeightKey(operator))
+	if bz == nil {
+		return
+	}
+	store.Delete(types.GetFeederDelegationKey(operator))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeederDelegationCleared,
+			sdk.NewAttribute(types.AttributeKeyOperator, operator.String()),
+			sdk.NewAttribute(types.AttributeKeyOldFeeder, sdk.AccAddress(bz).String()),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprint(ctx.BlockHeight())),
+		),
+	)
+}
+
+// GetFeederChangeHeight retrieves the height the validator last changed its feeder delegation at,
+// false if it never did
+func (k Keeper) GetFeederChangeHeight(ctx sdk.Context, operator sdk.ValAddress) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFeederChangeHeightKey(operator))
+	if bz == nil {
+		return 0, false
+	}
+
+	var height gogotypes.Int64Value
+	k.cdc.MustUnmarshal(bz, &height)
+	return height.Value, true
+}
+
+// SetFeederChangeHeight records the height the validator changed its feeder delegation at
+func (k Keeper) SetFeederChangeHeight(ctx sdk.Context, operator sdk.ValAddress, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.Int64Value{Value: height})
+	store.Set(types.GetFeederChangeHeightKey(operator), bz)
+}
+
 // IterateFeederDelegations iterates over the feed delegates and performs a callback function.
 func (k Keeper) IterateFeederDelegations(ctx sdk.Context,
 	handler func(delegator sdk.ValAddress, delegate sdk.AccAddress) (stop bool),
@@ -184,6 +265,13 @@ func (k Keeper) SetMissCounter(ctx sdk.Context, operator sdk.ValAddress, missCou
 	store.Set(types.GetMissCounterKey(operator), bz)
 }
 
+// IncrementMissCounter increases the miss counter of the validator by one, up to MaxMissCounter
+func (k Keeper) IncrementMissCounter(ctx sdk.Context, operator sdk.ValAddress) {
+	if missCounter := k.GetMissCounter(ctx, operator); missCounter < k.MaxMissCounter(ctx) {
+		k.SetMissCounter(ctx, operator, missCounter+1)
+	}
+}
+
 // DeleteMissCounter removes miss counter for the validator
 func (k Keeper) DeleteMissCounter(ctx sdk.Context, operator sdk.ValAddress) {
 	store := ctx.KVStore(k.storeKey)
@@ -209,6 +297,58 @@ func (k Keeper) IterateMissCounters(ctx sdk.Context,
 	}
 }
 
+//-----------------------------------
+// Reveal miss counter logic
+
+// GetRevealMissCounter retrieves the # of vote periods missed in this oracle slash window
+// in which the validator prevoted but did not reveal its vote
+func (k Keeper) GetRevealMissCounter(ctx sdk.Context, operator sdk.ValAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRevealMissCounterKey(operator))
+	if bz == nil {
+		// By default the counter is zero
+		return 0
+	}
+
+	var revealMissCounter gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(bz, &revealMissCounter)
+	return revealMissCounter.Value
+}
+
+// SetRevealMissCounter updates the # of vote periods missed in this oracle slash window
+// in which the validator prevoted but did not reveal its vote
+func (k Keeper) SetRevealMissCounter(ctx sdk.Context, operator sdk.ValAddress, revealMissCounter uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: revealMissCounter})
+	store.Set(types.GetRevealMissCounterKey(operator), bz)
+}
+
+// IncrementRevealMissCounter increases the reveal miss counter of the validator by one, up to MaxMissCounter
+func (k Keeper) IncrementRevealMissCounter(ctx sdk.Context, operator sdk.ValAddress) {
+	if revealMissCounter := k.GetRevealMissCounter(ctx, operator); revealMissCounter < k.MaxMissCounter(ctx) {
+		k.SetRevealMissCounter(ctx, operator, revealMissCounter+1)
+	}
+}
+
+// DeleteRevealMissCounter removes reveal miss counter for the validator
+func (k Keeper) DeleteRevealMissCounter(ctx sdk.Context, operator sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRevealMissCounterKey(operator))
+}
+
+// IsRevealMiss returns whether the validator has an outstanding prevote, but has not
+// revealed a vote in the current vote period. A prevote of the current vote period
+// counts as well, since a feeder prevoting every period without revealing replaces
+// its prevote of the previous vote period each time.
+func (k Keeper) IsRevealMiss(ctx sdk.Context, operator sdk.ValAddress) bool {
+	if _, err := k.GetAggregateExchangeRatePrevote(ctx, operator); err != nil {
+		return false
+	}
+
+	_, err := k.GetAggregateExchangeRateVote(ctx, operator)
+	return err != nil
+}
+
 //-----------------------------------
 // AggregateExchangeRatePrevote logic
 
@@ -298,6 +438,1215 @@ func (k Keeper) IterateAggregateExchangeRateVotes(ctx sdk.Context, handler func(
 	}
 }
 
+//-----------------------------------
+// Winning power logic
+
+// GetWinningPower retrieves the total voting power of the ballot winners of the last vote period
+func (k Keeper) GetWinningPower(ctx sdk.Context) int64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.WinningPowerKey)
+	if bz == nil {
+		return 0
+	}
+
+	var winningPower gogotypes.Int64Value
+	k.cdc.MustUnmarshal(bz, &winningPower)
+	return winningPower.Value
+}
+
+// SetWinningPower updates the total voting power of the ballot winners of the last vote period
+func (k Keeper) SetWinningPower(ctx sdk.Context, winningPower int64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.Int64Value{Value: winningPower})
+	store.Set(types.WinningPowerKey, bz)
+}
+
+//-----------------------------------
+// Vote period participation logic
+
+// GetVotePeriodParticipation retrieves the participation in the last vote period
+func (k Keeper) GetVotePeriodParticipation(ctx sdk.Context) types.VotePeriodParticipation {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.VotePeriodParticipationKey)
+	if bz == nil {
+		return types.VotePeriodParticipation{}
+	}
+
+	var participation types.VotePeriodParticipation
+	k.cdc.MustUnmarshal(bz, &participation)
+	return participation
+}
+
+// SetVotePeriodParticipation updates the participation in the last vote period
+func (k Keeper) SetVotePeriodParticipation(ctx sdk.Context, participation types.VotePeriodParticipation) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&participation)
+	store.Set(types.VotePeriodParticipationKey, bz)
+}
+
+// SetParticipationPoint keeps the participation of the vote period in the participation series,
+// replacing the one of the vote period ParticipationSeriesLength periods before
+func (k Keeper) SetParticipationPoint(ctx sdk.Context, point types.ParticipationPoint) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&point)
+	store.Set(types.GetParticipationPointKey(point.VotePeriod), bz)
+}
+
+// IterateParticipationPoints iterates over the points of the participation series, by slot of the ring
+func (k Keeper) IterateParticipationPoints(ctx sdk.Context, handler func(point types.ParticipationPoint) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ParticipationPointKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var point types.ParticipationPoint
+		k.cdc.MustUnmarshal(iter.Value(), &point)
+		if handler(point) {
+			break
+		}
+	}
+}
+
+// GetParticipationSeries returns the participation of at most the last limit vote periods, oldest
+// first. The points left in the ring by vote periods skipped since, e.g. with timed vote periods,
+// are older than the series and left out.
+func (k Keeper) GetParticipationSeries(ctx sdk.Context, limit uint64) []types.ParticipationPoint {
+	points := []types.ParticipationPoint{}
+	latest := uint64(0)
+	k.IterateParticipationPoints(ctx, func(point types.ParticipationPoint) (stop bool) {
+		points = append(points, point)
+		if point.VotePeriod > latest {
+			latest = point.VotePeriod
+		}
+		return false
+	})
+
+	series := []types.ParticipationPoint{}
+	for _, point := range points {
+		if point.VotePeriod+types.ParticipationSeriesLength > latest {
+			series = append(series, point)
+		}
+	}
+	sort.Slice(series, func(i, j int) bool {
+		return series[i].VotePeriod < series[j].VotePeriod
+	})
+	if uint64(len(series)) > limit {
+		series = series[uint64(len(series))-limit:]
+	}
+
+	return series
+}
+
+// GetLastTallyStats retrieves the structural counts of the ballots of the last tally
+func (k Keeper) GetLastTallyStats(ctx sdk.Context) types.TallyStats {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastTallyStatsKey)
+	if bz == nil {
+		return types.TallyStats{}
+	}
+
+	var stats types.TallyStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats
+}
+
+// SetLastTallyStats updates the structural counts of the ballots of the last tally
+func (k Keeper) SetLastTallyStats(ctx sdk.Context, stats types.TallyStats) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&stats)
+	store.Set(types.LastTallyStatsKey, bz)
+}
+
+//-----------------------------------
+// Commitment hash algorithm logic
+
+// GetCommitmentHashAlgo retrieves the commitment hash algorithm in effect. It follows the
+// CommitmentHashAlgo param, but only switches over at the end of a vote period.
+func (k Keeper) GetCommitmentHashAlgo(ctx sdk.Context) string {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CommitmentHashAlgoKey)
+	if bz == nil {
+		return types.DefaultCommitmentHashAlgo
+	}
+
+	var algo gogotypes.StringValue
+	k.cdc.MustUnmarshal(bz, &algo)
+	return algo.Value
+}
+
+// SetCommitmentHashAlgo updates the commitment hash algorithm in effect
+func (k Keeper) SetCommitmentHashAlgo(ctx sdk.Context, algo string) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.StringValue{Value: algo})
+	store.Set(types.CommitmentHashAlgoKey, bz)
+}
+
+// SwitchCommitmentHashAlgo puts the CommitmentHashAlgo param into effect, if it changed.
+// Prevotes committed with the previous algorithm can not be revealed anymore and are
+// deleted. Returns whether the algorithm was switched.
+func (k Keeper) SwitchCommitmentHashAlgo(ctx sdk.Context) bool {
+	oldAlgo := k.GetCommitmentHashAlgo(ctx)
+	newAlgo := k.CommitmentHashAlgo(ctx)
+	if oldAlgo == newAlgo {
+		return false
+	}
+
+	k.IterateAggregateExchangeRatePrevotes(ctx, func(voterAddr sdk.ValAddress, _ types.AggregateExchangeRatePrevote) (stop bool) {
+		k.DeleteAggregateExchangeRatePrevote(ctx, voterAddr)
+		return false
+	})
+	k.SetCommitmentHashAlgo(ctx, newAlgo)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeCommitmentHashAlgoSwitch,
+			sdk.NewAttribute(types.AttributeKeyOldAlgo, oldAlgo),
+			sdk.NewAttribute(types.AttributeKeyNewAlgo, newAlgo),
+		),
+	)
+
+	return true
+}
+
+//-----------------------------------
+// Smoothed power logic
+
+// GetSmoothedPower retrieves the smoothed voting power of a validator, false if it is not tracked
+func (k Keeper) GetSmoothedPower(ctx sdk.Context, operator sdk.ValAddress) (sdk.Dec, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetSmoothedPowerKey(operator))
+	if bz == nil {
+		return sdk.ZeroDec(), false
+	}
+
+	dp := sdk.DecProto{}
+	k.cdc.MustUnmarshal(bz, &dp)
+	return dp.Dec, true
+}
+
+// SetSmoothedPower updates the smoothed voting power of a validator
+func (k Keeper) SetSmoothedPower(ctx sdk.Context, operator sdk.ValAddress, power sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sdk.DecProto{Dec: power})
+	store.Set(types.GetSmoothedPowerKey(operator), bz)
+}
+
+// UpdateSmoothedPowers folds the power of the validators in the claim map into their exponential
+// moving average over the given number of vote periods, and returns the rounded averages by
+// validator address. Validators no longer in the claim map are forgotten, and so are all
+// validators when smoothing is disabled, in which case nil is returned.
+func (k Keeper) UpdateSmoothedPowers(ctx sdk.Context, validatorClaimMap map[string]types.Claim, windows uint64) map[string]int64 {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.SmoothedPowerKey)
+	var staleKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		operator := sdk.ValAddress(iter.Key()[2:])
+		if _, ok := validatorClaimMap[operator.String()]; windows == 0 || !ok {
+			staleKeys = append(staleKeys, iter.Key())
+		}
+	}
+	iter.Close()
+
+	for _, key := range staleKeys {
+		store.Delete(key)
+	}
+
+	if windows == 0 {
+		return nil
+	}
+
+	alpha := sdk.NewDec(2).QuoInt64(int64(windows) + 1)
+	smoothedPowers := make(map[string]int64, len(validatorClaimMap))
+	for key, claim := range validatorClaimMap {
+		power := sdk.NewDec(claim.Power)
+		if previous, ok := k.GetSmoothedPower(ctx, claim.Recipient); ok {
+			power = previous.Add(alpha.Mul(power.Sub(previous)))
+		}
+
+		k.SetSmoothedPower(ctx, claim.Recipient, power)
+		smoothedPowers[key] = power.RoundInt64()
+	}
+
+	return smoothedPowers
+}
+
+//-----------------------------------
+// Stale counter logic
+
+// GetStaleCounter retrieves the # of consecutive vote periods the denom failed to tally
+func (k Keeper) GetStaleCounter(ctx sdk.Context, denom string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetStaleCounterKey(denom))
+	if bz == nil {
+		// By default the counter is zero
+		return 0
+	}
+
+	var staleCounter gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(bz, &staleCounter)
+	return staleCounter.Value
+}
+
+// SetStaleCounter updates the # of consecutive vote periods the denom failed to tally
+func (k Keeper) SetStaleCounter(ctx sdk.Context, denom string, staleCounter uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: staleCounter})
+	store.Set(types.GetStaleCounterKey(denom), bz)
+}
+
+// DeleteStaleCounter removes the stale counter for the denom
+func (k Keeper) DeleteStaleCounter(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetStaleCounterKey(denom))
+}
+
+//-----------------------------------
+// Denom tally counter logic
+
+// GetDenomTallyCounter retrieves the # of vote periods the denom tallied and failed to tally in the current slash window
+func (k Keeper) GetDenomTallyCounter(ctx sdk.Context, denom string) types.DenomTallyCounter {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDenomTallyCounterKey(denom))
+	if bz == nil {
+		return types.DenomTallyCounter{}
+	}
+
+	var counter types.DenomTallyCounter
+	k.cdc.MustUnmarshal(bz, &counter)
+	return counter
+}
+
+// SetDenomTallyCounter updates the # of vote periods the denom tallied and failed to tally in the current slash window
+func (k Keeper) SetDenomTallyCounter(ctx sdk.Context, denom string, counter types.DenomTallyCounter) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&counter)
+	store.Set(types.GetDenomTallyCounterKey(denom), bz)
+}
+
+// DeleteDenomTallyCounter removes the tally counter for the denom
+func (k Keeper) DeleteDenomTallyCounter(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDenomTallyCounterKey(denom))
+}
+
+// CountDenomTally counts the outcome of the tally of the denom in the vote period
+func (k Keeper) CountDenomTally(ctx sdk.Context, denom string, tallied bool) {
+	counter := k.GetDenomTallyCounter(ctx, denom)
+	if tallied {
+		counter.SuccessPeriods++
+	} else {
+		counter.FailedPeriods++
+	}
+	k.SetDenomTallyCounter(ctx, denom, counter)
+}
+
+// IterateDenomTallyCounters iterates over the tally counters of the denoms and performs a callback function
+func (k Keeper) IterateDenomTallyCounters(ctx sdk.Context, handler func(denom string, counter types.DenomTallyCounter) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DenomTallyCounterKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		denom := string(iter.Key()[len(types.DenomTallyCounterKey):])
+		var counter types.DenomTallyCounter
+		k.cdc.MustUnmarshal(iter.Value(), &counter)
+		if handler(denom, counter) {
+			break
+		}
+	}
+}
+
+// ClearDenomTallyCounters removes the tally counters of all denoms, starting a new slash window
+func (k Keeper) ClearDenomTallyCounters(ctx sdk.Context) {
+	var denoms []string
+	k.IterateDenomTallyCounters(ctx, func(denom string, _ types.DenomTallyCounter) (stop bool) {
+		denoms = append(denoms, denom)
+		return false
+	})
+
+	for _, denom := range denoms {
+		k.DeleteDenomTallyCounter(ctx, denom)
+	}
+}
+
+//-----------------------------------
+// Denom tally outcome logic
+
+// GetDenomTallyOutcome retrieves the outcome of the last vote period of the denom, false if none was recorded
+func (k Keeper) GetDenomTallyOutcome(ctx sdk.Context, denom string) (types.DenomTallyOutcome, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDenomTallyOutcomeKey(denom))
+	if bz == nil {
+		return types.DenomTallyOutcome{}, false
+	}
+
+	var outcome types.DenomTallyOutcome
+	k.cdc.MustUnmarshal(bz, &outcome)
+	return outcome, true
+}
+
+// SetDenomTallyOutcome keeps the outcome of the last vote period of the denom
+func (k Keeper) SetDenomTallyOutcome(ctx sdk.Context, denom string, outcome types.DenomTallyOutcome) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&outcome)
+	store.Set(types.GetDenomTallyOutcomeKey(denom), bz)
+}
+
+// DeleteDenomTallyOutcome removes the outcome of the last vote period of the denom
+func (k Keeper) DeleteDenomTallyOutcome(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDenomTallyOutcomeKey(denom))
+}
+
+//-----------------------------------
+// Denom voter count logic
+
+// GetDenomVoterCount retrieves the # of validators which rated the denom in its last successful tally
+func (k Keeper) GetDenomVoterCount(ctx sdk.Context, denom string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDenomVoterCountKey(denom))
+	if bz == nil {
+		return 0
+	}
+
+	var voterCount gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(bz, &voterCount)
+	return voterCount.Value
+}
+
+// SetDenomVoterCount keeps the # of validators which rated the denom in its last successful tally
+func (k Keeper) SetDenomVoterCount(ctx sdk.Context, denom string, voterCount uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: voterCount})
+	store.Set(types.GetDenomVoterCountKey(denom), bz)
+}
+
+// DeleteDenomVoterCount removes the voter count of the denom
+func (k Keeper) DeleteDenomVoterCount(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDenomVoterCountKey(denom))
+}
+
+//-----------------------------------
+// Denom max move logic
+
+// GetDenomMaxMove retrieves the largest exchange rate move of the denom in the window it was recorded in, false if none was recorded
+func (k Keeper) GetDenomMaxMove(ctx sdk.Context, denom string) (types.DenomMaxMove, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDenomMaxMoveKey(denom))
+	if bz == nil {
+		return types.DenomMaxMove{}, false
+	}
+
+	var maxMove types.DenomMaxMove
+	k.cdc.MustUnmarshal(bz, &maxMove)
+	return maxMove, true
+}
+
+// SetDenomMaxMove keeps the largest exchange rate move of the denom in the current window
+func (k Keeper) SetDenomMaxMove(ctx sdk.Context, denom string, maxMove types.DenomMaxMove) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&maxMove)
+	store.Set(types.GetDenomMaxMoveKey(denom), bz)
+}
+
+// DeleteDenomMaxMove removes the largest exchange rate move of the denom
+func (k Keeper) DeleteDenomMaxMove(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDenomMaxMoveKey(denom))
+}
+
+// maxMoveWindowStart returns the first vote period of the max move window of the vote period.
+// Windows are aligned to multiples of their length, so they start at the same vote periods on all nodes.
+func maxMoveWindowStart(votePeriod, window uint64) uint64 {
+	if window == 0 {
+		return 0
+	}
+	return votePeriod - votePeriod%window
+}
+
+// CountDenomMove records the move of the exchange rate of the denom from the previous vote period to the
+// tally of the vote period, if it is the largest of the current window. A record of an earlier window
+// is replaced. Nothing is recorded while the window is zero.
+func (k Keeper) CountDenomMove(ctx sdk.Context, denom string, previousRate, exchangeRate sdk.Dec, votePeriod, window uint64) {
+	if window == 0 || !previousRate.IsPositive() {
+		return
+	}
+
+	// |rate / previous rate - 1| * 100, as the difference itself may be out of range
+	ratio, err := types.SafeQuo(exchangeRate, previousRate)
+	if err != nil {
+		return
+	}
+	movePercent, err := types.SafeMul(ratio.Sub(sdk.OneDec()).Abs(), sdk.NewDec(100))
+	if err != nil {
+		return
+	}
+
+	windowStart := maxMoveWindowStart(votePeriod, window)
+	maxMove, ok := k.GetDenomMaxMove(ctx, denom)
+	if ok && maxMove.WindowStart == windowStart && movePercent.LTE(maxMove.MaxMovePercent) {
+		return
+	}
+
+	k.SetDenomMaxMove(ctx, denom, types.DenomMaxMove{
+		WindowStart:    windowStart,
+		MaxMovePercent: movePercent,
+		VotePeriod:     votePeriod,
+	})
+}
+
+//-----------------------------------
+// Tracking denom logic
+
+// MirrorTrackedDenoms sets the exchange rate of each denom tracking another one to the one of the
+// tracked denom, along with its stale counter and voter count, so the rate reads as fresh or as
+// carried forward as the one it derives from. Without a tracked rate, the denom has none either.
+func (k Keeper) MirrorTrackedDenoms(ctx sdk.Context, whitelist types.DenomList) {
+	for _, denom := range whitelist {
+		tracked := whitelist.TrackedDenom(denom.Name)
+		if len(tracked) == 0 {
+			continue
+		}
+
+		exchangeRate, err := k.GetExchangeRate(ctx, tracked)
+		if err != nil {
+			k.DeleteExchangeRate(ctx, denom.Name)
+		} else {
+			k.SetExchangeRate(ctx, denom.Name, exchangeRate)
+		}
+
+		if staleCounter := k.GetStaleCounter(ctx, tracked); staleCounter > 0 {
+			k.SetStaleCounter(ctx, denom.Name, staleCounter)
+		} else {
+			k.DeleteStaleCounter(ctx, denom.Name)
+		}
+		k.SetDenomVoterCount(ctx, denom.Name, k.GetDenomVoterCount(ctx, tracked))
+	}
+}
+
+//-----------------------------------
+// Validator accuracy counter logic
+
+// GetValidatorAccuracyCounter retrieves the # of exchange rates the validator submitted and had within the reward band
+// in the current and the previous slash window
+func (k Keeper) GetValidatorAccuracyCounter(ctx sdk.Context, operator sdk.ValAddress) types.ValidatorAccuracyCounter {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorAccuracyCounterKey(operator))
+	if bz == nil {
+		return types.ValidatorAccuracyCounter{}
+	}
+
+	var counter types.ValidatorAccuracyCounter
+	k.cdc.MustUnmarshal(bz, &counter)
+	return counter
+}
+
+// SetValidatorAccuracyCounter updates the # of exchange rates the validator submitted and had within the reward band
+func (k Keeper) SetValidatorAccuracyCounter(ctx sdk.Context, operator sdk.ValAddress, counter types.ValidatorAccuracyCounter) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&counter)
+	store.Set(types.GetValidatorAccuracyCounterKey(operator), bz)
+}
+
+// DeleteValidatorAccuracyCounter removes the accuracy counter of the validator
+func (k Keeper) DeleteValidatorAccuracyCounter(ctx sdk.Context, operator sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorAccuracyCounterKey(operator))
+}
+
+// CountValidatorAccuracy adds the exchange rates the validator submitted on the denoms tallied in the vote period,
+// and the ones of them within the reward band, to the current slash window
+func (k Keeper) CountValidatorAccuracy(ctx sdk.Context, operator sdk.ValAddress, submissions, inBandSubmissions uint64) {
+	counter := k.GetValidatorAccuracyCounter(ctx, operator)
+	counter.Submissions += submissions
+	counter.InBandSubmissions += inBandSubmissions
+	k.SetValidatorAccuracyCounter(ctx, operator, counter)
+}
+
+// IterateValidatorAccuracyCounters iterates over the accuracy counters of the validators and performs a callback function
+func (k Keeper) IterateValidatorAccuracyCounters(ctx sdk.Context, handler func(operator sdk.ValAddress, counter types.ValidatorAccuracyCounter) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorAccuracyCounterKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		operator := sdk.ValAddress(iter.Key()[2:])
+		var counter types.ValidatorAccuracyCounter
+		k.cdc.MustUnmarshal(iter.Value(), &counter)
+		if handler(operator, counter) {
+			break
+		}
+	}
+}
+
+// RollValidatorAccuracyCounters starts a new slash window, keeping the counts of the ending one as the previous
+// slash window. Validators which submitted nothing in either are removed.
+func (k Keeper) RollValidatorAccuracyCounters(ctx sdk.Context) {
+	counters := map[string]types.ValidatorAccuracyCounter{}
+	var operators []sdk.ValAddress
+	k.IterateValidatorAccuracyCounters(ctx, func(operator sdk.ValAddress, counter types.ValidatorAccuracyCounter) (stop bool) {
+		operators = append(operators, operator)
+		counters[operator.String()] = counter
+		return false
+	})
+
+	for _, operator := range operators {
+		counter := counters[operator.String()]
+		if counter.Submissions == 0 {
+			k.DeleteValidatorAccuracyCounter(ctx, operator)
+			continue
+		}
+
+		k.SetValidatorAccuracyCounter(ctx, operator, types.ValidatorAccuracyCounter{
+			PreviousSubmissions:       counter.Submissions,
+			PreviousInBandSubmissions: counter.InBandSubmissions,
+		})
+	}
+}
+
+//-----------------------------------
+// Required denom logic
+
+// RegisterRequiredDenom records that the module requires fresh exchange rates of the denom,
+// so that it is not delisted while the module still depends on it. Registrations are not
+// exported at genesis, the requiring modules register their denoms again in InitGenesis.
+func (k Keeper) RegisterRequiredDenom(ctx sdk.Context, denom, moduleName string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return errors.Wrap(types.ErrInvalidDenom, err.Error())
+	}
+	if len(moduleName) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "module name must be set")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRequiredDenomKey(denom, moduleName), []byte{})
+	return nil
+}
+
+// DeregisterRequiredDenom removes the record that the module requires the denom
+func (k Keeper) DeregisterRequiredDenom(ctx sdk.Context, denom, moduleName string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRequiredDenomKey(denom, moduleName))
+}
+
+// GetRequiringModules returns the names of the modules requiring the denom, sorted by name
+func (k Keeper) GetRequiringModules(ctx sdk.Context, denom string) (moduleNames []string) {
+	prefix := types.GetRequiredDenomPrefix(denom)
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		moduleNames = append(moduleNames, string(iter.Key()[len(prefix):]))
+	}
+	return moduleNames
+}
+
+// IsRequiredDenom returns whether any module requires the denom
+func (k Keeper) IsRequiredDenom(ctx sdk.Context, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetRequiredDenomPrefix(denom))
+	defer iter.Close()
+	return iter.Valid()
+}
+
+// IterateRequiredDenoms iterates over the modules requiring a denom and performs a callback function
+func (k Keeper) IterateRequiredDenoms(ctx sdk.Context, handler func(denom, moduleName string) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.RequiredDenomKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[len(types.RequiredDenomKey):]
+		denomLen := int(key[0])
+		denom := string(key[1 : 1+denomLen])
+		moduleName := string(key[1+denomLen:])
+		if handler(denom, moduleName) {
+			break
+		}
+	}
+}
+
+//-----------------------------------
+// Post upgrade grace logic
+
+// GetLastUpgradeVotePeriod retrieves the vote period of the last chain upgrade, false if none was recorded
+func (k Keeper) GetLastUpgradeVotePeriod(ctx sdk.Context) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastUpgradeVotePeriodKey)
+	if bz == nil {
+		return 0, false
+	}
+
+	var votePeriod gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(bz, &votePeriod)
+	return votePeriod.Value, true
+}
+
+// SetLastUpgradeVotePeriod keeps the vote period of the last chain upgrade
+func (k Keeper) SetLastUpgradeVotePeriod(ctx sdk.Context, votePeriod uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: votePeriod})
+	store.Set(types.LastUpgradeVotePeriodKey, bz)
+}
+
+// RecordUpgrade starts the post upgrade grace with the current vote period. It is called
+// by the upgrade handlers of the chain.
+func (k Keeper) RecordUpgrade(ctx sdk.Context) {
+	votePeriod := k.CurrentVotePeriod(ctx)
+	k.SetLastUpgradeVotePeriod(ctx, votePeriod)
+	k.Logger(ctx).Info("post upgrade grace started", "height", ctx.BlockHeight(), "vote_period", votePeriod, "grace_periods", k.PostUpgradeGracePeriods(ctx))
+}
+
+// IsPostUpgradeGrace returns whether misses are not counted in the vote period, as it
+// follows a chain upgrade too closely
+func (k Keeper) IsPostUpgradeGrace(ctx sdk.Context, votePeriod uint64) bool {
+	upgradePeriod, ok := k.GetLastUpgradeVotePeriod(ctx)
+	return ok && votePeriod < upgradePeriod+k.PostUpgradeGracePeriods(ctx)
+}
+
+//-----------------------------------
+// Observer logic
+
+// GetObserverExemption retrieves the height an observer is exempt from slashing until,
+// false if the validator is not an observer
+func (k Keeper) GetObserverExemption(ctx sdk.Context, operator sdk.ValAddress) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetObserverKey(operator))
+	if bz == nil {
+		return 0, false
+	}
+
+	var exemptUntil gogotypes.Int64Value
+	k.cdc.MustUnmarshal(bz, &exemptUntil)
+	return exemptUntil.Value, true
+}
+
+// SetObserverExemption registers the validator as an observer, exempt from slashing for
+// missing votes until the height
+func (k Keeper) SetObserverExemption(ctx sdk.Context, operator sdk.ValAddress, exemptUntil int64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.Int64Value{Value: exemptUntil})
+	store.Set(types.GetObserverKey(operator), bz)
+}
+
+// DeleteObserverExemption removes the validator from the observers
+func (k Keeper) DeleteObserverExemption(ctx sdk.Context, operator sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetObserverKey(operator))
+}
+
+// IsExemptObserver returns whether the validator is an observer exempt from slashing
+// at the current height
+func (k Keeper) IsExemptObserver(ctx sdk.Context, operator sdk.ValAddress) bool {
+	exemptUntil, ok := k.GetObserverExemption(ctx, operator)
+	return ok && ctx.BlockHeight() < exemptUntil
+}
+
+// IterateObserverExemptions iterates over the observers and performs a callback function
+func (k Keeper) IterateObserverExemptions(ctx sdk.Context,
+	handler func(operator sdk.ValAddress, exemptUntil int64) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ObserverKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		operator := sdk.ValAddress(iter.Key()[2:])
+
+		var exemptUntil gogotypes.Int64Value
+		k.cdc.MustUnmarshal(iter.Value(), &exemptUntil)
+
+		if handler(operator, exemptUntil.Value) {
+			break
+		}
+	}
+}
+
+// PruneObserverExemptions removes the observers whose exemption expired
+func (k Keeper) PruneObserverExemptions(ctx sdk.Context) {
+	var expired []sdk.ValAddress
+	k.IterateObserverExemptions(ctx, func(operator sdk.ValAddress, exemptUntil int64) (stop bool) {
+		if ctx.BlockHeight() >= exemptUntil {
+			expired = append(expired, operator)
+		}
+		return false
+	})
+
+	for _, operator := range expired {
+		k.DeleteObserverExemption(ctx, operator)
+	}
+}
+
+//-----------------------------------
+// Oracle alert config logic
+
+// GetOracleAlertConfig retrieves the miss rate the validator declared to accept, false if it declared none
+func (k Keeper) GetOracleAlertConfig(ctx sdk.Context, operator sdk.ValAddress) (types.OracleAlertConfig, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetOracleAlertConfigKey(operator))
+	if bz == nil {
+		return types.OracleAlertConfig{}, false
+	}
+
+	var config types.OracleAlertConfig
+	k.cdc.MustUnmarshal(bz, &config)
+	return config, true
+}
+
+// SetOracleAlertConfig keeps the miss rate the validator declared to accept
+func (k Keeper) SetOracleAlertConfig(ctx sdk.Context, operator sdk.ValAddress, config types.OracleAlertConfig) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&config)
+	store.Set(types.GetOracleAlertConfigKey(operator), bz)
+}
+
+// DeleteOracleAlertConfig removes the miss rate the validator declared to accept
+func (k Keeper) DeleteOracleAlertConfig(ctx sdk.Context, operator sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetOracleAlertConfigKey(operator))
+}
+
+// IterateOracleAlertConfigs iterates over the miss rates declared by the validators and performs a callback function
+func (k Keeper) IterateOracleAlertConfigs(ctx sdk.Context,
+	handler func(operator sdk.ValAddress, config types.OracleAlertConfig) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.OracleAlertConfigKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		operator := sdk.ValAddress(iter.Key()[2:])
+
+		var config types.OracleAlertConfig
+		k.cdc.MustUnmarshal(iter.Value(), &config)
+
+		if handler(operator, config) {
+			break
+		}
+	}
+}
+
+//-----------------------------------
+// Rejected tuples logic
+
+// GetRejectedTuples retrieves the rejected exchange rates of the last vote of the validator, false if none
+func (k Keeper) GetRejectedTuples(ctx sdk.Context, operator sdk.ValAddress) (types.RejectedTuples, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRejectedTuplesKey(operator))
+	if bz == nil {
+		return types.RejectedTuples{}, false
+	}
+
+	var rejected types.RejectedTuples
+	k.cdc.MustUnmarshal(bz, &rejected)
+	return rejected, true
+}
+
+// SetRejectedTuples keeps the rejected exchange rates of the last vote of the validator
+func (k Keeper) SetRejectedTuples(ctx sdk.Context, operator sdk.ValAddress, rejected types.RejectedTuples) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&rejected)
+	store.Set(types.GetRejectedTuplesKey(operator), bz)
+}
+
+// DeleteRejectedTuples removes the rejected exchange rates of the validator
+func (k Keeper) DeleteRejectedTuples(ctx sdk.Context, operator sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRejectedTuplesKey(operator))
+}
+
+// IterateRejectedTuples iterates over the rejected exchange rates of the validators
+func (k Keeper) IterateRejectedTuples(ctx sdk.Context,
+	handler func(operator sdk.ValAddress, rejected types.RejectedTuples) (stop bool),
+) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.RejectedTuplesKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		operator := sdk.ValAddress(iter.Key()[2:])
+
+		var rejected types.RejectedTuples
+		k.cdc.MustUnmarshal(iter.Value(), &rejected)
+
+		if handler(operator, rejected) {
+			break
+		}
+	}
+}
+
+// PruneRejectedTuples removes the rejected exchange rates of votes older than the current vote
+// period, left by validators which stopped voting
+func (k Keeper) PruneRejectedTuples(ctx sdk.Context) {
+	votePeriod := k.CurrentVotePeriod(ctx)
+
+	var stale []sdk.ValAddress
+	k.IterateRejectedTuples(ctx, func(operator sdk.ValAddress, rejected types.RejectedTuples) (stop bool) {
+		if rejected.VotePeriod < votePeriod {
+			stale = append(stale, operator)
+		}
+		return false
+	})
+
+	for _, operator := range stale {
+		k.DeleteRejectedTuples(ctx, operator)
+	}
+}
+
+// RejectVoteTuples returns the exchange rates of a vote which are left out of the tally,
+// namely the ones of denoms which are not a vote target
+func (k Keeper) RejectVoteTuples(ctx sdk.Context, tuples types.ExchangeRateTuples) []types.RejectedTuple {
+	voteTargets := map[string]struct{}{}
+	for _, denom := range k.VoteTargets(ctx) {
+		voteTargets[denom] = struct{}{}
+	}
+
+	var rejected []types.RejectedTuple
+	for _, tuple := range tuples {
+		if _, ok := voteTargets[tuple.Denom]; !ok {
+			rejected = append(rejected, types.RejectedTuple{Denom: tuple.Denom, Reason: types.RejectReasonNotVoteTarget})
+		}
+	}
+
+	return rejected
+}
+
+//-----------------------------------
+// Whitelist change logic
+
+// RecordWhitelistChange logs the denom as added to or removed from the whitelist in the current
+// vote period, unless the log is disabled. Only the last change of a denom in a vote period is kept.
+func (k Keeper) RecordWhitelistChange(ctx sdk.Context, denom string, added bool) {
+	if k.WhitelistChangeRetention(ctx) == 0 {
+		return
+	}
+
+	votePeriod := k.CurrentVotePeriod(ctx)
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&types.WhitelistChange{Denom: denom, VotePeriod: votePeriod, Added: added})
+	store.Set(types.GetWhitelistChangeKey(votePeriod, denom), bz)
+}
+
+// IterateWhitelistChanges iterates over the logged whitelist changes from the vote period on, oldest first
+func (k Keeper) IterateWhitelistChanges(ctx sdk.Context, fromPeriod uint64, handler func(change types.WhitelistChange) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.GetWhitelistChangePeriodPrefix(fromPeriod), sdk.PrefixEndBytes(types.WhitelistChangeKey))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var change types.WhitelistChange
+		k.cdc.MustUnmarshal(iter.Value(), &change)
+		if handler(change) {
+			break
+		}
+	}
+}
+
+// PruneWhitelistChanges removes the whitelist changes logged before the last retention vote periods,
+// all of them if the log is disabled
+func (k Keeper) PruneWhitelistChanges(ctx sdk.Context, votePeriod, retention uint64) {
+	store := ctx.KVStore(k.storeKey)
+	end := sdk.PrefixEndBytes(types.WhitelistChangeKey)
+	if retention > 0 {
+		end = types.GetWhitelistChangePeriodPrefix(firstWindowPeriod(votePeriod, retention))
+	}
+
+	iter := store.Iterator(types.WhitelistChangeKey, end)
+	defer iter.Close()
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// firstWindowPeriod returns the first of the last windows vote periods, up to the vote period
+func firstWindowPeriod(votePeriod, windows uint64) uint64 {
+	if votePeriod+1 <= windows {
+		return 0
+	}
+	return votePeriod + 1 - windows
+}
+
+//-----------------------------------
+// Last vote period logic
+
+// GetLastSubmission retrieves the aggregate vote a validator submitted in the last vote period
+func (k Keeper) GetLastSubmission(ctx sdk.Context, voter sdk.ValAddress) (aggregateVote types.AggregateExchangeRateVote, err error) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetLastSubmissionKey(voter))
+	if b == nil {
+		err = errors.Wrap(types.ErrNoAggregateVote, voter.String())
+		return
+	}
+	k.cdc.MustUnmarshal(b, &aggregateVote)
+	return
+}
+
+// SetLastSubmission keeps the aggregate vote a validator submitted in the last vote period
+func (k Keeper) SetLastSubmission(ctx sdk.Context, voter sdk.ValAddress, vote types.AggregateExchangeRateVote) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&vote)
+	store.Set(types.GetLastSubmissionKey(voter), bz)
+}
+
+// IterateLastSubmissions iterates over the aggregate votes submitted in the last vote period
+func (k Keeper) IterateLastSubmissions(ctx sdk.Context, handler func(voterAddr sdk.ValAddress, aggregateVote types.AggregateExchangeRateVote) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.LastSubmissionKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		voterAddr := sdk.ValAddress(iter.Key()[2:])
+
+		var aggregateVote types.AggregateExchangeRateVote
+		k.cdc.MustUnmarshal(iter.Value(), &aggregateVote)
+		if handler(voterAddr, aggregateVote) {
+			break
+		}
+	}
+}
+
+// GetTallyBounds retrieves the reward band boundaries of the last tally of the denom,
+// false if the denom did not tally in the last vote period
+func (k Keeper) GetTallyBounds(ctx sdk.Context, denom string) (types.TallyBounds, bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetTallyBoundsKey(denom))
+	if b == nil {
+		return types.TallyBounds{}, false
+	}
+
+	var bounds types.TallyBounds
+	k.cdc.MustUnmarshal(b, &bounds)
+	return bounds, true
+}
+
+// SetTallyBounds keeps the reward band boundaries of the last tally of the denom
+func (k Keeper) SetTallyBounds(ctx sdk.Context, denom string, bounds types.TallyBounds) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&bounds)
+	store.Set(types.GetTallyBoundsKey(denom), bz)
+}
+
+// IterateTallyBounds iterates over the tally bounds of the denoms tallied in the last vote period
+func (k Keeper) IterateTallyBounds(ctx sdk.Context, handler func(denom string, bounds types.TallyBounds) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TallyBoundsKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		denom := string(iter.Key()[len(types.TallyBoundsKey):])
+		var bounds types.TallyBounds
+		k.cdc.MustUnmarshal(iter.Value(), &bounds)
+		if handler(denom, bounds) {
+			break
+		}
+	}
+}
+
+// ClearLastVotePeriod removes the submissions and tally bounds kept from the last vote period
+func (k Keeper) ClearLastVotePeriod(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	for _, prefix := range [][]byte{types.LastSubmissionKey, types.TallyBoundsKey} {
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+		var keys [][]byte
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+}
+
+//-----------------------------------
+// Denom grace logic
+
+// GetDenomGraceExit retrieves the vote period from which missing the denom is counted,
+// false if the denom is not tracked
+func (k Keeper) GetDenomGraceExit(ctx sdk.Context, denom string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDenomGraceExitKey(denom))
+	if bz == nil {
+		return 0, false
+	}
+
+	var exitPeriod gogotypes.UInt64Value
+	k.cdc.MustUnmarshal(bz, &exitPeriod)
+	return exitPeriod.Value, true
+}
+
+// SetDenomGraceExit updates the vote period from which missing the denom is counted
+func (k Keeper) SetDenomGraceExit(ctx sdk.Context, denom string, exitPeriod uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&gogotypes.UInt64Value{Value: exitPeriod})
+	store.Set(types.GetDenomGraceExitKey(denom), bz)
+}
+
+// DeleteDenomGraceExit removes the grace exit period of the denom
+func (k Keeper) DeleteDenomGraceExit(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDenomGraceExitKey(denom))
+}
+
+// IterateDenomGraceExits iterates over the grace exit periods of the tracked denoms
+func (k Keeper) IterateDenomGraceExits(ctx sdk.Context, handler func(denom string, exitPeriod uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DenomGraceExitKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		denom := string(iter.Key()[len(types.DenomGraceExitKey):])
+		var exitPeriod gogotypes.UInt64Value
+		k.cdc.MustUnmarshal(iter.Value(), &exitPeriod)
+		if handler(denom, exitPeriod.Value) {
+			break
+		}
+	}
+}
+
+// UpdateDenomGraceExits starts the grace window of the vote targets seen for the first time
+// and forgets the denoms which are no longer a vote target, so a relisted denom gets a new window.
+func (k Keeper) UpdateDenomGraceExits(ctx sdk.Context, voteTargets []string, votePeriod uint64) {
+	targets := map[string]struct{}{}
+	for _, denom := range voteTargets {
+		targets[denom] = struct{}{}
+	}
+
+	var removed []string
+	k.IterateDenomGraceExits(ctx, func(denom string, _ uint64) (stop bool) {
+		if _, ok := targets[denom]; ok {
+			delete(targets, denom)
+		} else {
+			removed = append(removed, denom)
+		}
+		return false
+	})
+
+	// The denoms tracked are the whitelist as of the last vote period, so the
+	// difference also records the whitelist changes made by governance
+	for _, denom := range removed {
+		k.DeleteDenomGraceExit(ctx, denom)
+		k.RecordWhitelistChange(ctx, denom, false)
+	}
+
+	var added []string
+	gracePeriods := k.DenomGracePeriods(ctx)
+	for _, denom := range voteTargets {
+		if _, ok := targets[denom]; ok {
+			k.SetDenomGraceExit(ctx, denom, votePeriod+gracePeriods)
+			k.RecordWhitelistChange(ctx, denom, true)
+			added = append(added, denom)
+		}
+	}
+	k.notifyWhitelistObservers(ctx, added, removed)
+}
+
+// IsDenomInGrace returns whether missing the denom is not counted in the vote period
+func (k Keeper) IsDenomInGrace(ctx sdk.Context, denom string, votePeriod uint64) bool {
+	exitPeriod, ok := k.GetDenomGraceExit(ctx, denom)
+	return ok && votePeriod < exitPeriod
+}
+
+// DelistDenom removes the denom from the whitelist and clears its state,
+// the same as a governance proposal dropping it from the whitelist would.
+func (k Keeper) DelistDenom(ctx sdk.Context, denom string) {
+	params := k.GetParams(ctx)
+	whitelist := types.DenomList{}
+	for _, d := range params.Whitelist {
+		if d.Name != denom {
+			whitelist = append(whitelist, d)
+		}
+	}
+	params.Whitelist = whitelist
+	k.SetParams(ctx, params)
+
+	k.DeleteExchangeRate(ctx, denom)
+	k.DeleteStaleCounter(ctx, denom)
+	k.DeleteDenomTallyCounter(ctx, denom)
+	k.DeleteDenomGraceExit(ctx, denom)
+	k.DeleteDenomTallyOutcome(ctx, denom)
+	k.DeleteDenomVoterCount(ctx, denom)
+	k.DeleteDenomMaxMove(ctx, denom)
+	k.RecordWhitelistChange(ctx, denom, false)
+	k.notifyWhitelistObservers(ctx, nil, []string{denom})
+}
+
+// denomKeys are the keys of all state stored by denom, along with the name of the state
+var denomKeys = []struct {
+	name string
+	key  func(denom string) []byte
+}{
+	{"ExchangeRate", types.GetExchangeRateKey},
+	{"StaleCounter", types.GetStaleCounterKey},
+	{"DenomGraceExit", types.GetDenomGraceExitKey},
+	{"TallyBounds", types.GetTallyBoundsKey},
+	{"DenomTallyCounter", types.GetDenomTallyCounterKey},
+	{"DenomTallyOutcome", types.GetDenomTallyOutcomeKey},
+	{"DenomVoterCount", types.GetDenomVoterCountKey},
+	{"DenomMaxMove", types.GetDenomMaxMoveKey},
+}
+
+// GetRawDenomState returns the store entries of all state stored by denom, as persisted
+func (k Keeper) GetRawDenomState(ctx sdk.Context, denom string) []types.RawStoreEntry {
+	store := ctx.KVStore(k.storeKey)
+	entries := []types.RawStoreEntry{}
+	for _, denomKey := range denomKeys {
+		key := denomKey.key(denom)
+		if bz := store.Get(key); bz != nil {
+			entries = append(entries, types.RawStoreEntry{
+				Name:  denomKey.name,
+				Key:   hex.EncodeToString(key),
+				Value: hex.EncodeToString(bz),
+			})
+		}
+	}
+
+	return entries
+}
+
+// RenameDenom moves the whitelist entry and all state keyed by the old denom to the
+// new denom, e.g. after the IBC path of the denom changed. It fails without changing
+// anything, if the old denom is not whitelisted or the new denom is in use already.
+func (k Keeper) RenameDenom(ctx sdk.Context, oldDenom, newDenom string) error {
+	params := k.GetParams(ctx)
+	renamed := false
+	for i, d := range params.Whitelist {
+		if d.Name == newDenom {
+			return errors.Wrapf(types.ErrDenomExists, "%s is whitelisted", newDenom)
+		}
+		if d.Name == oldDenom {
+			params.Whitelist[i].Name = newDenom
+			renamed = true
+		}
+		if d.QuoteDenom == oldDenom {
+			params.Whitelist[i].QuoteDenom = newDenom
+		}
+		if d.Tracks == oldDenom {
+			params.Whitelist[i].Tracks = newDenom
+		}
+	}
+	if !renamed {
+		return errors.Wrapf(types.ErrUnknownDenom, "%s is not whitelisted", oldDenom)
+	}
+	if moduleNames := k.GetRequiringModules(ctx, oldDenom); len(moduleNames) > 0 {
+		return errors.Wrapf(types.ErrDenomRequired, "%s is required by %s", oldDenom, strings.Join(moduleNames, ", "))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, denomKey := range denomKeys {
+		if store.Has(denomKey.key(newDenom)) {
+			return errors.Wrapf(types.ErrDenomExists, "%s has state stored", newDenom)
+		}
+	}
+
+	for _, denomKey := range denomKeys {
+		if bz := store.Get(denomKey.key(oldDenom)); bz != nil {
+			store.Set(denomKey.key(newDenom), bz)
+			store.Delete(denomKey.key(oldDenom))
+		}
+	}
+	k.SetParams(ctx, params)
+	k.RecordWhitelistChange(ctx, oldDenom, false)
+	k.RecordWhitelistChange(ctx, newDenom, true)
+	k.notifyWhitelistObservers(ctx, []string{newDenom}, []string{oldDenom})
+
+	return nil
+}
+
 // ValidateFeeder return the given feeder is allowed to feed the message or not
 func (k Keeper) ValidateFeeder(ctx sdk.Context, feederAddr sdk.AccAddress, validatorAddr sdk.ValAddress) error {
 	if !feederAddr.Equals(validatorAddr) {
@@ -2,12 +2,18 @@ package keeper
 
 import (
 	"bytes"
+	"strings"
 	"testing"
+	"time"
 
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/Team-Kujira/core/x/oracle/types"
 
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/x/staking"
@@ -57,6 +63,47 @@ func TestExchangeRate(t *testing.T) {
 	require.True(t, numExchangeRates == 3)
 }
 
+func TestExchangeRateProof(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// The stores of the test input share a database, so the oracle store is committed on its own
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	cms := store.NewCommitMultiStore(dbm.NewMemDB())
+	cms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	k := input.OracleKeeper
+	k.storeKey = key
+	ctx := input.Ctx.WithMultiStore(cms)
+
+	rate := sdk.NewDecWithPrec(1234567, 3)
+	k.SetExchangeRate(ctx, types.TestDenomA, rate)
+	k.SetExchangeRate(ctx, types.TestDenomB, sdk.OneDec())
+
+	// The app hash is the root of the committed multistore
+	commitID := cms.Commit()
+
+	// The app queries the multistore with the path stripped of its store prefix
+	res := cms.(storetypes.Queryable).Query(abci.RequestQuery{
+		Path:   strings.TrimPrefix(types.ExchangeRateProofPath, "/store"),
+		Data:   types.GetExchangeRateKey(types.TestDenomA),
+		Height: commitID.Version,
+		Prove:  true,
+	})
+	require.Equal(t, uint32(0), res.Code, res.Log)
+	require.NotNil(t, res.ProofOps)
+
+	var stored sdk.DecProto
+	input.Cdc.MustUnmarshal(res.Value, &stored)
+	require.Equal(t, rate, stored.Dec)
+	require.NoError(t, types.VerifyExchangeRateProof(res.ProofOps, commitID.Hash, types.TestDenomA, rate))
+
+	// A different rate, denom or root does not verify
+	require.Error(t, types.VerifyExchangeRateProof(res.ProofOps, commitID.Hash, types.TestDenomA, sdk.OneDec()))
+	require.Error(t, types.VerifyExchangeRateProof(res.ProofOps, commitID.Hash, types.TestDenomB, rate))
+	require.Error(t, types.VerifyExchangeRateProof(res.ProofOps, bytes.Repeat([]byte{1}, 32), types.TestDenomA, rate))
+	require.Error(t, types.VerifyExchangeRateProof(nil, commitID.Hash, types.TestDenomA, rate))
+}
+
 func TestIterateExchangeRates(t *testing.T) {
 	input := CreateTestInput(t)
 
@@ -123,14 +170,34 @@ func TestParams(t *testing.T) {
 
 	// Should really test validateParams, but skipping because obvious
 	newParams := types.Params{
-		VotePeriod:               votePeriod,
-		VoteThreshold:            voteThreshold,
-		RewardBand:               oracleRewardBand,
-		RewardDistributionWindow: rewardDistributionWindow,
-		Whitelist:                whitelist,
-		SlashFraction:            slashFraction,
-		SlashWindow:              slashWindow,
-		MinValidPerWindow:        minValidPerWindow,
+		VotePeriod:                 votePeriod,
+		VoteThreshold:              voteThreshold,
+		RewardBand:                 oracleRewardBand,
+		RewardDistributionWindow:   rewardDistributionWindow,
+		Whitelist:                  whitelist,
+		SlashFraction:              slashFraction,
+		SlashWindow:                slashWindow,
+		MinValidPerWindow:          minValidPerWindow,
+		AggregationMethod:          types.AggregationMethodMode,
+		ModeBucketPrecision:        4,
+		ProgressiveSlashing:        true,
+		ProgressiveSlashFloor:      sdk.NewDecWithPrec(1, 5),
+		CommitmentHashAlgo:         types.CommitmentHashAlgoSHA256,
+		VotePeriodDuration:         time.Minute,
+		MaxPowerShare:              sdk.NewDecWithPrec(25, 2),
+		RevealMissWeight:           sdk.NewDecWithPrec(5, 1),
+		AccuracyWeightedRewards:    true,
+		MaxEventDenomsPerBlock:     10,
+		FeederChangeCooldownBlocks: 100,
+		RevealGraceBlocks:          2,
+		LegacyRateEvents:           false,
+		WhitelistChangeRetention:   1000,
+		MaxDenomsPerVote:           50,
+		OracleFeeShare:             sdk.NewDecWithPrec(1, 1),
+		MaxVoteFutureDrift:         1,
+		PostUpgradeGracePeriods:    2,
+		MaxMoveWindow:              2880,
+		EvenMedianRule:             types.EvenMedianRuleAverage,
 	}
 	input.OracleKeeper.SetParams(input.Ctx, newParams)
 
@@ -174,6 +241,304 @@ func TestIterateFeederDelegations(t *testing.T) {
 	require.Equal(t, Addrs[1], delegates[0])
 }
 
+func TestStaleCounter(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// Test default getters and setters
+	require.Equal(t, uint64(0), input.OracleKeeper.GetStaleCounter(input.Ctx, types.TestDenomA))
+
+	input.OracleKeeper.SetStaleCounter(input.Ctx, types.TestDenomA, 3)
+	require.Equal(t, uint64(3), input.OracleKeeper.GetStaleCounter(input.Ctx, types.TestDenomA))
+	require.Equal(t, uint64(0), input.OracleKeeper.GetStaleCounter(input.Ctx, types.TestDenomB))
+
+	input.OracleKeeper.DeleteStaleCounter(input.Ctx, types.TestDenomA)
+	require.Equal(t, uint64(0), input.OracleKeeper.GetStaleCounter(input.Ctx, types.TestDenomA))
+}
+
+func TestObserverExemptions(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := input.Ctx.WithBlockHeight(100)
+
+	_, ok := input.OracleKeeper.GetObserverExemption(ctx, ValAddrs[0])
+	require.False(t, ok)
+	require.False(t, input.OracleKeeper.IsExemptObserver(ctx, ValAddrs[0]))
+
+	input.OracleKeeper.SetObserverExemption(ctx, ValAddrs[0], 101)
+	input.OracleKeeper.SetObserverExemption(ctx, ValAddrs[1], 100)
+	exemptUntil, ok := input.OracleKeeper.GetObserverExemption(ctx, ValAddrs[0])
+	require.True(t, ok)
+	require.Equal(t, int64(101), exemptUntil)
+
+	// The exemption ends at its height
+	require.True(t, input.OracleKeeper.IsExemptObserver(ctx, ValAddrs[0]))
+	require.False(t, input.OracleKeeper.IsExemptObserver(ctx, ValAddrs[1]))
+	require.False(t, input.OracleKeeper.IsExemptObserver(ctx.WithBlockHeight(101), ValAddrs[0]))
+
+	observers := map[string]int64{}
+	input.OracleKeeper.IterateObserverExemptions(ctx, func(operator sdk.ValAddress, exemptUntil int64) (stop bool) {
+		observers[operator.String()] = exemptUntil
+		return false
+	})
+	require.Equal(t, map[string]int64{ValAddrs[0].String(): 101, ValAddrs[1].String(): 100}, observers)
+
+	input.OracleKeeper.PruneObserverExemptions(ctx)
+	_, ok = input.OracleKeeper.GetObserverExemption(ctx, ValAddrs[0])
+	require.True(t, ok)
+	_, ok = input.OracleKeeper.GetObserverExemption(ctx, ValAddrs[1])
+	require.False(t, ok)
+
+	input.OracleKeeper.DeleteObserverExemption(ctx, ValAddrs[0])
+	require.False(t, input.OracleKeeper.IsExemptObserver(ctx, ValAddrs[0]))
+}
+
+func TestGetUSDExchangeRate(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// DenomB is quoted in DenomC, which is quoted in DenomD
+	input.OracleKeeper.SetWhitelist(input.Ctx, types.DenomList{
+		{Name: types.TestDenomB, QuoteDenom: types.TestDenomC},
+		{Name: types.TestDenomC, QuoteDenom: types.TestDenomD},
+		{Name: types.TestDenomD},
+	})
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomB, sdk.NewDecWithPrec(5, 1))
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomC, sdk.NewDec(4))
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomD, sdk.NewDec(3))
+
+	rate, err := input.OracleKeeper.GetUSDExchangeRate(input.Ctx, types.TestDenomD)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(3), rate)
+	rate, err = input.OracleKeeper.GetUSDExchangeRate(input.Ctx, types.TestDenomB)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(6), rate)
+
+	// The conversion fails without the exchange rate of a quote denom
+	input.OracleKeeper.DeleteExchangeRate(input.Ctx, types.TestDenomD)
+	_, err = input.OracleKeeper.GetUSDExchangeRate(input.Ctx, types.TestDenomB)
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+	_, err = input.OracleKeeper.GetUSDExchangeRate(input.Ctx, types.TestDenomE)
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+}
+
+func TestValidatorAccuracyCounters(t *testing.T) {
+	input := CreateTestInput(t)
+
+	require.Equal(t, types.ValidatorAccuracyCounter{}, input.OracleKeeper.GetValidatorAccuracyCounter(input.Ctx, ValAddrs[0]))
+
+	input.OracleKeeper.CountValidatorAccuracy(input.Ctx, ValAddrs[0], 3, 2)
+	input.OracleKeeper.CountValidatorAccuracy(input.Ctx, ValAddrs[0], 2, 2)
+	input.OracleKeeper.CountValidatorAccuracy(input.Ctx, ValAddrs[1], 1, 0)
+	require.Equal(t, types.ValidatorAccuracyCounter{Submissions: 5, InBandSubmissions: 4}, input.OracleKeeper.GetValidatorAccuracyCounter(input.Ctx, ValAddrs[0]))
+
+	// The ending slash window becomes the previous one
+	input.OracleKeeper.RollValidatorAccuracyCounters(input.Ctx)
+	input.OracleKeeper.CountValidatorAccuracy(input.Ctx, ValAddrs[0], 1, 1)
+	require.Equal(t, types.ValidatorAccuracyCounter{
+		Submissions:               1,
+		InBandSubmissions:         1,
+		PreviousSubmissions:       5,
+		PreviousInBandSubmissions: 4,
+	}, input.OracleKeeper.GetValidatorAccuracyCounter(input.Ctx, ValAddrs[0]))
+
+	// Validators which submitted nothing in the ending slash window are removed with the next roll
+	input.OracleKeeper.RollValidatorAccuracyCounters(input.Ctx)
+	counters := map[string]types.ValidatorAccuracyCounter{}
+	input.OracleKeeper.IterateValidatorAccuracyCounters(input.Ctx, func(operator sdk.ValAddress, counter types.ValidatorAccuracyCounter) (stop bool) {
+		counters[operator.String()] = counter
+		return false
+	})
+	require.Equal(t, map[string]types.ValidatorAccuracyCounter{
+		ValAddrs[0].String(): {PreviousSubmissions: 1, PreviousInBandSubmissions: 1},
+	}, counters)
+
+	input.OracleKeeper.DeleteValidatorAccuracyCounter(input.Ctx, ValAddrs[0])
+	require.Equal(t, types.ValidatorAccuracyCounter{}, input.OracleKeeper.GetValidatorAccuracyCounter(input.Ctx, ValAddrs[0]))
+}
+
+func TestRequiredDenoms(t *testing.T) {
+	input := CreateTestInput(t)
+
+	require.False(t, input.OracleKeeper.IsRequiredDenom(input.Ctx, types.TestDenomA))
+	require.Empty(t, input.OracleKeeper.GetRequiringModules(input.Ctx, types.TestDenomA))
+
+	require.NoError(t, input.OracleKeeper.RegisterRequiredDenom(input.Ctx, types.TestDenomA, "lending"))
+	require.NoError(t, input.OracleKeeper.RegisterRequiredDenom(input.Ctx, types.TestDenomA, "cdp"))
+	require.NoError(t, input.OracleKeeper.RegisterRequiredDenom(input.Ctx, types.TestDenomA, "cdp"))
+	require.NoError(t, input.OracleKeeper.RegisterRequiredDenom(input.Ctx, types.TestDenomB, "lending"))
+	require.ErrorIs(t, input.OracleKeeper.RegisterRequiredDenom(input.Ctx, "", "lending"), types.ErrInvalidDenom)
+	require.Error(t, input.OracleKeeper.RegisterRequiredDenom(input.Ctx, types.TestDenomA, ""))
+
+	require.True(t, input.OracleKeeper.IsRequiredDenom(input.Ctx, types.TestDenomA))
+	require.Equal(t, []string{"cdp", "lending"}, input.OracleKeeper.GetRequiringModules(input.Ctx, types.TestDenomA))
+	require.Equal(t, []string{"lending"}, input.OracleKeeper.GetRequiringModules(input.Ctx, types.TestDenomB))
+	// a denom sharing a prefix with a required denom is not required
+	require.False(t, input.OracleKeeper.IsRequiredDenom(input.Ctx, "ukuj"))
+
+	required := map[string][]string{}
+	input.OracleKeeper.IterateRequiredDenoms(input.Ctx, func(denom, moduleName string) (stop bool) {
+		required[denom] = append(required[denom], moduleName)
+		return false
+	})
+	require.Equal(t, map[string][]string{
+		types.TestDenomA: {"cdp", "lending"},
+		types.TestDenomB: {"lending"},
+	}, required)
+
+	input.OracleKeeper.DeregisterRequiredDenom(input.Ctx, types.TestDenomB, "lending")
+	require.False(t, input.OracleKeeper.IsRequiredDenom(input.Ctx, types.TestDenomB))
+	require.True(t, input.OracleKeeper.IsRequiredDenom(input.Ctx, types.TestDenomA))
+}
+
+func TestDenomGraceExit(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// Test default getters and setters
+	_, ok := input.OracleKeeper.GetDenomGraceExit(input.Ctx, types.TestDenomA)
+	require.False(t, ok)
+	require.False(t, input.OracleKeeper.IsDenomInGrace(input.Ctx, types.TestDenomA, 0))
+
+	input.OracleKeeper.SetDenomGraceExit(input.Ctx, types.TestDenomA, 3)
+	exitPeriod, ok := input.OracleKeeper.GetDenomGraceExit(input.Ctx, types.TestDenomA)
+	require.True(t, ok)
+	require.Equal(t, uint64(3), exitPeriod)
+	require.True(t, input.OracleKeeper.IsDenomInGrace(input.Ctx, types.TestDenomA, 2))
+	require.False(t, input.OracleKeeper.IsDenomInGrace(input.Ctx, types.TestDenomA, 3))
+
+	input.OracleKeeper.DeleteDenomGraceExit(input.Ctx, types.TestDenomA)
+	_, ok = input.OracleKeeper.GetDenomGraceExit(input.Ctx, types.TestDenomA)
+	require.False(t, ok)
+
+	// New vote targets get a grace window, known ones keep theirs and removed ones are forgotten
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.DenomGracePeriods = 5
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	input.OracleKeeper.SetDenomGraceExit(input.Ctx, types.TestDenomA, 1)
+	input.OracleKeeper.SetDenomGraceExit(input.Ctx, types.TestDenomC, 1)
+	input.OracleKeeper.UpdateDenomGraceExits(input.Ctx, []string{types.TestDenomA, types.TestDenomB}, 10)
+
+	exitPeriods := map[string]uint64{}
+	input.OracleKeeper.IterateDenomGraceExits(input.Ctx, func(denom string, exitPeriod uint64) (stop bool) {
+		exitPeriods[denom] = exitPeriod
+		return false
+	})
+	require.Equal(t, map[string]uint64{types.TestDenomA: 1, types.TestDenomB: 15}, exitPeriods)
+}
+
+func TestCountDenomMove(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// Nothing is counted while the window is zero, nor without a previous rate
+	input.OracleKeeper.CountDenomMove(input.Ctx, types.TestDenomA, sdk.NewDec(8), sdk.NewDec(10), 1, 0)
+	input.OracleKeeper.CountDenomMove(input.Ctx, types.TestDenomA, sdk.ZeroDec(), sdk.NewDec(10), 1, 10)
+	_, ok := input.OracleKeeper.GetDenomMaxMove(input.Ctx, types.TestDenomA)
+	require.False(t, ok)
+
+	// Moves up and down count alike, and only the largest of the window is kept
+	input.OracleKeeper.CountDenomMove(input.Ctx, types.TestDenomA, sdk.NewDec(8), sdk.NewDec(10), 11, 10)
+	input.OracleKeeper.CountDenomMove(input.Ctx, types.TestDenomA, sdk.NewDec(10), sdk.NewDec(6), 12, 10)
+	input.OracleKeeper.CountDenomMove(input.Ctx, types.TestDenomA, sdk.NewDec(6), sdk.NewDec(7), 13, 10)
+	maxMove, ok := input.OracleKeeper.GetDenomMaxMove(input.Ctx, types.TestDenomA)
+	require.True(t, ok)
+	require.Equal(t, types.DenomMaxMove{WindowStart: 10, MaxMovePercent: sdk.NewDec(40), VotePeriod: 12}, maxMove)
+
+	// The next window starts over, even with a smaller move
+	input.OracleKeeper.CountDenomMove(input.Ctx, types.TestDenomA, sdk.NewDec(7), sdk.NewDec(7), 20, 10)
+	maxMove, ok = input.OracleKeeper.GetDenomMaxMove(input.Ctx, types.TestDenomA)
+	require.True(t, ok)
+	require.Equal(t, types.DenomMaxMove{WindowStart: 20, MaxMovePercent: sdk.ZeroDec(), VotePeriod: 20}, maxMove)
+
+	input.OracleKeeper.DeleteDenomMaxMove(input.Ctx, types.TestDenomA)
+	_, ok = input.OracleKeeper.GetDenomMaxMove(input.Ctx, types.TestDenomA)
+	require.False(t, ok)
+}
+
+func TestWhitelistChanges(t *testing.T) {
+	input := CreateTestInput(t)
+	votePeriod := int64(input.OracleKeeper.VotePeriod(input.Ctx))
+	changes := func() []types.WhitelistChange {
+		var changes []types.WhitelistChange
+		input.OracleKeeper.IterateWhitelistChanges(input.Ctx, 0, func(change types.WhitelistChange) (stop bool) {
+			changes = append(changes, change)
+			return false
+		})
+		return changes
+	}
+
+	// Nothing is logged while the log is disabled
+	input.OracleKeeper.RecordWhitelistChange(input.Ctx, types.TestDenomA, true)
+	require.Empty(t, changes())
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.WhitelistChangeRetention = 3
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// The vote targets are compared to the denoms tracked for their grace window
+	ctx := input.Ctx.WithBlockHeight(votePeriod)
+	input.OracleKeeper.SetDenomGraceExit(ctx, types.TestDenomA, 0)
+	input.OracleKeeper.SetDenomGraceExit(ctx, types.TestDenomC, 0)
+	input.OracleKeeper.UpdateDenomGraceExits(ctx, []string{types.TestDenomA, types.TestDenomB}, 1)
+
+	// Delisting a denom removes its grace window, so it is logged directly
+	input.OracleKeeper.DelistDenom(ctx.WithBlockHeight(2*votePeriod), types.TestDenomA)
+	require.Equal(t, []types.WhitelistChange{
+		{Denom: types.TestDenomB, VotePeriod: 1, Added: true},
+		{Denom: types.TestDenomC, VotePeriod: 1, Added: false},
+		{Denom: types.TestDenomA, VotePeriod: 2, Added: false},
+	}, changes())
+
+	// Changes older than the retention are pruned
+	input.OracleKeeper.PruneWhitelistChanges(input.Ctx, 4, 3)
+	require.Equal(t, []types.WhitelistChange{{Denom: types.TestDenomA, VotePeriod: 2, Added: false}}, changes())
+
+	// All of them once the log is disabled
+	input.OracleKeeper.PruneWhitelistChanges(input.Ctx, 4, 0)
+	require.Empty(t, changes())
+}
+
+func TestWinningPower(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// Test default getters and setters
+	require.Equal(t, int64(0), input.OracleKeeper.GetWinningPower(input.Ctx))
+
+	input.OracleKeeper.SetWinningPower(input.Ctx, 42)
+	require.Equal(t, int64(42), input.OracleKeeper.GetWinningPower(input.Ctx))
+}
+
+func TestUpdateSmoothedPowers(t *testing.T) {
+	input := CreateTestInput(t)
+
+	claims := func(power0, power1 int64) map[string]types.Claim {
+		return map[string]types.Claim{
+			ValAddrs[0].String(): types.NewClaim(power0, 0, 0, ValAddrs[0]),
+			ValAddrs[1].String(): types.NewClaim(power1, 0, 0, ValAddrs[1]),
+		}
+	}
+
+	// The average starts at the current power
+	powers := input.OracleKeeper.UpdateSmoothedPowers(input.Ctx, claims(10, 10), 3)
+	require.Equal(t, map[string]int64{ValAddrs[0].String(): 10, ValAddrs[1].String(): 10}, powers)
+
+	// With 3 windows, half of the change is applied per vote period
+	powers = input.OracleKeeper.UpdateSmoothedPowers(input.Ctx, claims(100, 10), 3)
+	require.Equal(t, map[string]int64{ValAddrs[0].String(): 55, ValAddrs[1].String(): 10}, powers)
+	power, ok := input.OracleKeeper.GetSmoothedPower(input.Ctx, ValAddrs[0])
+	require.True(t, ok)
+	require.Equal(t, sdk.NewDec(55), power)
+
+	// Validators leaving the claim map are forgotten
+	input.OracleKeeper.UpdateSmoothedPowers(input.Ctx, map[string]types.Claim{
+		ValAddrs[0].String(): types.NewClaim(100, 0, 0, ValAddrs[0]),
+	}, 3)
+	_, ok = input.OracleKeeper.GetSmoothedPower(input.Ctx, ValAddrs[1])
+	require.False(t, ok)
+
+	// Disabling the smoothing forgets all validators
+	require.Nil(t, input.OracleKeeper.UpdateSmoothedPowers(input.Ctx, claims(100, 10), 0))
+	_, ok = input.OracleKeeper.GetSmoothedPower(input.Ctx, ValAddrs[0])
+	require.False(t, ok)
+}
+
 func TestMissCounter(t *testing.T) {
 	input := CreateTestInput(t)
 
@@ -186,9 +551,36 @@ func TestMissCounter(t *testing.T) {
 	counter = input.OracleKeeper.GetMissCounter(input.Ctx, ValAddrs[0])
 	require.Equal(t, missCounter, counter)
 
+	input.OracleKeeper.IncrementMissCounter(input.Ctx, ValAddrs[0])
+	require.Equal(t, missCounter+1, input.OracleKeeper.GetMissCounter(input.Ctx, ValAddrs[0]))
+
 	input.OracleKeeper.DeleteMissCounter(input.Ctx, ValAddrs[0])
 	counter = input.OracleKeeper.GetMissCounter(input.Ctx, ValAddrs[0])
 	require.Equal(t, uint64(0), counter)
+
+	// The counters stop at the vote periods of the slash window
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.VotePeriod = 10
+	params.SlashWindow = 100
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	require.Equal(t, uint64(10), input.OracleKeeper.MaxMissCounter(input.Ctx))
+
+	input.OracleKeeper.SetMissCounter(input.Ctx, ValAddrs[0], 9)
+	input.OracleKeeper.IncrementMissCounter(input.Ctx, ValAddrs[0])
+	require.Equal(t, uint64(10), input.OracleKeeper.GetMissCounter(input.Ctx, ValAddrs[0]))
+	input.OracleKeeper.IncrementMissCounter(input.Ctx, ValAddrs[0])
+	require.Equal(t, uint64(10), input.OracleKeeper.GetMissCounter(input.Ctx, ValAddrs[0]))
+	input.OracleKeeper.SetRevealMissCounter(input.Ctx, ValAddrs[0], 9)
+	input.OracleKeeper.IncrementRevealMissCounter(input.Ctx, ValAddrs[0])
+	input.OracleKeeper.IncrementRevealMissCounter(input.Ctx, ValAddrs[0])
+	require.Equal(t, uint64(10), input.OracleKeeper.GetRevealMissCounter(input.Ctx, ValAddrs[0]))
+
+	// With timed vote periods, the window holds at most a vote period per block
+	params.VotePeriodDuration = time.Minute
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	require.Equal(t, uint64(100), input.OracleKeeper.MaxMissCounter(input.Ctx))
+	input.OracleKeeper.IncrementMissCounter(input.Ctx, ValAddrs[0])
+	require.Equal(t, uint64(11), input.OracleKeeper.GetMissCounter(input.Ctx, ValAddrs[0]))
 }
 
 func TestIterateMissCounters(t *testing.T) {
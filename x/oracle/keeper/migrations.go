@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Team-Kujira/core/x/oracle/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the oracle store from version 1 to 2.
+// Parameters introduced after version 1 are missing from the param store of
+// existing chains and are initialized with their default values. The denoms
+// already whitelisted are past their grace window, and the commitment hash
+// algorithm of the params is put into effect right away.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	defaults := types.DefaultParams()
+	for _, pair := range defaults.ParamSetPairs() {
+		if !m.keeper.paramSpace.Has(ctx, pair.Key) {
+			m.keeper.paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+
+	m.keeper.SetCommitmentHashAlgo(ctx, m.keeper.CommitmentHashAlgo(ctx))
+
+	votePeriod := m.keeper.CurrentVotePeriod(ctx)
+	for _, denom := range m.keeper.Whitelist(ctx) {
+		m.keeper.SetDenomGraceExit(ctx, denom.Name, votePeriod)
+	}
+
+	return nil
+}
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -44,6 +46,16 @@ func (ms msgServer) AggregateExchangeRatePrevote(goCtx context.Context, msg *typ
 		return nil, errors.Wrap(types.ErrInvalidHash, err.Error())
 	}
 
+	// The commitment must be made with the hash algorithm in effect
+	algo := ms.GetCommitmentHashAlgo(ctx)
+	hashSize, err := types.CommitmentHashSize(algo)
+	if err != nil {
+		return nil, err
+	}
+	if len(voteHash) != hashSize {
+		return nil, errors.Wrapf(types.ErrInvalidHashLength, "%s commitments are %d bytes long, not %d", algo, hashSize, len(voteHash))
+	}
+
 	aggregatePrevote := types.NewAggregateExchangeRatePrevote(voteHash, valAddr, uint64(ctx.BlockHeight()))
 	ms.SetAggregateExchangeRatePrevote(ctx, valAddr, aggregatePrevote)
 
@@ -79,16 +91,40 @@ func (ms msgServer) AggregateExchangeRateVote(goCtx context.Context, msg *types.
 		return nil, err
 	}
 
-	params := ms.GetParams(ctx)
-
 	aggregatePrevote, err := ms.GetAggregateExchangeRatePrevote(ctx, valAddr)
 	if err != nil {
 		return nil, errors.Wrap(types.ErrNoAggregatePrevote, msg.Validator)
 	}
 
-	// Check a msg is submitted proper period
-	if (uint64(ctx.BlockHeight())/params.VotePeriod)-(aggregatePrevote.SubmitBlock/params.VotePeriod) != 1 {
-		return nil, types.ErrRevealPeriodMissMatch
+	// Check a msg is submitted proper period, the vote can only be revealed against a prevote
+	// of the directly preceding vote period and never against one of an already closed window,
+	// unless it landed within the reveal grace past the window
+	if !ms.IsPreviousVotePeriod(ctx, aggregatePrevote.SubmitBlock) && !ms.IsRevealGracePeriod(ctx, aggregatePrevote.SubmitBlock) {
+		return nil, errors.Wrapf(types.ErrRevealPeriodMissMatch, "prevote submitted at height %d, vote in period %d", aggregatePrevote.SubmitBlock, ms.CurrentVotePeriod(ctx))
+	}
+
+	// Once the drift is set, a vote has to be bound to the vote period it is revealed in, so that a
+	// signed vote cannot be held back and replayed later. It may be bound up to the drift ahead, or
+	// to the previous vote period when revealed within its reveal grace.
+	if drift := ms.MaxVoteFutureDrift(ctx); drift > 0 {
+		current := ms.CurrentVotePeriod(ctx)
+		if msg.VotePeriod == 0 {
+			return nil, errors.Wrapf(types.ErrVoteNotBound, "current period %d", current)
+		}
+		if msg.VotePeriod > current+drift {
+			return nil, errors.Wrapf(types.ErrVoteTooFarAhead, "vote bound to period %d, current period %d, max drift %d", msg.VotePeriod, current, drift)
+		}
+		if msg.VotePeriod < current &&
+			(msg.VotePeriod+1 != current || !ms.IsRevealGracePeriod(ctx, aggregatePrevote.SubmitBlock)) {
+			return nil, errors.Wrapf(types.ErrVoteExpired, "vote bound to period %d, current period %d", msg.VotePeriod, current)
+		}
+	}
+
+	// The exchange rates are counted before they are parsed, so that an oversized vote is rejected cheaply
+	if maxDenoms := ms.MaxVoteDenoms(ctx); maxDenoms > 0 {
+		if denoms := uint64(strings.Count(msg.ExchangeRates, types.ExchangeRateSeparator)) + 1; denoms > maxDenoms {
+			return nil, errors.Wrapf(types.ErrTooManyDenoms, "vote contains %d denoms, exceeding the max of %d", denoms, maxDenoms)
+		}
 	}
 
 	exchangeRateTuples, err := types.ParseExchangeRateTuples(msg.ExchangeRates)
@@ -104,7 +140,10 @@ func (ms msgServer) AggregateExchangeRateVote(goCtx context.Context, msg *types.
 	// }
 
 	// Verify a exchange rate with aggregate prevote hash
-	hash := types.GetAggregateVoteHash(msg.Salt, msg.ExchangeRates, valAddr)
+	hash, err := types.GetAggregateVoteHashWithAlgo(ms.GetCommitmentHashAlgo(ctx), msg.Salt, msg.ExchangeRates, valAddr)
+	if err != nil {
+		return nil, err
+	}
 	if aggregatePrevote.Hash != hash.String() {
 		return nil, errors.Wrapf(types.ErrVerificationFailed, "must be given %s not %s", aggregatePrevote.Hash, hash)
 	}
@@ -113,12 +152,35 @@ func (ms msgServer) AggregateExchangeRateVote(goCtx context.Context, msg *types.
 	ms.SetAggregateExchangeRateVote(ctx, valAddr, types.NewAggregateExchangeRateVote(exchangeRateTuples, valAddr))
 	ms.DeleteAggregateExchangeRatePrevote(ctx, valAddr)
 
+	// Exchange rates left out of the tally are reported, so the validator does not miss them unaware
+	rejected := ms.RejectVoteTuples(ctx, exchangeRateTuples)
+	if len(rejected) == 0 {
+		ms.DeleteRejectedTuples(ctx, valAddr)
+	} else {
+		ms.SetRejectedTuples(ctx, valAddr, types.RejectedTuples{VotePeriod: ms.CurrentVotePeriod(ctx), Tuples: rejected})
+	}
+	for _, tuple := range rejected {
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventVoteTupleRejected{
+			Validator: msg.Validator,
+			Denom:     tuple.Denom,
+			Reason:    tuple.Reason,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	// The attestation is only logged for auditing, it is neither verified nor tallied
+	voteEvent := sdk.NewEvent(
+		types.EventTypeAggregateVote,
+		sdk.NewAttribute(types.AttributeKeyVoter, msg.Validator),
+		sdk.NewAttribute(types.AttributeKeyExchangeRates, msg.ExchangeRates),
+	)
+	if msg.Attestation != "" {
+		voteEvent = voteEvent.AppendAttributes(sdk.NewAttribute(types.AttributeKeyAttestation, msg.Attestation))
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeAggregateVote,
-			sdk.NewAttribute(types.AttributeKeyVoter, msg.Validator),
-			sdk.NewAttribute(types.AttributeKeyExchangeRates, msg.ExchangeRates),
-		),
+		voteEvent,
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
@@ -148,14 +210,30 @@ func (ms msgServer) DelegateFeedConsent(goCtx context.Context, msg *types.MsgDel
 		return nil, errors.Wrap(stakingtypes.ErrNoValidatorFound, msg.Operator)
 	}
 
-	// Set the delegation
+	// Reject changing the delegation again before the cooldown passed
+	if cooldown := ms.FeederChangeCooldownBlocks(ctx); cooldown > 0 {
+		if changeHeight, ok := ms.GetFeederChangeHeight(ctx, operatorAddr); ok && ctx.BlockHeight() < changeHeight+int64(cooldown) {
+			return nil, errors.Wrapf(types.ErrFeederChangeCooldown, "changed at height %d, can change again at height %d", changeHeight, changeHeight+int64(cooldown))
+		}
+	}
+
+	// Set the delegation, the validator itself is the feeder when there was none
+	oldFeeder := ms.GetFeederDelegation(ctx, operatorAddr)
 	ms.SetFeederDelegation(ctx, operatorAddr, delegateAddr)
+	ms.SetFeederChangeHeight(ctx, operatorAddr, ctx.BlockHeight())
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeFeedDelegate,
 			sdk.NewAttribute(types.AttributeKeyFeeder, msg.Delegate),
 		),
+		sdk.NewEvent(
+			types.EventTypeFeederDelegationChanged,
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+			sdk.NewAttribute(types.AttributeKeyOldFeeder, oldFeeder.String()),
+			sdk.NewAttribute(types.AttributeKeyNewFeeder, msg.Delegate),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprint(ctx.BlockHeight())),
+		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
@@ -165,3 +243,40 @@ func (ms msgServer) DelegateFeedConsent(goCtx context.Context, msg *types.MsgDel
 
 	return &types.MsgDelegateFeedConsentResponse{}, nil
 }
+
+func (ms msgServer) SetOracleAlertConfig(goCtx context.Context, msg *types.MsgSetOracleAlertConfig) (*types.MsgSetOracleAlertConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	operatorAddr, err := sdk.ValAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the sender is a validator
+	val := ms.StakingKeeper.Validator(ctx, operatorAddr)
+	if val == nil {
+		return nil, errors.Wrap(stakingtypes.ErrNoValidatorFound, msg.Operator)
+	}
+
+	// A max miss rate of zero removes the config
+	if msg.MaxMissRate.IsZero() {
+		ms.DeleteOracleAlertConfig(ctx, operatorAddr)
+	} else {
+		ms.Keeper.SetOracleAlertConfig(ctx, operatorAddr, types.OracleAlertConfig{MaxMissRate: msg.MaxMissRate})
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeOracleAlertConfigSet,
+			sdk.NewAttribute(types.AttributeKeyOperator, msg.Operator),
+			sdk.NewAttribute(types.AttributeKeyMaxMissRate, msg.MaxMissRate.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Operator),
+		),
+	})
+
+	return &types.MsgSetOracleAlertConfigResponse{}, nil
+}
@@ -2,14 +2,23 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 
 	"github.com/Team-Kujira/core/x/oracle/types"
 
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 )
@@ -71,6 +80,137 @@ func TestMsgServer_FeederDelegation(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMsgServer_FeederDelegationChangedEvent(t *testing.T) {
+	input, msgServer := setup(t)
+
+	delegate := func(feeder sdk.AccAddress) map[string]string {
+		ctx := input.Ctx.WithEventManager(sdk.NewEventManager())
+		_, err := msgServer.DelegateFeedConsent(sdk.WrapSDKContext(ctx), types.NewMsgDelegateFeedConsent(ValAddrs[0], feeder))
+		require.NoError(t, err)
+
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeFeederDelegationChanged {
+				attributes := map[string]string{}
+				for _, attribute := range event.Attributes {
+					attributes[attribute.Key] = attribute.Value
+				}
+				return attributes
+			}
+		}
+
+		require.FailNow(t, "no feeder delegation changed event")
+		return nil
+	}
+
+	height := fmt.Sprint(input.Ctx.BlockHeight())
+
+	// set
+	require.Equal(t, map[string]string{
+		types.AttributeKeyOperator:  ValAddrs[0].String(),
+		types.AttributeKeyOldFeeder: Addrs[0].String(),
+		types.AttributeKeyNewFeeder: Addrs[1].String(),
+		types.AttributeKeyHeight:    height,
+	}, delegate(Addrs[1]))
+
+	// change
+	require.Equal(t, map[string]string{
+		types.AttributeKeyOperator:  ValAddrs[0].String(),
+		types.AttributeKeyOldFeeder: Addrs[1].String(),
+		types.AttributeKeyNewFeeder: Addrs[2].String(),
+		types.AttributeKeyHeight:    height,
+	}, delegate(Addrs[2]))
+
+	// clear back to the validator itself
+	require.Equal(t, map[string]string{
+		types.AttributeKeyOperator:  ValAddrs[0].String(),
+		types.AttributeKeyOldFeeder: Addrs[2].String(),
+		types.AttributeKeyNewFeeder: Addrs[0].String(),
+		types.AttributeKeyHeight:    height,
+	}, delegate(Addrs[0]))
+}
+
+func TestMsgServer_FeederChangeCooldown(t *testing.T) {
+	input, msgServer := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.FeederChangeCooldownBlocks = 10
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	delegate := func(height int64, feeder sdk.AccAddress) error {
+		_, err := msgServer.DelegateFeedConsent(sdk.WrapSDKContext(input.Ctx.WithBlockHeight(height)), types.NewMsgDelegateFeedConsent(ValAddrs[0], feeder))
+		return err
+	}
+
+	// The first change is not limited
+	require.NoError(t, delegate(100, Addrs[1]))
+
+	// Changing again is rejected during the cooldown
+	require.ErrorIs(t, delegate(100, Addrs[2]), types.ErrFeederChangeCooldown)
+	require.ErrorIs(t, delegate(109, Addrs[2]), types.ErrFeederChangeCooldown)
+	require.Equal(t, Addrs[1], input.OracleKeeper.GetFeederDelegation(input.Ctx, ValAddrs[0]))
+
+	// Other validators are not affected
+	_, err := msgServer.DelegateFeedConsent(sdk.WrapSDKContext(input.Ctx.WithBlockHeight(105)), types.NewMsgDelegateFeedConsent(ValAddrs[1], Addrs[2]))
+	require.NoError(t, err)
+
+	// And allowed after it, starting a new cooldown
+	require.NoError(t, delegate(110, Addrs[2]))
+	require.Equal(t, Addrs[2], input.OracleKeeper.GetFeederDelegation(input.Ctx, ValAddrs[0]))
+	require.ErrorIs(t, delegate(119, Addrs[0]), types.ErrFeederChangeCooldown)
+
+	// Without a cooldown, the feeder can be changed every block
+	params.FeederChangeCooldownBlocks = 0
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	require.NoError(t, delegate(111, Addrs[0]))
+	require.NoError(t, delegate(111, Addrs[1]))
+}
+
+func TestMsgServer_Secp256r1Feeder(t *testing.T) {
+	input, msgServer := setup(t)
+
+	// a feeder backed by a P-256 key, as used by HSMs
+	feederKey, err := secp256r1.GenPrivKey()
+	require.NoError(t, err)
+	feederAddr := sdk.AccAddress(feederKey.PubKey().Address())
+	feederAcc := input.AccountKeeper.NewAccountWithAddress(input.Ctx, feederAddr)
+	require.NoError(t, feederAcc.SetPubKey(feederKey.PubKey()))
+	input.AccountKeeper.SetAccount(input.Ctx, feederAcc)
+
+	_, err = msgServer.DelegateFeedConsent(sdk.WrapSDKContext(input.Ctx), types.NewMsgDelegateFeedConsent(ValAddrs[0], feederAddr))
+	require.NoError(t, err)
+
+	salt := strings.Repeat("1", types.SaltLength)
+	exchangeRatesStr := randomExchangeRate.String() + types.TestDenomD
+	hash := types.GetAggregateVoteHash(salt, exchangeRatesStr, ValAddrs[0])
+	prevoteMsg := types.NewMsgAggregateExchangeRatePrevote(hash, feederAddr, ValAddrs[0])
+	voteMsg := types.NewMsgAggregateExchangeRateVote(salt, exchangeRatesStr, feederAddr, ValAddrs[0])
+
+	// the messages are signed by the feeder and the signature is accepted by the ante handler
+	for _, msg := range []legacytx.LegacyMsg{prevoteMsg, voteMsg} {
+		require.NoError(t, msg.ValidateBasic())
+		require.Equal(t, []sdk.AccAddress{feederAddr}, msg.GetSigners())
+
+		sig, err := feederKey.Sign(msg.GetSignBytes())
+		require.NoError(t, err)
+		require.True(t, feederAcc.GetPubKey().VerifySignature(msg.GetSignBytes(), sig))
+
+		err = ante.DefaultSigVerificationGasConsumer(sdk.NewInfiniteGasMeter(), signing.SignatureV2{
+			PubKey: feederAcc.GetPubKey(),
+			Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT, Signature: sig},
+		}, authtypes.DefaultParams())
+		require.NoError(t, err)
+	}
+
+	_, err = msgServer.AggregateExchangeRatePrevote(sdk.WrapSDKContext(input.Ctx), prevoteMsg)
+	require.NoError(t, err)
+	_, err = msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(input.Ctx.WithBlockHeight(1)), voteMsg)
+	require.NoError(t, err)
+
+	vote, err := input.OracleKeeper.GetAggregateExchangeRateVote(input.Ctx, ValAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, types.ExchangeRateTuples{{Denom: types.TestDenomD, ExchangeRate: randomExchangeRate}}, vote.ExchangeRateTuples)
+}
+
 func TestMsgServer_AggregatePrevoteVote(t *testing.T) {
 	input, msgServer := setup(t)
 
@@ -141,11 +281,339 @@ func TestMsgServer_AggregatePrevoteVote(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestMsgServer_AggregateVoteReplay(t *testing.T) {
+	input, msgServer := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.VotePeriod = 5
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	salt := "1"
+	exchangeRatesStr := fmt.Sprintf("1000.23%s,0.29%s", types.TestDenomC, types.TestDenomB)
+	hash := types.GetAggregateVoteHash(salt, exchangeRatesStr, ValAddrs[0])
+	prevoteMsg := types.NewMsgAggregateExchangeRatePrevote(hash, Addrs[0], ValAddrs[0])
+	voteMsg := types.NewMsgAggregateExchangeRateVote(salt, exchangeRatesStr, Addrs[0], ValAddrs[0])
+
+	// prevote in period 0, reveal in period 1
+	_, err := msgServer.AggregateExchangeRatePrevote(sdk.WrapSDKContext(input.Ctx), prevoteMsg)
+	require.NoError(t, err)
+	input.Ctx = input.Ctx.WithBlockHeight(5)
+	_, err = msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(input.Ctx), voteMsg)
+	require.NoError(t, err)
+
+	// replaying the vote in the same or a later period finds no prevote
+	_, err = msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(input.Ctx), voteMsg)
+	require.ErrorIs(t, err, types.ErrNoAggregatePrevote)
+	input.Ctx = input.Ctx.WithBlockHeight(10)
+	_, err = msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(input.Ctx), voteMsg)
+	require.ErrorIs(t, err, types.ErrNoAggregatePrevote)
+
+	// the feeder reuses the salt for a prevote in period 2, the vote can not be
+	// revealed once the window of that prevote is closed
+	_, err = msgServer.AggregateExchangeRatePrevote(sdk.WrapSDKContext(input.Ctx), prevoteMsg)
+	require.NoError(t, err)
+	input.Ctx = input.Ctx.WithBlockHeight(20)
+	_, err = msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(input.Ctx), voteMsg)
+	require.ErrorIs(t, err, types.ErrRevealPeriodMissMatch)
+
+	// nor within the period of the prevote itself
+	input.Ctx = input.Ctx.WithBlockHeight(14)
+	_, err = msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(input.Ctx), voteMsg)
+	require.ErrorIs(t, err, types.ErrRevealPeriodMissMatch)
+
+	// the prevote of a closed window is removed with the ballot
+	input.Ctx = input.Ctx.WithBlockHeight(19)
+	input.OracleKeeper.ClearBallots(input.Ctx, params.VotePeriod)
+	_, err = input.OracleKeeper.GetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[0])
+	require.Error(t, err)
+}
+
 var (
 	stakingAmt         = sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction)
 	randomExchangeRate = sdk.NewDec(1700)
 )
 
+func TestMsgServer_RevealGrace(t *testing.T) {
+	input, msgServer := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.VotePeriod = 5
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	salt := "1"
+	exchangeRatesStr := fmt.Sprintf("1000.23%s,0.29%s", types.TestDenomC, types.TestDenomB)
+	hash := types.GetAggregateVoteHash(salt, exchangeRatesStr, ValAddrs[0])
+	prevoteMsg := types.NewMsgAggregateExchangeRatePrevote(hash, Addrs[0], ValAddrs[0])
+	voteMsg := types.NewMsgAggregateExchangeRateVote(salt, exchangeRatesStr, Addrs[0], ValAddrs[0])
+
+	prevote := func(height int64) {
+		_, err := msgServer.AggregateExchangeRatePrevote(sdk.WrapSDKContext(input.Ctx.WithBlockHeight(height)), prevoteMsg)
+		require.NoError(t, err)
+	}
+	vote := func(height int64) error {
+		_, err := msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(input.Ctx.WithBlockHeight(height)), voteMsg)
+		return err
+	}
+
+	// Without a grace, a vote landing a block past the window of its prevote is rejected
+	prevote(3)
+	require.ErrorIs(t, vote(10), types.ErrRevealPeriodMissMatch)
+
+	// Within the grace it is accepted, the prevote of period 0 surviving the end of period 1
+	params.RevealGraceBlocks = 2
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	input.OracleKeeper.ClearBallots(input.Ctx.WithBlockHeight(9), input.OracleKeeper.PrevoteRetentionBlocks(input.Ctx.WithBlockHeight(9)))
+	require.NoError(t, vote(11))
+
+	// Beyond the grace, it is rejected again
+	prevote(3)
+	require.ErrorIs(t, vote(12), types.ErrRevealPeriodMissMatch)
+	require.ErrorIs(t, vote(15), types.ErrRevealPeriodMissMatch)
+
+	// The grace does not bind a vote to a prevote of an even older vote period
+	prevote(9)
+	require.ErrorIs(t, vote(20), types.ErrRevealPeriodMissMatch)
+
+	// With timed vote periods, the prevotes older than the previous vote period are accepted within the grace
+	params.VotePeriodDuration = time.Minute
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	input.OracleKeeper.SetVotePeriodClock(input.Ctx, types.VotePeriodClock{Period: 5, StartHeight: 100, PreviousStartHeight: 90})
+	prevote(85)
+	require.ErrorIs(t, vote(102), types.ErrRevealPeriodMissMatch)
+	require.NoError(t, vote(101))
+	prevote(95)
+	require.NoError(t, vote(105))
+}
+
+func TestMsgServer_RevealGraceCapped(t *testing.T) {
+	input, msgServer := setup(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.VotePeriod = 5
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// A parameter change of the single key is not checked against the vote period
+	input.OracleKeeper.paramSpace.Set(input.Ctx, types.KeyRevealGraceBlocks, uint64(5))
+
+	salt := "1"
+	exchangeRatesStr := fmt.Sprintf("1000.23%s,0.29%s", types.TestDenomC, types.TestDenomB)
+	hash := types.GetAggregateVoteHash(salt, exchangeRatesStr, ValAddrs[0])
+	_, err := msgServer.AggregateExchangeRatePrevote(sdk.WrapSDKContext(input.Ctx.WithBlockHeight(3)), types.NewMsgAggregateExchangeRatePrevote(hash, Addrs[0], ValAddrs[0]))
+	require.NoError(t, err)
+
+	// The grace is capped below the vote period, so a vote period late reveal is rejected
+	require.False(t, input.OracleKeeper.IsRevealGracePeriod(input.Ctx.WithBlockHeight(14), 3))
+	require.True(t, input.OracleKeeper.IsRevealGracePeriod(input.Ctx.WithBlockHeight(13), 3))
+	_, err = msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(input.Ctx.WithBlockHeight(14)), types.NewMsgAggregateExchangeRateVote(salt, exchangeRatesStr, Addrs[0], ValAddrs[0]))
+	require.ErrorIs(t, err, types.ErrRevealPeriodMissMatch)
+}
+
+func TestMsgServer_SetOracleAlertConfig(t *testing.T) {
+	input, msgServer := setup(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	_, err := querier.OracleAlertConfig(ctx, &types.QueryOracleAlertConfigRequest{ValidatorAddr: ValAddrs[0].String()})
+	require.ErrorIs(t, err, types.ErrNoAlertConfig)
+
+	// Only validators can declare it
+	_, err = msgServer.SetOracleAlertConfig(ctx, types.NewMsgSetOracleAlertConfig(sdk.ValAddress(Addrs[4]), sdk.NewDecWithPrec(1, 1)))
+	require.Error(t, err)
+
+	_, err = msgServer.SetOracleAlertConfig(ctx, types.NewMsgSetOracleAlertConfig(ValAddrs[0], sdk.NewDecWithPrec(1, 1)))
+	require.NoError(t, err)
+
+	// The miss rate is relative to the 100 vote periods of the slash window
+	input.OracleKeeper.SetMissCounter(input.Ctx, ValAddrs[0], 5)
+	res, err := querier.OracleAlertConfig(ctx, &types.QueryOracleAlertConfigRequest{ValidatorAddr: ValAddrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(1, 1), res.MaxMissRate)
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), res.MissRate)
+
+	// Zero removes it
+	_, err = msgServer.SetOracleAlertConfig(ctx, types.NewMsgSetOracleAlertConfig(ValAddrs[0], sdk.ZeroDec()))
+	require.NoError(t, err)
+	_, found := input.OracleKeeper.GetOracleAlertConfig(input.Ctx, ValAddrs[0])
+	require.False(t, found)
+}
+
+func TestMsgServer_RejectedTuples(t *testing.T) {
+	input, msgServer := setup(t)
+	querier := NewQuerier(input.OracleKeeper)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomC}, {Name: types.TestDenomD}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	vote := func(height int64, exchangeRates string) sdk.Events {
+		salt := "1"
+		hash := types.GetAggregateVoteHash(salt, exchangeRates, ValAddrs[0])
+		ctx := input.Ctx.WithBlockHeight(height)
+		_, err := msgServer.AggregateExchangeRatePrevote(sdk.WrapSDKContext(ctx), types.NewMsgAggregateExchangeRatePrevote(hash, Addrs[0], ValAddrs[0]))
+		require.NoError(t, err)
+
+		ctx = ctx.WithBlockHeight(height + 1).WithEventManager(sdk.NewEventManager())
+		_, err = msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(ctx), types.NewMsgAggregateExchangeRateVote(salt, exchangeRates, Addrs[0], ValAddrs[0]))
+		require.NoError(t, err)
+		return ctx.EventManager().Events()
+	}
+
+	// A misspelled denom is a valid coin denom, so the vote is accepted without it
+	events := vote(0, "1000.23denomC,0.27denomd")
+	var rejected []*types.EventVoteTupleRejected
+	for _, event := range events {
+		if event.Type == proto.MessageName(&types.EventVoteTupleRejected{}) {
+			msg, err := sdk.ParseTypedEvent(abci.Event(event))
+			require.NoError(t, err)
+			rejected = append(rejected, msg.(*types.EventVoteTupleRejected))
+		}
+	}
+	require.Equal(t, []*types.EventVoteTupleRejected{{
+		Validator: ValAddrs[0].String(),
+		Denom:     "denomd",
+		Reason:    types.RejectReasonNotVoteTarget,
+	}}, rejected)
+
+	res, err := querier.RejectedTuples(sdk.WrapSDKContext(input.Ctx), &types.QueryRejectedTuplesRequest{ValidatorAddr: ValAddrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.VotePeriod)
+	require.Equal(t, []types.RejectedTuple{{Denom: "denomd", Reason: types.RejectReasonNotVoteTarget}}, res.Tuples)
+
+	// The next vote without rejected exchange rates clears them
+	vote(1, "1000.23denomC,0.27denomD")
+	res, err = querier.RejectedTuples(sdk.WrapSDKContext(input.Ctx), &types.QueryRejectedTuplesRequest{ValidatorAddr: ValAddrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), res.VotePeriod)
+	require.Empty(t, res.Tuples)
+
+	_, err = querier.RejectedTuples(sdk.WrapSDKContext(input.Ctx), &types.QueryRejectedTuplesRequest{ValidatorAddr: "invalid"})
+	require.ErrorIs(t, err, types.ErrInvalidValidator)
+}
+
+func TestMsgServer_MaxDenomsPerVote(t *testing.T) {
+	input, msgServer := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomC}, {Name: types.TestDenomD}}
+	params.MaxDenomsPerVote = 3
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	vote := func(exchangeRates string) error {
+		salt := "1"
+		hash := types.GetAggregateVoteHash(salt, exchangeRates, ValAddrs[0])
+		_, err := msgServer.AggregateExchangeRatePrevote(sdk.WrapSDKContext(input.Ctx), types.NewMsgAggregateExchangeRatePrevote(hash, Addrs[0], ValAddrs[0]))
+		require.NoError(t, err)
+
+		_, err = msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(input.Ctx.WithBlockHeight(1)), types.NewMsgAggregateExchangeRateVote(salt, exchangeRates, Addrs[0], ValAddrs[0]))
+		return err
+	}
+
+	// At the cap
+	require.NoError(t, vote("1.0denomB,1.0denomC,1.0denomD"))
+
+	// Above the cap
+	err := vote("1.0denomB,1.0denomC,1.0denomD,1.0denomE")
+	require.ErrorIs(t, err, types.ErrTooManyDenoms)
+
+	// The cap never rejects a vote on all the vote targets
+	params.Whitelist = types.DenomList{{Name: types.TestDenomA}, {Name: types.TestDenomB}, {Name: types.TestDenomC}, {Name: types.TestDenomD}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	require.Equal(t, uint64(4), input.OracleKeeper.MaxVoteDenoms(input.Ctx))
+	require.NoError(t, vote("1.0denomB,1.0denomC,1.0denomD,1.0ukuji"))
+
+	// Zero disables it
+	params.MaxDenomsPerVote = 0
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	require.NoError(t, vote("1.0denomB,1.0denomC,1.0denomD,1.0denomE,1.0ukuji"))
+}
+
+func TestMsgServer_MaxVoteFutureDrift(t *testing.T) {
+	input, msgServer := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.MaxVoteFutureDrift = 2
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	exchangeRates := "1.0denomA,1.0ukuji"
+	vote := func(votePeriod uint64) error {
+		salt := "1"
+		hash := types.GetAggregateVoteHash(salt, exchangeRates, ValAddrs[0])
+		_, err := msgServer.AggregateExchangeRatePrevote(sdk.WrapSDKContext(input.Ctx), types.NewMsgAggregateExchangeRatePrevote(hash, Addrs[0], ValAddrs[0]))
+		require.NoError(t, err)
+
+		msg := types.NewMsgAggregateExchangeRateVote(salt, exchangeRates, Addrs[0], ValAddrs[0])
+		msg.VotePeriod = votePeriod
+		_, err = msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(input.Ctx.WithBlockHeight(1)), msg)
+		return err
+	}
+
+	// The vote is revealed in period 1
+	require.NoError(t, vote(1))
+	require.NoError(t, vote(3))
+
+	// Unbound
+	err := vote(0)
+	require.ErrorIs(t, err, types.ErrVoteNotBound)
+
+	// Bound too far in the future
+	err = vote(4)
+	require.ErrorIs(t, err, types.ErrVoteTooFarAhead)
+	err = vote(1000)
+	require.ErrorIs(t, err, types.ErrVoteTooFarAhead)
+
+	// Zero disables it
+	params.MaxVoteFutureDrift = 0
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	require.NoError(t, vote(0))
+	require.NoError(t, vote(1000))
+}
+
+func TestMsgServer_MaxVoteFutureDriftReplay(t *testing.T) {
+	input, msgServer := setup(t)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.VotePeriod = 2
+	params.MaxVoteFutureDrift = 1
+	params.RevealGraceBlocks = 1
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	salt := "1"
+	exchangeRates := "1.0denomA,1.0ukuji"
+	hash := types.GetAggregateVoteHash(salt, exchangeRates, ValAddrs[0])
+	prevote := func(height int64) {
+		_, err := msgServer.AggregateExchangeRatePrevote(sdk.WrapSDKContext(input.Ctx.WithBlockHeight(height)), types.NewMsgAggregateExchangeRatePrevote(hash, Addrs[0], ValAddrs[0]))
+		require.NoError(t, err)
+	}
+	vote := func(height int64, msg *types.MsgAggregateExchangeRateVote) error {
+		_, err := msgServer.AggregateExchangeRateVote(sdk.WrapSDKContext(input.Ctx.WithBlockHeight(height)), msg)
+		return err
+	}
+
+	// A vote signed for period 1 is held back
+	held := types.NewMsgAggregateExchangeRateVote(salt, exchangeRates, Addrs[0], ValAddrs[0])
+	held.VotePeriod = 1
+
+	// It is accepted in its period, or within the reveal grace of the following one
+	prevote(0)
+	require.NoError(t, vote(2, held))
+	prevote(0)
+	require.NoError(t, vote(4, held))
+
+	// Replayed against a later prevote of the same rates, it is rejected
+	prevote(8)
+	err := vote(10, held)
+	require.ErrorIs(t, err, types.ErrVoteExpired)
+
+	// Within the reveal grace, but two periods past the one it is bound to
+	prevote(4)
+	err = vote(8, held)
+	require.ErrorIs(t, err, types.ErrVoteExpired)
+
+	// Past the reveal grace, the previous period is rejected too
+	params.RevealGraceBlocks = 0
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	prevote(2)
+	err = vote(4, held)
+	require.ErrorIs(t, err, types.ErrVoteExpired)
+}
+
 func setup(t *testing.T) (TestInput, types.MsgServer) {
 	input := CreateTestInput(t)
 	params := input.OracleKeeper.GetParams(input.Ctx)
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/Team-Kujira/core/x/oracle/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -36,6 +39,37 @@ func (k Keeper) Whitelist(ctx sdk.Context) (res types.DenomList) {
 	return
 }
 
+// VoteTargets returns the names of the whitelisted denoms, which require votes.
+// The list is cached in the transient store for the rest of the block, unless the
+// whitelist was modified within the block, so the cache never outlives a block.
+func (k Keeper) VoteTargets(ctx sdk.Context) []string {
+	modified := k.paramSpace.Modified(ctx, types.KeyWhitelist)
+
+	tstore := ctx.TransientStore(k.tStoreKey)
+	if !modified {
+		if bz := tstore.Get(types.VoteTargetsCacheKey); bz != nil {
+			var voteTargets []string
+			if err := json.Unmarshal(bz, &voteTargets); err == nil {
+				return voteTargets
+			}
+		}
+	}
+
+	voteTargets := []string{}
+	for _, denom := range k.Whitelist(ctx) {
+		voteTargets = append(voteTargets, denom.Name)
+	}
+
+	if !modified {
+		bz, err := json.Marshal(voteTargets)
+		if err == nil {
+			tstore.Set(types.VoteTargetsCacheKey, bz)
+		}
+	}
+
+	return voteTargets
+}
+
 // SetWhitelist store new whitelist to param store
 // this function is only for test purpose
 func (k Keeper) SetWhitelist(ctx sdk.Context, whitelist types.DenomList) {
@@ -60,6 +94,171 @@ func (k Keeper) MinValidPerWindow(ctx sdk.Context) (res sdk.Dec) {
 	return
 }
 
+// ExcludeJailedFromThreshold returns whether jailed validators are left out of the vote threshold denominator
+func (k Keeper) ExcludeJailedFromThreshold(ctx sdk.Context) (res bool) {
+	k.paramSpace.Get(ctx, types.KeyExcludeJailedFromThreshold, &res)
+	return
+}
+
+// AggregationMethod returns the method used to derive the exchange rate of a ballot
+func (k Keeper) AggregationMethod(ctx sdk.Context) (res string) {
+	k.paramSpace.Get(ctx, types.KeyAggregationMethod, &res)
+	return
+}
+
+// ModeBucketPrecision returns the number of decimal places rates are rounded to by the mode aggregation
+func (k Keeper) ModeBucketPrecision(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyModeBucketPrecision, &res)
+	return
+}
+
+// AutoDelistAfterStaleWindows returns the number of consecutive vote periods a denom may fail to tally before it is delisted
+func (k Keeper) AutoDelistAfterStaleWindows(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyAutoDelistAfterStaleWindows, &res)
+	return
+}
+
+// DenomGracePeriods returns the number of vote periods a newly whitelisted denom is exempt from miss counting
+func (k Keeper) DenomGracePeriods(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyDenomGracePeriods, &res)
+	return
+}
+
+// MaxCarryForwardPeriods returns the number of consecutive vote periods the rate of a denom failing to tally is kept
+func (k Keeper) MaxCarryForwardPeriods(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaxCarryForwardPeriods, &res)
+	return
+}
+
+// ProgressiveSlashing returns whether the slash fraction scales with the miss severity
+func (k Keeper) ProgressiveSlashing(ctx sdk.Context) (res bool) {
+	k.paramSpace.Get(ctx, types.KeyProgressiveSlashing, &res)
+	return
+}
+
+// ProgressiveSlashFloor returns the slash fraction of a validator just below MinValidPerWindow under progressive slashing
+func (k Keeper) ProgressiveSlashFloor(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyProgressiveSlashFloor, &res)
+	return
+}
+
+// CommitmentHashAlgo returns the hash algorithm vote commitments are to be made with
+func (k Keeper) CommitmentHashAlgo(ctx sdk.Context) (res string) {
+	k.paramSpace.Get(ctx, types.KeyCommitmentHashAlgo, &res)
+	return
+}
+
+// PowerSmoothingWindows returns the number of vote periods the voting power weighting the votes is smoothed over
+func (k Keeper) PowerSmoothingWindows(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyPowerSmoothingWindows, &res)
+	return
+}
+
+// VotePeriodDuration returns the block time duration of a vote period, zero if it is counted in blocks
+func (k Keeper) VotePeriodDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramSpace.Get(ctx, types.KeyVotePeriodDuration, &res)
+	return
+}
+
+// MaxPowerShare returns the share of the power of a ballot the power weighting a vote is capped at, zero if disabled
+func (k Keeper) MaxPowerShare(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyMaxPowerShare, &res)
+	return
+}
+
+// RevealMissWeight returns the weight of a missed vote period in which the validator prevoted but did not reveal
+func (k Keeper) RevealMissWeight(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyRevealMissWeight, &res)
+	return
+}
+
+// AccuracyWeightedRewards returns whether the reward shares of the ballot winners are weighted by their accuracy
+func (k Keeper) AccuracyWeightedRewards(ctx sdk.Context) (res bool) {
+	k.paramSpace.Get(ctx, types.KeyAccuracyWeightedRewards, &res)
+	return
+}
+
+// MaxEventDenomsPerBlock returns the number of denoms above which per-denom end block events are coalesced, zero if unlimited
+func (k Keeper) MaxEventDenomsPerBlock(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaxEventDenomsPerBlock, &res)
+	return
+}
+
+// FeederChangeCooldownBlocks returns the number of blocks a feeder delegation cannot be changed for after a change
+func (k Keeper) FeederChangeCooldownBlocks(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyFeederChangeCooldownBlocks, &res)
+	return
+}
+
+// RevealGraceBlocks returns the number of blocks at the start of a vote period in which a vote can still be
+// revealed against a prevote of two vote periods before
+func (k Keeper) RevealGraceBlocks(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyRevealGraceBlocks, &res)
+	return
+}
+
+// LegacyRateEvents returns whether the exchange_rate_update attribute events are emitted along with the typed EventOracleUpdate
+func (k Keeper) LegacyRateEvents(ctx sdk.Context) (res bool) {
+	k.paramSpace.Get(ctx, types.KeyLegacyRateEvents, &res)
+	return
+}
+
+// WhitelistChangeRetention returns the number of vote periods the whitelist changes are logged for
+func (k Keeper) WhitelistChangeRetention(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyWhitelistChangeRetention, &res)
+	return
+}
+
+// MaxDenomsPerVote returns the number of exchange rates a vote may contain at most, unlimited if zero
+func (k Keeper) MaxDenomsPerVote(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaxDenomsPerVote, &res)
+	return
+}
+
+// MaxVoteDenoms returns the number of exchange rates a vote may contain at most, unlimited if zero.
+// The cap is raised to the number of vote targets, so that a vote on all of them is never rejected.
+func (k Keeper) MaxVoteDenoms(ctx sdk.Context) uint64 {
+	maxDenoms := k.MaxDenomsPerVote(ctx)
+	if maxDenoms == 0 {
+		return 0
+	}
+	if targets := uint64(len(k.VoteTargets(ctx))); targets > maxDenoms {
+		return targets
+	}
+
+	return maxDenoms
+}
+
+// OracleFeeShare returns the share of the collected fees moved to the oracle reward pool, zero if disabled
+func (k Keeper) OracleFeeShare(ctx sdk.Context) (res sdk.Dec) {
+	k.paramSpace.Get(ctx, types.KeyOracleFeeShare, &res)
+	return
+}
+
+// MaxVoteFutureDrift returns the number of vote periods a vote may be bound ahead of the current one, unlimited if zero
+func (k Keeper) MaxVoteFutureDrift(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaxVoteFutureDrift, &res)
+	return
+}
+
+// PostUpgradeGracePeriods returns the number of vote periods following a chain upgrade in which misses are not counted
+func (k Keeper) PostUpgradeGracePeriods(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyPostUpgradeGracePeriods, &res)
+	return
+}
+
+// EvenMedianRule returns the rule picking the weighted median of a ballot whose power splits exactly in half
+func (k Keeper) EvenMedianRule(ctx sdk.Context) (res string) {
+	k.paramSpace.Get(ctx, types.KeyEvenMedianRule, &res)
+	return
+}
+
+// MaxMoveWindow returns the number of vote periods the largest exchange rate move of each denom is tracked over
+func (k Keeper) MaxMoveWindow(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.KeyMaxMoveWindow, &res)
+	return
+}
+
 // GetParams returns the total set of oracle parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/Team-Kujira/core/x/oracle/types"
+)
+
+// commitBlock resets the transient stores of the test input, like committing a block does
+func commitBlock(input TestInput) {
+	ms := input.Ctx.MultiStore().(*rootmulti.Store)
+	for _, name := range []string{paramstypes.TStoreKey, types.TStoreKey} {
+		ms.GetStoreByName(name).(storetypes.Committer).Commit()
+	}
+}
+
+func TestVoteTargetsCache(t *testing.T) {
+	input := CreateTestInput(t)
+	input.OracleKeeper.SetWhitelist(input.Ctx, types.DenomList{{Name: types.TestDenomA}, {Name: types.TestDenomB}})
+
+	// The whitelist was modified within the block, nothing is cached
+	require.Equal(t, []string{types.TestDenomA, types.TestDenomB}, input.OracleKeeper.VoteTargets(input.Ctx))
+	tstore := input.Ctx.TransientStore(input.OracleKeeper.tStoreKey)
+	require.False(t, tstore.Has(types.VoteTargetsCacheKey))
+
+	commitBlock(input)
+	require.Equal(t, []string{types.TestDenomA, types.TestDenomB}, input.OracleKeeper.VoteTargets(input.Ctx))
+	require.True(t, tstore.Has(types.VoteTargetsCacheKey))
+
+	// Updating the whitelist bypasses the cache for the rest of the block
+	input.OracleKeeper.SetWhitelist(input.Ctx, types.DenomList{{Name: types.TestDenomC}})
+	require.Equal(t, []string{types.TestDenomC}, input.OracleKeeper.VoteTargets(input.Ctx))
+
+	// The cache does not leak into the next block
+	commitBlock(input)
+	require.False(t, tstore.Has(types.VoteTargetsCacheKey))
+	require.Equal(t, []string{types.TestDenomC}, input.OracleKeeper.VoteTargets(input.Ctx))
+}
+
+func TestParamChangeRejectsUnsatisfiableValues(t *testing.T) {
+	input := CreateTestInput(t)
+	params := input.OracleKeeper.GetParams(input.Ctx)
+
+	// Parameter change proposals update the subspace one key at a time
+	for _, tc := range []struct {
+		key   []byte
+		value string
+	}{
+		{types.KeyVoteThreshold, `"1.010000000000000000"`},
+		{types.KeyVoteThreshold, `"0.000000000000000000"`},
+		{types.KeyVoteThreshold, `"-0.500000000000000000"`},
+		{types.KeyRewardBand, `"1.010000000000000000"`},
+		{types.KeyRewardBand, `"-0.010000000000000000"`},
+		{types.KeyVotePeriod, `"0"`},
+	} {
+		err := input.OracleKeeper.paramSpace.Update(input.Ctx, tc.key, []byte(tc.value))
+		require.Error(t, err, "%s: %s", tc.key, tc.value)
+	}
+	require.Equal(t, params, input.OracleKeeper.GetParams(input.Ctx))
+
+	require.NoError(t, input.OracleKeeper.paramSpace.Update(input.Ctx, types.KeyVoteThreshold, []byte(`"1.000000000000000000"`)))
+	require.Equal(t, sdk.OneDec(), input.OracleKeeper.VoteThreshold(input.Ctx))
+}
+
+func BenchmarkVoteTargets(b *testing.B) {
+	input := CreateTestInput(b)
+
+	whitelist := types.DenomList{}
+	for i := 0; i < 50; i++ {
+		whitelist = append(whitelist, types.Denom{Name: fmt.Sprintf("denom%d", i)})
+	}
+	input.OracleKeeper.SetWhitelist(input.Ctx, whitelist)
+	commitBlock(input)
+
+	// Gas consumed reflects the store reads
+	b.Run("whitelist", func(b *testing.B) {
+		ctx := input.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		for i := 0; i < b.N; i++ {
+			var voteTargets []string
+			for _, denom := range input.OracleKeeper.Whitelist(ctx) {
+				voteTargets = append(voteTargets, denom.Name)
+			}
+		}
+		b.ReportMetric(float64(ctx.GasMeter().GasConsumed())/float64(b.N), "gas/op")
+	})
+
+	b.Run("cached", func(b *testing.B) {
+		ctx := input.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		for i := 0; i < b.N; i++ {
+			input.OracleKeeper.VoteTargets(ctx)
+		}
+		b.ReportMetric(float64(ctx.GasMeter().GasConsumed())/float64(b.N), "gas/op")
+	})
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"strings"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/Team-Kujira/core/x/oracle/types"
+)
+
+// NewOracleProposalHandler creates a governance handler for the oracle proposals
+func NewOracleProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.RenameDenomProposal:
+			return k.RenameDenom(ctx, c.OldDenom, c.NewDenom)
+
+		case *types.DelistDenomProposal:
+			return handleDelistDenomProposal(ctx, k, c)
+
+		case *types.SetObserverProposal:
+			return handleSetObserverProposal(ctx, k, c)
+
+		default:
+			return errors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized oracle proposal content type: %T", c)
+		}
+	}
+}
+
+// handleDelistDenomProposal delists the denom, unless it is not whitelisted, another
+// module still requires it or another denom is quoted in it or tracks it
+func handleDelistDenomProposal(ctx sdk.Context, k Keeper, p *types.DelistDenomProposal) error {
+	whitelist := k.Whitelist(ctx)
+	whitelisted := false
+	for _, d := range whitelist {
+		whitelisted = whitelisted || d.Name == p.Denom
+	}
+	if !whitelisted {
+		return errors.Wrapf(types.ErrUnknownDenom, "%s is not whitelisted", p.Denom)
+	}
+	if moduleNames := k.GetRequiringModules(ctx, p.Denom); len(moduleNames) > 0 {
+		return errors.Wrapf(types.ErrDenomRequired, "%s is required by %s", p.Denom, strings.Join(moduleNames, ", "))
+	}
+	if quoted := whitelist.QuotedIn(p.Denom); len(quoted) > 0 {
+		return errors.Wrapf(types.ErrDenomRequired, "%s is the quote denom of %s", p.Denom, strings.Join(quoted, ", "))
+	}
+	if tracking := whitelist.TrackedBy(p.Denom); len(tracking) > 0 {
+		return errors.Wrapf(types.ErrDenomRequired, "%s is tracked by %s", p.Denom, strings.Join(tracking, ", "))
+	}
+
+	k.DelistDenom(ctx, p.Denom)
+	return nil
+}
+
+// handleSetObserverProposal exempts the validator from slashing for the exemption blocks,
+// or removes it from the observers if zero
+func handleSetObserverProposal(ctx sdk.Context, k Keeper, p *types.SetObserverProposal) error {
+	operator, err := sdk.ValAddressFromBech32(p.Validator)
+	if err != nil {
+		return errors.Wrap(types.ErrInvalidValidator, err.Error())
+	}
+
+	if p.ExemptionBlocks == 0 {
+		k.DeleteObserverExemption(ctx, operator)
+		return nil
+	}
+
+	if k.StakingKeeper.Validator(ctx, operator) == nil {
+		return errors.Wrap(types.ErrUnknownValidator, p.Validator)
+	}
+
+	k.SetObserverExemption(ctx, operator, ctx.BlockHeight()+int64(p.ExemptionBlocks))
+	return nil
+}
@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/Team-Kujira/core/x/oracle/types"
+)
+
+func TestRenameDenomProposal(t *testing.T) {
+	input := CreateTestInput(t)
+	handler := NewOracleProposalHandler(input.OracleKeeper)
+	oldDenom, newDenom := "ibc/AAA", "ibc/BBB"
+
+	input.OracleKeeper.SetWhitelist(input.Ctx, types.DenomList{
+		{Name: types.TestDenomA},
+		{Name: oldDenom, VotePeriodMultiplier: 4},
+	})
+	input.OracleKeeper.SetExchangeRate(input.Ctx, oldDenom, sdk.NewDec(3))
+	input.OracleKeeper.SetStaleCounter(input.Ctx, oldDenom, 2)
+	input.OracleKeeper.SetDenomGraceExit(input.Ctx, oldDenom, 7)
+	bounds := types.TallyBounds{LowerBound: sdk.NewDec(2), UpperBound: sdk.NewDec(4)}
+	input.OracleKeeper.SetTallyBounds(input.Ctx, oldDenom, bounds)
+	input.OracleKeeper.CountDenomTally(input.Ctx, oldDenom, true)
+	outcome := types.DenomTallyOutcome{Reason: types.TallyOutcomeStale, VotePeriod: 5, AchievedFraction: sdk.ZeroDec(), RequiredFraction: sdk.NewDecWithPrec(5, 1)}
+	input.OracleKeeper.SetDenomTallyOutcome(input.Ctx, oldDenom, outcome)
+
+	require.NoError(t, handler(input.Ctx, types.NewRenameDenomProposal("title", "description", oldDenom, newDenom)))
+
+	require.Equal(t, types.DenomList{
+		{Name: types.TestDenomA},
+		{Name: newDenom, VotePeriodMultiplier: 4},
+	}, input.OracleKeeper.Whitelist(input.Ctx))
+
+	rate, err := input.OracleKeeper.GetExchangeRate(input.Ctx, newDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(3), rate)
+	require.Equal(t, uint64(2), input.OracleKeeper.GetStaleCounter(input.Ctx, newDenom))
+	exitPeriod, ok := input.OracleKeeper.GetDenomGraceExit(input.Ctx, newDenom)
+	require.True(t, ok)
+	require.Equal(t, uint64(7), exitPeriod)
+	newBounds, ok := input.OracleKeeper.GetTallyBounds(input.Ctx, newDenom)
+	require.True(t, ok)
+	require.Equal(t, bounds, newBounds)
+	require.Equal(t, types.DenomTallyCounter{SuccessPeriods: 1}, input.OracleKeeper.GetDenomTallyCounter(input.Ctx, newDenom))
+	newOutcome, ok := input.OracleKeeper.GetDenomTallyOutcome(input.Ctx, newDenom)
+	require.True(t, ok)
+	require.Equal(t, outcome, newOutcome)
+
+	// Nothing is left under the old denom
+	store := input.Ctx.KVStore(input.OracleKeeper.storeKey)
+	for _, denomKey := range denomKeys {
+		require.False(t, store.Has(denomKey.key(oldDenom)))
+	}
+}
+
+func TestRenameDenomProposalRejected(t *testing.T) {
+	input := CreateTestInput(t)
+	handler := NewOracleProposalHandler(input.OracleKeeper)
+	whitelist := types.DenomList{{Name: types.TestDenomA}, {Name: types.TestDenomB}}
+	input.OracleKeeper.SetWhitelist(input.Ctx, whitelist)
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomA, sdk.OneDec())
+	input.OracleKeeper.SetStaleCounter(input.Ctx, types.TestDenomC, 1)
+
+	// The new denom is whitelisted or has state stored already
+	err := handler(input.Ctx, types.NewRenameDenomProposal("title", "description", types.TestDenomA, types.TestDenomB))
+	require.ErrorIs(t, err, types.ErrDenomExists)
+	err = handler(input.Ctx, types.NewRenameDenomProposal("title", "description", types.TestDenomA, types.TestDenomC))
+	require.ErrorIs(t, err, types.ErrDenomExists)
+
+	// The old denom is not whitelisted
+	err = handler(input.Ctx, types.NewRenameDenomProposal("title", "description", types.TestDenomD, types.TestDenomE))
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+
+	// Nothing changed
+	require.Equal(t, whitelist, input.OracleKeeper.Whitelist(input.Ctx))
+	rate, err := input.OracleKeeper.GetExchangeRate(input.Ctx, types.TestDenomA)
+	require.NoError(t, err)
+	require.Equal(t, sdk.OneDec(), rate)
+
+	err = handler(input.Ctx, &govtypes.TextProposal{Title: "title", Description: "description"})
+	require.Error(t, err)
+}
+
+func TestDelistDenomProposal(t *testing.T) {
+	input := CreateTestInput(t)
+	handler := NewOracleProposalHandler(input.OracleKeeper)
+	input.OracleKeeper.SetWhitelist(input.Ctx, types.DenomList{{Name: types.TestDenomA}, {Name: types.TestDenomB}})
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomB, sdk.OneDec())
+
+	require.NoError(t, handler(input.Ctx, types.NewDelistDenomProposal("title", "description", types.TestDenomB)))
+	require.Equal(t, types.DenomList{{Name: types.TestDenomA}}, input.OracleKeeper.Whitelist(input.Ctx))
+	_, err := input.OracleKeeper.GetExchangeRate(input.Ctx, types.TestDenomB)
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+
+	// The denom is not whitelisted anymore
+	err = handler(input.Ctx, types.NewDelistDenomProposal("title", "description", types.TestDenomB))
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+}
+
+func TestDelistDenomProposalRequiredDenom(t *testing.T) {
+	input := CreateTestInput(t)
+	handler := NewOracleProposalHandler(input.OracleKeeper)
+	whitelist := types.DenomList{{Name: types.TestDenomA}, {Name: types.TestDenomB}}
+	input.OracleKeeper.SetWhitelist(input.Ctx, whitelist)
+	require.NoError(t, input.OracleKeeper.RegisterRequiredDenom(input.Ctx, types.TestDenomB, "lending"))
+	require.NoError(t, input.OracleKeeper.RegisterRequiredDenom(input.Ctx, types.TestDenomB, "cdp"))
+
+	// Neither delisting nor renaming a required denom passes
+	err := handler(input.Ctx, types.NewDelistDenomProposal("title", "description", types.TestDenomB))
+	require.ErrorIs(t, err, types.ErrDenomRequired)
+	require.ErrorContains(t, err, "cdp, lending")
+	err = handler(input.Ctx, types.NewRenameDenomProposal("title", "description", types.TestDenomB, types.TestDenomC))
+	require.ErrorIs(t, err, types.ErrDenomRequired)
+	require.Equal(t, whitelist, input.OracleKeeper.Whitelist(input.Ctx))
+
+	// Until the last module deregisters it
+	input.OracleKeeper.DeregisterRequiredDenom(input.Ctx, types.TestDenomB, "lending")
+	err = handler(input.Ctx, types.NewDelistDenomProposal("title", "description", types.TestDenomB))
+	require.ErrorIs(t, err, types.ErrDenomRequired)
+	input.OracleKeeper.DeregisterRequiredDenom(input.Ctx, types.TestDenomB, "cdp")
+	require.NoError(t, handler(input.Ctx, types.NewDelistDenomProposal("title", "description", types.TestDenomB)))
+	require.Equal(t, types.DenomList{{Name: types.TestDenomA}}, input.OracleKeeper.Whitelist(input.Ctx))
+}
+
+func TestQuoteDenomProposals(t *testing.T) {
+	input := CreateTestInput(t)
+	handler := NewOracleProposalHandler(input.OracleKeeper)
+	input.OracleKeeper.SetWhitelist(input.Ctx, types.DenomList{
+		{Name: types.TestDenomA, QuoteDenom: types.TestDenomB},
+		{Name: types.TestDenomB},
+	})
+
+	// A quote denom cannot be delisted
+	err := handler(input.Ctx, types.NewDelistDenomProposal("title", "description", types.TestDenomB))
+	require.ErrorIs(t, err, types.ErrDenomRequired)
+	require.ErrorContains(t, err, "quote denom of "+types.TestDenomA)
+
+	// Renaming it renames the quote denom of the denoms quoted in it
+	require.NoError(t, handler(input.Ctx, types.NewRenameDenomProposal("title", "description", types.TestDenomB, types.TestDenomC)))
+	require.Equal(t, types.DenomList{
+		{Name: types.TestDenomA, QuoteDenom: types.TestDenomC},
+		{Name: types.TestDenomC},
+	}, input.OracleKeeper.Whitelist(input.Ctx))
+
+	require.NoError(t, handler(input.Ctx, types.NewDelistDenomProposal("title", "description", types.TestDenomA)))
+	require.NoError(t, handler(input.Ctx, types.NewDelistDenomProposal("title", "description", types.TestDenomC)))
+	require.Empty(t, input.OracleKeeper.Whitelist(input.Ctx))
+}
+
+func TestTrackedDenomProposals(t *testing.T) {
+	input := CreateTestInput(t)
+	handler := NewOracleProposalHandler(input.OracleKeeper)
+	input.OracleKeeper.SetWhitelist(input.Ctx, types.DenomList{
+		{Name: types.TestDenomA, Tracks: types.TestDenomB},
+		{Name: types.TestDenomB},
+	})
+
+	// A tracked denom cannot be delisted
+	err := handler(input.Ctx, types.NewDelistDenomProposal("title", "description", types.TestDenomB))
+	require.ErrorIs(t, err, types.ErrDenomRequired)
+	require.ErrorContains(t, err, "tracked by "+types.TestDenomA)
+
+	// Renaming it renames the tracked denom of the denoms tracking it
+	require.NoError(t, handler(input.Ctx, types.NewRenameDenomProposal("title", "description", types.TestDenomB, types.TestDenomC)))
+	require.Equal(t, types.DenomList{
+		{Name: types.TestDenomA, Tracks: types.TestDenomC},
+		{Name: types.TestDenomC},
+	}, input.OracleKeeper.Whitelist(input.Ctx))
+}
+
+func TestSetObserverProposal(t *testing.T) {
+	input, _ := setup(t)
+	handler := NewOracleProposalHandler(input.OracleKeeper)
+	ctx := input.Ctx.WithBlockHeight(100)
+
+	require.NoError(t, handler(ctx, types.NewSetObserverProposal("title", "description", ValAddrs[0], 50)))
+	exemptUntil, ok := input.OracleKeeper.GetObserverExemption(ctx, ValAddrs[0])
+	require.True(t, ok)
+	require.Equal(t, int64(150), exemptUntil)
+
+	// An exemption of zero blocks removes the observer
+	require.NoError(t, handler(ctx, types.NewSetObserverProposal("title", "description", ValAddrs[0], 0)))
+	require.False(t, input.OracleKeeper.IsExemptObserver(ctx, ValAddrs[0]))
+
+	// The validator must exist
+	err := handler(ctx, types.NewSetObserverProposal("title", "description", sdk.ValAddress(Addrs[4]), 50))
+	require.ErrorIs(t, err, types.ErrUnknownValidator)
+}
@@ -2,11 +2,17 @@ package keeper
 
 import (
 	"context"
+	"sort"
+	"time"
 
+	"cosmossdk.io/errors"
+	gogotypes "github.com/cosmos/gogoproto/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/Team-Kujira/core/x/oracle/types"
 )
@@ -17,7 +23,8 @@ type querier struct {
 }
 
 // NewQuerier returns an implementation of the oracle QueryServer interface
-// for the provided Keeper.
+// for the provided Keeper. The queries never write to the store, so they can
+// be served from read-only replicas.
 func NewQuerier(keeper Keeper) types.QueryServer {
 	return &querier{Keeper: keeper}
 }
@@ -40,7 +47,7 @@ func (q querier) ExchangeRate(c context.Context, req *types.QueryExchangeRateReq
 	}
 
 	if len(req.Denom) == 0 {
-		return nil, status.Error(codes.InvalidArgument, "empty denom")
+		return nil, errors.Wrap(types.ErrInvalidDenom, "empty denom")
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
@@ -49,20 +56,43 @@ func (q querier) ExchangeRate(c context.Context, req *types.QueryExchangeRateReq
 		return nil, err
 	}
 
-	return &types.QueryExchangeRateResponse{ExchangeRate: exchangeRate}, nil
+	// A rate is only kept while failing to tally when it is carried forward,
+	// so the stale counter is also the age of the rate
+	carriedPeriods := q.GetStaleCounter(ctx, req.Denom)
+
+	// The exchange rate of a quote denom may be missing, while the one of the denom is not
+	usdExchangeRate, err := q.GetUSDExchangeRate(ctx, req.Denom)
+	if err != nil {
+		usdExchangeRate = sdk.ZeroDec()
+	}
+
+	whitelist := q.Whitelist(ctx)
+	return &types.QueryExchangeRateResponse{
+		ExchangeRate:    exchangeRate,
+		CarriedForward:  carriedPeriods > 0,
+		CarriedPeriods:  carriedPeriods,
+		AgePeriods:      carriedPeriods,
+		QuoteDenom:      whitelist.QuoteDenom(req.Denom),
+		VoterCount:      q.GetDenomVoterCount(ctx, req.Denom),
+		UsdExchangeRate: usdExchangeRate,
+		Tracks:          whitelist.TrackedDenom(req.Denom),
+	}, nil
 }
 
 // ExchangeRates queries exchange rates of all denoms
 func (q querier) ExchangeRates(c context.Context, _ *types.QueryExchangeRatesRequest) (*types.QueryExchangeRatesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
-	var exchangeRates sdk.DecCoins
+	// Without exchange rates, e.g. on a fresh chain, the lists are empty rather than missing
+	exchangeRates := sdk.DecCoins{}
+	agePeriods := []types.ExchangeRateAge{}
 	q.IterateExchangeRates(ctx, func(denom string, rate sdk.Dec) (stop bool) {
 		exchangeRates = append(exchangeRates, sdk.NewDecCoinFromDec(denom, rate))
+		agePeriods = append(agePeriods, types.ExchangeRateAge{Denom: denom, AgePeriods: q.GetStaleCounter(ctx, denom)})
 		return false
 	})
 
-	return &types.QueryExchangeRatesResponse{ExchangeRates: exchangeRates}, nil
+	return &types.QueryExchangeRatesResponse{ExchangeRates: exchangeRates, AgePeriods: agePeriods}, nil
 }
 
 // Actives queries all denoms for which exchange rates exist
@@ -86,7 +116,7 @@ func (q querier) FeederDelegation(c context.Context, req *types.QueryFeederDeleg
 
 	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, errors.Wrap(types.ErrInvalidValidator, err.Error())
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
@@ -103,15 +133,57 @@ func (q querier) MissCounter(c context.Context, req *types.QueryMissCounterReque
 
 	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, errors.Wrap(types.ErrInvalidValidator, err.Error())
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
 	return &types.QueryMissCounterResponse{
-		MissCounter: q.GetMissCounter(ctx, valAddr),
+		MissCounter:       q.GetMissCounter(ctx, valAddr),
+		RevealMissCounter: q.GetRevealMissCounter(ctx, valAddr),
 	}, nil
 }
 
+// MissCounters queries the miss counters of all validators with missed votes in the current slash window
+func (q querier) MissCounters(c context.Context, req *types.QueryMissCountersRequest) (*types.QueryMissCountersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	votePeriodsPerWindow := q.VotePeriodsPerSlashWindow(ctx)
+	minValidPerWindow := q.MinValidPerWindow(ctx)
+	revealMissWeight := q.RevealMissWeight(ctx)
+
+	var missCounters []types.MissCounterStatus
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.MissCounterKey)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var missCounter gogotypes.UInt64Value
+		if err := q.cdc.Unmarshal(value, &missCounter); err != nil {
+			return err
+		}
+
+		valAddr := sdk.ValAddress(key[1:])
+		revealMissCounter := q.GetRevealMissCounter(ctx, valAddr)
+
+		// The validator is at risk once it can no longer reach MinValidPerWindow in the slash window
+		misses := weightedMisses(missCounter.Value, revealMissCounter, revealMissWeight)
+		atRisk := votePeriodsPerWindow > 0 && validVoteRate(votePeriodsPerWindow, misses).LT(minValidPerWindow)
+
+		missCounters = append(missCounters, types.MissCounterStatus{
+			ValidatorAddr:     valAddr.String(),
+			MissCounter:       missCounter.Value,
+			AtRisk:            atRisk,
+			RevealMissCounter: revealMissCounter,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMissCountersResponse{MissCounters: missCounters, Pagination: pageRes}, nil
+}
+
 // AggregatePrevote queries an aggregate prevote of a validator
 func (q querier) AggregatePrevote(c context.Context, req *types.QueryAggregatePrevoteRequest) (*types.QueryAggregatePrevoteResponse, error) {
 	if req == nil {
@@ -120,7 +192,7 @@ func (q querier) AggregatePrevote(c context.Context, req *types.QueryAggregatePr
 
 	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, errors.Wrap(types.ErrInvalidValidator, err.Error())
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
@@ -157,7 +229,7 @@ func (q querier) AggregateVote(c context.Context, req *types.QueryAggregateVoteR
 
 	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, errors.Wrap(types.ErrInvalidValidator, err.Error())
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
@@ -185,3 +257,994 @@ func (q querier) AggregateVotes(c context.Context, _ *types.QueryAggregateVotesR
 		AggregateVotes: votes,
 	}, nil
 }
+
+// RewardEstimate queries the projected reward of the next vote period per unit of voting power
+func (q querier) RewardEstimate(c context.Context, req *types.QueryRewardEstimateRequest) (*types.QueryRewardEstimateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rewardPerPower, winningPower, err := q.GetRewardEstimate(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &types.QueryRewardEstimateResponse{
+		RewardPerPower: rewardPerPower,
+		WinningPower:   winningPower,
+	}
+
+	if len(req.ValidatorAddr) != 0 {
+		valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+		if err != nil {
+			return nil, errors.Wrap(types.ErrInvalidValidator, err.Error())
+		}
+
+		validator := q.StakingKeeper.Validator(ctx, valAddr)
+		if validator == nil {
+			return nil, errors.Wrap(types.ErrUnknownValidator, req.ValidatorAddr)
+		}
+
+		power := validator.GetConsensusPower(q.StakingKeeper.PowerReduction(ctx))
+		res.ValidatorReward = rewardPerPower.MulDec(sdk.NewDec(power))
+	}
+
+	return res, nil
+}
+
+// VoteHashSpec queries the format of the aggregate vote hash preimage
+func (q querier) VoteHashSpec(c context.Context, _ *types.QueryVoteHashSpecRequest) (*types.QueryVoteHashSpecResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	spec := types.GetVoteHashSpec(q.GetCommitmentHashAlgo(ctx))
+	return &spec, nil
+}
+
+// IsFeederAuthorized queries whether the feeder may submit votes on behalf of the validator
+func (q querier) IsFeederAuthorized(c context.Context, req *types.QueryIsFeederAuthorizedRequest) (*types.QueryIsFeederAuthorizedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidValidator, err.Error())
+	}
+
+	feederAddr, err := sdk.AccAddressFromBech32(req.FeederAddr)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidFeeder, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.QueryIsFeederAuthorizedResponse{
+		Authorized:       true,
+		AuthorizedFeeder: q.GetFeederDelegation(ctx, valAddr).String(),
+	}
+
+	// Apply the same rule the vote messages are checked against
+	if err := q.ValidateFeeder(ctx, feederAddr, valAddr); err != nil {
+		res.Authorized = false
+		res.Reason = err.Error()
+	}
+
+	return res, nil
+}
+
+// DenomBackingPower queries the voting power backing each denom in the current vote period
+func (q querier) DenomBackingPower(c context.Context, req *types.QueryDenomBackingPowerRequest) (*types.QueryDenomBackingPowerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	backingPower := q.GetDenomBackingPower(ctx)
+
+	if len(req.Denom) != 0 {
+		return &types.QueryDenomBackingPowerResponse{
+			BackingPowers: []types.DenomBackingPower{{Denom: req.Denom, Power: backingPower[req.Denom]}},
+		}, nil
+	}
+
+	backingPowers := make([]types.DenomBackingPower, 0, len(backingPower))
+	for denom, power := range backingPower {
+		backingPowers = append(backingPowers, types.DenomBackingPower{Denom: denom, Power: power})
+	}
+	sort.Slice(backingPowers, func(i, j int) bool {
+		return backingPowers[i].Denom < backingPowers[j].Denom
+	})
+
+	return &types.QueryDenomBackingPowerResponse{BackingPowers: backingPowers}, nil
+}
+
+// UpcomingGraceExits queries the denoms in their grace window, the soonest exit first
+func (q querier) UpcomingGraceExits(c context.Context, req *types.QueryUpcomingGraceExitsRequest) (*types.QueryUpcomingGraceExitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	votePeriod := q.VotePeriod(ctx)
+	timed := q.VotePeriodDuration(ctx) != 0
+	currentPeriod := q.CurrentVotePeriod(ctx)
+
+	graceExits := []types.DenomGraceExit{}
+	q.IterateDenomGraceExits(ctx, func(denom string, exitPeriod uint64) (stop bool) {
+		if exitPeriod > currentPeriod {
+			// The height is not known in advance, if the vote periods are timed
+			exitHeight := int64(exitPeriod * votePeriod)
+			if timed {
+				exitHeight = 0
+			}
+
+			graceExits = append(graceExits, types.DenomGraceExit{
+				Denom:      denom,
+				ExitPeriod: exitPeriod,
+				ExitHeight: exitHeight,
+			})
+		}
+		return false
+	})
+
+	sort.SliceStable(graceExits, func(i, j int) bool {
+		return graceExits[i].ExitPeriod < graceExits[j].ExitPeriod
+	})
+
+	return &types.QueryUpcomingGraceExitsResponse{GraceExits: graceExits}, nil
+}
+
+// LightClientState queries the params, exchange rates and current vote period at a single height,
+// along with the oracle store keys to prove them with. The params are kept by x/params and need
+// a separate proof against its store.
+func (q querier) LightClientState(c context.Context, req *types.QueryLightClientStateRequest) (*types.QueryLightClientStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := q.GetParams(ctx)
+
+	var exchangeRates sdk.DecCoins
+	var exchangeRateKeys [][]byte
+	q.IterateExchangeRates(ctx, func(denom string, rate sdk.Dec) (stop bool) {
+		exchangeRates = append(exchangeRates, sdk.NewDecCoinFromDec(denom, rate))
+		exchangeRateKeys = append(exchangeRateKeys, types.GetExchangeRateKey(denom))
+		return false
+	})
+
+	// Fixed vote periods are derived from the height and the proven VotePeriod param
+	var votePeriodClockKey []byte
+	if params.VotePeriodDuration > 0 {
+		votePeriodClockKey = types.VotePeriodClockKey
+	}
+
+	return &types.QueryLightClientStateResponse{
+		Height:             ctx.BlockHeight(),
+		Params:             params,
+		ExchangeRates:      exchangeRates,
+		VotePeriod:         q.CurrentVotePeriod(ctx),
+		StoreName:          types.StoreKey,
+		ExchangeRateKeys:   exchangeRateKeys,
+		VotePeriodClockKey: votePeriodClockKey,
+	}, nil
+}
+
+// ValidatorRateDeviation queries how far the rates a validator submitted in the last vote period were from the tallied rates
+func (q querier) ValidatorRateDeviation(c context.Context, req *types.QueryValidatorRateDeviationRequest) (*types.QueryValidatorRateDeviationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidValidator, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	submission, err := q.GetLastSubmission(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	deviations := []types.RateDeviation{}
+	for _, tuple := range submission.ExchangeRateTuples {
+		// Skip abstain votes and denoms which did not tally
+		bounds, ok := q.GetTallyBounds(ctx, tuple.Denom)
+		if !tuple.ExchangeRate.IsPositive() || !ok {
+			continue
+		}
+
+		finalized, err := q.GetExchangeRate(ctx, tuple.Denom)
+		if err != nil {
+			continue
+		}
+
+		// (submitted / finalized - 1) * 100, as the difference itself may be out of range
+		ratio, err := types.SafeQuo(tuple.ExchangeRate, finalized)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		percentDiff, err := types.SafeMul(ratio.Sub(sdk.OneDec()), sdk.NewDec(100))
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		deviations = append(deviations, types.RateDeviation{
+			Denom:        tuple.Denom,
+			Submitted:    tuple.ExchangeRate,
+			Finalized:    finalized,
+			PercentDiff:  percentDiff,
+			InRewardBand: tuple.ExchangeRate.GTE(bounds.LowerBound) && tuple.ExchangeRate.LTE(bounds.UpperBound),
+		})
+	}
+
+	sort.Slice(deviations, func(i, j int) bool {
+		return deviations[i].Denom < deviations[j].Denom
+	})
+
+	return &types.QueryValidatorRateDeviationResponse{Deviations: deviations}, nil
+}
+
+// ValidatorMissingDenoms queries the active denoms a validator has not voted on in the current vote period
+func (q querier) ValidatorMissingDenoms(c context.Context, req *types.QueryValidatorMissingDenomsRequest) (*types.QueryValidatorMissingDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidValidator, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// Abstain votes count as voted, as they do for the miss counter. Without a
+	// revealed vote, every active denom is missing.
+	voted := map[string]struct{}{}
+	if vote, err := q.GetAggregateExchangeRateVote(ctx, valAddr); err == nil {
+		for _, tuple := range vote.ExchangeRateTuples {
+			voted[tuple.Denom] = struct{}{}
+		}
+	}
+
+	// Denoms resting in the vote period because of their multiplier or tracking another denom are not required
+	votePeriod := q.CurrentVotePeriod(ctx)
+	missingDenoms := []string{}
+	for _, denom := range q.Whitelist(ctx) {
+		if _, ok := voted[denom.Name]; !ok && denom.IsDue(votePeriod) && len(denom.Tracks) == 0 {
+			missingDenoms = append(missingDenoms, denom.Name)
+		}
+	}
+	sort.Strings(missingDenoms)
+
+	return &types.QueryValidatorMissingDenomsResponse{MissingDenoms: missingDenoms}, nil
+}
+
+// DenomTallySuccessRate queries the number of vote periods each denom tallied and failed to tally in the current slash window
+func (q querier) DenomTallySuccessRate(c context.Context, req *types.QueryDenomTallySuccessRateRequest) (*types.QueryDenomTallySuccessRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if len(req.Denom) != 0 {
+		counter := q.GetDenomTallyCounter(ctx, req.Denom)
+		return &types.QueryDenomTallySuccessRateResponse{
+			SuccessRates: []types.DenomTallySuccessRate{{
+				Denom:          req.Denom,
+				SuccessPeriods: counter.SuccessPeriods,
+				FailedPeriods:  counter.FailedPeriods,
+			}},
+		}, nil
+	}
+
+	successRates := []types.DenomTallySuccessRate{}
+	q.IterateDenomTallyCounters(ctx, func(denom string, counter types.DenomTallyCounter) (stop bool) {
+		successRates = append(successRates, types.DenomTallySuccessRate{
+			Denom:          denom,
+			SuccessPeriods: counter.SuccessPeriods,
+			FailedPeriods:  counter.FailedPeriods,
+		})
+		return false
+	})
+
+	return &types.QueryDenomTallySuccessRateResponse{SuccessRates: successRates}, nil
+}
+
+// PendingReveals queries the prevotes which can be revealed in the current vote period and have no vote yet
+func (q querier) PendingReveals(c context.Context, req *types.QueryPendingRevealsRequest) (*types.QueryPendingRevealsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// The vote can be revealed until the last block of the current vote period,
+	// which is not known in advance, if the vote periods are timed
+	var remainingBlocks uint64
+	if q.VotePeriodDuration(ctx) == 0 {
+		votePeriod := q.VotePeriod(ctx)
+		remainingBlocks = votePeriod - uint64(ctx.BlockHeight())%votePeriod - 1
+	}
+
+	pendingReveals := []types.PendingReveal{}
+	q.IterateAggregateExchangeRatePrevotes(ctx, func(voterAddr sdk.ValAddress, prevote types.AggregateExchangeRatePrevote) (stop bool) {
+		if !q.IsPreviousVotePeriod(ctx, prevote.SubmitBlock) {
+			return false
+		}
+		if _, err := q.GetAggregateExchangeRateVote(ctx, voterAddr); err == nil {
+			return false
+		}
+
+		pendingReveals = append(pendingReveals, types.PendingReveal{
+			Voter:           prevote.Voter,
+			SubmitBlock:     prevote.SubmitBlock,
+			RemainingBlocks: remainingBlocks,
+		})
+		return false
+	})
+
+	sort.Slice(pendingReveals, func(i, j int) bool {
+		return pendingReveals[i].Voter < pendingReveals[j].Voter
+	})
+
+	return &types.QueryPendingRevealsResponse{PendingReveals: pendingReveals}, nil
+}
+
+// RequiredDenoms queries the denoms other modules registered as required, with the modules requiring them
+func (q querier) RequiredDenoms(c context.Context, req *types.QueryRequiredDenomsRequest) (*types.QueryRequiredDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// The modules of a denom are iterated in order, the denoms by length first
+	modules := map[string][]string{}
+	q.IterateRequiredDenoms(ctx, func(denom, moduleName string) (stop bool) {
+		modules[denom] = append(modules[denom], moduleName)
+		return false
+	})
+
+	requiredDenoms := []types.RequiredDenom{}
+	for denom, moduleNames := range modules {
+		requiredDenoms = append(requiredDenoms, types.RequiredDenom{Denom: denom, Modules: moduleNames})
+	}
+	sort.Slice(requiredDenoms, func(i, j int) bool {
+		return requiredDenoms[i].Denom < requiredDenoms[j].Denom
+	})
+
+	return &types.QueryRequiredDenomsResponse{RequiredDenoms: requiredDenoms}, nil
+}
+
+// DenomSchedule queries the vote period each whitelisted denom is next tallied with votes required
+func (q querier) DenomSchedule(c context.Context, req *types.QueryDenomScheduleRequest) (*types.QueryDenomScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	votePeriod := q.VotePeriod(ctx)
+	duration := q.VotePeriodDuration(ctx)
+	currentPeriod := q.CurrentVotePeriod(ctx)
+
+	schedules := []types.DenomSchedule{}
+	for _, denom := range q.Whitelist(ctx) {
+		if req.Denom != "" && denom.Name != req.Denom {
+			continue
+		}
+
+		// Missing the denom is only counted once it left its grace window
+		inGrace := q.IsDenomInGrace(ctx, denom.Name, currentPeriod)
+		fromPeriod := currentPeriod
+		if inGrace {
+			fromPeriod, _ = q.GetDenomGraceExit(ctx, denom.Name)
+		}
+		nextPeriod := denom.NextDuePeriod(fromPeriod)
+
+		// A timed vote period is closed by the first block crossing its boundary,
+		// assuming each of the following vote periods lasts a single duration
+		schedule := types.DenomSchedule{
+			Denom:                denom.Name,
+			VotePeriodMultiplier: denom.VotePeriodMultiplier,
+			NextPeriod:           nextPeriod,
+			InGrace:              inGrace,
+		}
+		if duration == 0 {
+			schedule.RemainingBlocks = (nextPeriod+1)*votePeriod - 1 - uint64(ctx.BlockHeight())
+		} else {
+			boundary := q.GetVotePeriodClock(ctx).Boundary + 1 + int64(nextPeriod-currentPeriod)
+			if remaining := time.Unix(0, boundary*int64(duration)).Sub(ctx.BlockTime()); remaining > 0 {
+				schedule.RemainingTime = remaining
+			}
+		}
+		schedules = append(schedules, schedule)
+	}
+
+	if req.Denom != "" && len(schedules) == 0 {
+		return nil, errors.Wrapf(types.ErrUnknownDenom, "%s is not whitelisted", req.Denom)
+	}
+
+	sort.SliceStable(schedules, func(i, j int) bool {
+		return schedules[i].NextPeriod < schedules[j].NextPeriod
+	})
+
+	return &types.QueryDenomScheduleResponse{CurrentPeriod: currentPeriod, Schedules: schedules}, nil
+}
+
+// Observers queries the validators exempt from slashing as observers
+func (q querier) Observers(c context.Context, req *types.QueryObserversRequest) (*types.QueryObserversResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// Expired observers are only pruned at the end of the slash window
+	observers := []types.Observer{}
+	q.IterateObserverExemptions(ctx, func(operator sdk.ValAddress, exemptUntil int64) (stop bool) {
+		if ctx.BlockHeight() < exemptUntil {
+			observers = append(observers, types.Observer{Validator: operator.String(), ExemptUntilHeight: exemptUntil})
+		}
+		return false
+	})
+	sort.SliceStable(observers, func(i, j int) bool {
+		return observers[i].ExemptUntilHeight < observers[j].ExemptUntilHeight
+	})
+
+	return &types.QueryObserversResponse{Observers: observers}, nil
+}
+
+// BandMembershipStats queries the share of the rating power of the last vote period within the reward band of each denom
+func (q querier) BandMembershipStats(c context.Context, req *types.QueryBandMembershipStatsRequest) (*types.QueryBandMembershipStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if len(req.Denom) != 0 {
+		bounds, ok := q.GetTallyBounds(ctx, req.Denom)
+		if !ok {
+			return nil, errors.Wrapf(types.ErrUnknownDenom, "%s did not tally in the last vote period", req.Denom)
+		}
+
+		return &types.QueryBandMembershipStatsResponse{
+			Stats: []types.BandMembershipStats{bandMembershipStats(req.Denom, bounds)},
+		}, nil
+	}
+
+	stats := []types.BandMembershipStats{}
+	q.IterateTallyBounds(ctx, func(denom string, bounds types.TallyBounds) (stop bool) {
+		stats = append(stats, bandMembershipStats(denom, bounds))
+		return false
+	})
+
+	return &types.QueryBandMembershipStatsResponse{Stats: stats}, nil
+}
+
+// bandMembershipStats returns the band membership of the last tally of the denom
+func bandMembershipStats(denom string, bounds types.TallyBounds) types.BandMembershipStats {
+	fraction := sdk.ZeroDec()
+	if bounds.RatedPower > 0 {
+		fraction = sdk.NewDec(bounds.InBandPower).QuoInt64(bounds.RatedPower)
+	}
+
+	return types.BandMembershipStats{
+		Denom:          denom,
+		RatedPower:     bounds.RatedPower,
+		InBandPower:    bounds.InBandPower,
+		InBandFraction: fraction,
+	}
+}
+
+// ValidatorAccuracyRanking queries the validators ranked by the share of their submissions within the reward band
+func (q querier) ValidatorAccuracyRanking(c context.Context, req *types.QueryValidatorAccuracyRankingRequest) (*types.QueryValidatorAccuracyRankingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	ranking := []types.ValidatorAccuracy{}
+	q.IterateValidatorAccuracyCounters(ctx, func(operator sdk.ValAddress, counter types.ValidatorAccuracyCounter) (stop bool) {
+		submissions := counter.Submissions + counter.PreviousSubmissions
+		if submissions == 0 {
+			return false
+		}
+
+		inBandSubmissions := counter.InBandSubmissions + counter.PreviousInBandSubmissions
+		ranking = append(ranking, types.ValidatorAccuracy{
+			ValidatorAddr:     operator.String(),
+			Submissions:       submissions,
+			InBandSubmissions: inBandSubmissions,
+			InBandFraction:    sdk.NewDecFromInt(sdk.NewIntFromUint64(inBandSubmissions)).QuoInt(sdk.NewIntFromUint64(submissions)),
+		})
+		return false
+	})
+
+	// Among validators with the same share, the ones with more submissions come first
+	sort.SliceStable(ranking, func(i, j int) bool {
+		if !ranking[i].InBandFraction.Equal(ranking[j].InBandFraction) {
+			return ranking[i].InBandFraction.GT(ranking[j].InBandFraction) != req.Ascending
+		}
+		return ranking[i].Submissions > ranking[j].Submissions
+	})
+	if req.Limit != 0 && uint64(len(ranking)) > req.Limit {
+		ranking = ranking[:req.Limit]
+	}
+
+	return &types.QueryValidatorAccuracyRankingResponse{Ranking: ranking}, nil
+}
+
+// DenomTallyDiagnosis queries the outcome of the last vote period of a denom and why it did not tally
+func (q querier) DenomTallyDiagnosis(c context.Context, req *types.QueryDenomTallyDiagnosisRequest) (*types.QueryDenomTallyDiagnosisResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, errors.Wrap(types.ErrInvalidDenom, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	outcome, ok := q.GetDenomTallyOutcome(ctx, req.Denom)
+	if !ok {
+		return nil, errors.Wrapf(types.ErrUnknownDenom, "%s has no vote period outcome", req.Denom)
+	}
+
+	return &types.QueryDenomTallyDiagnosisResponse{Outcome: outcome}, nil
+}
+
+// ParticipationSummary queries the bonded power and validators, and how much of them voted in the last vote period
+func (q querier) ParticipationSummary(c context.Context, req *types.QueryParticipationSummaryRequest) (*types.QueryParticipationSummaryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	participation := q.GetVotePeriodParticipation(ctx)
+
+	return &types.QueryParticipationSummaryResponse{
+		BondedPower:        participation.BondedPower,
+		BondedValidators:   participation.BondedValidators,
+		VotedPower:         participation.VotedPower,
+		Voters:             participation.Voters,
+		ParticipationRatio: participation.Ratio(),
+	}, nil
+}
+
+// RawDenomState queries the store entries of a denom as persisted, for debugging
+func (q querier) RawDenomState(c context.Context, req *types.QueryRawDenomStateRequest) (*types.QueryRawDenomStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, errors.Wrap(types.ErrInvalidDenom, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	entries := q.GetRawDenomState(ctx, req.Denom)
+
+	// A whitelisted denom may have nothing stored yet
+	whitelisted := false
+	for _, denom := range q.Whitelist(ctx) {
+		whitelisted = whitelisted || denom.Name == req.Denom
+	}
+	if len(entries) == 0 && !whitelisted {
+		return nil, errors.Wrapf(types.ErrUnknownDenom, "%s is not whitelisted and has no state stored", req.Denom)
+	}
+
+	return &types.QueryRawDenomStateResponse{Entries: entries}, nil
+}
+
+// MedianFlipCost queries the additional voting power needed to move the median of each denom by
+// a relative amount, estimated from the votes of the last vote period
+func (q querier) MedianFlipCost(c context.Context, req *types.QueryMedianFlipCostRequest) (*types.QueryMedianFlipCostResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	move := types.DefaultMedianFlipMove
+	if len(req.Move) != 0 {
+		var err error
+		move, err = sdk.NewDecFromStr(req.Move)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if !move.IsPositive() || move.GTE(sdk.OneDec()) {
+		return nil, status.Errorf(codes.InvalidArgument, "move must be between 0 and 1: %s", move)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ballots := q.GetLastBallots(ctx)
+	if len(req.Denom) != 0 {
+		ballot, ok := ballots[req.Denom]
+		if !ok {
+			return nil, errors.Wrapf(types.ErrUnknownDenom, "%s has no ballot in the last vote period", req.Denom)
+		}
+		ballots = map[string]types.ExchangeRateBallot{req.Denom: ballot}
+	}
+
+	evenMedianRule := q.EvenMedianRule(ctx)
+	flipCosts := []types.MedianFlipCost{}
+	for denom, ballot := range ballots {
+		median, err := ballot.WeightedMedianWithRule(evenMedianRule)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		raiseTarget, err := types.SafeMul(median, sdk.OneDec().Add(move))
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		raisePower, err := ballot.MedianFlipPower(raiseTarget, evenMedianRule)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		lowerPower, err := ballot.MedianFlipPower(median.Mul(sdk.OneDec().Sub(move)), evenMedianRule)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		flipCosts = append(flipCosts, types.MedianFlipCost{
+			Denom:       denom,
+			Median:      median,
+			BallotPower: ballot.Power(),
+			RaisePower:  raisePower,
+			LowerPower:  lowerPower,
+		})
+	}
+
+	sort.Slice(flipCosts, func(i, j int) bool {
+		return flipCosts[i].Denom < flipCosts[j].Denom
+	})
+
+	return &types.QueryMedianFlipCostResponse{Move: move, FlipCosts: flipCosts}, nil
+}
+
+// OracleAlertConfig queries the miss rate a validator declared to accept, along with its miss rate in the
+// current slash window
+func (q querier) OracleAlertConfig(c context.Context, req *types.QueryOracleAlertConfigRequest) (*types.QueryOracleAlertConfigResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidValidator, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	config, ok := q.GetOracleAlertConfig(ctx, valAddr)
+	if !ok {
+		return nil, errors.Wrap(types.ErrNoAlertConfig, req.ValidatorAddr)
+	}
+
+	return &types.QueryOracleAlertConfigResponse{
+		MaxMissRate: config.MaxMissRate,
+		MissRate:    q.MissRate(ctx, valAddr),
+	}, nil
+}
+
+// ModuleInfo queries the consensus version of the module and its optional features enabled by the params
+func (q querier) ModuleInfo(c context.Context, _ *types.QueryModuleInfoRequest) (*types.QueryModuleInfoResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryModuleInfoResponse{
+		ConsensusVersion: types.ConsensusVersion,
+		Features:         q.GetParams(ctx).Features(),
+	}, nil
+}
+
+// FreshExchangeRate queries the exchange rate of a denom, failing if it is older than the max age
+func (q querier) FreshExchangeRate(c context.Context, req *types.QueryFreshExchangeRateRequest) (*types.QueryFreshExchangeRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, errors.Wrap(types.ErrInvalidDenom, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	exchangeRate, err := q.GetExchangeRate(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// The stale counter is the age of the rate, see ExchangeRate
+	agePeriods := q.GetStaleCounter(ctx, req.Denom)
+	if agePeriods > req.MaxAgePeriods {
+		return nil, errors.Wrapf(types.ErrStaleExchangeRate, "exchange rate of %s is %d vote periods old, exceeding the max age of %d", req.Denom, agePeriods, req.MaxAgePeriods)
+	}
+
+	return &types.QueryFreshExchangeRateResponse{ExchangeRate: exchangeRate, AgePeriods: agePeriods}, nil
+}
+
+// WhitelistChanges queries the denoms added to or removed from the whitelist in the last vote periods
+func (q querier) WhitelistChanges(c context.Context, req *types.QueryWhitelistChangesRequest) (*types.QueryWhitelistChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	fromPeriod := uint64(0)
+	if req.Windows > 0 {
+		fromPeriod = firstWindowPeriod(q.CurrentVotePeriod(ctx), req.Windows)
+	}
+
+	res := &types.QueryWhitelistChangesResponse{
+		Added:   []types.WhitelistChange{},
+		Removed: []types.WhitelistChange{},
+	}
+	q.IterateWhitelistChanges(ctx, fromPeriod, func(change types.WhitelistChange) (stop bool) {
+		if change.Added {
+			res.Added = append(res.Added, change)
+		} else {
+			res.Removed = append(res.Removed, change)
+		}
+		return false
+	})
+
+	return res, nil
+}
+
+// RejectedTuples queries the exchange rates of the last vote of a validator left out of the tally
+func (q querier) RejectedTuples(c context.Context, req *types.QueryRejectedTuplesRequest) (*types.QueryRejectedTuplesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidValidator, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rejected, ok := q.GetRejectedTuples(ctx, valAddr)
+	if !ok {
+		return &types.QueryRejectedTuplesResponse{Tuples: []types.RejectedTuple{}}, nil
+	}
+
+	return &types.QueryRejectedTuplesResponse{VotePeriod: rejected.VotePeriod, Tuples: rejected.Tuples}, nil
+}
+
+// RevealWindowStatus queries the prevote of a validator and the blocks its vote can be revealed in
+func (q querier) RevealWindowStatus(c context.Context, req *types.QueryRevealWindowStatusRequest) (*types.QueryRevealWindowStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidValidator, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	res := &types.QueryRevealWindowStatusResponse{VotePeriod: q.CurrentVotePeriod(ctx)}
+
+	// The votes are cleared at the end of every vote period
+	if _, err := q.GetAggregateExchangeRateVote(ctx, valAddr); err == nil {
+		res.Revealed = true
+	}
+
+	prevote, err := q.GetAggregateExchangeRatePrevote(ctx, valAddr)
+	if err != nil {
+		return res, nil
+	}
+
+	res.HasPrevote = true
+	res.SubmitBlock = prevote.SubmitBlock
+	res.Revealable = q.IsPreviousVotePeriod(ctx, prevote.SubmitBlock) || q.IsRevealGracePeriod(ctx, prevote.SubmitBlock)
+
+	// The vote can be revealed in the vote period following the one of the prevote, and in the
+	// first blocks of the one after within the reveal grace
+	if q.VotePeriodDuration(ctx) == 0 {
+		votePeriod := q.VotePeriod(ctx)
+		res.RevealOpenBlock = (prevote.SubmitBlock/votePeriod + 1) * votePeriod
+		res.RevealCloseBlock = res.RevealOpenBlock + votePeriod + q.revealGraceBlocks(ctx) - 1
+	} else if q.IsPreviousVotePeriod(ctx, prevote.SubmitBlock) {
+		res.RevealOpenBlock = uint64(q.GetVotePeriodClock(ctx).StartHeight)
+	}
+
+	return res, nil
+}
+
+// BallotHistogram queries the votes of the last ballot of a denom bucketed by exchange rate, weighted by power
+func (q querier) BallotHistogram(c context.Context, req *types.QueryBallotHistogramRequest) (*types.QueryBallotHistogramResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.Denom) == 0 {
+		return nil, errors.Wrap(types.ErrInvalidDenom, "empty denom")
+	}
+
+	buckets := req.Buckets
+	if buckets == 0 {
+		buckets = types.DefaultHistogramBuckets
+	}
+	if buckets > types.MaxHistogramBuckets {
+		return nil, status.Errorf(codes.InvalidArgument, "buckets must not exceed %d", types.MaxHistogramBuckets)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ballot, ok := q.GetLastBallots(ctx)[req.Denom]
+	if !ok {
+		return nil, errors.Wrapf(types.ErrUnknownDenom, "%s has no ballot in the last vote period", req.Denom)
+	}
+
+	edges, powers, votes, err := ballot.Histogram(int(buckets))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBallotHistogramResponse{
+		Edges:       edges,
+		Powers:      powers,
+		Votes:       votes,
+		BallotPower: ballot.Power(),
+	}, nil
+}
+
+// SharedFeeders queries the groups of validators voting through the same feeder account
+func (q querier) SharedFeeders(c context.Context, _ *types.QuerySharedFeedersRequest) (*types.QuerySharedFeedersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	validators := map[string]map[string]struct{}{}
+	q.IterateFeederDelegations(ctx, func(delegator sdk.ValAddress, delegate sdk.AccAddress) (stop bool) {
+		feeder := delegate.String()
+		if validators[feeder] == nil {
+			validators[feeder] = map[string]struct{}{}
+		}
+		validators[feeder][delegator.String()] = struct{}{}
+
+		// The feeder may be the account of a validator voting by itself
+		valAddr := sdk.ValAddress(delegate)
+		if q.StakingKeeper.Validator(ctx, valAddr) != nil && q.GetFeederDelegation(ctx, valAddr).Equals(delegate) {
+			validators[feeder][valAddr.String()] = struct{}{}
+		}
+
+		return false
+	})
+
+	groups := []types.FeederGroup{}
+	for feeder, set := range validators {
+		if len(set) < 2 {
+			continue
+		}
+
+		group := make([]string, 0, len(set))
+		for validator := range set {
+			group = append(group, validator)
+		}
+
+		sort.Strings(group)
+		groups = append(groups, types.FeederGroup{Feeder: feeder, Validators: group})
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		if len(groups[i].Validators) != len(groups[j].Validators) {
+			return len(groups[i].Validators) > len(groups[j].Validators)
+		}
+		return groups[i].Feeder < groups[j].Feeder
+	})
+
+	return &types.QuerySharedFeedersResponse{Groups: groups}, nil
+}
+
+// LastTallyStats queries the structural counts of the ballots of the last tally
+func (q querier) LastTallyStats(c context.Context, _ *types.QueryLastTallyStatsRequest) (*types.QueryLastTallyStatsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	stats := q.GetLastTallyStats(ctx)
+	if stats.Denoms == nil {
+		stats.Denoms = []types.DenomTallyStats{}
+	}
+
+	return &types.QueryLastTallyStatsResponse{VotePeriod: stats.VotePeriod, Denoms: stats.Denoms}, nil
+}
+
+// RecommendedDenoms queries the whitelisted denoms a feeder must report, leaving out the ones in grace
+func (q querier) RecommendedDenoms(c context.Context, _ *types.QueryRecommendedDenomsRequest) (*types.QueryRecommendedDenomsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	votePeriod := q.CurrentVotePeriod(ctx)
+
+	// Resting denoms are kept, as a feeder configuration has to cover them in their due periods,
+	// while the denoms tracking another denom are never voted on
+	whitelist := q.Whitelist(ctx)
+	denoms := []string{}
+	graceDenoms := []string{}
+	for _, denom := range q.VoteTargets(ctx) {
+		if len(whitelist.TrackedDenom(denom)) != 0 {
+			continue
+		}
+		if q.IsDenomInGrace(ctx, denom, votePeriod) {
+			graceDenoms = append(graceDenoms, denom)
+		} else {
+			denoms = append(denoms, denom)
+		}
+	}
+	sort.Strings(denoms)
+	sort.Strings(graceDenoms)
+
+	return &types.QueryRecommendedDenomsResponse{Denoms: denoms, GraceDenoms: graceDenoms}, nil
+}
+
+// DenomStatuses queries the whitelisted denoms grouped by the state of their feed
+func (q querier) DenomStatuses(c context.Context, _ *types.QueryDenomStatusesRequest) (*types.QueryDenomStatusesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	votePeriod := q.CurrentVotePeriod(ctx)
+
+	// A denom in grace is pending whatever its rate. Otherwise, the rates are only kept while
+	// fresh, i.e. tallied in the last vote period or resting since, or carried forward.
+	statusDenoms := map[string][]string{}
+	for _, denom := range q.VoteTargets(ctx) {
+		var status string
+		_, err := q.GetExchangeRate(ctx, denom)
+		switch {
+		case q.IsDenomInGrace(ctx, denom, votePeriod):
+			status = types.DenomStatusPending
+		case err != nil:
+			status = types.DenomStatusFailing
+		case q.GetStaleCounter(ctx, denom) > 0:
+			status = types.DenomStatusStale
+		default:
+			status = types.DenomStatusActive
+		}
+		statusDenoms[status] = append(statusDenoms[status], denom)
+	}
+
+	buckets := make([]types.DenomStatusBucket, len(types.DenomStatuses))
+	for i, status := range types.DenomStatuses {
+		denoms := statusDenoms[status]
+		if denoms == nil {
+			denoms = []string{}
+		}
+		sort.Strings(denoms)
+		buckets[i] = types.DenomStatusBucket{Status: status, Count: uint64(len(denoms)), Denoms: denoms}
+	}
+
+	return &types.QueryDenomStatusesResponse{Buckets: buckets}, nil
+}
+
+// ParticipationSeries queries the participation ratio of the last vote periods, oldest first
+func (q querier) ParticipationSeries(c context.Context, req *types.QueryParticipationSeriesRequest) (*types.QueryParticipationSeriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = types.DefaultParticipationSeriesLimit
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParticipationSeriesResponse{Points: q.GetParticipationSeries(ctx, limit)}, nil
+}
+
+// MaxPeriodMove queries the largest move of the exchange rate of a denom between consecutive vote periods in the current window
+func (q querier) MaxPeriodMove(c context.Context, req *types.QueryMaxPeriodMoveRequest) (*types.QueryMaxPeriodMoveResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Denom) == 0 {
+		return nil, errors.Wrap(types.ErrInvalidDenom, "empty denom")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	window := q.MaxMoveWindow(ctx)
+	res := &types.QueryMaxPeriodMoveResponse{
+		MaxMovePercent: sdk.ZeroDec(),
+		WindowStart:    maxMoveWindowStart(q.CurrentVotePeriod(ctx), window),
+		Window:         window,
+	}
+
+	// A record of an earlier window is only replaced by the next tally of the denom
+	if maxMove, ok := q.GetDenomMaxMove(ctx, req.Denom); ok && window > 0 && maxMove.WindowStart == res.WindowStart {
+		res.MaxMovePercent = maxMove.MaxMovePercent
+		res.VotePeriod = maxMove.VotePeriod
+	}
+
+	return res, nil
+}
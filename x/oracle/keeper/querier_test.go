@@ -2,12 +2,20 @@ package keeper
 
 import (
 	"bytes"
+	"context"
+	"encoding/hex"
+	"reflect"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/Team-Kujira/core/x/oracle/types"
 )
@@ -41,6 +49,119 @@ func TestQueryExchangeRate(t *testing.T) {
 	})
 	require.NoError(t, err)
 	require.Equal(t, rate, res.ExchangeRate)
+	require.Equal(t, uint64(0), res.AgePeriods)
+	require.Equal(t, uint64(0), res.VoterCount)
+
+	// The voter count of the last successful tally is reported along with the rate
+	input.OracleKeeper.SetDenomVoterCount(input.Ctx, types.TestDenomD, 7)
+	res, err = querier.ExchangeRate(ctx, &types.QueryExchangeRateRequest{
+		Denom: types.TestDenomD,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), res.VoterCount)
+
+	// The rate ages while the denom fails to tally
+	input.OracleKeeper.SetStaleCounter(input.Ctx, types.TestDenomD, 3)
+	res, err = querier.ExchangeRate(ctx, &types.QueryExchangeRateRequest{
+		Denom: types.TestDenomD,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), res.AgePeriods)
+	require.Empty(t, res.QuoteDenom)
+	require.Equal(t, rate, res.UsdExchangeRate)
+
+	// The exchange rate of a denom quoted in another is converted to USD
+	input.OracleKeeper.SetWhitelist(input.Ctx, types.DenomList{{Name: types.TestDenomC, QuoteDenom: types.TestDenomD}, {Name: types.TestDenomD}})
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomC, sdk.NewDecWithPrec(2, 3))
+	res, err = querier.ExchangeRate(ctx, &types.QueryExchangeRateRequest{
+		Denom: types.TestDenomC,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(2, 3), res.ExchangeRate)
+	require.Equal(t, types.TestDenomD, res.QuoteDenom)
+	require.Equal(t, sdk.NewDecWithPrec(34, 1), res.UsdExchangeRate)
+
+	// Unless the quote denom has no exchange rate
+	input.OracleKeeper.DeleteExchangeRate(input.Ctx, types.TestDenomD)
+	res, err = querier.ExchangeRate(ctx, &types.QueryExchangeRateRequest{
+		Denom: types.TestDenomC,
+	})
+	require.NoError(t, err)
+	require.True(t, res.UsdExchangeRate.IsZero())
+
+	// The rate of a tracking denom reports the denom it derives from
+	input.OracleKeeper.SetWhitelist(input.Ctx, types.DenomList{{Name: types.TestDenomC, Tracks: types.TestDenomD}, {Name: types.TestDenomD}})
+	res, err = querier.ExchangeRate(ctx, &types.QueryExchangeRateRequest{
+		Denom: types.TestDenomC,
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.TestDenomD, res.Tracks)
+}
+
+func TestQueryErrors(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	invalidAddr := "invalid"
+	unknownValidator := sdk.ValAddress([]byte("unknown_validator___")).String()
+	valAddr := ValAddrs[0].String()
+
+	for _, tc := range []struct {
+		name  string
+		query func() error
+		err   error
+		code  codes.Code
+	}{
+		{"empty denom", func() error {
+			_, err := querier.ExchangeRate(ctx, &types.QueryExchangeRateRequest{})
+			return err
+		}, types.ErrInvalidDenom, codes.InvalidArgument},
+		{"unknown denom", func() error {
+			_, err := querier.ExchangeRate(ctx, &types.QueryExchangeRateRequest{Denom: types.TestDenomD})
+			return err
+		}, types.ErrUnknownDenom, codes.NotFound},
+		{"feeder delegation of invalid validator", func() error {
+			_, err := querier.FeederDelegation(ctx, &types.QueryFeederDelegationRequest{ValidatorAddr: invalidAddr})
+			return err
+		}, types.ErrInvalidValidator, codes.InvalidArgument},
+		{"miss counter of invalid validator", func() error {
+			_, err := querier.MissCounter(ctx, &types.QueryMissCounterRequest{ValidatorAddr: invalidAddr})
+			return err
+		}, types.ErrInvalidValidator, codes.InvalidArgument},
+		{"prevote of invalid validator", func() error {
+			_, err := querier.AggregatePrevote(ctx, &types.QueryAggregatePrevoteRequest{ValidatorAddr: invalidAddr})
+			return err
+		}, types.ErrInvalidValidator, codes.InvalidArgument},
+		{"no prevote", func() error {
+			_, err := querier.AggregatePrevote(ctx, &types.QueryAggregatePrevoteRequest{ValidatorAddr: valAddr})
+			return err
+		}, types.ErrNoAggregatePrevote, codes.NotFound},
+		{"no vote", func() error {
+			_, err := querier.AggregateVote(ctx, &types.QueryAggregateVoteRequest{ValidatorAddr: valAddr})
+			return err
+		}, types.ErrNoAggregateVote, codes.NotFound},
+		{"reward estimate of unknown validator", func() error {
+			_, err := querier.RewardEstimate(ctx, &types.QueryRewardEstimateRequest{ValidatorAddr: unknownValidator})
+			return err
+		}, types.ErrUnknownValidator, codes.NotFound},
+		{"invalid feeder", func() error {
+			_, err := querier.IsFeederAuthorized(ctx, &types.QueryIsFeederAuthorizedRequest{ValidatorAddr: valAddr, FeederAddr: invalidAddr})
+			return err
+		}, types.ErrInvalidFeeder, codes.InvalidArgument},
+		{"no submission", func() error {
+			_, err := querier.ValidatorRateDeviation(ctx, &types.QueryValidatorRateDeviationRequest{ValidatorAddr: valAddr})
+			return err
+		}, types.ErrNoAggregateVote, codes.NotFound},
+		{"missing denoms of invalid validator", func() error {
+			_, err := querier.ValidatorMissingDenoms(ctx, &types.QueryValidatorMissingDenomsRequest{ValidatorAddr: invalidAddr})
+			return err
+		}, types.ErrInvalidValidator, codes.InvalidArgument},
+	} {
+		err := tc.query()
+		require.ErrorIs(t, err, tc.err, tc.name)
+		require.Equal(t, tc.code, status.Code(err), tc.name)
+	}
 }
 
 func TestQueryMissCounter(t *testing.T) {
@@ -48,8 +169,9 @@ func TestQueryMissCounter(t *testing.T) {
 	ctx := sdk.WrapSDKContext(input.Ctx)
 	querier := NewQuerier(input.OracleKeeper)
 
-	missCounter := uint64(1)
+	missCounter := uint64(3)
 	input.OracleKeeper.SetMissCounter(input.Ctx, ValAddrs[0], missCounter)
+	input.OracleKeeper.SetRevealMissCounter(input.Ctx, ValAddrs[0], 1)
 
 	// empty request
 	_, err := querier.MissCounter(ctx, nil)
@@ -61,6 +183,58 @@ func TestQueryMissCounter(t *testing.T) {
 	})
 	require.NoError(t, err)
 	require.Equal(t, missCounter, res.MissCounter)
+	require.Equal(t, uint64(1), res.RevealMissCounter)
+}
+
+func TestQueryMissCounters(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	// empty request
+	_, err := querier.MissCounters(ctx, nil)
+	require.Error(t, err)
+
+	// 100 vote periods per slash window, at most 50 may be missed
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.VotePeriod = 1
+	params.SlashWindow = 100
+	params.MinValidPerWindow = sdk.NewDecWithPrec(5, 1)
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	input.OracleKeeper.SetMissCounter(input.Ctx, ValAddrs[0], 10)
+	input.OracleKeeper.SetMissCounter(input.Ctx, ValAddrs[1], 50)
+	input.OracleKeeper.SetMissCounter(input.Ctx, ValAddrs[2], 51)
+
+	res, err := querier.MissCounters(ctx, &types.QueryMissCountersRequest{})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []types.MissCounterStatus{
+		{ValidatorAddr: ValAddrs[0].String(), MissCounter: 10, AtRisk: false},
+		{ValidatorAddr: ValAddrs[1].String(), MissCounter: 50, AtRisk: false},
+		{ValidatorAddr: ValAddrs[2].String(), MissCounter: 51, AtRisk: true},
+	}, res.MissCounters)
+
+	// Misses without a reveal weigh less with a RevealMissWeight below one
+	params.RevealMissWeight = sdk.NewDecWithPrec(5, 1)
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	input.OracleKeeper.SetRevealMissCounter(input.Ctx, ValAddrs[2], 2)
+
+	res, err = querier.MissCounters(ctx, &types.QueryMissCountersRequest{})
+	require.NoError(t, err)
+	require.Contains(t, res.MissCounters, types.MissCounterStatus{
+		ValidatorAddr: ValAddrs[2].String(), MissCounter: 51, AtRisk: false, RevealMissCounter: 2,
+	})
+
+	// paginated
+	res, err = querier.MissCounters(ctx, &types.QueryMissCountersRequest{Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Len(t, res.MissCounters, 2)
+	require.NotEmpty(t, res.Pagination.NextKey)
+
+	next, err := querier.MissCounters(ctx, &types.QueryMissCountersRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, next.MissCounters, 1)
+	require.Empty(t, next.Pagination.NextKey)
 }
 
 func TestQueryExchangeRates(t *testing.T) {
@@ -68,17 +242,29 @@ func TestQueryExchangeRates(t *testing.T) {
 	ctx := sdk.WrapSDKContext(input.Ctx)
 	querier := NewQuerier(input.OracleKeeper)
 
+	// A fresh chain with an empty whitelist has empty lists
+	input.OracleKeeper.SetWhitelist(input.Ctx, types.DenomList{})
+	res, err := querier.ExchangeRates(ctx, &types.QueryExchangeRatesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.DecCoins{}, res.ExchangeRates)
+	require.Equal(t, []types.ExchangeRateAge{}, res.AgePeriods)
+
 	rate := sdk.NewDec(1700)
 	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomD, rate)
 	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomB, rate)
+	input.OracleKeeper.SetStaleCounter(input.Ctx, types.TestDenomD, 2)
 
-	res, err := querier.ExchangeRates(ctx, &types.QueryExchangeRatesRequest{})
+	res, err = querier.ExchangeRates(ctx, &types.QueryExchangeRatesRequest{})
 	require.NoError(t, err)
 
 	require.Equal(t, sdk.DecCoins{
 		sdk.NewDecCoinFromDec(types.TestDenomB, rate),
 		sdk.NewDecCoinFromDec(types.TestDenomD, rate),
 	}, res.ExchangeRates)
+	require.Equal(t, []types.ExchangeRateAge{
+		{Denom: types.TestDenomB, AgePeriods: 0},
+		{Denom: types.TestDenomD, AgePeriods: 2},
+	}, res.AgePeriods)
 }
 
 func TestQueryActives(t *testing.T) {
@@ -86,12 +272,18 @@ func TestQueryActives(t *testing.T) {
 	ctx := sdk.WrapSDKContext(input.Ctx)
 	querier := NewQuerier(input.OracleKeeper)
 
+	// A fresh chain with an empty whitelist has no active denoms
+	input.OracleKeeper.SetWhitelist(input.Ctx, types.DenomList{})
+	res, err := querier.Actives(ctx, &types.QueryActivesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{}, res.Actives)
+
 	rate := sdk.NewDec(1700)
 	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomD, rate)
 	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomC, rate)
 	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomB, rate)
 
-	res, err := querier.Actives(ctx, &types.QueryActivesRequest{})
+	res, err = querier.Actives(ctx, &types.QueryActivesRequest{})
 	require.NoError(t, err)
 
 	targetDenoms := []string{
@@ -122,6 +314,136 @@ func TestQueryFeederDelegation(t *testing.T) {
 	require.Equal(t, Addrs[1].String(), res.FeederAddr)
 }
 
+func TestQuerySharedFeeders(t *testing.T) {
+	input, _ := setup(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	res, err := querier.SharedFeeders(ctx, &types.QuerySharedFeedersRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Groups)
+
+	// The account of validator 2 feeds it as well as validator 3
+	input.OracleKeeper.SetFeederDelegation(input.Ctx, ValAddrs[0], Addrs[4])
+	input.OracleKeeper.SetFeederDelegation(input.Ctx, ValAddrs[1], Addrs[4])
+	input.OracleKeeper.SetFeederDelegation(input.Ctx, ValAddrs[3], Addrs[2])
+	input.OracleKeeper.SetFeederDelegation(input.Ctx, ValAddrs[4], Addrs[4])
+
+	res, err = querier.SharedFeeders(ctx, &types.QuerySharedFeedersRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Groups, 2)
+	require.Equal(t, Addrs[4].String(), res.Groups[0].Feeder)
+	require.ElementsMatch(t, []string{ValAddrs[0].String(), ValAddrs[1].String(), ValAddrs[4].String()}, res.Groups[0].Validators)
+	require.True(t, sort.StringsAreSorted(res.Groups[0].Validators))
+	shared := []string{ValAddrs[2].String(), ValAddrs[3].String()}
+	sort.Strings(shared)
+	require.Equal(t, types.FeederGroup{Feeder: Addrs[2].String(), Validators: shared}, res.Groups[1])
+
+	// Validator 2 no longer feeds by itself, leaving validator 3 alone
+	input.OracleKeeper.SetFeederDelegation(input.Ctx, ValAddrs[2], Addrs[3])
+
+	res, err = querier.SharedFeeders(ctx, &types.QuerySharedFeedersRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Groups, 1)
+	require.Equal(t, Addrs[4].String(), res.Groups[0].Feeder)
+}
+
+func TestQueryLastTallyStats(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	res, err := querier.LastTallyStats(ctx, &types.QueryLastTallyStatsRequest{})
+	require.NoError(t, err)
+	require.Zero(t, res.VotePeriod)
+	require.Empty(t, res.Denoms)
+
+	stats := []types.DenomTallyStats{{Denom: types.TestDenomA, Votes: 3, AbstainVotes: 1, Tallied: true, CappedVotes: 1}}
+	input.OracleKeeper.SetLastTallyStats(input.Ctx, types.TallyStats{VotePeriod: 5, Denoms: stats})
+
+	res, err = querier.LastTallyStats(ctx, &types.QueryLastTallyStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), res.VotePeriod)
+	require.Equal(t, stats, res.Denoms)
+}
+
+func TestQueryRecommendedDenoms(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	input.OracleKeeper.SetWhitelist(input.Ctx, types.DenomList{
+		{Name: types.TestDenomD},
+		{Name: types.TestDenomC, VotePeriodMultiplier: 5},
+		{Name: types.TestDenomB},
+	})
+
+	// Denom B is in grace until the next vote period
+	votePeriod := input.OracleKeeper.CurrentVotePeriod(input.Ctx)
+	input.OracleKeeper.SetDenomGraceExit(input.Ctx, types.TestDenomB, votePeriod+1)
+
+	res, err := querier.RecommendedDenoms(ctx, &types.QueryRecommendedDenomsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{types.TestDenomC, types.TestDenomD}, res.Denoms)
+	require.Equal(t, []string{types.TestDenomB}, res.GraceDenoms)
+
+	// Once the grace ended, the denom has to be reported
+	input.OracleKeeper.SetDenomGraceExit(input.Ctx, types.TestDenomB, votePeriod)
+	res, err = querier.RecommendedDenoms(ctx, &types.QueryRecommendedDenomsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{types.TestDenomB, types.TestDenomC, types.TestDenomD}, res.Denoms)
+	require.Empty(t, res.GraceDenoms)
+
+	// A denom tracking another one is never voted on
+	input.OracleKeeper.SetWhitelist(input.Ctx, types.DenomList{
+		{Name: types.TestDenomD},
+		{Name: types.TestDenomE, Tracks: types.TestDenomD},
+	})
+	res, err = querier.RecommendedDenoms(ctx, &types.QueryRecommendedDenomsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{types.TestDenomD}, res.Denoms)
+}
+
+func TestQueryDenomStatuses(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	input.OracleKeeper.SetWhitelist(input.Ctx, types.DenomList{
+		{Name: types.TestDenomA},
+		{Name: types.TestDenomB},
+		{Name: types.TestDenomC},
+		{Name: types.TestDenomD},
+		{Name: types.TestDenomE},
+	})
+
+	// Denom A tallied, denom B is carried forward, denom C is in grace and denoms D and E failed
+	votePeriod := input.OracleKeeper.CurrentVotePeriod(input.Ctx)
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomA, sdk.OneDec())
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomB, sdk.OneDec())
+	input.OracleKeeper.SetStaleCounter(input.Ctx, types.TestDenomB, 2)
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomC, sdk.OneDec())
+	input.OracleKeeper.SetDenomGraceExit(input.Ctx, types.TestDenomC, votePeriod+1)
+	input.OracleKeeper.SetStaleCounter(input.Ctx, types.TestDenomD, 1)
+
+	res, err := querier.DenomStatuses(ctx, &types.QueryDenomStatusesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.DenomStatusBucket{
+		{Status: types.DenomStatusActive, Count: 1, Denoms: []string{types.TestDenomA}},
+		{Status: types.DenomStatusStale, Count: 1, Denoms: []string{types.TestDenomB}},
+		{Status: types.DenomStatusPending, Count: 1, Denoms: []string{types.TestDenomC}},
+		{Status: types.DenomStatusFailing, Count: 2, Denoms: []string{types.TestDenomD, types.TestDenomE}},
+	}, res.Buckets)
+
+	// Empty buckets are still reported
+	input.OracleKeeper.SetWhitelist(input.Ctx, types.DenomList{{Name: types.TestDenomA}})
+	res, err = querier.DenomStatuses(ctx, &types.QueryDenomStatusesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Buckets, 4)
+	require.Equal(t, uint64(0), res.Buckets[3].Count)
+	require.Empty(t, res.Buckets[3].Denoms)
+}
+
 func TestQueryAggregatePrevote(t *testing.T) {
 	input := CreateTestInput(t)
 	ctx := sdk.WrapSDKContext(input.Ctx)
@@ -175,6 +497,84 @@ func TestQueryAggregatePrevotes(t *testing.T) {
 	require.Equal(t, expectedPrevotes, res.AggregatePrevotes)
 }
 
+func TestQueryPendingReveals(t *testing.T) {
+	input := CreateTestInput(t)
+	votePeriod := input.OracleKeeper.VotePeriod(input.Ctx)
+	input.Ctx = input.Ctx.WithBlockHeight(int64(votePeriod) + 2)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	// empty request
+	_, err := querier.PendingReveals(ctx, nil)
+	require.Error(t, err)
+
+	// Validator 0 prevoted in the previous vote period, validator 1 revealed its
+	// prevote already and validator 2 prevoted in the current vote period
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[0], types.NewAggregateExchangeRatePrevote(types.AggregateVoteHash{}, ValAddrs[0], 3))
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[1], types.NewAggregateExchangeRatePrevote(types.AggregateVoteHash{}, ValAddrs[1], 5))
+	input.OracleKeeper.SetAggregateExchangeRateVote(input.Ctx, ValAddrs[1], types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{
+		{Denom: types.TestDenomA, ExchangeRate: sdk.OneDec()},
+	}, ValAddrs[1]))
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[2], types.NewAggregateExchangeRatePrevote(types.AggregateVoteHash{}, ValAddrs[2], votePeriod+1))
+
+	res, err := querier.PendingReveals(ctx, &types.QueryPendingRevealsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.PendingReveal{
+		{Voter: ValAddrs[0].String(), SubmitBlock: 3, RemainingBlocks: votePeriod - 3},
+	}, res.PendingReveals)
+}
+
+func TestQueryRevealWindowStatus(t *testing.T) {
+	input := CreateTestInput(t)
+	votePeriod := input.OracleKeeper.VotePeriod(input.Ctx)
+	input.Ctx = input.Ctx.WithBlockHeight(int64(votePeriod) + 2)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	_, err := querier.RevealWindowStatus(ctx, nil)
+	require.Error(t, err)
+	_, err = querier.RevealWindowStatus(ctx, &types.QueryRevealWindowStatusRequest{ValidatorAddr: "invalid"})
+	require.ErrorIs(t, err, types.ErrInvalidValidator)
+
+	// Without a prevote
+	status := func(valAddr sdk.ValAddress) *types.QueryRevealWindowStatusResponse {
+		res, err := querier.RevealWindowStatus(ctx, &types.QueryRevealWindowStatusRequest{ValidatorAddr: valAddr.String()})
+		require.NoError(t, err)
+		return res
+	}
+	require.Equal(t, &types.QueryRevealWindowStatusResponse{VotePeriod: 1}, status(ValAddrs[0]))
+
+	// A prevote of the previous vote period can be revealed in the current one
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[0], types.NewAggregateExchangeRatePrevote(types.AggregateVoteHash{}, ValAddrs[0], 3))
+	require.Equal(t, &types.QueryRevealWindowStatusResponse{
+		VotePeriod:       1,
+		HasPrevote:       true,
+		SubmitBlock:      3,
+		RevealOpenBlock:  votePeriod,
+		RevealCloseBlock: 2*votePeriod - 1,
+		Revealable:       true,
+	}, status(ValAddrs[0]))
+
+	// A prevote of the current vote period only in the next one, later with a reveal grace
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.RevealGraceBlocks = 2
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[1], types.NewAggregateExchangeRatePrevote(types.AggregateVoteHash{}, ValAddrs[1], votePeriod+1))
+	require.Equal(t, &types.QueryRevealWindowStatusResponse{
+		VotePeriod:       1,
+		HasPrevote:       true,
+		SubmitBlock:      votePeriod + 1,
+		RevealOpenBlock:  2 * votePeriod,
+		RevealCloseBlock: 3*votePeriod + 1,
+	}, status(ValAddrs[1]))
+
+	// A revealed vote
+	input.OracleKeeper.SetAggregateExchangeRateVote(input.Ctx, ValAddrs[2], types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{
+		{Denom: types.TestDenomA, ExchangeRate: sdk.OneDec()},
+	}, ValAddrs[2]))
+	require.Equal(t, &types.QueryRevealWindowStatusResponse{VotePeriod: 1, Revealed: true}, status(ValAddrs[2]))
+}
+
 func TestQueryAggregateVote(t *testing.T) {
 	input := CreateTestInput(t)
 	ctx := sdk.WrapSDKContext(input.Ctx)
@@ -227,3 +627,974 @@ func TestQueryAggregateVotes(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedVotes, res.AggregateVotes)
 }
+
+func TestQueryRewardEstimate(t *testing.T) {
+	input, _ := setup(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomA}}
+	params.OracleFeeShare = sdk.NewDecWithPrec(5, 1)
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	acc := input.AccountKeeper.GetModuleAccount(input.Ctx, types.ModuleName)
+	require.NoError(t, FundAccount(input, acc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin(types.TestDenomA, 1000000000))))
+	input.OracleKeeper.SetWinningPower(input.Ctx, 20)
+
+	// empty request
+	_, err := querier.RewardEstimate(ctx, nil)
+	require.Error(t, err)
+
+	res, err := querier.RewardEstimate(ctx, &types.QueryRewardEstimateRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(20), res.WinningPower)
+	require.False(t, res.RewardPerPower.IsZero())
+	require.Empty(t, res.ValidatorReward)
+
+	// validator with a power of 10
+	res, err = querier.RewardEstimate(ctx, &types.QueryRewardEstimateRequest{ValidatorAddr: ValAddrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, res.RewardPerPower.MulDec(sdk.NewDec(10)), res.ValidatorReward)
+
+	// invalid validator
+	_, err = querier.RewardEstimate(ctx, &types.QueryRewardEstimateRequest{ValidatorAddr: "invalid"})
+	require.Error(t, err)
+}
+
+func TestQueryVoteHashSpec(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	res, err := querier.VoteHashSpec(ctx, &types.QueryVoteHashSpecRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.GetVoteHashSpec(types.DefaultCommitmentHashAlgo), *res)
+	require.Equal(t, uint32(types.SaltLength), res.SaltLength)
+
+	// the spec follows the commitment hash algorithm in effect
+	input.OracleKeeper.SetCommitmentHashAlgo(input.Ctx, types.CommitmentHashAlgoSHA256)
+	res, err = querier.VoteHashSpec(ctx, &types.QueryVoteHashSpecRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.CommitmentHashAlgoSHA256, res.CommitmentHashAlgo)
+	require.Equal(t, uint32(32), res.HashLength)
+}
+
+func TestQueryModuleInfo(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	res, err := querier.ModuleInfo(ctx, &types.QueryModuleInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(types.ConsensusVersion), res.ConsensusVersion)
+	require.Equal(t, types.AggregationMethodMedian, res.Features[types.FeatureAggregationMethod])
+	require.Equal(t, "false", res.Features[types.FeatureTimedVotePeriods])
+	require.Equal(t, "false", res.Features[types.FeatureVotePeriodMultipliers])
+	require.Equal(t, "true", res.Features[types.FeatureLegacyRateEvents])
+
+	// Unsupported features are left out rather than disabled
+	_, ok := res.Features["twap"]
+	require.False(t, ok)
+
+	// The features follow the params
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.VotePeriodDuration = time.Minute
+	params.AggregationMethod = types.AggregationMethodMode
+	params.Whitelist = types.DenomList{{Name: types.TestDenomA}, {Name: types.TestDenomB, VotePeriodMultiplier: 4}}
+	params.MaxPowerShare = sdk.NewDecWithPrec(25, 2)
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	res, err = querier.ModuleInfo(ctx, &types.QueryModuleInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.AggregationMethodMode, res.Features[types.FeatureAggregationMethod])
+	require.Equal(t, "true", res.Features[types.FeatureTimedVotePeriods])
+	require.Equal(t, "true", res.Features[types.FeatureVotePeriodMultipliers])
+	require.Equal(t, "true", res.Features[types.FeaturePowerCap])
+	require.Equal(t, "false", res.Features[types.FeatureQuoteDenoms])
+}
+
+func TestQueryIsFeederAuthorized(t *testing.T) {
+	input, _ := setup(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	input.OracleKeeper.SetFeederDelegation(input.Ctx, ValAddrs[0], Addrs[1])
+
+	// empty request
+	_, err := querier.IsFeederAuthorized(ctx, nil)
+	require.Error(t, err)
+
+	// invalid feeder address
+	_, err = querier.IsFeederAuthorized(ctx, &types.QueryIsFeederAuthorizedRequest{ValidatorAddr: ValAddrs[0].String(), FeederAddr: "invalid"})
+	require.Error(t, err)
+
+	// delegated feeder
+	res, err := querier.IsFeederAuthorized(ctx, &types.QueryIsFeederAuthorizedRequest{ValidatorAddr: ValAddrs[0].String(), FeederAddr: Addrs[1].String()})
+	require.NoError(t, err)
+	require.True(t, res.Authorized)
+	require.Equal(t, Addrs[1].String(), res.AuthorizedFeeder)
+	require.Empty(t, res.Reason)
+
+	// the validator itself
+	res, err = querier.IsFeederAuthorized(ctx, &types.QueryIsFeederAuthorizedRequest{ValidatorAddr: ValAddrs[0].String(), FeederAddr: Addrs[0].String()})
+	require.NoError(t, err)
+	require.True(t, res.Authorized)
+
+	// another account
+	res, err = querier.IsFeederAuthorized(ctx, &types.QueryIsFeederAuthorizedRequest{ValidatorAddr: ValAddrs[0].String(), FeederAddr: Addrs[2].String()})
+	require.NoError(t, err)
+	require.False(t, res.Authorized)
+	require.Equal(t, Addrs[1].String(), res.AuthorizedFeeder)
+	require.Contains(t, res.Reason, types.ErrNoVotingPermission.Error())
+
+	// validator not in the active set
+	res, err = querier.IsFeederAuthorized(ctx, &types.QueryIsFeederAuthorizedRequest{ValidatorAddr: ValAddrs[3].String(), FeederAddr: Addrs[3].String()})
+	require.NoError(t, err)
+	require.False(t, res.Authorized)
+	require.NotEmpty(t, res.Reason)
+}
+
+func TestQueryDenomBackingPower(t *testing.T) {
+	input, _ := setup(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+	power := sdk.TokensToConsensusPower(stakingAmt, sdk.DefaultPowerReduction)
+
+	input.OracleKeeper.SetAggregateExchangeRateVote(input.Ctx, ValAddrs[0], types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{
+		{Denom: types.TestDenomC, ExchangeRate: sdk.OneDec()},
+		{Denom: types.TestDenomD, ExchangeRate: sdk.OneDec()},
+	}, ValAddrs[0]))
+	input.OracleKeeper.SetAggregateExchangeRateVote(input.Ctx, ValAddrs[1], types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{
+		{Denom: types.TestDenomC, ExchangeRate: sdk.OneDec()},
+		{Denom: types.TestDenomD, ExchangeRate: sdk.ZeroDec()},
+	}, ValAddrs[1]))
+	// not bonded
+	input.OracleKeeper.SetAggregateExchangeRateVote(input.Ctx, ValAddrs[3], types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{
+		{Denom: types.TestDenomC, ExchangeRate: sdk.OneDec()},
+	}, ValAddrs[3]))
+
+	// empty request
+	_, err := querier.DenomBackingPower(ctx, nil)
+	require.Error(t, err)
+
+	res, err := querier.DenomBackingPower(ctx, &types.QueryDenomBackingPowerRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.DenomBackingPower{
+		{Denom: types.TestDenomC, Power: 2 * power},
+		{Denom: types.TestDenomD, Power: power},
+	}, res.BackingPowers)
+
+	res, err = querier.DenomBackingPower(ctx, &types.QueryDenomBackingPowerRequest{Denom: types.TestDenomD})
+	require.NoError(t, err)
+	require.Equal(t, []types.DenomBackingPower{{Denom: types.TestDenomD, Power: power}}, res.BackingPowers)
+
+	// denom without votes
+	res, err = querier.DenomBackingPower(ctx, &types.QueryDenomBackingPowerRequest{Denom: types.TestDenomA})
+	require.NoError(t, err)
+	require.Equal(t, []types.DenomBackingPower{{Denom: types.TestDenomA, Power: 0}}, res.BackingPowers)
+}
+
+func TestQueryDenomTallySuccessRate(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	// empty request
+	_, err := querier.DenomTallySuccessRate(ctx, nil)
+	require.Error(t, err)
+
+	input.OracleKeeper.CountDenomTally(input.Ctx, types.TestDenomA, true)
+	input.OracleKeeper.CountDenomTally(input.Ctx, types.TestDenomA, true)
+	input.OracleKeeper.CountDenomTally(input.Ctx, types.TestDenomA, false)
+	input.OracleKeeper.CountDenomTally(input.Ctx, types.TestDenomC, false)
+
+	res, err := querier.DenomTallySuccessRate(ctx, &types.QueryDenomTallySuccessRateRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.DenomTallySuccessRate{
+		{Denom: types.TestDenomC, SuccessPeriods: 0, FailedPeriods: 1},
+		{Denom: types.TestDenomA, SuccessPeriods: 2, FailedPeriods: 1},
+	}, res.SuccessRates)
+
+	// filter by denom, reporting zeros for a denom without tallies
+	res, err = querier.DenomTallySuccessRate(ctx, &types.QueryDenomTallySuccessRateRequest{Denom: types.TestDenomA})
+	require.NoError(t, err)
+	require.Equal(t, []types.DenomTallySuccessRate{{Denom: types.TestDenomA, SuccessPeriods: 2, FailedPeriods: 1}}, res.SuccessRates)
+
+	res, err = querier.DenomTallySuccessRate(ctx, &types.QueryDenomTallySuccessRateRequest{Denom: types.TestDenomB})
+	require.NoError(t, err)
+	require.Equal(t, []types.DenomTallySuccessRate{{Denom: types.TestDenomB}}, res.SuccessRates)
+
+	// counters are cleared with the slash window
+	input.OracleKeeper.ClearDenomTallyCounters(input.Ctx)
+	res, err = querier.DenomTallySuccessRate(ctx, &types.QueryDenomTallySuccessRateRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.SuccessRates)
+}
+
+func TestQueryBandMembershipStats(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	// empty request
+	_, err := querier.BandMembershipStats(ctx, nil)
+	require.Error(t, err)
+
+	res, err := querier.BandMembershipStats(ctx, &types.QueryBandMembershipStatsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Stats)
+
+	bounds := types.TallyBounds{LowerBound: sdk.NewDec(1), UpperBound: sdk.NewDec(2), RatedPower: 40, InBandPower: 30}
+	input.OracleKeeper.SetTallyBounds(input.Ctx, types.TestDenomA, bounds)
+	input.OracleKeeper.SetTallyBounds(input.Ctx, types.TestDenomC, types.TallyBounds{LowerBound: sdk.NewDec(1), UpperBound: sdk.NewDec(2)})
+
+	res, err = querier.BandMembershipStats(ctx, &types.QueryBandMembershipStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.BandMembershipStats{
+		{Denom: types.TestDenomC, InBandFraction: sdk.ZeroDec()},
+		{Denom: types.TestDenomA, RatedPower: 40, InBandPower: 30, InBandFraction: sdk.NewDecWithPrec(75, 2)},
+	}, res.Stats)
+
+	// filter by denom
+	res, err = querier.BandMembershipStats(ctx, &types.QueryBandMembershipStatsRequest{Denom: types.TestDenomA})
+	require.NoError(t, err)
+	require.Equal(t, []types.BandMembershipStats{
+		{Denom: types.TestDenomA, RatedPower: 40, InBandPower: 30, InBandFraction: sdk.NewDecWithPrec(75, 2)},
+	}, res.Stats)
+
+	_, err = querier.BandMembershipStats(ctx, &types.QueryBandMembershipStatsRequest{Denom: types.TestDenomB})
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+}
+
+func TestQueryRequiredDenoms(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	// empty request
+	_, err := querier.RequiredDenoms(ctx, nil)
+	require.Error(t, err)
+
+	res, err := querier.RequiredDenoms(ctx, &types.QueryRequiredDenomsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.RequiredDenoms)
+
+	require.NoError(t, input.OracleKeeper.RegisterRequiredDenom(input.Ctx, types.TestDenomA, "lending"))
+	require.NoError(t, input.OracleKeeper.RegisterRequiredDenom(input.Ctx, types.TestDenomA, "cdp"))
+	require.NoError(t, input.OracleKeeper.RegisterRequiredDenom(input.Ctx, types.TestDenomC, "lending"))
+
+	res, err = querier.RequiredDenoms(ctx, &types.QueryRequiredDenomsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.RequiredDenom{
+		{Denom: types.TestDenomC, Modules: []string{"lending"}},
+		{Denom: types.TestDenomA, Modules: []string{"cdp", "lending"}},
+	}, res.RequiredDenoms)
+}
+
+func TestQueryObservers(t *testing.T) {
+	input := CreateTestInput(t)
+	input.Ctx = input.Ctx.WithBlockHeight(100)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	// empty request
+	_, err := querier.Observers(ctx, nil)
+	require.Error(t, err)
+
+	res, err := querier.Observers(ctx, &types.QueryObserversRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Observers)
+
+	input.OracleKeeper.SetObserverExemption(input.Ctx, ValAddrs[0], 300)
+	input.OracleKeeper.SetObserverExemption(input.Ctx, ValAddrs[1], 200)
+	// expired, but not pruned yet
+	input.OracleKeeper.SetObserverExemption(input.Ctx, ValAddrs[2], 100)
+
+	res, err = querier.Observers(ctx, &types.QueryObserversRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.Observer{
+		{Validator: ValAddrs[1].String(), ExemptUntilHeight: 200},
+		{Validator: ValAddrs[0].String(), ExemptUntilHeight: 300},
+	}, res.Observers)
+}
+
+func TestQueryValidatorAccuracyRanking(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	// empty request
+	_, err := querier.ValidatorAccuracyRanking(ctx, nil)
+	require.Error(t, err)
+
+	res, err := querier.ValidatorAccuracyRanking(ctx, &types.QueryValidatorAccuracyRankingRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Ranking)
+
+	input.OracleKeeper.SetValidatorAccuracyCounter(input.Ctx, ValAddrs[0], types.ValidatorAccuracyCounter{Submissions: 4, InBandSubmissions: 1})
+	input.OracleKeeper.SetValidatorAccuracyCounter(input.Ctx, ValAddrs[1], types.ValidatorAccuracyCounter{
+		Submissions:               2,
+		InBandSubmissions:         2,
+		PreviousSubmissions:       2,
+		PreviousInBandSubmissions: 1,
+	})
+	input.OracleKeeper.SetValidatorAccuracyCounter(input.Ctx, ValAddrs[2], types.ValidatorAccuracyCounter{Submissions: 8, InBandSubmissions: 6})
+	input.OracleKeeper.SetValidatorAccuracyCounter(input.Ctx, ValAddrs[3], types.ValidatorAccuracyCounter{})
+
+	mostAccurate := types.ValidatorAccuracy{ValidatorAddr: ValAddrs[2].String(), Submissions: 8, InBandSubmissions: 6, InBandFraction: sdk.NewDecWithPrec(75, 2)}
+	sameShare := types.ValidatorAccuracy{ValidatorAddr: ValAddrs[1].String(), Submissions: 4, InBandSubmissions: 3, InBandFraction: sdk.NewDecWithPrec(75, 2)}
+	leastAccurate := types.ValidatorAccuracy{ValidatorAddr: ValAddrs[0].String(), Submissions: 4, InBandSubmissions: 1, InBandFraction: sdk.NewDecWithPrec(25, 2)}
+
+	// Validators without submissions are left out, equal shares are ranked by the submissions
+	res, err = querier.ValidatorAccuracyRanking(ctx, &types.QueryValidatorAccuracyRankingRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.ValidatorAccuracy{mostAccurate, sameShare, leastAccurate}, res.Ranking)
+
+	res, err = querier.ValidatorAccuracyRanking(ctx, &types.QueryValidatorAccuracyRankingRequest{Ascending: true})
+	require.NoError(t, err)
+	require.Equal(t, []types.ValidatorAccuracy{leastAccurate, mostAccurate, sameShare}, res.Ranking)
+
+	res, err = querier.ValidatorAccuracyRanking(ctx, &types.QueryValidatorAccuracyRankingRequest{Limit: 1, Ascending: true})
+	require.NoError(t, err)
+	require.Equal(t, []types.ValidatorAccuracy{leastAccurate}, res.Ranking)
+}
+
+func TestQueryDenomTallyDiagnosis(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	// empty request
+	_, err := querier.DenomTallyDiagnosis(ctx, nil)
+	require.Error(t, err)
+
+	_, err = querier.DenomTallyDiagnosis(ctx, &types.QueryDenomTallyDiagnosisRequest{})
+	require.ErrorIs(t, err, types.ErrInvalidDenom)
+
+	_, err = querier.DenomTallyDiagnosis(ctx, &types.QueryDenomTallyDiagnosisRequest{Denom: types.TestDenomA})
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+
+	outcome := types.DenomTallyOutcome{
+		Reason:           types.TallyOutcomeBelowThreshold,
+		VotePeriod:       7,
+		AchievedFraction: sdk.NewDecWithPrec(4, 1),
+		RequiredFraction: sdk.NewDecWithPrec(5, 1),
+	}
+	input.OracleKeeper.SetDenomTallyOutcome(input.Ctx, types.TestDenomA, outcome)
+
+	res, err := querier.DenomTallyDiagnosis(ctx, &types.QueryDenomTallyDiagnosisRequest{Denom: types.TestDenomA})
+	require.NoError(t, err)
+	require.Equal(t, outcome, res.Outcome)
+}
+
+func TestQueryParticipationSummary(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	// empty request
+	_, err := querier.ParticipationSummary(ctx, nil)
+	require.Error(t, err)
+
+	// Nothing was tallied yet
+	res, err := querier.ParticipationSummary(ctx, &types.QueryParticipationSummaryRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.QueryParticipationSummaryResponse{ParticipationRatio: sdk.ZeroDec()}, *res)
+
+	input.OracleKeeper.SetVotePeriodParticipation(input.Ctx, types.VotePeriodParticipation{
+		BondedPower:      40,
+		BondedValidators: 4,
+		VotedPower:       30,
+		Voters:           2,
+	})
+
+	res, err = querier.ParticipationSummary(ctx, &types.QueryParticipationSummaryRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.QueryParticipationSummaryResponse{
+		BondedPower:        40,
+		BondedValidators:   4,
+		VotedPower:         30,
+		Voters:             2,
+		ParticipationRatio: sdk.NewDecWithPrec(75, 2),
+	}, *res)
+}
+
+func TestQueryMaxPeriodMove(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	// empty request
+	_, err := querier.MaxPeriodMove(ctx, nil)
+	require.Error(t, err)
+	_, err = querier.MaxPeriodMove(ctx, &types.QueryMaxPeriodMoveRequest{})
+	require.ErrorIs(t, err, types.ErrInvalidDenom)
+
+	// Nothing is reported while the tracking is disabled
+	votePeriod := input.OracleKeeper.CurrentVotePeriod(input.Ctx)
+	input.OracleKeeper.SetDenomMaxMove(input.Ctx, types.TestDenomA, types.DenomMaxMove{
+		WindowStart:    votePeriod,
+		MaxMovePercent: sdk.NewDec(3),
+		VotePeriod:     votePeriod,
+	})
+	res, err := querier.MaxPeriodMove(ctx, &types.QueryMaxPeriodMoveRequest{Denom: types.TestDenomA})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryMaxPeriodMoveResponse{MaxMovePercent: sdk.ZeroDec()}, res)
+
+	// The move of the current window is reported
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.MaxMoveWindow = 1
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	res, err = querier.MaxPeriodMove(ctx, &types.QueryMaxPeriodMoveRequest{Denom: types.TestDenomA})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryMaxPeriodMoveResponse{
+		MaxMovePercent: sdk.NewDec(3),
+		VotePeriod:     votePeriod,
+		WindowStart:    votePeriod,
+		Window:         1,
+	}, res)
+
+	// Not the one of an earlier window
+	next := input.Ctx.WithBlockHeight(input.Ctx.BlockHeight() + int64(params.VotePeriod))
+	res, err = querier.MaxPeriodMove(sdk.WrapSDKContext(next), &types.QueryMaxPeriodMoveRequest{Denom: types.TestDenomA})
+	require.NoError(t, err)
+	require.True(t, res.MaxMovePercent.IsZero())
+	require.Equal(t, votePeriod+1, res.WindowStart)
+}
+
+func TestQueryParticipationSeries(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	// empty request
+	_, err := querier.ParticipationSeries(ctx, nil)
+	require.Error(t, err)
+
+	res, err := querier.ParticipationSeries(ctx, &types.QueryParticipationSeriesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Points)
+
+	// The ring wraps around after its length, the vote period of slot 3 is skipped in the second round
+	last := uint64(types.ParticipationSeriesLength + 4)
+	point := func(votePeriod uint64) types.ParticipationPoint {
+		return types.ParticipationPoint{VotePeriod: votePeriod, ParticipationRatio: sdk.NewDecWithPrec(int64(votePeriod%100), 2)}
+	}
+	for votePeriod := uint64(1); votePeriod <= last; votePeriod++ {
+		if votePeriod != types.ParticipationSeriesLength+3 {
+			input.OracleKeeper.SetParticipationPoint(input.Ctx, point(votePeriod))
+		}
+	}
+
+	// The default limit returns the last vote periods, oldest first
+	res, err = querier.ParticipationSeries(ctx, &types.QueryParticipationSeriesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Points, types.DefaultParticipationSeriesLimit)
+	require.Equal(t, point(last), res.Points[types.DefaultParticipationSeriesLimit-1])
+
+	// The point left in the slot of the skipped vote period is older than the series
+	res, err = querier.ParticipationSeries(ctx, &types.QueryParticipationSeriesRequest{Limit: 2 * types.ParticipationSeriesLength})
+	require.NoError(t, err)
+	require.Len(t, res.Points, types.ParticipationSeriesLength-1)
+	require.Equal(t, point(5), res.Points[0])
+	for _, p := range res.Points {
+		require.NotEqual(t, uint64(3), p.VotePeriod)
+	}
+
+	res, err = querier.ParticipationSeries(ctx, &types.QueryParticipationSeriesRequest{Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []types.ParticipationPoint{point(last - 2), point(last)}, res.Points)
+}
+
+func TestQueryMedianFlipCost(t *testing.T) {
+	input, _ := setup(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	// empty request
+	_, err := querier.MedianFlipCost(ctx, nil)
+	require.Error(t, err)
+
+	for _, move := range []string{"abc", "0", "-0.1", "1"} {
+		_, err = querier.MedianFlipCost(ctx, &types.QueryMedianFlipCostRequest{Move: move})
+		require.Error(t, err, move)
+	}
+
+	// The three validators have a power of 10 each, the last one abstaining on denom B
+	rates := []string{"1", "2", "3"}
+	for i, valAddr := range ValAddrs[:3] {
+		rateB := sdk.ZeroDec()
+		if i < 2 {
+			rateB = sdk.NewDec(int64(i + 1))
+		}
+		input.OracleKeeper.SetLastSubmission(input.Ctx, valAddr, types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{
+			{Denom: types.TestDenomA, ExchangeRate: sdk.MustNewDecFromStr(rates[i])},
+			{Denom: types.TestDenomB, ExchangeRate: rateB},
+		}, valAddr))
+	}
+
+	res, err := querier.MedianFlipCost(ctx, &types.QueryMedianFlipCostRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultMedianFlipMove, res.Move)
+	require.Len(t, res.FlipCosts, 2)
+	require.Equal(t, types.TestDenomB, res.FlipCosts[0].Denom)
+	require.Equal(t, int64(20), res.FlipCosts[0].BallotPower)
+	require.Equal(t, sdk.OneDec(), res.FlipCosts[0].Median)
+	require.Equal(t, types.MedianFlipCost{
+		Denom:       types.TestDenomA,
+		Median:      sdk.NewDec(2),
+		BallotPower: 30,
+		RaisePower:  12,
+		LowerPower:  9,
+	}, res.FlipCosts[1])
+
+	res, err = querier.MedianFlipCost(ctx, &types.QueryMedianFlipCostRequest{Denom: types.TestDenomA, Move: "0.6"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(6, 1), res.Move)
+	require.Len(t, res.FlipCosts, 1)
+	require.Equal(t, int64(32), res.FlipCosts[0].RaisePower)
+	require.Equal(t, int64(29), res.FlipCosts[0].LowerPower)
+
+	_, err = querier.MedianFlipCost(ctx, &types.QueryMedianFlipCostRequest{Denom: types.TestDenomC})
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+}
+
+func TestQueryBallotHistogram(t *testing.T) {
+	input, _ := setup(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	// empty request
+	_, err := querier.BallotHistogram(ctx, nil)
+	require.Error(t, err)
+
+	_, err = querier.BallotHistogram(ctx, &types.QueryBallotHistogramRequest{})
+	require.ErrorIs(t, err, types.ErrInvalidDenom)
+
+	_, err = querier.BallotHistogram(ctx, &types.QueryBallotHistogramRequest{Denom: types.TestDenomA})
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+
+	// The three validators have a power of 10 each, the last one abstaining on denom B
+	rates := []string{"1", "1.5", "3"}
+	for i, valAddr := range ValAddrs[:3] {
+		rateB := sdk.ZeroDec()
+		if i == 0 {
+			rateB = sdk.NewDec(7)
+		}
+		input.OracleKeeper.SetLastSubmission(input.Ctx, valAddr, types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{
+			{Denom: types.TestDenomA, ExchangeRate: sdk.MustNewDecFromStr(rates[i])},
+			{Denom: types.TestDenomB, ExchangeRate: rateB},
+		}, valAddr))
+	}
+
+	_, err = querier.BallotHistogram(ctx, &types.QueryBallotHistogramRequest{Denom: types.TestDenomA, Buckets: types.MaxHistogramBuckets + 1})
+	require.Error(t, err)
+
+	res, err := querier.BallotHistogram(ctx, &types.QueryBallotHistogramRequest{Denom: types.TestDenomA})
+	require.NoError(t, err)
+	require.Len(t, res.Edges, types.DefaultHistogramBuckets+1)
+	require.Equal(t, sdk.OneDec(), res.Edges[0])
+	require.Equal(t, sdk.NewDec(3), res.Edges[types.DefaultHistogramBuckets])
+	require.Len(t, res.Powers, types.DefaultHistogramBuckets)
+	require.Equal(t, int64(10), res.Powers[0])
+	require.Equal(t, int64(10), res.Powers[5])
+	require.Equal(t, int64(10), res.Powers[types.DefaultHistogramBuckets-1])
+	require.Equal(t, uint32(1), res.Votes[5])
+	require.Equal(t, int64(30), res.BallotPower)
+
+	res, err = querier.BallotHistogram(ctx, &types.QueryBallotHistogramRequest{Denom: types.TestDenomA, Buckets: 2})
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Dec{sdk.OneDec(), sdk.NewDec(2), sdk.NewDec(3)}, res.Edges)
+	require.Equal(t, []int64{20, 10}, res.Powers)
+	require.Equal(t, []uint32{2, 1}, res.Votes)
+
+	// A single vote makes a single bucket
+	res, err = querier.BallotHistogram(ctx, &types.QueryBallotHistogramRequest{Denom: types.TestDenomB, Buckets: 5})
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Dec{sdk.NewDec(7), sdk.NewDec(7)}, res.Edges)
+	require.Equal(t, []int64{10}, res.Powers)
+	require.Equal(t, int64(10), res.BallotPower)
+}
+
+func TestQueryRawDenomState(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	// empty request
+	_, err := querier.RawDenomState(ctx, nil)
+	require.Error(t, err)
+
+	_, err = querier.RawDenomState(ctx, &types.QueryRawDenomStateRequest{})
+	require.ErrorIs(t, err, types.ErrInvalidDenom)
+
+	_, err = querier.RawDenomState(ctx, &types.QueryRawDenomStateRequest{Denom: "unknown"})
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+
+	// A whitelisted denom without state
+	input.OracleKeeper.SetWhitelist(input.Ctx, types.DenomList{{Name: types.TestDenomA}, {Name: types.TestDenomB}})
+	res, err := querier.RawDenomState(ctx, &types.QueryRawDenomStateRequest{Denom: types.TestDenomB})
+	require.NoError(t, err)
+	require.Empty(t, res.Entries)
+
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomA, sdk.NewDec(2))
+	input.OracleKeeper.SetStaleCounter(input.Ctx, types.TestDenomA, 3)
+	res, err = querier.RawDenomState(ctx, &types.QueryRawDenomStateRequest{Denom: types.TestDenomA})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 2)
+
+	store := input.Ctx.KVStore(input.OracleKeeper.storeKey)
+	require.Equal(t, "ExchangeRate", res.Entries[0].Name)
+	require.Equal(t, hex.EncodeToString(types.GetExchangeRateKey(types.TestDenomA)), res.Entries[0].Key)
+	require.Equal(t, hex.EncodeToString(store.Get(types.GetExchangeRateKey(types.TestDenomA))), res.Entries[0].Value)
+	require.Equal(t, "StaleCounter", res.Entries[1].Name)
+	require.Equal(t, hex.EncodeToString(types.GetStaleCounterKey(types.TestDenomA)), res.Entries[1].Key)
+
+	// State left behind by a delisted denom is still shown
+	input.OracleKeeper.SetWhitelist(input.Ctx, types.DenomList{})
+	res, err = querier.RawDenomState(ctx, &types.QueryRawDenomStateRequest{Denom: types.TestDenomA})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 2)
+}
+
+func TestQueryUpcomingGraceExits(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	// empty request
+	_, err := querier.UpcomingGraceExits(ctx, nil)
+	require.Error(t, err)
+
+	votePeriod := input.OracleKeeper.VotePeriod(input.Ctx)
+	currentPeriod := uint64(input.Ctx.BlockHeight()) / votePeriod
+	input.OracleKeeper.SetDenomGraceExit(input.Ctx, types.TestDenomA, currentPeriod)
+	input.OracleKeeper.SetDenomGraceExit(input.Ctx, types.TestDenomB, currentPeriod+5)
+	input.OracleKeeper.SetDenomGraceExit(input.Ctx, types.TestDenomC, currentPeriod+2)
+	input.OracleKeeper.SetDenomGraceExit(input.Ctx, types.TestDenomD, currentPeriod+2)
+
+	res, err := querier.UpcomingGraceExits(ctx, &types.QueryUpcomingGraceExitsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.DenomGraceExit{
+		{Denom: types.TestDenomC, ExitPeriod: currentPeriod + 2, ExitHeight: int64((currentPeriod + 2) * votePeriod)},
+		{Denom: types.TestDenomD, ExitPeriod: currentPeriod + 2, ExitHeight: int64((currentPeriod + 2) * votePeriod)},
+		{Denom: types.TestDenomB, ExitPeriod: currentPeriod + 5, ExitHeight: int64((currentPeriod + 5) * votePeriod)},
+	}, res.GraceExits)
+}
+
+func TestQueryDenomSchedule(t *testing.T) {
+	input := CreateTestInput(t)
+	querier := NewQuerier(input.OracleKeeper)
+
+	// empty request
+	_, err := querier.DenomSchedule(sdk.WrapSDKContext(input.Ctx), nil)
+	require.Error(t, err)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.VotePeriod = 10
+	params.Whitelist = types.DenomList{
+		{Name: types.TestDenomA, VotePeriodMultiplier: 4},
+		{Name: types.TestDenomB},
+		{Name: types.TestDenomC, VotePeriodMultiplier: 4},
+		{Name: types.TestDenomD, VotePeriodMultiplier: 3},
+	}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// height 53 is in vote period 5, DenomC is in its grace window until period 9
+	input.Ctx = input.Ctx.WithBlockHeight(53)
+	input.OracleKeeper.SetDenomGraceExit(input.Ctx, types.TestDenomC, 9)
+
+	res, err := querier.DenomSchedule(sdk.WrapSDKContext(input.Ctx), &types.QueryDenomScheduleRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), res.CurrentPeriod)
+	require.Equal(t, []types.DenomSchedule{
+		{Denom: types.TestDenomB, NextPeriod: 5, RemainingBlocks: 6},
+		{Denom: types.TestDenomD, VotePeriodMultiplier: 3, NextPeriod: 6, RemainingBlocks: 16},
+		{Denom: types.TestDenomA, VotePeriodMultiplier: 4, NextPeriod: 8, RemainingBlocks: 36},
+		{Denom: types.TestDenomC, VotePeriodMultiplier: 4, NextPeriod: 12, InGrace: true, RemainingBlocks: 76},
+	}, res.Schedules)
+
+	// filter by denom
+	res, err = querier.DenomSchedule(sdk.WrapSDKContext(input.Ctx), &types.QueryDenomScheduleRequest{Denom: types.TestDenomD})
+	require.NoError(t, err)
+	require.Equal(t, []types.DenomSchedule{
+		{Denom: types.TestDenomD, VotePeriodMultiplier: 3, NextPeriod: 6, RemainingBlocks: 16},
+	}, res.Schedules)
+
+	_, err = querier.DenomSchedule(sdk.WrapSDKContext(input.Ctx), &types.QueryDenomScheduleRequest{Denom: types.TestDenomE})
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+
+	// timed vote periods of 30s, 10s into vote period 5
+	params.VotePeriodDuration = 30 * time.Second
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	input.Ctx = input.Ctx.WithBlockTime(time.Unix(1000*30+10, 0))
+	input.OracleKeeper.SetVotePeriodClock(input.Ctx, types.VotePeriodClock{Period: 5, StartHeight: 50, Boundary: 1000})
+
+	res, err = querier.DenomSchedule(sdk.WrapSDKContext(input.Ctx), &types.QueryDenomScheduleRequest{Denom: types.TestDenomA})
+	require.NoError(t, err)
+	require.Equal(t, []types.DenomSchedule{
+		{Denom: types.TestDenomA, VotePeriodMultiplier: 4, NextPeriod: 8, RemainingTime: 110 * time.Second},
+	}, res.Schedules)
+}
+
+func TestQueryValidatorMissingDenoms(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	// empty request
+	_, err := querier.ValidatorMissingDenoms(ctx, nil)
+	require.Error(t, err)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomD}, {Name: types.TestDenomB}, {Name: types.TestDenomC}}
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	// nothing revealed yet
+	res, err := querier.ValidatorMissingDenoms(ctx, &types.QueryValidatorMissingDenomsRequest{ValidatorAddr: ValAddrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, []string{types.TestDenomB, types.TestDenomC, types.TestDenomD}, res.MissingDenoms)
+
+	// abstain votes count as voted, denoms not whitelisted are ignored
+	input.OracleKeeper.SetAggregateExchangeRateVote(input.Ctx, ValAddrs[0], types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{
+		{Denom: types.TestDenomC, ExchangeRate: sdk.NewDec(5)},
+		{Denom: types.TestDenomB, ExchangeRate: sdk.ZeroDec()},
+		{Denom: types.TestDenomE, ExchangeRate: sdk.NewDec(1)},
+	}, ValAddrs[0]))
+	res, err = querier.ValidatorMissingDenoms(ctx, &types.QueryValidatorMissingDenomsRequest{ValidatorAddr: ValAddrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, []string{types.TestDenomD}, res.MissingDenoms)
+
+	// all denoms covered
+	input.OracleKeeper.SetAggregateExchangeRateVote(input.Ctx, ValAddrs[0], types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{
+		{Denom: types.TestDenomB, ExchangeRate: sdk.NewDec(1)},
+		{Denom: types.TestDenomC, ExchangeRate: sdk.NewDec(1)},
+		{Denom: types.TestDenomD, ExchangeRate: sdk.NewDec(1)},
+	}, ValAddrs[0]))
+	res, err = querier.ValidatorMissingDenoms(ctx, &types.QueryValidatorMissingDenomsRequest{ValidatorAddr: ValAddrs[0].String()})
+	require.NoError(t, err)
+	require.Empty(t, res.MissingDenoms)
+
+	// a denom tracking another one is not required
+	params.Whitelist = append(params.Whitelist, types.Denom{Name: types.TestDenomE, Tracks: types.TestDenomD})
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	res, err = querier.ValidatorMissingDenoms(ctx, &types.QueryValidatorMissingDenomsRequest{ValidatorAddr: ValAddrs[0].String()})
+	require.NoError(t, err)
+	require.Empty(t, res.MissingDenoms)
+}
+
+func TestQueryLightClientState(t *testing.T) {
+	input := CreateTestInput(t)
+	input.Ctx = input.Ctx.WithBlockHeight(100)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	// empty request
+	_, err := querier.LightClientState(ctx, nil)
+	require.Error(t, err)
+
+	rate := sdk.NewDec(1700)
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomD, rate)
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomB, rate)
+
+	res, err := querier.LightClientState(ctx, &types.QueryLightClientStateRequest{})
+	require.NoError(t, err)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	require.Equal(t, int64(100), res.Height)
+	require.Equal(t, params, res.Params)
+	require.Equal(t, sdk.DecCoins{
+		sdk.NewDecCoinFromDec(types.TestDenomB, rate),
+		sdk.NewDecCoinFromDec(types.TestDenomD, rate),
+	}, res.ExchangeRates)
+	require.Equal(t, 100/params.VotePeriod, res.VotePeriod)
+
+	// Each exchange rate is read from the oracle store at its key
+	require.Equal(t, types.StoreKey, res.StoreName)
+	require.Equal(t, [][]byte{types.GetExchangeRateKey(types.TestDenomB), types.GetExchangeRateKey(types.TestDenomD)}, res.ExchangeRateKeys)
+	store := input.Ctx.KVStore(input.OracleKeeper.storeKey)
+	for i, key := range res.ExchangeRateKeys {
+		require.True(t, store.Has(key), res.ExchangeRates[i].Denom)
+	}
+	require.Empty(t, res.VotePeriodClockKey)
+
+	// With timed vote periods, the vote period is read from the clock
+	params.VotePeriodDuration = time.Minute
+	input.OracleKeeper.SetParams(input.Ctx, params)
+	input.OracleKeeper.SetVotePeriodClock(input.Ctx, types.VotePeriodClock{Period: 7, StartHeight: 95})
+	res, err = querier.LightClientState(ctx, &types.QueryLightClientStateRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), res.VotePeriod)
+	require.Equal(t, types.VotePeriodClockKey, res.VotePeriodClockKey)
+	require.True(t, store.Has(res.VotePeriodClockKey))
+}
+
+// readOnlyMultiStore hands out stores panicking on any write
+type readOnlyMultiStore struct {
+	storetypes.MultiStore
+}
+
+func (ms readOnlyMultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	return readOnlyKVStore{ms.MultiStore.GetKVStore(key)}
+}
+
+type readOnlyKVStore struct {
+	storetypes.KVStore
+}
+
+func (s readOnlyKVStore) Set(key, _ []byte) {
+	panic("write to read-only store: " + string(key))
+}
+
+func (s readOnlyKVStore) Delete(key []byte) {
+	panic("delete from read-only store: " + string(key))
+}
+
+func TestQueryReadOnly(t *testing.T) {
+	input, _ := setup(t)
+	rate := sdk.NewDec(2)
+	vote := types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{{Denom: types.TestDenomA, ExchangeRate: rate}}, ValAddrs[0])
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomA, rate)
+	input.OracleKeeper.SetAggregateExchangeRatePrevote(input.Ctx, ValAddrs[0], types.NewAggregateExchangeRatePrevote(types.AggregateVoteHash{}, ValAddrs[0], 0))
+	input.OracleKeeper.SetAggregateExchangeRateVote(input.Ctx, ValAddrs[0], vote)
+	input.OracleKeeper.SetLastSubmission(input.Ctx, ValAddrs[0], vote)
+	input.OracleKeeper.SetTallyBounds(input.Ctx, types.TestDenomA, types.TallyBounds{LowerBound: rate, UpperBound: rate, RatedPower: 1, InBandPower: 1})
+	input.OracleKeeper.SetMissCounter(input.Ctx, ValAddrs[1], 1)
+	input.OracleKeeper.SetDenomTallyCounter(input.Ctx, types.TestDenomA, types.DenomTallyCounter{SuccessPeriods: 1})
+	input.OracleKeeper.SetDenomTallyOutcome(input.Ctx, types.TestDenomA, types.DenomTallyOutcome{Reason: types.TallyOutcomeSuccess, AchievedFraction: sdk.OneDec(), RequiredFraction: sdk.OneDec()})
+	input.OracleKeeper.SetValidatorAccuracyCounter(input.Ctx, ValAddrs[0], types.ValidatorAccuracyCounter{Submissions: 1, InBandSubmissions: 1})
+	input.OracleKeeper.SetObserverExemption(input.Ctx, ValAddrs[2], 100)
+	input.OracleKeeper.SetDenomGraceExit(input.Ctx, types.TestDenomB, 10)
+
+	// Queries are served from a cache of the committed state, writes to it panic
+	ctx := input.Ctx.WithMultiStore(readOnlyMultiStore{input.Ctx.MultiStore().CacheMultiStore()})
+	querier := NewQuerier(input.OracleKeeper)
+
+	// Call every query with the request fields naming stored state, failing or not
+	requestFields := map[string]string{
+		"Denom":         types.TestDenomA,
+		"ValidatorAddr": ValAddrs[0].String(),
+		"FeederAddr":    Addrs[0].String(),
+	}
+	server := reflect.TypeOf((*types.QueryServer)(nil)).Elem()
+	for i := 0; i < server.NumMethod(); i++ {
+		method := server.Method(i)
+		req := reflect.New(method.Type.In(1).Elem())
+		for field, value := range requestFields {
+			if f := req.Elem().FieldByName(field); f.IsValid() {
+				f.SetString(value)
+			}
+		}
+
+		var out []reflect.Value
+		require.NotPanics(t, func() {
+			out = reflect.ValueOf(querier).MethodByName(method.Name).Call([]reflect.Value{
+				reflect.ValueOf(sdk.WrapSDKContext(ctx)).Convert(reflect.TypeOf((*context.Context)(nil)).Elem()),
+				req,
+			})
+		}, method.Name)
+
+		// Decimals left unset print as a bare zero instead of the canonical form
+		if out[1].IsNil() {
+			requireDecsSet(t, out[0], method.Name)
+		}
+	}
+}
+
+// requireDecsSet requires all the decimals reachable from v to be set
+func requireDecsSet(t *testing.T, v reflect.Value, path string) {
+	if v.Type() == reflect.TypeOf(sdk.Dec{}) {
+		require.False(t, v.Interface().(sdk.Dec).IsNil(), path)
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			requireDecsSet(t, v.Elem(), path)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				requireDecsSet(t, v.Field(i), path+"."+v.Type().Field(i).Name)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			requireDecsSet(t, v.Index(i), path)
+		}
+	case reflect.Map:
+		for _, key := range v.MapKeys() {
+			requireDecsSet(t, v.MapIndex(key), path)
+		}
+	}
+}
+
+func TestQueryFreshExchangeRate(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	_, err := querier.FreshExchangeRate(ctx, nil)
+	require.Error(t, err)
+
+	_, err = querier.FreshExchangeRate(ctx, &types.QueryFreshExchangeRateRequest{Denom: types.TestDenomD})
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+
+	rate := sdk.NewDec(1700)
+	input.OracleKeeper.SetExchangeRate(input.Ctx, types.TestDenomD, rate)
+	res, err := querier.FreshExchangeRate(ctx, &types.QueryFreshExchangeRateRequest{Denom: types.TestDenomD})
+	require.NoError(t, err)
+	require.Equal(t, rate, res.ExchangeRate)
+	require.Equal(t, uint64(0), res.AgePeriods)
+
+	// A rate up to the max age is fresh
+	input.OracleKeeper.SetStaleCounter(input.Ctx, types.TestDenomD, 3)
+	res, err = querier.FreshExchangeRate(ctx, &types.QueryFreshExchangeRateRequest{Denom: types.TestDenomD, MaxAgePeriods: 3})
+	require.NoError(t, err)
+	require.Equal(t, rate, res.ExchangeRate)
+	require.Equal(t, uint64(3), res.AgePeriods)
+
+	// An older one is stale, with its age reported
+	_, err = querier.FreshExchangeRate(ctx, &types.QueryFreshExchangeRateRequest{Denom: types.TestDenomD, MaxAgePeriods: 2})
+	require.ErrorIs(t, err, types.ErrStaleExchangeRate)
+	require.Contains(t, err.Error(), "3 vote periods old")
+}
+
+func TestQueryWhitelistChanges(t *testing.T) {
+	input := CreateTestInput(t)
+	querier := NewQuerier(input.OracleKeeper)
+	votePeriod := int64(input.OracleKeeper.VotePeriod(input.Ctx))
+
+	_, err := querier.WhitelistChanges(sdk.WrapSDKContext(input.Ctx), nil)
+	require.Error(t, err)
+
+	params := input.OracleKeeper.GetParams(input.Ctx)
+	params.WhitelistChangeRetention = 100
+	input.OracleKeeper.SetParams(input.Ctx, params)
+
+	input.OracleKeeper.RecordWhitelistChange(input.Ctx.WithBlockHeight(votePeriod), types.TestDenomA, true)
+	input.OracleKeeper.RecordWhitelistChange(input.Ctx.WithBlockHeight(3*votePeriod), types.TestDenomB, true)
+	input.OracleKeeper.RecordWhitelistChange(input.Ctx.WithBlockHeight(5*votePeriod), types.TestDenomA, false)
+
+	ctx := sdk.WrapSDKContext(input.Ctx.WithBlockHeight(5 * votePeriod))
+	res, err := querier.WhitelistChanges(ctx, &types.QueryWhitelistChangesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.WhitelistChange{
+		{Denom: types.TestDenomA, VotePeriod: 1, Added: true},
+		{Denom: types.TestDenomB, VotePeriod: 3, Added: true},
+	}, res.Added)
+	require.Equal(t, []types.WhitelistChange{{Denom: types.TestDenomA, VotePeriod: 5, Added: false}}, res.Removed)
+
+	// The last 3 vote periods are 3 to 5
+	res, err = querier.WhitelistChanges(ctx, &types.QueryWhitelistChangesRequest{Windows: 3})
+	require.NoError(t, err)
+	require.Equal(t, []types.WhitelistChange{{Denom: types.TestDenomB, VotePeriod: 3, Added: true}}, res.Added)
+	require.Len(t, res.Removed, 1)
+
+	res, err = querier.WhitelistChanges(ctx, &types.QueryWhitelistChangesRequest{Windows: 1})
+	require.NoError(t, err)
+	require.Empty(t, res.Added)
+	require.Len(t, res.Removed, 1)
+}
@@ -3,6 +3,8 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/Team-Kujira/core/x/oracle/types"
@@ -12,14 +14,15 @@ import (
 // at the end of every VotePeriod, give out a portion of spread fees collected in the oracle reward pool
 //
 //	to the oracle voters that voted faithfully.
+//
+// All denoms of the reward pool are given out, whether or not they are vote targets.
 func (k Keeper) RewardBallotWinners(
 	ctx sdk.Context,
 	votePeriod int64,
 	rewardDistributionWindow int64,
-	voteTargets []string,
 	ballotWinners map[string]types.Claim,
-) {
-	rewardDenoms := voteTargets
+) error {
+	rewardDenoms := k.GetRewardPoolDenoms(ctx)
 
 	// Sum weight of the claims
 	ballotPowerSum := int64(0)
@@ -29,34 +32,49 @@ func (k Keeper) RewardBallotWinners(
 
 	// Exit if the ballot is empty
 	if ballotPowerSum == 0 {
-		return
+		return nil
 	}
 
-	// The Reward distributionRatio = votePeriod/rewardDistributionWindow
-	distributionRatio := sdk.NewDec(votePeriod).QuoInt64(rewardDistributionWindow)
+	// The share of each claim is its weight in the ballot, which is additionally
+	// weighted by the accuracy of its votes, if enabled
+	shares := make(map[string]sdk.Dec, len(ballotWinners))
+	if k.AccuracyWeightedRewards(ctx) {
+		accuracyWeightSum := sdk.ZeroDec()
+		for _, winner := range ballotWinners {
+			if !winner.AccuracyWeight.IsNil() {
+				accuracyWeightSum = accuracyWeightSum.Add(winner.AccuracyWeight)
+			}
+		}
 
-	var periodRewards sdk.DecCoins
-	for _, denom := range rewardDenoms {
-		rewardPool := k.GetRewardPool(ctx, denom)
+		// Exit if the ballot has only abstaining votes
+		if !accuracyWeightSum.IsPositive() {
+			return nil
+		}
 
-		// return if there's no rewards to give out
-		if rewardPool.IsZero() {
-			continue
+		for key, winner := range ballotWinners {
+			shares[key] = sdk.ZeroDec()
+			if !winner.AccuracyWeight.IsNil() {
+				shares[key] = winner.AccuracyWeight.Quo(accuracyWeightSum)
+			}
 		}
+	} else {
+		for key, winner := range ballotWinners {
+			shares[key] = sdk.NewDec(winner.Weight).QuoInt64(ballotPowerSum)
+		}
+	}
 
-		periodRewards = periodRewards.Add(sdk.NewDecCoinFromDec(
-			denom,
-			sdk.NewDecFromInt(rewardPool.Amount).Mul(distributionRatio),
-		))
+	periodRewards, err := k.PeriodRewards(ctx, votePeriod, rewardDistributionWindow, rewardDenoms)
+	if err != nil {
+		return err
 	}
 
 	// Dole out rewards
 	var distributedReward sdk.Coins
-	for _, winner := range ballotWinners {
+	for key, winner := range ballotWinners {
 		receiverVal := k.StakingKeeper.Validator(ctx, winner.Recipient)
 
 		// Reflects contribution
-		rewardCoins, _ := periodRewards.MulDec(sdk.NewDec(winner.Weight).QuoInt64(ballotPowerSum)).TruncateDecimal()
+		rewardCoins, _ := periodRewards.MulDec(shares[key]).TruncateDecimal()
 
 		// In case absence of the validator, we just skip distribution
 		if receiverVal != nil && !rewardCoins.IsZero() {
@@ -66,8 +84,93 @@ func (k Keeper) RewardBallotWinners(
 	}
 
 	// Move distributed reward to distribution module
-	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.distrName, distributedReward)
+	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.distrName, distributedReward)
 	if err != nil {
 		panic(fmt.Sprintf("[oracle] Failed to send coins to distribution module %s", err.Error()))
 	}
+
+	return nil
+}
+
+// ShareFees moves the OracleFeeShare of the fees held by the fee collector to the oracle reward pool,
+// before they are allocated by the distribution module. It is a no-op if the share is zero.
+// It returns ErrDecOverflow if a fee balance is too large to be represented as sdk.Dec.
+func (k Keeper) ShareFees(ctx sdk.Context) error {
+	share := k.OracleFeeShare(ctx)
+	if !share.IsPositive() {
+		return nil
+	}
+
+	feeCollector := k.accountKeeper.GetModuleAddress(k.feeCollectorName)
+	var sharedFees sdk.Coins
+	for _, fee := range k.bankKeeper.GetAllBalances(ctx, feeCollector) {
+		sharedFee, err := types.SafeMul(sdk.NewDecFromInt(fee.Amount), share)
+		if err != nil {
+			return errors.Wrapf(err, "fees of %s", fee.Denom)
+		}
+
+		sharedFees = sharedFees.Add(sdk.NewCoin(fee.Denom, sharedFee.TruncateInt()))
+	}
+
+	if sharedFees.IsZero() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, sharedFees)
+}
+
+// PeriodRewards returns the portion of the reward pool released to ballot winners in a single vote period.
+// It returns ErrDecOverflow if a reward pool balance is too large to be represented as sdk.Dec.
+func (k Keeper) PeriodRewards(
+	ctx sdk.Context,
+	votePeriod int64,
+	rewardDistributionWindow int64,
+	rewardDenoms []string,
+) (sdk.DecCoins, error) {
+	// The Reward distributionRatio = votePeriod/rewardDistributionWindow
+	distributionRatio := sdk.NewDec(votePeriod).QuoInt64(rewardDistributionWindow)
+
+	var periodRewards sdk.DecCoins
+	for _, denom := range rewardDenoms {
+		rewardPool := k.GetRewardPool(ctx, denom)
+
+		// return if there's no rewards to give out
+		if rewardPool.IsZero() {
+			continue
+		}
+
+		periodReward, err := types.SafeMul(sdk.NewDecFromInt(rewardPool.Amount), distributionRatio)
+		if err != nil {
+			return nil, errors.Wrapf(err, "reward pool of %s", denom)
+		}
+
+		periodRewards = periodRewards.Add(sdk.NewDecCoinFromDec(denom, periodReward))
+	}
+
+	return periodRewards, nil
+}
+
+// GetRewardEstimate returns the reward expected to be released next vote period for each unit
+// of winning voting power, together with the winning power of the last period.
+// The estimate assumes that the winning power of the next period equals the last one, that
+// every winner lands inside the reward band of all ballots and that the rewards are not
+// weighted by accuracy; it is not a guarantee of what RewardBallotWinners pays out.
+// Nothing is paid out, and so nothing estimated, while OracleFeeShare is zero.
+func (k Keeper) GetRewardEstimate(ctx sdk.Context) (sdk.DecCoins, int64, error) {
+	winningPower := k.GetWinningPower(ctx)
+	if winningPower == 0 {
+		return sdk.DecCoins{}, 0, nil
+	}
+
+	params := k.GetParams(ctx)
+	if !params.OracleFeeShare.IsPositive() {
+		return sdk.DecCoins{}, winningPower, nil
+	}
+
+	periodRewards, err := k.PeriodRewards(ctx, int64(params.VotePeriod), int64(params.RewardDistributionWindow), k.GetRewardPoolDenoms(ctx))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return periodRewards.QuoDec(sdk.NewDec(winningPower)), winningPower, nil
 }
@@ -1,11 +1,13 @@
 package keeper
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 
@@ -54,15 +56,11 @@ func TestRewardBallotWinners(t *testing.T) {
 	err = FundAccount(input, acc.GetAddress(), givingAmt)
 	require.NoError(t, err)
 
-	voteTargets := []string{
-		types.TestDenomA,
-		types.TestDenomB,
-	}
-
 	votePeriodsPerWindow := sdk.NewDec((int64)(input.OracleKeeper.RewardDistributionWindow(input.Ctx))).
 		QuoInt64((int64)(input.OracleKeeper.VotePeriod(input.Ctx))).
 		TruncateInt64()
-	input.OracleKeeper.RewardBallotWinners(ctx, (int64)(input.OracleKeeper.VotePeriod(input.Ctx)), (int64)(input.OracleKeeper.RewardDistributionWindow(input.Ctx)), voteTargets, claims)
+	err = input.OracleKeeper.RewardBallotWinners(ctx, (int64)(input.OracleKeeper.VotePeriod(input.Ctx)), (int64)(input.OracleKeeper.RewardDistributionWindow(input.Ctx)), claims)
+	require.NoError(t, err)
 	outstandingRewardsDec := input.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, addr)
 	outstandingRewards, _ := outstandingRewardsDec.TruncateDecimal()
 	require.Equal(t, sdk.NewDecFromInt(givingAmt.AmountOf(types.TestDenomA)).QuoInt64(votePeriodsPerWindow).QuoInt64(3).TruncateInt(),
@@ -77,3 +75,136 @@ func TestRewardBallotWinners(t *testing.T) {
 	require.Equal(t, sdk.NewDecFromInt(givingAmt.AmountOf(types.TestDenomB)).QuoInt64(votePeriodsPerWindow).QuoInt64(3).MulInt64(2).TruncateInt(),
 		outstandingRewards1.AmountOf(types.TestDenomB))
 }
+
+func TestRewardBallotWinnersAccuracyWeighted(t *testing.T) {
+	input, _ := setup(t)
+	ctx := input.Ctx
+
+	params := input.OracleKeeper.GetParams(ctx)
+	params.AccuracyWeightedRewards = true
+	input.OracleKeeper.SetParams(ctx, params)
+
+	// Equal power, but the first voter was closer to the exchange rate
+	exchangeRate := sdk.NewDec(100)
+	spread := sdk.NewDec(2)
+	accuracy, err := types.VoteAccuracy(sdk.NewDec(100), exchangeRate, spread)
+	require.NoError(t, err)
+	accuracy1, err := types.VoteAccuracy(sdk.NewDec(102), exchangeRate, spread)
+	require.NoError(t, err)
+	require.True(t, accuracy.GT(accuracy1))
+
+	claim := types.NewClaim(10, 10, 1, ValAddrs[0])
+	claim.AccuracyWeight = accuracy.MulInt64(10)
+	claim1 := types.NewClaim(10, 10, 1, ValAddrs[1])
+	claim1.AccuracyWeight = accuracy1.MulInt64(10)
+	claims := map[string]types.Claim{
+		ValAddrs[0].String(): claim,
+		ValAddrs[1].String(): claim1,
+	}
+
+	givingAmt := sdk.NewCoins(sdk.NewInt64Coin(types.TestDenomA, 30000000))
+	acc := input.AccountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	require.NoError(t, FundAccount(input, acc.GetAddress(), givingAmt))
+
+	periodRewards, err := input.OracleKeeper.PeriodRewards(ctx, 1, 10, []string{types.TestDenomA})
+	require.NoError(t, err)
+	err = input.OracleKeeper.RewardBallotWinners(ctx, 1, 10, claims)
+	require.NoError(t, err)
+
+	rewards, _ := input.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, ValAddrs[0]).TruncateDecimal()
+	rewards1, _ := input.DistrKeeper.GetValidatorOutstandingRewardsCoins(ctx, ValAddrs[1]).TruncateDecimal()
+	require.True(t, rewards.AmountOf(types.TestDenomA).GT(rewards1.AmountOf(types.TestDenomA)))
+
+	// The shares are normalized, so the total distributed is unchanged, up to truncation
+	total := rewards.AmountOf(types.TestDenomA).Add(rewards1.AmountOf(types.TestDenomA))
+	expected := periodRewards.AmountOf(types.TestDenomA).TruncateInt()
+	require.True(t, expected.Sub(total).LTE(sdk.NewInt(int64(len(claims)))), "distributed %s of %s", total, expected)
+}
+
+func TestRewardEstimate(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := input.Ctx
+
+	params := input.OracleKeeper.GetParams(ctx)
+	params.Whitelist = types.DenomList{{Name: types.TestDenomA}, {Name: types.TestDenomB}}
+	params.OracleFeeShare = sdk.ZeroDec()
+	input.OracleKeeper.SetParams(ctx, params)
+
+	givingAmt := sdk.NewCoins(sdk.NewInt64Coin(types.TestDenomA, 30000000), sdk.NewInt64Coin(types.TestDenomB, 40000000))
+	acc := input.AccountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	require.NoError(t, FundAccount(input, acc.GetAddress(), givingAmt))
+
+	// No winners recorded yet
+	rewardPerPower, winningPower, err := input.OracleKeeper.GetRewardEstimate(ctx)
+	require.NoError(t, err)
+	require.True(t, rewardPerPower.IsZero())
+	require.Equal(t, int64(0), winningPower)
+
+	// Nothing is paid out while no fees are shared with the oracle
+	input.OracleKeeper.SetWinningPower(ctx, 30)
+	rewardPerPower, winningPower, err = input.OracleKeeper.GetRewardEstimate(ctx)
+	require.NoError(t, err)
+	require.True(t, rewardPerPower.IsZero())
+	require.Equal(t, int64(30), winningPower)
+
+	params.OracleFeeShare = sdk.NewDecWithPrec(5, 1)
+	input.OracleKeeper.SetParams(ctx, params)
+	rewardPerPower, winningPower, err = input.OracleKeeper.GetRewardEstimate(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(30), winningPower)
+
+	periodRewards, err := input.OracleKeeper.PeriodRewards(ctx, int64(params.VotePeriod), int64(params.RewardDistributionWindow), []string{types.TestDenomA, types.TestDenomB})
+	require.NoError(t, err)
+	require.Equal(t, periodRewards.QuoDec(sdk.NewDec(30)), rewardPerPower)
+	require.Equal(t,
+		sdk.NewDecFromInt(givingAmt.AmountOf(types.TestDenomA)).Mul(sdk.NewDec(int64(params.VotePeriod)).QuoInt64(int64(params.RewardDistributionWindow))).QuoInt64(30),
+		rewardPerPower.AmountOf(types.TestDenomA),
+	)
+}
+
+func TestPeriodRewardsOverflow(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := input.Ctx
+
+	// max sdk.Int reward pool released within a single vote period
+	maxAmt := sdk.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)))
+	acc := input.AccountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	require.NoError(t, FundAccount(input, acc.GetAddress(), sdk.NewCoins(sdk.NewCoin(types.TestDenomA, maxAmt))))
+
+	_, err := input.OracleKeeper.PeriodRewards(ctx, 1, 1, []string{types.TestDenomA})
+	require.ErrorIs(t, err, types.ErrDecOverflow)
+
+	claims := map[string]types.Claim{
+		ValAddrs[0].String(): {Power: 10, Weight: 10, WinCount: 1, Recipient: ValAddrs[0]},
+	}
+	require.NotPanics(t, func() {
+		err = input.OracleKeeper.RewardBallotWinners(ctx, 1, 1, claims)
+	})
+	require.ErrorIs(t, err, types.ErrDecOverflow)
+}
+
+func TestShareFees(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := input.Ctx
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(types.TestDenomA, 1000), sdk.NewInt64Coin(types.TestDenomB, 10))
+	feeCollector := input.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.NoError(t, FundAccount(input, feeCollector, fees))
+	oracleAcc := input.AccountKeeper.GetModuleAddress(types.ModuleName)
+	pool := input.BankKeeper.GetAllBalances(ctx, oracleAcc)
+
+	// A zero share is a no-op
+	require.NoError(t, input.OracleKeeper.ShareFees(ctx))
+	require.Equal(t, fees, input.BankKeeper.GetAllBalances(ctx, feeCollector))
+	require.Equal(t, pool, input.BankKeeper.GetAllBalances(ctx, oracleAcc))
+
+	// The shared fees are truncated
+	params := input.OracleKeeper.GetParams(ctx)
+	params.OracleFeeShare = sdk.NewDecWithPrec(25, 2)
+	input.OracleKeeper.SetParams(ctx, params)
+
+	require.NoError(t, input.OracleKeeper.ShareFees(ctx))
+	shared := sdk.NewCoins(sdk.NewInt64Coin(types.TestDenomA, 250), sdk.NewInt64Coin(types.TestDenomB, 2))
+	require.Equal(t, fees.Sub(shared...), input.BankKeeper.GetAllBalances(ctx, feeCollector))
+	require.Equal(t, pool.Add(shared...), input.BankKeeper.GetAllBalances(ctx, oracleAcc))
+}
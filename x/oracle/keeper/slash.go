@@ -4,28 +4,46 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MaxMissCounter returns the cap of the miss counters, the most vote periods a slash window can
+// hold, as a validator misses each vote period at most once. A timed vote period lasts at least
+// a block, so the window holds at most as many of them as it has blocks.
+func (k Keeper) MaxMissCounter(ctx sdk.Context) uint64 {
+	if k.VotePeriodDuration(ctx) == 0 {
+		return k.SlashWindow(ctx) / k.VotePeriod(ctx)
+	}
+
+	return k.SlashWindow(ctx)
+}
+
 // SlashAndResetMissCounters do slash any operator who over criteria & clear all operators miss counter to zero
 func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 	height := ctx.BlockHeight()
 	distributionHeight := height - sdk.ValidatorUpdateDelay - 1
 
-	// slash_window / vote_period
-	votePeriodsPerWindow := uint64(
-		sdk.NewDec(int64(k.SlashWindow(ctx))).
-			QuoInt64(int64(k.VotePeriod(ctx))).
-			TruncateInt64(),
-	)
+	// slash_window / vote_period, or the vote periods closed in the window if they are timed
+	votePeriodsPerWindow := k.VotePeriodsPerSlashWindow(ctx)
+	k.resetSlashWindowPeriods(ctx)
 	minValidPerWindow := k.MinValidPerWindow(ctx)
 	slashFraction := k.SlashFraction(ctx)
+	progressiveSlashing := k.ProgressiveSlashing(ctx)
+	progressiveSlashFloor := k.ProgressiveSlashFloor(ctx)
+	revealMissWeight := k.RevealMissWeight(ctx)
 	powerReduction := k.StakingKeeper.PowerReduction(ctx)
 
 	k.IterateMissCounters(ctx, func(operator sdk.ValAddress, missCounter uint64) bool {
-		// Calculate valid vote rate; (SlashWindow - MissCounter)/SlashWindow
-		validVoteRate := sdk.NewDecFromInt(
-			sdk.NewInt(int64(votePeriodsPerWindow - missCounter))).
-			QuoInt64(int64(votePeriodsPerWindow))
+		// Nothing to rate if no timed vote period closed in the window
+		revealMissCounter := k.GetRevealMissCounter(ctx, operator)
+		k.DeleteRevealMissCounter(ctx, operator)
+		if votePeriodsPerWindow == 0 {
+			k.DeleteMissCounter(ctx, operator)
+			return false
+		}
+
+		misses := weightedMisses(missCounter, revealMissCounter, revealMissWeight)
+		validVoteRate := validVoteRate(votePeriodsPerWindow, misses)
 
-		// Penalize the validator whose the valid vote rate is smaller than min threshold
+		// Penalize the validator whose the valid vote rate is smaller than min threshold,
+		// the misses of an exempt observer were not counted
 		if validVoteRate.LT(minValidPerWindow) {
 			validator := k.StakingKeeper.Validator(ctx, operator)
 			if validator.IsBonded() && !validator.IsJailed() {
@@ -34,8 +52,13 @@ func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 					panic(err)
 				}
 
+				fraction := slashFraction
+				if progressiveSlashing {
+					fraction = progressiveSlashFraction(progressiveSlashFloor, slashFraction, minValidPerWindow, validVoteRate)
+				}
+
 				k.SlashingKeeper.Slash(
-					ctx, consAddr, slashFraction,
+					ctx, consAddr, fraction,
 					validator.GetConsensusPower(powerReduction), distributionHeight,
 				)
 				k.SlashingKeeper.Jail(ctx, consAddr)
@@ -45,4 +68,68 @@ func (k Keeper) SlashAndResetMissCounters(ctx sdk.Context) {
 		k.DeleteMissCounter(ctx, operator)
 		return false
 	})
+
+	k.PruneObserverExemptions(ctx)
+	k.PruneRejectedTuples(ctx)
+}
+
+// MissRate returns the weighted misses of the validator in the current slash window relative to
+// the vote periods of the window, zero if no timed vote period closed in it yet
+func (k Keeper) MissRate(ctx sdk.Context, operator sdk.ValAddress) sdk.Dec {
+	votePeriodsPerWindow := k.VotePeriodsPerSlashWindow(ctx)
+	if votePeriodsPerWindow == 0 {
+		return sdk.ZeroDec()
+	}
+
+	misses := weightedMisses(k.GetMissCounter(ctx, operator), k.GetRevealMissCounter(ctx, operator), k.RevealMissWeight(ctx))
+	return sdk.OneDec().Sub(validVoteRate(votePeriodsPerWindow, misses))
+}
+
+// weightedMisses counts the misses in which the validator prevoted but did not reveal
+// with the reveal miss weight, and the other misses in full
+func weightedMisses(missCounter uint64, revealMissCounter uint64, revealMissWeight sdk.Dec) sdk.Dec {
+	if revealMissCounter > missCounter {
+		revealMissCounter = missCounter
+	}
+
+	return uint64ToDec(missCounter - revealMissCounter).Add(revealMissWeight.Mul(uint64ToDec(revealMissCounter)))
+}
+
+// validVoteRate calculates the valid vote rate; (SlashWindow - MissCounter)/SlashWindow
+func validVoteRate(votePeriodsPerWindow uint64, misses sdk.Dec) sdk.Dec {
+	periods := uint64ToDec(votePeriodsPerWindow)
+
+	// Misses counted in blocks before the vote periods became timed may exceed the closed periods
+	if misses.GT(periods) {
+		misses = periods
+	}
+
+	return periods.Sub(misses).Quo(periods)
+}
+
+// progressiveSlashFraction scales the slash fraction linearly with the miss severity,
+// the share of MinValidPerWindow the valid vote rate fell short of:
+//
+//	severity = (minValidPerWindow - validVoteRate) / minValidPerWindow
+//	fraction = floor + (slashFraction - floor) * severity
+//
+// A validator just below MinValidPerWindow is slashed by the floor, one without
+// any valid vote by the full slash fraction. A floor above the slash fraction is
+// capped to it, so the floor never slashes more than the full slash fraction.
+func progressiveSlashFraction(floor, slashFraction, minValidPerWindow, validVoteRate sdk.Dec) sdk.Dec {
+	if floor.GT(slashFraction) {
+		floor = slashFraction
+	}
+
+	severity := minValidPerWindow.Sub(validVoteRate).Quo(minValidPerWindow)
+	if severity.GT(sdk.OneDec()) {
+		severity = sdk.OneDec()
+	}
+
+	return floor.Add(slashFraction.Sub(floor).Mul(severity))
+}
+
+// uint64ToDec converts a counter to sdk.Dec without overflowing int64
+func uint64ToDec(counter uint64) sdk.Dec {
+	return sdk.NewDecFromInt(sdk.NewIntFromUint64(counter))
 }
@@ -1,10 +1,14 @@
 package keeper
 
 import (
+	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/Team-Kujira/core/x/oracle/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
@@ -87,3 +91,133 @@ func TestSlashAndResetMissCounters(t *testing.T) {
 	validator, _ = input.StakingKeeper.GetValidator(input.Ctx, ValAddrs[0])
 	require.Equal(t, amt, validator.Tokens)
 }
+
+func TestProgressiveSlashFraction(t *testing.T) {
+	floor := sdk.NewDecWithPrec(1, 2)
+	slashFraction := sdk.NewDecWithPrec(1, 1)
+	minValidPerWindow := sdk.NewDecWithPrec(5, 1)
+
+	// just below the threshold, half way and without any valid vote
+	require.Equal(t, sdk.MustNewDecFromStr("0.0118"), progressiveSlashFraction(floor, slashFraction, minValidPerWindow, sdk.NewDecWithPrec(49, 2)))
+	require.Equal(t, sdk.MustNewDecFromStr("0.055"), progressiveSlashFraction(floor, slashFraction, minValidPerWindow, sdk.NewDecWithPrec(25, 2)))
+	require.Equal(t, slashFraction, progressiveSlashFraction(floor, slashFraction, minValidPerWindow, sdk.ZeroDec()))
+	require.Equal(t, floor, progressiveSlashFraction(floor, slashFraction, minValidPerWindow, minValidPerWindow))
+
+	// A floor above the slash fraction is capped to it
+	require.Equal(t, slashFraction, progressiveSlashFraction(sdk.NewDecWithPrec(5, 1), slashFraction, minValidPerWindow, sdk.NewDecWithPrec(49, 2)))
+	require.Equal(t, slashFraction, progressiveSlashFraction(sdk.NewDecWithPrec(5, 1), slashFraction, minValidPerWindow, sdk.ZeroDec()))
+}
+
+func TestValidVoteRateOverflow(t *testing.T) {
+	// Pathologically long slash windows and miss counters convert without overflowing int64
+	require.Equal(t, sdk.OneDec(), validVoteRate(math.MaxUint64, sdk.ZeroDec()))
+	require.Equal(t, sdk.ZeroDec(), validVoteRate(math.MaxUint64, weightedMisses(math.MaxUint64, 0, sdk.OneDec())))
+	require.Equal(t, sdk.ZeroDec(), validVoteRate(100, weightedMisses(math.MaxUint64, math.MaxUint64, sdk.NewDecWithPrec(5, 1))))
+
+	misses := weightedMisses(math.MaxUint64, math.MaxUint64-1, sdk.NewDecWithPrec(5, 1))
+	require.Equal(t, sdk.NewDecFromInt(sdk.NewIntFromUint64(math.MaxUint64-1)).QuoInt64(2).Add(sdk.OneDec()), misses)
+	require.True(t, validVoteRate(math.MaxUint64, misses).GT(sdk.NewDecWithPrec(49, 2)))
+}
+
+func TestSlashAndResetMissCountersProgressive(t *testing.T) {
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+
+	slash := func(progressive bool, missCounter uint64) sdk.Int {
+		input := CreateTestInput(t)
+		params := input.OracleKeeper.GetParams(input.Ctx)
+		params.VotePeriod = 1
+		params.SlashWindow = 100
+		params.MinValidPerWindow = sdk.NewDecWithPrec(5, 1)
+		params.SlashFraction = sdk.NewDecWithPrec(1, 1)
+		params.ProgressiveSlashing = progressive
+		params.ProgressiveSlashFloor = sdk.NewDecWithPrec(1, 2)
+		input.OracleKeeper.SetParams(input.Ctx, params)
+
+		sh := stakingkeeper.NewMsgServerImpl(&input.StakingKeeper)
+		_, err := sh.CreateValidator(input.Ctx, NewTestMsgCreateValidator(ValAddrs[0], ValPubKeys[0], amt))
+		require.NoError(t, err)
+		staking.EndBlocker(input.Ctx, &input.StakingKeeper)
+
+		input.OracleKeeper.SetMissCounter(input.Ctx, ValAddrs[0], missCounter)
+		input.OracleKeeper.SlashAndResetMissCounters(input.Ctx)
+
+		validator, _ := input.StakingKeeper.GetValidator(input.Ctx, ValAddrs[0])
+		return validator.GetBondedTokens()
+	}
+
+	testCases := []struct {
+		missCounter uint64
+		flat        sdk.Dec
+		progressive sdk.Dec
+	}{
+		{50, sdk.ZeroDec(), sdk.ZeroDec()},
+		{51, sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.0118")},
+		{75, sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.055")},
+		{100, sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.1")},
+		// counters beyond int64 are not read as negative
+		{math.MaxUint64, sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("0.1")},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, amt.Sub(tc.flat.MulInt(amt).TruncateInt()), slash(false, tc.missCounter), "flat, %d misses", tc.missCounter)
+		require.Equal(t, amt.Sub(tc.progressive.MulInt(amt).TruncateInt()), slash(true, tc.missCounter), "progressive, %d misses", tc.missCounter)
+	}
+}
+
+func TestSlashAndResetMissCountersTimed(t *testing.T) {
+	amt := sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction)
+
+	slash := func(closedPeriods uint64, missCounter uint64) sdk.Int {
+		input := CreateTestInput(t)
+		params := input.OracleKeeper.GetParams(input.Ctx)
+		params.MinValidPerWindow = sdk.NewDecWithPrec(5, 1)
+		params.SlashFraction = sdk.NewDecWithPrec(1, 1)
+		params.VotePeriodDuration = 30 * time.Second
+		input.OracleKeeper.SetParams(input.Ctx, params)
+
+		sh := stakingkeeper.NewMsgServerImpl(&input.StakingKeeper)
+		_, err := sh.CreateValidator(input.Ctx, NewTestMsgCreateValidator(ValAddrs[0], ValPubKeys[0], amt))
+		require.NoError(t, err)
+		staking.EndBlocker(input.Ctx, &input.StakingKeeper)
+
+		// The valid vote rate is measured against the vote periods closed in the window
+		input.OracleKeeper.SetVotePeriodClock(input.Ctx, types.VotePeriodClock{SlashWindowPeriods: closedPeriods})
+		input.OracleKeeper.SetMissCounter(input.Ctx, ValAddrs[0], missCounter)
+		input.OracleKeeper.SlashAndResetMissCounters(input.Ctx)
+		require.Equal(t, uint64(0), input.OracleKeeper.GetVotePeriodClock(input.Ctx).SlashWindowPeriods)
+		require.Equal(t, uint64(0), input.OracleKeeper.GetMissCounter(input.Ctx, ValAddrs[0]))
+
+		validator, _ := input.StakingKeeper.GetValidator(input.Ctx, ValAddrs[0])
+		return validator.GetBondedTokens()
+	}
+
+	slashed := amt.Sub(sdk.NewDecWithPrec(1, 1).MulInt(amt).TruncateInt())
+	require.Equal(t, amt, slash(10, 5))
+	require.Equal(t, slashed, slash(10, 6))
+
+	// Misses above the closed periods count as missing all of them
+	require.Equal(t, slashed, slash(4, 6))
+
+	// Nobody is rated without a closed period
+	require.Equal(t, amt, slash(0, 6))
+}
+
+func TestSlashAndResetMissCountersPrunesRejectedTuples(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := input.Ctx.WithBlockHeight(99)
+	params := input.OracleKeeper.GetParams(ctx)
+	params.VotePeriod = 1
+	params.SlashWindow = 100
+	input.OracleKeeper.SetParams(ctx, params)
+
+	tuples := []types.RejectedTuple{{Denom: types.TestDenomB, Reason: types.RejectReasonNotVoteTarget}}
+	input.OracleKeeper.SetRejectedTuples(ctx, ValAddrs[0], types.RejectedTuples{VotePeriod: 99, Tuples: tuples})
+	input.OracleKeeper.SetRejectedTuples(ctx, ValAddrs[1], types.RejectedTuples{VotePeriod: 12, Tuples: tuples})
+
+	// The entry of the validator which stopped voting is removed, the one of the last vote kept
+	input.OracleKeeper.SlashAndResetMissCounters(ctx)
+	_, ok := input.OracleKeeper.GetRejectedTuples(ctx, ValAddrs[0])
+	require.True(t, ok)
+	_, ok = input.OracleKeeper.GetRejectedTuples(ctx, ValAddrs[1])
+	require.False(t, ok)
+}
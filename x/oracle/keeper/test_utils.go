@@ -63,7 +63,7 @@ func MakeTestCodec(t *testing.T) codec.Codec {
 }
 
 // MakeEncodingConfig nolint
-func MakeEncodingConfig(_ *testing.T) simparams.EncodingConfig {
+func MakeEncodingConfig(_ testing.TB) simparams.EncodingConfig {
 	amino := codec.NewLegacyAmino()
 	interfaceRegistry := codectypes.NewInterfaceRegistry()
 	codec := codec.NewProtoCodec(interfaceRegistry)
@@ -133,12 +133,13 @@ type TestInput struct {
 }
 
 // CreateTestInput nolint
-func CreateTestInput(t *testing.T) TestInput {
+func CreateTestInput(t testing.TB) TestInput {
 	keyAcc := sdk.NewKVStoreKey(authtypes.StoreKey)
 	keyBank := sdk.NewKVStoreKey(banktypes.StoreKey)
 	keyParams := sdk.NewKVStoreKey(paramstypes.StoreKey)
 	tKeyParams := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
 	keyOracle := sdk.NewKVStoreKey(types.StoreKey)
+	tKeyOracle := sdk.NewTransientStoreKey(types.TStoreKey)
 	keySlashing := sdk.NewKVStoreKey(slashingtypes.StoreKey)
 	keyStaking := sdk.NewKVStoreKey(stakingtypes.StoreKey)
 	keyDistr := sdk.NewKVStoreKey(distrtypes.StoreKey)
@@ -155,6 +156,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	ms.MountStoreWithDB(tKeyParams, storetypes.StoreTypeTransient, db)
 	ms.MountStoreWithDB(keyParams, storetypes.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyOracle, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tKeyOracle, storetypes.StoreTypeTransient, db)
 	ms.MountStoreWithDB(keySlashing, storetypes.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyStaking, storetypes.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyDistr, storetypes.StoreTypeIAVL, db)
@@ -259,6 +261,7 @@ func CreateTestInput(t *testing.T) TestInput {
 	keeper := NewKeeper(
 		appCodec,
 		keyOracle,
+		tKeyOracle,
 		paramsKeeper.Subspace(types.ModuleName),
 		accountKeeper,
 		bankKeeper,
@@ -266,6 +269,7 @@ func CreateTestInput(t *testing.T) TestInput {
 		slashingKeeper,
 		stakingKeeper,
 		distrtypes.ModuleName,
+		authtypes.FeeCollectorName,
 	)
 
 	defaults := types.DefaultParams()
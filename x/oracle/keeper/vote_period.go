@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Team-Kujira/core/x/oracle/types"
+)
+
+// Vote periods are counted in blocks by default: period n spans the heights
+// [n * VotePeriod, (n+1) * VotePeriod). When VotePeriodDuration is set, a vote period
+// is instead closed by the first block whose time crosses a multiple of the duration
+// since the unix epoch, and the VotePeriodClock keeps track of the period boundaries.
+// Only the consensus block time is used, so all nodes close a period at the same block.
+
+// GetVotePeriodClock retrieves the vote period clock. Without a stored clock, i.e. until
+// the first end blocker after VotePeriodDuration was set, the clock continues from the
+// vote period counted in blocks.
+func (k Keeper) GetVotePeriodClock(ctx sdk.Context) types.VotePeriodClock {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.VotePeriodClockKey)
+	if bz != nil {
+		var clock types.VotePeriodClock
+		k.cdc.MustUnmarshal(bz, &clock)
+		return clock
+	}
+
+	votePeriod := k.VotePeriod(ctx)
+	period := uint64(ctx.BlockHeight()) / votePeriod
+	startHeight := int64(period * votePeriod)
+	previousStartHeight := startHeight - int64(votePeriod)
+	if previousStartHeight < 0 {
+		previousStartHeight = 0
+	}
+
+	return types.VotePeriodClock{
+		Period:              period,
+		StartHeight:         startHeight,
+		PreviousStartHeight: previousStartHeight,
+		Boundary:            votePeriodBoundary(ctx.BlockTime(), k.VotePeriodDuration(ctx)),
+		SlashWindowPeriods:  (uint64(ctx.BlockHeight()) % k.SlashWindow(ctx)) / votePeriod,
+	}
+}
+
+// SetVotePeriodClock updates the vote period clock
+func (k Keeper) SetVotePeriodClock(ctx sdk.Context, clock types.VotePeriodClock) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&clock)
+	store.Set(types.VotePeriodClockKey, bz)
+}
+
+// DeleteVotePeriodClock removes the vote period clock
+func (k Keeper) DeleteVotePeriodClock(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.VotePeriodClockKey)
+}
+
+// SyncVotePeriodClock stores the vote period clock once VotePeriodDuration is set,
+// and removes it once the vote periods are counted in blocks again.
+func (k Keeper) SyncVotePeriodClock(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	if k.VotePeriodDuration(ctx) == 0 {
+		if store.Has(types.VotePeriodClockKey) {
+			k.DeleteVotePeriodClock(ctx)
+		}
+		return
+	}
+
+	if !store.Has(types.VotePeriodClockKey) {
+		k.SetVotePeriodClock(ctx, k.GetVotePeriodClock(ctx))
+	}
+}
+
+// CurrentVotePeriod returns the number of the vote period the block belongs to
+func (k Keeper) CurrentVotePeriod(ctx sdk.Context) uint64 {
+	if k.VotePeriodDuration(ctx) == 0 {
+		return uint64(ctx.BlockHeight()) / k.VotePeriod(ctx)
+	}
+
+	return k.GetVotePeriodClock(ctx).Period
+}
+
+// IsVotePeriodLastBlock returns whether the vote period closes with the block. A block
+// time crossing several boundaries at once, e.g. after a chain halt, closes a single period.
+func (k Keeper) IsVotePeriodLastBlock(ctx sdk.Context) bool {
+	duration := k.VotePeriodDuration(ctx)
+	if duration == 0 {
+		return (uint64(ctx.BlockHeight())+1)%k.VotePeriod(ctx) == 0
+	}
+
+	return votePeriodBoundary(ctx.BlockTime(), duration) > k.GetVotePeriodClock(ctx).Boundary
+}
+
+// IsPreviousVotePeriod returns whether the height belongs to the vote period preceding
+// the one of the block
+func (k Keeper) IsPreviousVotePeriod(ctx sdk.Context, height uint64) bool {
+	if k.VotePeriodDuration(ctx) == 0 {
+		votePeriod := k.VotePeriod(ctx)
+		return uint64(ctx.BlockHeight())/votePeriod == height/votePeriod+1
+	}
+
+	clock := k.GetVotePeriodClock(ctx)
+	return clock.Period > 0 && int64(height) >= clock.PreviousStartHeight && int64(height) < clock.StartHeight
+}
+
+// revealGraceBlocks returns RevealGraceBlocks capped below VotePeriod. The cap is only checked by
+// Params.Validate, which a parameter change of the single key does not go through.
+func (k Keeper) revealGraceBlocks(ctx sdk.Context) uint64 {
+	grace := k.RevealGraceBlocks(ctx)
+	if votePeriod := k.VotePeriod(ctx); grace >= votePeriod {
+		return votePeriod - 1
+	}
+	return grace
+}
+
+// IsRevealGracePeriod returns whether the height belongs to the vote period two before the one of
+// the block, and the block is one of the first RevealGraceBlocks of its vote period. A vote revealing
+// a prevote of that height landed just past its reveal window. With timed vote periods, the start
+// of the vote period two before is not kept, so any height before the previous vote period passes,
+// the older prevotes being deleted at the end of the previous vote period by PrevoteRetentionBlocks.
+func (k Keeper) IsRevealGracePeriod(ctx sdk.Context, height uint64) bool {
+	grace := k.revealGraceBlocks(ctx)
+	if grace == 0 {
+		return false
+	}
+
+	if k.VotePeriodDuration(ctx) == 0 {
+		votePeriod := k.VotePeriod(ctx)
+		blockHeight := uint64(ctx.BlockHeight())
+		return blockHeight%votePeriod < grace && blockHeight/votePeriod == height/votePeriod+2
+	}
+
+	clock := k.GetVotePeriodClock(ctx)
+	return clock.Period > 1 && ctx.BlockHeight()-clock.StartHeight < int64(grace) && int64(height) < clock.PreviousStartHeight
+}
+
+// PrevoteRetentionBlocks returns the number of blocks up to the block in which the prevotes are kept
+// at the end of the vote period, those of the vote period and, during a reveal grace, the previous one
+func (k Keeper) PrevoteRetentionBlocks(ctx sdk.Context) uint64 {
+	blocks := k.CurrentVotePeriodBlocks(ctx)
+	if k.revealGraceBlocks(ctx) == 0 {
+		return blocks
+	}
+
+	if k.VotePeriodDuration(ctx) == 0 {
+		return blocks + k.VotePeriod(ctx)
+	}
+
+	// Exactly the prevotes from the start of the previous vote period on, which the timed reveal
+	// grace relies on
+	clock := k.GetVotePeriodClock(ctx)
+	return blocks + uint64(clock.StartHeight-clock.PreviousStartHeight) - 1
+}
+
+// CurrentVotePeriodBlocks returns the number of blocks of the vote period up to the block
+func (k Keeper) CurrentVotePeriodBlocks(ctx sdk.Context) uint64 {
+	if k.VotePeriodDuration(ctx) == 0 {
+		return k.VotePeriod(ctx)
+	}
+
+	return uint64(ctx.BlockHeight()-k.GetVotePeriodClock(ctx).StartHeight) + 1
+}
+
+// EndVotePeriod starts the next vote period with the next block, if the vote periods are timed
+func (k Keeper) EndVotePeriod(ctx sdk.Context) {
+	duration := k.VotePeriodDuration(ctx)
+	if duration == 0 {
+		return
+	}
+
+	clock := k.GetVotePeriodClock(ctx)
+	k.SetVotePeriodClock(ctx, types.VotePeriodClock{
+		Period:              clock.Period + 1,
+		StartHeight:         ctx.BlockHeight() + 1,
+		PreviousStartHeight: clock.StartHeight,
+		Boundary:            votePeriodBoundary(ctx.BlockTime(), duration),
+		SlashWindowPeriods:  clock.SlashWindowPeriods + 1,
+	})
+}
+
+// VotePeriodsPerSlashWindow returns the number of vote periods of the current slash window
+func (k Keeper) VotePeriodsPerSlashWindow(ctx sdk.Context) uint64 {
+	if k.VotePeriodDuration(ctx) == 0 {
+		return k.SlashWindow(ctx) / k.VotePeriod(ctx)
+	}
+
+	return k.GetVotePeriodClock(ctx).SlashWindowPeriods
+}
+
+// resetSlashWindowPeriods restarts counting the vote periods of the slash window
+func (k Keeper) resetSlashWindowPeriods(ctx sdk.Context) {
+	if k.VotePeriodDuration(ctx) == 0 {
+		return
+	}
+
+	clock := k.GetVotePeriodClock(ctx)
+	clock.SlashWindowPeriods = 0
+	k.SetVotePeriodClock(ctx, clock)
+}
+
+// votePeriodBoundary returns the number of whole durations between the unix epoch and the time
+func votePeriodBoundary(t time.Time, duration time.Duration) int64 {
+	if duration == 0 {
+		return 0
+	}
+
+	return t.UnixNano() / int64(duration)
+}
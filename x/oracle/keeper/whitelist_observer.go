@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// WhitelistObserver is called with the denoms added to and removed from the whitelist, sorted
+type WhitelistObserver func(ctx sdk.Context, added, removed []string)
+
+// RegisterWhitelistObserver registers an in-process observer of the whitelist changes, e.g. for a
+// module caching the vote targets. It is called for the denoms delisted or renamed by governance
+// and delisted automatically, as they are, and for the changes of the whitelist param at the end
+// of the vote period, along with the whitelist change log. The observers are registered when the
+// app is built, as they are not persisted.
+func (k Keeper) RegisterWhitelistObserver(observer WhitelistObserver) {
+	*k.whitelistObservers = append(*k.whitelistObservers, observer)
+}
+
+// notifyWhitelistObservers calls the observers in the order they were registered
+func (k Keeper) notifyWhitelistObservers(ctx sdk.Context, added, removed []string) {
+	if len(added) == 0 && len(removed) == 0 {
+		return
+	}
+
+	added = append([]string{}, added...)
+	removed = append([]string{}, removed...)
+	sort.Strings(added)
+	sort.Strings(removed)
+	for _, observer := range *k.whitelistObservers {
+		k.callWhitelistObserver(ctx, observer, added, removed)
+	}
+}
+
+// callWhitelistObserver calls the observer in a cache context, which is only written if it
+// returns. A panicking observer is logged rather than failing the change of the whitelist.
+func (k Keeper) callWhitelistObserver(ctx sdk.Context, observer WhitelistObserver, added, removed []string) {
+	defer func() {
+		if r := recover(); r != nil {
+			k.Logger(ctx).Error("whitelist observer panicked", "err", r)
+		}
+	}()
+
+	cacheCtx, write := ctx.CacheContext()
+	observer(cacheCtx, append([]string{}, added...), append([]string{}, removed...))
+	write()
+}
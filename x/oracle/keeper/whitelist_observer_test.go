@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Team-Kujira/core/x/oracle/types"
+)
+
+type whitelistDiff struct {
+	added, removed []string
+}
+
+func TestWhitelistObserver(t *testing.T) {
+	input := CreateTestInput(t)
+	handler := NewOracleProposalHandler(input.OracleKeeper)
+
+	// The observer registered on a copy of the keeper is called by all of them
+	var diffs []whitelistDiff
+	k := input.OracleKeeper
+	k.RegisterWhitelistObserver(func(_ sdk.Context, added, removed []string) {
+		diffs = append(diffs, whitelistDiff{added: added, removed: removed})
+	})
+
+	// Governance adds denoms through the whitelist param, seen at the end of the vote period
+	input.OracleKeeper.SetWhitelist(input.Ctx, types.DenomList{{Name: types.TestDenomB}, {Name: types.TestDenomA}})
+	input.OracleKeeper.UpdateDenomGraceExits(input.Ctx, input.OracleKeeper.VoteTargets(input.Ctx), 1)
+	require.Equal(t, []whitelistDiff{{added: []string{types.TestDenomB, types.TestDenomA}, removed: []string{}}}, diffs)
+
+	// Unchanged whitelist
+	diffs = nil
+	input.OracleKeeper.UpdateDenomGraceExits(input.Ctx, input.OracleKeeper.VoteTargets(input.Ctx), 2)
+	require.Empty(t, diffs)
+
+	// Delisted denom
+	require.NoError(t, handler(input.Ctx, types.NewDelistDenomProposal("title", "description", types.TestDenomA)))
+	require.Equal(t, []whitelistDiff{{added: []string{}, removed: []string{types.TestDenomA}}}, diffs)
+
+	// Renamed denom
+	diffs = nil
+	require.NoError(t, handler(input.Ctx, types.NewRenameDenomProposal("title", "description", types.TestDenomB, types.TestDenomC)))
+	require.Equal(t, []whitelistDiff{{added: []string{types.TestDenomC}, removed: []string{types.TestDenomB}}}, diffs)
+
+	// Governance removes a denom through the whitelist param
+	diffs = nil
+	input.OracleKeeper.SetWhitelist(input.Ctx, types.DenomList{})
+	input.OracleKeeper.UpdateDenomGraceExits(input.Ctx, input.OracleKeeper.VoteTargets(input.Ctx), 3)
+	require.Equal(t, []whitelistDiff{{added: []string{}, removed: []string{types.TestDenomC}}}, diffs)
+}
+
+func TestWhitelistObserverPanic(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// A panicking observer does not fail the change, nor keep its writes, nor stop the others
+	called := 0
+	input.OracleKeeper.RegisterWhitelistObserver(func(ctx sdk.Context, _, _ []string) {
+		input.OracleKeeper.SetStaleCounter(ctx, types.TestDenomD, 1)
+		panic("observer failure")
+	})
+	input.OracleKeeper.RegisterWhitelistObserver(func(ctx sdk.Context, _, _ []string) {
+		input.OracleKeeper.SetStaleCounter(ctx, types.TestDenomE, 1)
+		called++
+	})
+
+	input.OracleKeeper.SetWhitelist(input.Ctx, types.DenomList{{Name: types.TestDenomA}})
+	require.NotPanics(t, func() {
+		input.OracleKeeper.DelistDenom(input.Ctx, types.TestDenomA)
+	})
+	require.Empty(t, input.OracleKeeper.Whitelist(input.Ctx))
+	require.Equal(t, 1, called)
+	require.Equal(t, uint64(0), input.OracleKeeper.GetStaleCounter(input.Ctx, types.TestDenomD))
+	require.Equal(t, uint64(1), input.OracleKeeper.GetStaleCounter(input.Ctx, types.TestDenomE))
+}
@@ -113,12 +113,22 @@ func RandomizedGenState(simState *module.SimulationState) {
 			SlashFraction:            slashFraction,
 			SlashWindow:              slashWindow,
 			MinValidPerWindow:        minValidPerWindow,
+			AggregationMethod:        types.DefaultAggregationMethod,
+			EvenMedianRule:           types.DefaultEvenMedianRule,
+			ModeBucketPrecision:      types.DefaultModeBucketPrecision,
+			ProgressiveSlashFloor:    sdk.ZeroDec(),
+			CommitmentHashAlgo:       types.DefaultCommitmentHashAlgo,
+			MaxPowerShare:            sdk.ZeroDec(),
+			RevealMissWeight:         sdk.OneDec(),
+			OracleFeeShare:           sdk.ZeroDec(),
 		},
 		[]types.ExchangeRateTuple{},
 		[]types.FeederDelegation{},
 		[]types.MissCounter{},
 		[]types.AggregateExchangeRatePrevote{},
 		[]types.AggregateExchangeRateVote{},
+		[]types.ObserverExemption{},
+		[]types.ValidatorAlertConfig{},
 	)
 
 	bz, err := json.MarshalIndent(&oracleGenesis.Params, "", " ")
@@ -6,43 +6,102 @@ import (
 	"github.com/Team-Kujira/core/x/oracle/types"
 )
 
-// Tally calculates the median and returns it. Sets the set of voters to be rewarded, i.e. voted within
-// a reasonable spread from the weighted median to the store
+// Tally calculates the exchange rate of the ballot with the given aggregation method, the weighted
+// median by default, and returns it. Sets the set of voters to be rewarded, i.e. voted within
+// a reasonable spread from the exchange rate to the store. The median of a ballot whose power
+// splits exactly in half is picked by the even median rule.
 // CONTRACT: pb must be sorted
 func Tally(_ sdk.Context,
 	pb types.ExchangeRateBallot,
 	rewardBand sdk.Dec,
+	aggregationMethod string,
+	modeBucketPrecision uint64,
+	evenMedianRule string,
 	validatorClaimMap map[string]types.Claim,
 	missMap map[string]sdk.ValAddress,
 ) (sdk.Dec, error) {
-	weightedMedian, err := pb.WeightedMedian()
+	var exchangeRate sdk.Dec
+	var err error
+	if aggregationMethod == types.AggregationMethodMode {
+		exchangeRate, err = pb.WeightedMode(modeBucketPrecision, evenMedianRule)
+	} else {
+		exchangeRate, err = pb.WeightedMedianWithRule(evenMedianRule)
+	}
 	if err != nil {
 		return sdk.ZeroDec(), err
 	}
 
-	standardDeviation, err := pb.StandardDeviation()
+	lowerBound, upperBound, err := RewardBounds(pb, exchangeRate, rewardBand, evenMedianRule)
 	if err != nil {
 		return sdk.ZeroDec(), err
 	}
 
-	rewardSpread := weightedMedian.Mul(rewardBand.QuoInt64(2))
-	rewardSpread = sdk.MaxDec(rewardSpread, standardDeviation)
+	spread := upperBound.Sub(exchangeRate)
+
+	// The accuracies are computed upfront, so that a failing tally leaves the claims untouched
+	accuracies := make([]sdk.Dec, len(pb))
+	for i, vote := range pb {
+		if vote.ExchangeRate.IsPositive() && vote.ExchangeRate.GTE(lowerBound) && vote.ExchangeRate.LTE(upperBound) {
+			accuracies[i], err = types.VoteAccuracy(vote.ExchangeRate, exchangeRate, spread)
+			if err != nil {
+				return sdk.ZeroDec(), err
+			}
+		}
+	}
 
-	for _, vote := range pb {
+	for i, vote := range pb {
 		key := vote.Voter.String()
 		claim := validatorClaimMap[key]
 		// Filter ballot winners & abstain voters
-		if (vote.ExchangeRate.GTE(weightedMedian.Sub(rewardSpread)) &&
-			vote.ExchangeRate.LTE(weightedMedian.Add(rewardSpread))) ||
+		if (vote.ExchangeRate.GTE(lowerBound) &&
+			vote.ExchangeRate.LTE(upperBound)) ||
 			!vote.ExchangeRate.IsPositive() {
 			claim := validatorClaimMap[key]
 			claim.Weight += vote.Power
 			claim.WinCount++
+
+			// Abstaining votes have no accuracy to weight their power by
+			if claim.AccuracyWeight.IsNil() {
+				claim.AccuracyWeight = sdk.ZeroDec()
+			}
+			if vote.ExchangeRate.IsPositive() {
+				claim.AccuracyWeight = claim.AccuracyWeight.Add(accuracies[i].MulInt64(vote.Power))
+			}
+
 			validatorClaimMap[key] = claim
 		} else {
 			missMap[claim.Recipient.String()] = claim.Recipient
 		}
 	}
 
-	return weightedMedian, nil
+	return exchangeRate, nil
+}
+
+// RewardBounds returns the range around the exchange rate of the ballot a vote has to be in
+// to be rewarded. It spans half the reward band, or the standard deviation of the ballot if larger,
+// on each side of the exchange rate. The deviation is taken around the median picked by the even
+// median rule.
+func RewardBounds(pb types.ExchangeRateBallot, exchangeRate, rewardBand sdk.Dec, evenMedianRule string) (sdk.Dec, sdk.Dec, error) {
+	standardDeviation, err := pb.StandardDeviationWithRule(evenMedianRule)
+	if err != nil {
+		return sdk.ZeroDec(), sdk.ZeroDec(), err
+	}
+
+	rewardSpread, err := types.SafeMul(exchangeRate, rewardBand.QuoInt64(2))
+	if err != nil {
+		return sdk.ZeroDec(), sdk.ZeroDec(), err
+	}
+	rewardSpread = sdk.MaxDec(rewardSpread, standardDeviation)
+
+	// Both bounds may exceed the sdk.Dec range for extreme medians
+	lowerBound, err := types.SafeSub(exchangeRate, rewardSpread)
+	if err != nil {
+		return sdk.ZeroDec(), sdk.ZeroDec(), err
+	}
+	upperBound, err := types.SafeAdd(exchangeRate, rewardSpread)
+	if err != nil {
+		return sdk.ZeroDec(), sdk.ZeroDec(), err
+	}
+
+	return lowerBound, upperBound, nil
 }
@@ -69,6 +69,6 @@ func TestFuzz_Tally(t *testing.T) {
 	missMap := map[string]sdk.ValAddress{}
 
 	require.NotPanics(t, func() {
-		oracle.Tally(input.Ctx, ballot, rewardBand, claimMap, missMap)
+		oracle.Tally(input.Ctx, ballot, rewardBand, types.AggregationMethodMedian, types.DefaultModeBucketPrecision, types.DefaultEvenMedianRule, claimMap, missMap)
 	})
 }
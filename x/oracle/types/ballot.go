@@ -1,6 +1,7 @@
 package types
 
 import (
+	"math/big"
 	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -52,35 +53,231 @@ func (pb ExchangeRateBallot) Power() int64 {
 	return totalPower
 }
 
+// BandPower returns the power of the votes rating the denom, i.e. not abstaining, and the
+// power of the ones between the bounds
+func (pb ExchangeRateBallot) BandPower(lowerBound, upperBound sdk.Dec) (ratedPower, inBandPower int64) {
+	for _, vote := range pb {
+		if !vote.ExchangeRate.IsPositive() {
+			continue
+		}
+
+		ratedPower += vote.Power
+		if vote.ExchangeRate.GTE(lowerBound) && vote.ExchangeRate.LTE(upperBound) {
+			inBandPower += vote.Power
+		}
+	}
+
+	return ratedPower, inBandPower
+}
+
+// CapPower caps the power of each vote at the max share of the total power of the ballot,
+// returning the number of votes capped. The excess power is dropped rather than redistributed
+// to the other votes, so a capped vote keeps exactly the max share of the power the ballot had.
+// A zero max share leaves the ballot untouched.
+func (pb ExchangeRateBallot) CapPower(maxShare sdk.Dec) (capped int) {
+	if !maxShare.IsPositive() {
+		return 0
+	}
+
+	// Rounded up, so the cap never drops a vote entirely
+	maxPower := maxShare.MulInt64(pb.Power()).Ceil().TruncateInt64()
+	for i := range pb {
+		if pb[i].Power > maxPower {
+			pb[i].Power = maxPower
+			capped++
+		}
+	}
+
+	return capped
+}
+
 // WeightedMedian returns the median weighted by the power of the ExchangeRateVote.
+// Votes without power, e.g. of validators whose power rounds to zero, are never the median,
+// so it is zero if no vote has power. A ballot whose power splits exactly in half takes the
+// lower of the two middle votes, see WeightedMedianWithRule.
 // CONTRACT: ballot must be sorted
 func (pb ExchangeRateBallot) WeightedMedian() (sdk.Dec, error) {
+	return pb.WeightedMedianWithRule(EvenMedianRuleLower)
+}
+
+// WeightedMedianWithRule returns the median weighted by the power of the ExchangeRateVote, i.e.
+// the first vote by which the summed power reaches half of the total power, rounded down. If the
+// summed power is exactly half of the total at that vote, e.g. with an even number of votes of
+// equal power, the rule picks the lower of the two middle votes, the upper one, i.e. the next
+// vote with power, or their average. Any other rule picks the lower one.
+// CONTRACT: ballot must be sorted
+func (pb ExchangeRateBallot) WeightedMedianWithRule(rule string) (sdk.Dec, error) {
 	if !sort.IsSorted(pb) {
 		return sdk.ZeroDec(), ErrBallotNotSorted
 	}
 
 	totalPower := pb.Power()
-	if pb.Len() > 0 {
-		pivot := int64(0)
-		for _, v := range pb {
-			votePower := v.Power
-
-			pivot += votePower
-			if pivot >= (totalPower / 2) {
-				return v.ExchangeRate, nil
+	pivot := int64(0)
+	for i, v := range pb {
+		votePower := v.Power
+		if votePower <= 0 {
+			continue
+		}
+
+		pivot += votePower
+		if pivot < totalPower/2 {
+			continue
+		}
+		if 2*pivot != totalPower || (rule != EvenMedianRuleUpper && rule != EvenMedianRuleAverage) {
+			return v.ExchangeRate, nil
+		}
+
+		// The other half of the power is held by the votes above, so one of them has power
+		for _, upper := range pb[i+1:] {
+			if upper.Power <= 0 {
+				continue
 			}
+			if rule == EvenMedianRuleUpper {
+				return upper.ExchangeRate, nil
+			}
+
+			// The sorted votes are averaged through their difference, which cannot overflow
+			return v.ExchangeRate.Add(upper.ExchangeRate.Sub(v.ExchangeRate).QuoInt64(2)), nil
 		}
+		return v.ExchangeRate, nil
 	}
 	return sdk.ZeroDec(), nil
 }
 
-// StandardDeviation returns the standard deviation by the power of the ExchangeRateVote.
+// MedianFlipPower returns the least power which, voting the target exchange rate, moves the
+// weighted median of the ballot, picked with the even median rule, to the target or past it,
+// away from the current median. It is zero if the median is the target already.
+// CONTRACT: ballot must be sorted
+func (pb ExchangeRateBallot) MedianFlipPower(target sdk.Dec, evenMedianRule string) (int64, error) {
+	median, err := pb.WeightedMedianWithRule(evenMedianRule)
+	if err != nil {
+		return 0, err
+	}
+
+	moved := func(power int64) bool {
+		ballot := make(ExchangeRateBallot, len(pb), len(pb)+1)
+		copy(ballot, pb)
+		ballot = append(ballot, NewVoteForTally(target, "", nil, power))
+		sort.Sort(ballot)
+
+		flipped, _ := ballot.WeightedMedianWithRule(evenMedianRule)
+		if target.GTE(median) {
+			return flipped.GTE(target)
+		}
+		return flipped.LTE(target)
+	}
+
+	// A power exceeding the power of the ballot by two always moves the median, and any more
+	// power moves it as well, so the least power moving it is searched for
+	totalPower := pb.Power()
+	return int64(sort.Search(int(totalPower+2), func(i int) bool {
+		return moved(int64(i))
+	})), nil
+}
+
+// Histogram buckets the votes of the ballot into n buckets of equal width between its lowest and
+// highest exchange rate, returning the n+1 edges of the buckets along with the power and the number
+// of votes of each. The buckets include their lower edge, and the last one its upper edge as well.
+// A ballot whose votes all have the same exchange rate has a single bucket.
+// CONTRACT: ballot must be sorted
+func (pb ExchangeRateBallot) Histogram(n int) (edges []sdk.Dec, powers []int64, votes []uint32, err error) {
+	if !sort.IsSorted(pb) {
+		return nil, nil, nil, ErrBallotNotSorted
+	}
+	if pb.Len() == 0 || n <= 0 {
+		return nil, nil, nil, nil
+	}
+
+	low, high := pb[0].ExchangeRate, pb[pb.Len()-1].ExchangeRate
+	width := high.Sub(low).QuoInt64(int64(n))
+	if !width.IsPositive() {
+		n = 1
+		width = high.Sub(low)
+	}
+
+	edges = make([]sdk.Dec, n+1)
+	for i := range edges {
+		edges[i] = low.Add(width.MulInt64(int64(i)))
+	}
+	edges[n] = high
+
+	powers = make([]int64, n)
+	votes = make([]uint32, n)
+	for _, v := range pb {
+		i := n - 1
+		if width.IsPositive() {
+			// The quotient is rounded, so the bucket is moved down if its lower edge is past the rate
+			i = int(v.ExchangeRate.Sub(low).Quo(width).TruncateInt64())
+			if i > n-1 {
+				i = n - 1
+			}
+			for i > 0 && v.ExchangeRate.LT(edges[i]) {
+				i--
+			}
+		}
+
+		powers[i] += v.Power
+		votes[i]++
+	}
+
+	return edges, powers, votes, nil
+}
+
+// WeightedMode returns the exchange rate of the bucket holding the most voting power,
+// where votes are grouped by their exchange rate rounded to precision decimal places.
+// The value of the winning bucket is the weighted median of its votes, picked with the even median rule,
+// ties are won by the lower bucket.
+// CONTRACT: ballot must be sorted
+func (pb ExchangeRateBallot) WeightedMode(precision uint64, evenMedianRule string) (sdk.Dec, error) {
+	if !sort.IsSorted(pb) {
+		return sdk.ZeroDec(), ErrBallotNotSorted
+	}
+
+	if pb.Len() == 0 {
+		return sdk.ZeroDec(), nil
+	}
+
+	// sorted votes share a bucket with their neighbours only
+	var modeBucket ExchangeRateBallot
+	modePower := int64(0)
+	start := 0
+	for i := 1; i <= pb.Len(); i++ {
+		if i < pb.Len() && bucketOf(pb[i].ExchangeRate, precision).Cmp(bucketOf(pb[start].ExchangeRate, precision)) == 0 {
+			continue
+		}
+
+		bucket := pb[start:i]
+		if power := bucket.Power(); modeBucket == nil || power > modePower {
+			modeBucket = bucket
+			modePower = power
+		}
+		start = i
+	}
+
+	return modeBucket.WeightedMedianWithRule(evenMedianRule)
+}
+
+// bucketOf returns the exchange rate rounded half up to precision decimal places, scaled to an integer
+func bucketOf(rate sdk.Dec, precision uint64) *big.Int {
+	unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(sdk.Precision-precision)), nil)
+	bucket := new(big.Int).Add(rate.BigInt(), new(big.Int).Quo(unit, big.NewInt(2)))
+	return bucket.Quo(bucket, unit)
+}
+
+// StandardDeviation returns the standard deviation by the power of the ExchangeRateVote,
+// around the weighted median picked by the lower even median rule, see StandardDeviationWithRule.
 func (pb ExchangeRateBallot) StandardDeviation() (sdk.Dec, error) {
+	return pb.StandardDeviationWithRule(EvenMedianRuleLower)
+}
+
+// StandardDeviationWithRule returns the standard deviation by the power of the ExchangeRateVote,
+// around the weighted median picked by the even median rule, see WeightedMedianWithRule.
+func (pb ExchangeRateBallot) StandardDeviationWithRule(evenMedianRule string) (sdk.Dec, error) {
 	if len(pb) == 0 {
 		return sdk.ZeroDec(), nil
 	}
 
-	median, err := pb.WeightedMedian()
+	median, err := pb.WeightedMedianWithRule(evenMedianRule)
 	if err != nil {
 		return sdk.ZeroDec(), err
 	}
@@ -88,15 +285,27 @@ func (pb ExchangeRateBallot) StandardDeviation() (sdk.Dec, error) {
 	sum := sdk.ZeroDec()
 	ballotLength := int64(len(pb))
 	for _, v := range pb {
-		func() {
-			defer func() {
-				if e := recover(); e != nil {
-					ballotLength--
-				}
-			}()
-			deviation := v.ExchangeRate.Sub(median)
-			sum = sum.Add(deviation.Mul(deviation))
-		}()
+		// Votes whose squared deviation does not fit into sdk.Dec are left out
+		deviation, err := SafeSub(v.ExchangeRate, median)
+		if err != nil {
+			ballotLength--
+			continue
+		}
+		squared, err := SafeMul(deviation, deviation)
+		if err != nil {
+			ballotLength--
+			continue
+		}
+		newSum, err := SafeAdd(sum, squared)
+		if err != nil {
+			ballotLength--
+			continue
+		}
+		sum = newSum
+	}
+
+	if ballotLength == 0 {
+		return sdk.ZeroDec(), ErrDecOverflow
 	}
 
 	variance := sum.QuoInt64(ballotLength)
@@ -131,6 +340,10 @@ type Claim struct {
 	Weight    int64
 	WinCount  int64
 	Recipient sdk.ValAddress
+
+	// AccuracyWeight is the power of the winning votes weighted by their accuracy,
+	// nil until the first winning vote is counted
+	AccuracyWeight sdk.Dec
 }
 
 // NewClaim generates a Claim instance.
@@ -142,3 +355,23 @@ func NewClaim(power, weight, winCount int64, recipient sdk.ValAddress) Claim {
 		Recipient: recipient,
 	}
 }
+
+// VoteAccuracy returns spread / (spread + |rate - exchangeRate|), the accuracy of a vote
+// within the reward spread around the exchange rate of its ballot. It is one for a vote
+// on the exchange rate and one half for a vote on the edge of the reward band.
+func VoteAccuracy(rate, exchangeRate, spread sdk.Dec) (sdk.Dec, error) {
+	if !spread.IsPositive() {
+		return sdk.OneDec(), nil
+	}
+
+	deviation, err := SafeSub(rate, exchangeRate)
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+	denominator, err := SafeAdd(spread, deviation.Abs())
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	return spread.Quo(denominator), nil
+}
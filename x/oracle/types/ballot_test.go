@@ -107,6 +107,56 @@ func TestPBPower(t *testing.T) {
 	require.Equal(t, ballotPower, pb.Power())
 }
 
+func TestPBBandPower(t *testing.T) {
+	_, valAccAddrs, _ := types.GenerateRandomTestCase()
+	pb := types.ExchangeRateBallot{
+		types.NewVoteForTally(sdk.NewDec(1), types.TestDenomD, valAccAddrs[0], 10),
+		types.NewVoteForTally(sdk.NewDec(2), types.TestDenomD, valAccAddrs[1], 20),
+		types.NewVoteForTally(sdk.NewDec(3), types.TestDenomD, valAccAddrs[2], 30),
+		types.NewVoteForTally(sdk.ZeroDec(), types.TestDenomD, valAccAddrs[3], 40),
+	}
+
+	// Abstaining votes are not rated, the bounds are inclusive
+	ratedPower, inBandPower := pb.BandPower(sdk.NewDec(2), sdk.NewDec(3))
+	require.Equal(t, int64(60), ratedPower)
+	require.Equal(t, int64(50), inBandPower)
+
+	ratedPower, inBandPower = types.ExchangeRateBallot{}.BandPower(sdk.NewDec(2), sdk.NewDec(3))
+	require.Zero(t, ratedPower)
+	require.Zero(t, inBandPower)
+}
+
+func TestPBCapPower(t *testing.T) {
+	_, valAccAddrs, _ := types.GenerateRandomTestCase()
+	newBallot := func() types.ExchangeRateBallot {
+		return types.ExchangeRateBallot{
+			types.NewVoteForTally(sdk.NewDec(1), types.TestDenomD, valAccAddrs[0], 10),
+			types.NewVoteForTally(sdk.NewDec(2), types.TestDenomD, valAccAddrs[1], 20),
+			types.NewVoteForTally(sdk.NewDec(3), types.TestDenomD, valAccAddrs[2], 70),
+		}
+	}
+
+	// Disabled
+	pb := newBallot()
+	pb.CapPower(sdk.ZeroDec())
+	require.Equal(t, newBallot(), pb)
+
+	// Only the votes above the cap lose power, which is not redistributed
+	pb = newBallot()
+	require.Equal(t, 1, pb.CapPower(sdk.NewDecWithPrec(25, 2)))
+	require.Equal(t, []int64{10, 20, 25}, []int64{pb[0].Power, pb[1].Power, pb[2].Power})
+
+	// The cap is rounded up
+	pb = newBallot()
+	pb.CapPower(sdk.NewDecWithPrec(1, 3))
+	require.Equal(t, []int64{1, 1, 1}, []int64{pb[0].Power, pb[1].Power, pb[2].Power})
+
+	// A cap of the whole ballot power changes nothing
+	pb = newBallot()
+	require.Zero(t, pb.CapPower(sdk.OneDec()))
+	require.Equal(t, newBallot(), pb)
+}
+
 func TestPBWeightedMedian(t *testing.T) {
 	tests := []struct {
 		inputs      []int64
@@ -147,6 +197,22 @@ func TestPBWeightedMedian(t *testing.T) {
 			sdk.NewDec(0),
 			false,
 		},
+		{
+			// Zero power votes are never the median, even below half of a single unit of power
+			[]int64{1, 2, 3},
+			[]int64{5, 1, 5},
+			[]bool{false, true, false},
+			sdk.NewDec(2),
+			false,
+		},
+		{
+			// Only zero power votes
+			[]int64{1, 2},
+			[]int64{1, 1},
+			[]bool{false, false},
+			sdk.NewDec(0),
+			false,
+		},
 		{
 			// not sorted panic
 			[]int64{2, 1, 10, 100000},
@@ -187,6 +253,128 @@ func TestPBWeightedMedian(t *testing.T) {
 	}
 }
 
+func TestPBWeightedMedianWithRule(t *testing.T) {
+	ballot := func(powers ...int64) types.ExchangeRateBallot {
+		pb := types.ExchangeRateBallot{}
+		for i, power := range powers {
+			pb = append(pb, types.NewVoteForTally(sdk.NewDec(int64(i+1)), types.TestDenomD, sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()), power))
+		}
+		return pb
+	}
+
+	tests := []struct {
+		name    string
+		ballot  types.ExchangeRateBallot
+		lower   sdk.Dec
+		upper   sdk.Dec
+		average sdk.Dec
+	}{
+		// four votes of equal power split in half between the second and the third
+		{"equal power", ballot(10, 10, 10, 10), sdk.NewDec(2), sdk.NewDec(3), sdk.NewDecWithPrec(25, 1)},
+		// the power splits in half after the second vote, whatever the number of votes
+		{"weighted split", ballot(10, 30, 20, 20), sdk.NewDec(2), sdk.NewDec(3), sdk.NewDecWithPrec(25, 1)},
+		// the third vote holds the middle of the power, so the rules agree
+		{"weighted middle", ballot(10, 20, 30, 20), sdk.NewDec(3), sdk.NewDec(3), sdk.NewDec(3)},
+		// votes without power are skipped for the upper middle vote
+		{"powerless upper", ballot(10, 10, 0, 20), sdk.NewDec(2), sdk.NewDec(4), sdk.NewDec(3)},
+	}
+
+	for _, tc := range tests {
+		for rule, expected := range map[string]sdk.Dec{
+			types.EvenMedianRuleLower:   tc.lower,
+			types.EvenMedianRuleUpper:   tc.upper,
+			types.EvenMedianRuleAverage: tc.average,
+		} {
+			median, err := tc.ballot.WeightedMedianWithRule(rule)
+			require.NoError(t, err)
+			require.Equal(t, expected, median, "%s: %s", tc.name, rule)
+		}
+
+		// The lower rule is the one of WeightedMedian, also taken for an unset rule
+		median, err := tc.ballot.WeightedMedian()
+		require.NoError(t, err)
+		require.Equal(t, tc.lower, median, tc.name)
+		median, err = tc.ballot.WeightedMedianWithRule("")
+		require.NoError(t, err)
+		require.Equal(t, tc.lower, median, tc.name)
+	}
+}
+
+func TestPBMedianFlipPower(t *testing.T) {
+	pb := types.ExchangeRateBallot{}
+	for _, rate := range []int64{1, 2, 3} {
+		pb = append(pb, types.NewVoteForTally(sdk.NewDec(rate), types.TestDenomD, sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()), 10))
+	}
+
+	median, err := pb.WeightedMedian()
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(2), median)
+
+	// 12 power at 3 makes the cumulative power of 1 and 2 fall short of half of the 42
+	power, err := pb.MedianFlipPower(sdk.NewDec(3), types.DefaultEvenMedianRule)
+	require.NoError(t, err)
+	require.Equal(t, int64(12), power)
+
+	// 9 power at 1 reaches half of the 39 together with the 10 voting 1
+	power, err = pb.MedianFlipPower(sdk.OneDec(), types.DefaultEvenMedianRule)
+	require.NoError(t, err)
+	require.Equal(t, int64(9), power)
+
+	// Moving past all the votes takes more power than the whole ballot
+	power, err = pb.MedianFlipPower(sdk.NewDec(100), types.DefaultEvenMedianRule)
+	require.NoError(t, err)
+	require.Equal(t, int64(32), power)
+
+	// The median is the target already
+	power, err = pb.MedianFlipPower(sdk.NewDec(2), types.DefaultEvenMedianRule)
+	require.NoError(t, err)
+	require.Zero(t, power)
+
+	// not sorted
+	pb[0], pb[2] = pb[2], pb[0]
+	_, err = pb.MedianFlipPower(sdk.NewDec(3), types.DefaultEvenMedianRule)
+	require.Error(t, err)
+}
+
+func TestPBHistogram(t *testing.T) {
+	pb := types.ExchangeRateBallot{}
+	for i, rate := range []string{"1", "1.5", "2", "3.9", "5"} {
+		pb = append(pb, types.NewVoteForTally(sdk.MustNewDecFromStr(rate), types.TestDenomD, sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()), int64(i+1)))
+	}
+
+	// The rates on an edge fall in the bucket above it, the highest in the last bucket
+	edges, powers, votes, err := pb.Histogram(4)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Dec{sdk.NewDec(1), sdk.NewDec(2), sdk.NewDec(3), sdk.NewDec(4), sdk.NewDec(5)}, edges)
+	require.Equal(t, []int64{3, 3, 4, 5}, powers)
+	require.Equal(t, []uint32{2, 1, 1, 1}, votes)
+
+	// Edges rounded down still bound the rates of their buckets
+	edges, powers, votes, err = pb.Histogram(3)
+	require.NoError(t, err)
+	require.Len(t, edges, 4)
+	require.Equal(t, sdk.MustNewDecFromStr("2.333333333333333333"), edges[1])
+	require.Equal(t, sdk.NewDec(5), edges[3])
+	require.Equal(t, []int64{6, 0, 9}, powers)
+	require.Equal(t, []uint32{3, 0, 2}, votes)
+
+	// A single vote, or votes all of the same rate, make a single bucket
+	edges, powers, votes, err = pb[:1].Histogram(20)
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Dec{sdk.NewDec(1), sdk.NewDec(1)}, edges)
+	require.Equal(t, []int64{1}, powers)
+	require.Equal(t, []uint32{1}, votes)
+
+	edges, _, _, err = types.ExchangeRateBallot{}.Histogram(20)
+	require.NoError(t, err)
+	require.Empty(t, edges)
+
+	// not sorted
+	pb[0], pb[2] = pb[2], pb[0]
+	_, _, _, err = pb.Histogram(4)
+	require.Error(t, err)
+}
+
 func TestPBStandardDeviation(t *testing.T) {
 	tests := []struct {
 		inputs            []float64
@@ -270,6 +458,43 @@ func TestPBStandardDeviationOverflow(t *testing.T) {
 	require.Equal(t, sdk.ZeroDec(), sd)
 }
 
+func TestPBStandardDeviationWithRule(t *testing.T) {
+	pb := types.ExchangeRateBallot{}
+	for _, rate := range []int64{1, 2, 4, 10} {
+		pb = append(pb, types.NewVoteForTally(sdk.NewDec(rate), types.TestDenomD, sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()), 10))
+	}
+
+	// The ballot splits in half between 2 and 4, the deviation is taken around the median of the rule
+	for rule, median := range map[string]sdk.Dec{
+		types.EvenMedianRuleLower:   sdk.NewDec(2),
+		types.EvenMedianRuleUpper:   sdk.NewDec(4),
+		types.EvenMedianRuleAverage: sdk.NewDec(3),
+	} {
+		variance := sdk.ZeroDec()
+		for _, vote := range pb {
+			deviation := vote.ExchangeRate.Sub(median)
+			variance = variance.Add(deviation.Mul(deviation))
+		}
+		expected, err := variance.QuoInt64(int64(len(pb))).ApproxSqrt()
+		require.NoError(t, err)
+
+		sd, err := pb.StandardDeviationWithRule(rule)
+		require.NoError(t, err)
+		require.Equal(t, expected, sd, rule)
+	}
+
+	sd, err := pb.StandardDeviationWithRule(types.EvenMedianRuleUpper)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(35, 1), sd)
+
+	// The lower median by default
+	lower, err := pb.StandardDeviationWithRule(types.EvenMedianRuleLower)
+	require.NoError(t, err)
+	sd, err = pb.StandardDeviation()
+	require.NoError(t, err)
+	require.Equal(t, lower, sd)
+}
+
 func TestNewClaim(t *testing.T) {
 	power := int64(10)
 	weight := int64(11)
@@ -283,3 +508,72 @@ func TestNewClaim(t *testing.T) {
 		Recipient: addr,
 	}, claim)
 }
+
+func TestPBStandardDeviationMaxRate(t *testing.T) {
+	maxRate := maxDec()
+
+	pb := types.ExchangeRateBallot{
+		types.NewVoteForTally(sdk.ZeroDec(), types.TestDenomA, sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()), 1),
+		types.NewVoteForTally(sdk.NewDec(2), types.TestDenomA, sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()), 2),
+		types.NewVoteForTally(maxRate, types.TestDenomA, sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()), 1),
+	}
+
+	// the squared deviation of the max rate vote is left out
+	deviation, err := pb.StandardDeviation()
+	require.NoError(t, err)
+	require.Equal(t, "1.414213562373095049", deviation.String())
+}
+
+func TestPBWeightedMode(t *testing.T) {
+	vote := func(rate string, power int64) types.VoteForTally {
+		return types.NewVoteForTally(sdk.MustNewDecFromStr(rate), types.TestDenomA, sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()), power)
+	}
+
+	tests := []struct {
+		name      string
+		ballot    types.ExchangeRateBallot
+		precision uint64
+		mode      sdk.Dec
+	}{
+		{
+			name:      "empty ballot",
+			ballot:    types.ExchangeRateBallot{},
+			precision: 2,
+			mode:      sdk.ZeroDec(),
+		},
+		{
+			name:      "clustered votes outweigh the single highest power bucket",
+			ballot:    types.ExchangeRateBallot{vote("1.001", 10), vote("1.002", 10), vote("1.004", 10), vote("1.5", 25), vote("2", 5)},
+			precision: 2,
+			mode:      sdk.MustNewDecFromStr("1.002"),
+		},
+		{
+			name:      "finer buckets split the cluster",
+			ballot:    types.ExchangeRateBallot{vote("1.001", 10), vote("1.002", 10), vote("1.004", 10), vote("1.5", 25), vote("2", 5)},
+			precision: 3,
+			mode:      sdk.MustNewDecFromStr("1.5"),
+		},
+		{
+			name:      "ties are won by the lower bucket",
+			ballot:    types.ExchangeRateBallot{vote("1", 10), vote("2", 10)},
+			precision: 0,
+			mode:      sdk.OneDec(),
+		},
+		{
+			name:      "rounding half up moves 1.5 into the bucket of 2.4",
+			ballot:    types.ExchangeRateBallot{vote("0.4", 5), vote("1.4", 5), vote("1.5", 3), vote("2.4", 4)},
+			precision: 0,
+			mode:      sdk.MustNewDecFromStr("1.5"),
+		},
+	}
+
+	for _, tc := range tests {
+		mode, err := tc.ballot.WeightedMode(tc.precision, types.DefaultEvenMedianRule)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.mode, mode, tc.name)
+	}
+
+	// unsorted ballot
+	_, err := types.ExchangeRateBallot{vote("2", 1), vote("1", 1)}.WeightedMode(2, types.DefaultEvenMedianRule)
+	require.ErrorIs(t, err, types.ErrBallotNotSorted)
+}
@@ -6,6 +6,7 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
 // RegisterLegacyAminoCodec registers the necessary x/oracle interfaces and concrete types
@@ -14,6 +15,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAggregateExchangeRatePrevote{}, "oracle/MsgAggregateExchangeRatePrevote", nil)
 	cdc.RegisterConcrete(&MsgAggregateExchangeRateVote{}, "oracle/MsgAggregateExchangeRateVote", nil)
 	cdc.RegisterConcrete(&MsgDelegateFeedConsent{}, "oracle/MsgDelegateFeedConsent", nil)
+	cdc.RegisterConcrete(&MsgSetOracleAlertConfig{}, "oracle/MsgSetOracleAlertConfig", nil)
+	cdc.RegisterConcrete(&RenameDenomProposal{}, "oracle/RenameDenomProposal", nil)
+	cdc.RegisterConcrete(&DelistDenomProposal{}, "oracle/DelistDenomProposal", nil)
+	cdc.RegisterConcrete(&SetObserverProposal{}, "oracle/SetObserverProposal", nil)
 }
 
 // RegisterInterfaces registers the x/oracle interfaces types with the interface registry
@@ -22,6 +27,13 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgDelegateFeedConsent{},
 		&MsgAggregateExchangeRatePrevote{},
 		&MsgAggregateExchangeRateVote{},
+		&MsgSetOracleAlertConfig{},
+	)
+
+	registry.RegisterImplementations((*govtypes.Content)(nil),
+		&RenameDenomProposal{},
+		&DelistDenomProposal{},
+		&SetObserverProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SafeAdd returns a + b, or ErrDecOverflow if the result exceeds the range of sdk.Dec.
+func SafeAdd(a, b sdk.Dec) (sdk.Dec, error) {
+	return safeDecOp(func() sdk.Dec { return a.Add(b) })
+}
+
+// SafeSub returns a - b, or ErrDecOverflow if the result exceeds the range of sdk.Dec.
+func SafeSub(a, b sdk.Dec) (sdk.Dec, error) {
+	return safeDecOp(func() sdk.Dec { return a.Sub(b) })
+}
+
+// SafeMul returns a * b, or ErrDecOverflow if the result exceeds the range of sdk.Dec.
+func SafeMul(a, b sdk.Dec) (sdk.Dec, error) {
+	return safeDecOp(func() sdk.Dec { return a.Mul(b) })
+}
+
+// SafeQuo returns a / b, or ErrDecOverflow if the result exceeds the range of sdk.Dec.
+func SafeQuo(a, b sdk.Dec) (sdk.Dec, error) {
+	return safeDecOp(func() sdk.Dec { return a.Quo(b) })
+}
+
+// safeDecOp runs op and converts the out of range panic of sdk.Dec into an error
+func safeDecOp(op func() sdk.Dec) (res sdk.Dec, err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			res, err = sdk.ZeroDec(), ErrDecOverflow
+		}
+	}()
+
+	return op(), nil
+}
@@ -0,0 +1,96 @@
+package types_test
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Team-Kujira/core/x/oracle/types"
+)
+
+// maxDec returns the largest value representable by sdk.Dec
+func maxDec() sdk.Dec {
+	maxInt := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 315), big.NewInt(1))
+	return sdk.NewDecFromBigIntWithPrec(maxInt, sdk.Precision)
+}
+
+func TestSafeDecOps(t *testing.T) {
+	max := maxDec()
+
+	sum, err := types.SafeAdd(sdk.NewDec(1), sdk.NewDec(2))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(3), sum)
+
+	_, err = types.SafeAdd(max, max)
+	require.ErrorIs(t, err, types.ErrDecOverflow)
+
+	diff, err := types.SafeSub(max, max)
+	require.NoError(t, err)
+	require.True(t, diff.IsZero())
+
+	_, err = types.SafeSub(max.Neg(), max)
+	require.ErrorIs(t, err, types.ErrDecOverflow)
+
+	product, err := types.SafeMul(max, sdk.OneDec())
+	require.NoError(t, err)
+	require.Equal(t, max, product)
+
+	_, err = types.SafeMul(max, sdk.NewDec(2))
+	require.ErrorIs(t, err, types.ErrDecOverflow)
+
+	quotient, err := types.SafeQuo(max, sdk.OneDec())
+	require.NoError(t, err)
+	require.Equal(t, max, quotient)
+
+	_, err = types.SafeQuo(max, sdk.NewDecWithPrec(5, 1))
+	require.ErrorIs(t, err, types.ErrDecOverflow)
+}
+
+// TestDecJSONFormat pins the format of the decimals of the query responses and the CLI output,
+// which print the responses with the proto codec or the legacy amino one
+func TestDecJSONFormat(t *testing.T) {
+	canonical := regexp.MustCompile(`^-?(0|[1-9][0-9]*)\.[0-9]{18}$`)
+	protoCdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	for _, tc := range []struct {
+		rate     sdk.Dec
+		expected string
+	}{
+		{sdk.ZeroDec(), "0.000000000000000000"},
+		{sdk.OneDec(), "1.000000000000000000"},
+		{sdk.NewDecWithPrec(1, sdk.Precision), "0.000000000000000001"},
+		{sdk.NewDecWithPrec(123456, 10), "0.000012345600000000"},
+		{sdk.MustNewDecFromStr("123456789012345678901234567890.5"), "123456789012345678901234567890.500000000000000000"},
+		{maxDec(), maxDec().String()},
+	} {
+		require.Regexp(t, canonical, tc.expected)
+		require.Equal(t, tc.expected, tc.rate.String())
+
+		bz, err := protoCdc.MarshalJSON(&types.QueryExchangeRateResponse{ExchangeRate: tc.rate, UsdExchangeRate: tc.rate})
+		require.NoError(t, err)
+		require.Contains(t, string(bz), fmt.Sprintf(`"exchange_rate":"%s"`, tc.expected))
+		require.Contains(t, string(bz), fmt.Sprintf(`"usd_exchange_rate":"%s"`, tc.expected))
+
+		bz, err = protoCdc.MarshalJSON(&types.QueryExchangeRatesResponse{
+			ExchangeRates: sdk.DecCoins{sdk.NewDecCoinFromDec(types.TestDenomA, tc.rate)},
+		})
+		require.NoError(t, err)
+		require.Contains(t, string(bz), fmt.Sprintf(`"amount":"%s"`, tc.expected))
+
+		bz, err = codec.NewLegacyAmino().MarshalJSON(types.NewExchangeRateTuple(types.TestDenomA, tc.rate))
+		require.NoError(t, err)
+		require.Contains(t, string(bz), fmt.Sprintf(`"exchange_rate":"%s"`, tc.expected))
+	}
+
+	// A decimal left unset is printed as a bare zero, so the responses always set theirs
+	bz, err := protoCdc.MarshalJSON(&types.QueryExchangeRateResponse{ExchangeRate: sdk.Dec{}})
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"exchange_rate":"0"`)
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"strings"
 
 	"gopkg.in/yaml.v2"
@@ -14,7 +15,20 @@ func (d Denom) String() string {
 
 // Equal implements equal interface
 func (d Denom) Equal(d1 *Denom) bool {
-	return d.Name == d1.Name
+	return d.Name == d1.Name && d.VotePeriodMultiplier == d1.VotePeriodMultiplier && d.QuoteDenom == d1.QuoteDenom && d.Tracks == d1.Tracks
+}
+
+// IsDue returns whether the denom is tallied in the vote period
+func (d Denom) IsDue(votePeriod uint64) bool {
+	return d.VotePeriodMultiplier <= 1 || votePeriod%d.VotePeriodMultiplier == 0
+}
+
+// NextDuePeriod returns the first vote period from the given one on, which tallies the denom
+func (d Denom) NextDuePeriod(votePeriod uint64) uint64 {
+	if d.IsDue(votePeriod) {
+		return votePeriod
+	}
+	return (votePeriod/d.VotePeriodMultiplier + 1) * d.VotePeriodMultiplier
 }
 
 // DenomList is array of Denom
@@ -27,3 +41,115 @@ func (dl DenomList) String() (out string) {
 	}
 	return strings.TrimSpace(out)
 }
+
+// QuoteDenom returns the denom the exchange rate of the whitelisted denom is quoted in, empty for USD
+func (dl DenomList) QuoteDenom(name string) string {
+	for _, d := range dl {
+		if d.Name == name {
+			return d.QuoteDenom
+		}
+	}
+	return ""
+}
+
+// QuotedIn returns the names of the denoms whose exchange rates are quoted in the denom
+func (dl DenomList) QuotedIn(name string) (names []string) {
+	for _, d := range dl {
+		if d.QuoteDenom == name {
+			names = append(names, d.Name)
+		}
+	}
+	return names
+}
+
+// TrackedDenom returns the denom whose exchange rate the whitelisted denom mirrors, following
+// the denoms tracking other denoms to the one with a ballot of its own, empty if it has a ballot
+func (dl DenomList) TrackedDenom(name string) string {
+	tracks := make(map[string]string, len(dl))
+	for _, d := range dl {
+		tracks[d.Name] = d.Tracks
+	}
+
+	// The whitelist validation rules out cycles of tracked denoms
+	tracked := ""
+	for next := tracks[name]; len(next) != 0; next = tracks[next] {
+		tracked = next
+	}
+
+	return tracked
+}
+
+// TrackedBy returns the names of the denoms directly tracking the denom
+func (dl DenomList) TrackedBy(name string) (names []string) {
+	for _, d := range dl {
+		if d.Tracks == name {
+			names = append(names, d.Name)
+		}
+	}
+	return names
+}
+
+// validateQuoteDenoms checks that the quote denom of each denom is whitelisted,
+// and that the chain of quote denoms of each denom ends in USD
+func (dl DenomList) validateQuoteDenoms() error {
+	quotes := make(map[string]string, len(dl))
+	for _, d := range dl {
+		quotes[d.Name] = d.QuoteDenom
+	}
+
+	for _, d := range dl {
+		if len(d.QuoteDenom) == 0 {
+			continue
+		}
+		if _, ok := quotes[d.QuoteDenom]; !ok {
+			return fmt.Errorf("quote denom %s of %s is not whitelisted", d.QuoteDenom, d.Name)
+		}
+
+		// A chain visiting more denoms than whitelisted is a cycle
+		quote := d.QuoteDenom
+		for i := 0; len(quote) != 0; i++ {
+			if i == len(dl) {
+				return fmt.Errorf("quote denoms of %s form a cycle", d.Name)
+			}
+			quote = quotes[quote]
+		}
+	}
+
+	return nil
+}
+
+// validateTrackedDenoms checks that the tracked denom of each denom is whitelisted and quoted in
+// the same denom, and that the chain of tracked denoms of each denom ends in a denom with a ballot
+func (dl DenomList) validateTrackedDenoms() error {
+	denoms := make(map[string]Denom, len(dl))
+	for _, d := range dl {
+		denoms[d.Name] = d
+	}
+
+	for _, d := range dl {
+		if len(d.Tracks) == 0 {
+			continue
+		}
+		tracked, ok := denoms[d.Tracks]
+		if !ok {
+			return fmt.Errorf("tracked denom %s of %s is not whitelisted", d.Tracks, d.Name)
+		}
+		if d.QuoteDenom != tracked.QuoteDenom {
+			return fmt.Errorf("%s must be quoted in the quote denom of its tracked denom %s", d.Name, d.Tracks)
+		}
+		if d.VotePeriodMultiplier > 1 {
+			return fmt.Errorf("%s tracks %s and cannot have a vote period multiplier", d.Name, d.Tracks)
+		}
+
+		// A chain visiting more denoms than whitelisted is a cycle
+		next := d.Tracks
+		for i := 0; len(next) != 0; i++ {
+			if i == len(dl) {
+				return fmt.Errorf("tracked denoms of %s form a cycle", d.Name)
+			}
+			next = denoms[next].Tracks
+		}
+	}
+
+	return nil
+}
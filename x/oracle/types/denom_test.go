@@ -28,3 +28,58 @@ func Test_DenomList(t *testing.T) {
 	require.Equal(t, "name: denom3\n", denoms[2].String())
 	require.Equal(t, "name: denom1\n\nname: denom2\n\nname: denom3", denoms.String())
 }
+
+func TestDenomListQuoteDenoms(t *testing.T) {
+	denoms := types.DenomList{
+		{Name: "lp", QuoteDenom: "atom"},
+		{Name: "atom"},
+		{Name: "statom", QuoteDenom: "atom"},
+	}
+
+	require.Equal(t, "atom", denoms.QuoteDenom("lp"))
+	require.Empty(t, denoms.QuoteDenom("atom"))
+	require.Empty(t, denoms.QuoteDenom("unknown"))
+	require.Equal(t, []string{"lp", "statom"}, denoms.QuotedIn("atom"))
+	require.Empty(t, denoms.QuotedIn("lp"))
+	require.False(t, denoms[0].Equal(&types.Denom{Name: "lp"}))
+}
+
+func TestDenomListTrackedDenoms(t *testing.T) {
+	denoms := types.DenomList{
+		{Name: "stwbtc", Tracks: "wbtc"},
+		{Name: "wbtc", Tracks: "btc"},
+		{Name: "btc"},
+		{Name: "axlwbtc", Tracks: "btc"},
+	}
+
+	require.Equal(t, "btc", denoms.TrackedDenom("stwbtc"))
+	require.Equal(t, "btc", denoms.TrackedDenom("wbtc"))
+	require.Empty(t, denoms.TrackedDenom("btc"))
+	require.Empty(t, denoms.TrackedDenom("unknown"))
+	require.Equal(t, []string{"wbtc", "axlwbtc"}, denoms.TrackedBy("btc"))
+	require.Empty(t, denoms.TrackedBy("stwbtc"))
+	require.False(t, denoms[1].Equal(&types.Denom{Name: "wbtc"}))
+}
+
+func TestDenomIsDue(t *testing.T) {
+	require.True(t, types.Denom{Name: "denom1"}.IsDue(7))
+	require.True(t, types.Denom{Name: "denom1", VotePeriodMultiplier: 1}.IsDue(7))
+
+	denom := types.Denom{Name: "denom1", VotePeriodMultiplier: 4}
+	require.True(t, denom.IsDue(0))
+	require.False(t, denom.IsDue(1))
+	require.False(t, denom.IsDue(3))
+	require.True(t, denom.IsDue(8))
+	require.False(t, denom.Equal(&types.Denom{Name: "denom1"}))
+}
+
+func TestDenomNextDuePeriod(t *testing.T) {
+	require.Equal(t, uint64(7), types.Denom{Name: "denom1"}.NextDuePeriod(7))
+	require.Equal(t, uint64(7), types.Denom{Name: "denom1", VotePeriodMultiplier: 1}.NextDuePeriod(7))
+
+	denom := types.Denom{Name: "denom1", VotePeriodMultiplier: 4}
+	require.Equal(t, uint64(0), denom.NextDuePeriod(0))
+	require.Equal(t, uint64(4), denom.NextDuePeriod(1))
+	require.Equal(t, uint64(4), denom.NextDuePeriod(4))
+	require.Equal(t, uint64(8), denom.NextDuePeriod(5))
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/cometbft/cometbft/crypto/tmhash"
+	"google.golang.org/grpc/codes"
 
 	"cosmossdk.io/errors"
 )
@@ -20,8 +21,23 @@ var (
 	ErrRevealPeriodMissMatch = errors.Register(ModuleName, 8, "reveal period of submitted vote do not match with registered prevote")
 	ErrInvalidSaltLength     = errors.Register(ModuleName, 9, "invalid salt length; must be 64")
 	ErrInvalidSaltFormat     = errors.Register(ModuleName, 10, "invalid salt format")
-	ErrNoAggregatePrevote    = errors.Register(ModuleName, 11, "no aggregate prevote")
-	ErrNoAggregateVote       = errors.Register(ModuleName, 12, "no aggregate vote")
-	ErrUnknownDenom          = errors.Register(ModuleName, 13, "unknown denom")
+	ErrNoAggregatePrevote    = errors.RegisterWithGRPCCode(ModuleName, 11, codes.NotFound, "no aggregate prevote")
+	ErrNoAggregateVote       = errors.RegisterWithGRPCCode(ModuleName, 12, codes.NotFound, "no aggregate vote")
+	ErrUnknownDenom          = errors.RegisterWithGRPCCode(ModuleName, 13, codes.NotFound, "unknown denom")
 	ErrBallotNotSorted       = errors.Register(ModuleName, 14, "ballot not sorted")
+	ErrDecOverflow           = errors.Register(ModuleName, 15, "decimal overflow")
+	ErrInvalidValidator      = errors.RegisterWithGRPCCode(ModuleName, 16, codes.InvalidArgument, "invalid validator address")
+	ErrInvalidFeeder         = errors.RegisterWithGRPCCode(ModuleName, 17, codes.InvalidArgument, "invalid feeder address")
+	ErrUnknownValidator      = errors.RegisterWithGRPCCode(ModuleName, 18, codes.NotFound, "unknown validator")
+	ErrInvalidDenom          = errors.RegisterWithGRPCCode(ModuleName, 19, codes.InvalidArgument, "invalid denom")
+	ErrDenomExists           = errors.Register(ModuleName, 20, "denom already exists")
+	ErrDenomRequired         = errors.Register(ModuleName, 21, "denom required by another module")
+	ErrInvalidExemption      = errors.Register(ModuleName, 22, "invalid observer exemption")
+	ErrFeederChangeCooldown  = errors.Register(ModuleName, 23, "feeder delegation changed too recently")
+	ErrNoAlertConfig         = errors.RegisterWithGRPCCode(ModuleName, 24, codes.NotFound, "no alert config")
+	ErrStaleExchangeRate     = errors.RegisterWithGRPCCode(ModuleName, 25, codes.FailedPrecondition, "stale exchange rate")
+	ErrTooManyDenoms         = errors.Register(ModuleName, 26, "too many denoms in vote")
+	ErrVoteTooFarAhead       = errors.Register(ModuleName, 27, "vote bound too far ahead")
+	ErrVoteNotBound          = errors.Register(ModuleName, 28, "vote not bound to a vote period")
+	ErrVoteExpired           = errors.Register(ModuleName, 29, "vote bound to an expired vote period")
 )
@@ -2,12 +2,20 @@ package types
 
 // Oracle module event types
 const (
-	EventTypeExchangeRateUpdate = "exchange_rate_update"
-	EventTypePrevote            = "prevote"
-	EventTypeVote               = "vote"
-	EventTypeFeedDelegate       = "feed_delegate"
-	EventTypeAggregatePrevote   = "aggregate_prevote"
-	EventTypeAggregateVote      = "aggregate_vote"
+	EventTypeExchangeRateUpdate       = "exchange_rate_update"
+	EventTypePrevote                  = "prevote"
+	EventTypeVote                     = "vote"
+	EventTypeFeedDelegate             = "feed_delegate"
+	EventTypeAggregatePrevote         = "aggregate_prevote"
+	EventTypeAggregateVote            = "aggregate_vote"
+	EventTypeDenomAutoDelisted        = "denom_auto_delisted"
+	EventTypeExchangeRateUpdates      = "exchange_rate_updates"
+	EventTypeDenomsAutoDelisted       = "denoms_auto_delisted"
+	EventTypeFeederDelegationChanged  = "feeder_delegation_changed"
+	EventTypeFeederDelegationCleared  = "feeder_delegation_cleared"
+	EventTypeOracleAlertConfigSet     = "oracle_alert_config_set"
+	EventTypeOracleAlertCrossed       = "oracle_alert_crossed"
+	EventTypeCommitmentHashAlgoSwitch = "commitment_hash_algo_switch"
 
 	AttributeKeyDenom         = "denom"
 	AttributeKeyVoter         = "voter"
@@ -15,6 +23,25 @@ const (
 	AttributeKeyExchangeRates = "exchange_rates"
 	AttributeKeyOperator      = "operator"
 	AttributeKeyFeeder        = "feeder"
+	AttributeKeyStaleWindows  = "stale_windows"
+	AttributeKeyOldFeeder     = "old_feeder"
+	AttributeKeyNewFeeder     = "new_feeder"
+	AttributeKeyHeight        = "height"
+	AttributeKeyOldAlgo       = "old_algo"
+	AttributeKeyNewAlgo       = "new_algo"
+	AttributeKeyAttestation   = "attestation"
+	AttributeKeyDenoms        = "denoms"
+	AttributeKeyCount         = "count"
+	AttributeKeyMaxMissRate   = "max_miss_rate"
+	AttributeKeyMissRate      = "miss_rate"
 
 	AttributeValueCategory = ModuleName
 )
+
+// EventOracleUpdateVersion is the version of the format of EventOracleUpdate
+const EventOracleUpdateVersion = 1
+
+// Reasons of EventVoteTupleRejected
+const (
+	RejectReasonNotVoteTarget = "not_vote_target"
+)
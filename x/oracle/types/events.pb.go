@@ -0,0 +1,950 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kujira/oracle/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventOracleUpdate is emitted once per tally with the exchange rates it set,
+// for indexers to decode instead of parsing attribute events.
+type EventOracleUpdate struct {
+	// version defines the version of the event format, increased on breaking
+	// changes.
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// vote_period defines the vote period tallied.
+	VotePeriod uint64 `protobuf:"varint,2,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty"`
+	// entries defines the exchange rates set by the tally, sorted by denom.
+	Entries []OracleUpdateEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *EventOracleUpdate) Reset()         { *m = EventOracleUpdate{} }
+func (m *EventOracleUpdate) String() string { return proto.CompactTextString(m) }
+func (*EventOracleUpdate) ProtoMessage()    {}
+func (*EventOracleUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c7d6ec6304861fe4, []int{0}
+}
+func (m *EventOracleUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOracleUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOracleUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOracleUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOracleUpdate.Merge(m, src)
+}
+func (m *EventOracleUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOracleUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOracleUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOracleUpdate proto.InternalMessageInfo
+
+func (m *EventOracleUpdate) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *EventOracleUpdate) GetVotePeriod() uint64 {
+	if m != nil {
+		return m.VotePeriod
+	}
+	return 0
+}
+
+func (m *EventOracleUpdate) GetEntries() []OracleUpdateEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// OracleUpdateEntry defines an exchange rate set by a tally.
+type OracleUpdateEntry struct {
+	// denom defines the denom of the exchange rate.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// exchange_rate defines the tallied exchange rate.
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate"`
+	// vote_period defines the vote period the exchange rate was tallied in.
+	VotePeriod uint64 `protobuf:"varint,3,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty"`
+}
+
+func (m *OracleUpdateEntry) Reset()         { *m = OracleUpdateEntry{} }
+func (m *OracleUpdateEntry) String() string { return proto.CompactTextString(m) }
+func (*OracleUpdateEntry) ProtoMessage()    {}
+func (*OracleUpdateEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c7d6ec6304861fe4, []int{1}
+}
+func (m *OracleUpdateEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleUpdateEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleUpdateEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleUpdateEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleUpdateEntry.Merge(m, src)
+}
+func (m *OracleUpdateEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleUpdateEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleUpdateEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleUpdateEntry proto.InternalMessageInfo
+
+func (m *OracleUpdateEntry) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *OracleUpdateEntry) GetVotePeriod() uint64 {
+	if m != nil {
+		return m.VotePeriod
+	}
+	return 0
+}
+
+// EventVoteTupleRejected is emitted for each exchange rate of a revealed vote
+// which is left out of the tally, so the validator misses the denom it meant.
+type EventVoteTupleRejected struct {
+	// validator defines the validator the vote was revealed for.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// denom defines the denom of the rejected exchange rate.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// reason defines why the exchange rate is not tallied.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventVoteTupleRejected) Reset()         { *m = EventVoteTupleRejected{} }
+func (m *EventVoteTupleRejected) String() string { return proto.CompactTextString(m) }
+func (*EventVoteTupleRejected) ProtoMessage()    {}
+func (*EventVoteTupleRejected) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c7d6ec6304861fe4, []int{2}
+}
+func (m *EventVoteTupleRejected) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventVoteTupleRejected) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventVoteTupleRejected.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventVoteTupleRejected) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVoteTupleRejected.Merge(m, src)
+}
+func (m *EventVoteTupleRejected) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventVoteTupleRejected) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVoteTupleRejected.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventVoteTupleRejected proto.InternalMessageInfo
+
+func (m *EventVoteTupleRejected) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *EventVoteTupleRejected) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventVoteTupleRejected) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventOracleUpdate)(nil), "kujira.oracle.EventOracleUpdate")
+	proto.RegisterType((*OracleUpdateEntry)(nil), "kujira.oracle.OracleUpdateEntry")
+	proto.RegisterType((*EventVoteTupleRejected)(nil), "kujira.oracle.EventVoteTupleRejected")
+}
+
+func init() { proto.RegisterFile("kujira/oracle/events.proto", fileDescriptor_c7d6ec6304861fe4) }
+
+var fileDescriptor_c7d6ec6304861fe4 = []byte{
+	// 370 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xcd, 0xaa, 0xda, 0x40,
+	0x14, 0xc7, 0x33, 0xd7, 0xdb, 0x2b, 0x19, 0xeb, 0xc2, 0x20, 0x12, 0xa4, 0xc4, 0xe0, 0xa2, 0x48,
+	0xc1, 0x04, 0xda, 0x17, 0x28, 0xa2, 0xab, 0x2e, 0x5a, 0xa6, 0xb6, 0x8b, 0x6e, 0x64, 0x4c, 0x0e,
+	0x31, 0x6a, 0x72, 0xc2, 0xcc, 0x18, 0xf4, 0x2d, 0x7c, 0x83, 0xbe, 0x8e, 0x4b, 0x97, 0xa5, 0x0b,
+	0x29, 0xfa, 0x22, 0x25, 0x13, 0x83, 0xd6, 0xae, 0x66, 0xce, 0xf7, 0xff, 0x37, 0x73, 0x68, 0x77,
+	0xb5, 0x59, 0xc6, 0x82, 0xfb, 0x28, 0x78, 0xb0, 0x06, 0x1f, 0x72, 0x48, 0x95, 0xf4, 0x32, 0x81,
+	0x0a, 0xad, 0x66, 0x19, 0xf3, 0xca, 0x58, 0xb7, 0x1d, 0x61, 0x84, 0x3a, 0xe2, 0x17, 0xb7, 0x32,
+	0xa9, 0xbf, 0x27, 0xb4, 0x35, 0x29, 0xaa, 0x3e, 0xeb, 0xac, 0x6f, 0x59, 0xc8, 0x15, 0x58, 0x36,
+	0xad, 0xe7, 0x20, 0x64, 0x8c, 0xa9, 0x4d, 0x5c, 0x32, 0x68, 0xb2, 0xca, 0xb4, 0x7a, 0xb4, 0x91,
+	0xa3, 0x82, 0x59, 0x06, 0x22, 0xc6, 0xd0, 0x7e, 0x72, 0xc9, 0xe0, 0x99, 0xd1, 0xc2, 0xf5, 0x45,
+	0x7b, 0xac, 0x8f, 0xb4, 0x0e, 0xa9, 0x12, 0x31, 0x48, 0xbb, 0xe6, 0xd6, 0x06, 0x8d, 0xf7, 0xae,
+	0xf7, 0x8f, 0x0e, 0xef, 0x7e, 0xd0, 0x24, 0x55, 0x62, 0x37, 0x7a, 0x3e, 0x9c, 0x7a, 0x06, 0xab,
+	0xca, 0xfa, 0x3f, 0x09, 0x6d, 0xfd, 0x97, 0x64, 0xb5, 0xe9, 0xab, 0x10, 0x52, 0x4c, 0xb4, 0x20,
+	0x93, 0x95, 0x86, 0xf5, 0x95, 0x36, 0x61, 0x1b, 0x2c, 0x78, 0x1a, 0xc1, 0x4c, 0x70, 0x05, 0x5a,
+	0x90, 0x39, 0xf2, 0x8a, 0x8e, 0xbf, 0x4f, 0xbd, 0xb7, 0x51, 0xac, 0x16, 0x9b, 0xb9, 0x17, 0x60,
+	0xe2, 0x07, 0x28, 0x13, 0x94, 0xd7, 0x63, 0x28, 0xc3, 0x95, 0xaf, 0x76, 0x19, 0x48, 0x6f, 0x0c,
+	0x01, 0x7b, 0x5d, 0x35, 0x61, 0x05, 0xfd, 0x03, 0x63, 0xed, 0x91, 0xb1, 0x1f, 0xd2, 0x8e, 0x7e,
+	0xb3, 0xef, 0xa8, 0x60, 0xba, 0xc9, 0xd6, 0xc0, 0x60, 0x09, 0x81, 0x82, 0xd0, 0x7a, 0x43, 0xcd,
+	0x9c, 0xaf, 0xe3, 0x90, 0x2b, 0x14, 0x57, 0xa5, 0x37, 0xc7, 0x8d, 0xe1, 0xe9, 0x9e, 0xa1, 0x43,
+	0x5f, 0x04, 0x70, 0x89, 0xa9, 0x9e, 0x64, 0xb2, 0xab, 0x35, 0x1a, 0x1f, 0xce, 0x0e, 0x39, 0x9e,
+	0x1d, 0xf2, 0xe7, 0xec, 0x90, 0xfd, 0xc5, 0x31, 0x8e, 0x17, 0xc7, 0xf8, 0x75, 0x71, 0x8c, 0x1f,
+	0xef, 0xee, 0xb0, 0xa6, 0xc0, 0x93, 0xe1, 0xa7, 0x72, 0x0b, 0x02, 0x14, 0xe0, 0x6f, 0xab, 0x65,
+	0xd0, 0x78, 0xf3, 0x17, 0xfd, 0xcf, 0x1f, 0xfe, 0x0e, 0x00, 0x30, 0x65, 0xde, 0x7f, 0x2a, 0x02,
+	0x00, 0x00,
+}
+
+func (m *EventOracleUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOracleUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOracleUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.VotePeriod != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.VotePeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Version != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleUpdateEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleUpdateEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleUpdateEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotePeriod != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.VotePeriod))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventVoteTupleRejected) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventVoteTupleRejected) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventVoteTupleRejected) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventOracleUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovEvents(uint64(m.Version))
+	}
+	if m.VotePeriod != 0 {
+		n += 1 + sovEvents(uint64(m.VotePeriod))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OracleUpdateEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.VotePeriod != 0 {
+		n += 1 + sovEvents(uint64(m.VotePeriod))
+	}
+	return n
+}
+
+func (m *EventVoteTupleRejected) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventOracleUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOracleUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOracleUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, OracleUpdateEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleUpdateEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleUpdateEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleUpdateEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventVoteTupleRejected) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventVoteTupleRejected: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventVoteTupleRejected: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -21,6 +21,7 @@ type StakingKeeper interface {
 	ValidatorsPowerStoreIterator(ctx sdk.Context) sdk.Iterator                 // an iterator for the current validator power store
 	MaxValidators(sdk.Context) uint32                                          // MaxValidators returns the maximum amount of bonded validators
 	PowerReduction(ctx sdk.Context) (res math.Int)
+	IterateLastValidators(ctx sdk.Context, fn func(index int64, validator stakingtypes.ValidatorI) (stop bool)) // iterate through the validators of the last block
 }
 
 // DistributionKeeper is expected keeper for distribution module
@@ -12,6 +12,8 @@ func NewGenesisState(
 	feederDelegations []FeederDelegation, missCounters []MissCounter,
 	aggregateExchangeRatePrevotes []AggregateExchangeRatePrevote,
 	aggregateExchangeRateVotes []AggregateExchangeRateVote,
+	observerExemptions []ObserverExemption,
+	validatorAlertConfigs []ValidatorAlertConfig,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                        params,
@@ -20,6 +22,8 @@ func NewGenesisState(
 		MissCounters:                  missCounters,
 		AggregateExchangeRatePrevotes: aggregateExchangeRatePrevotes,
 		AggregateExchangeRateVotes:    aggregateExchangeRateVotes,
+		ObserverExemptions:            observerExemptions,
+		ValidatorAlertConfigs:         validatorAlertConfigs,
 	}
 }
 
@@ -30,7 +34,9 @@ func DefaultGenesisState() *GenesisState {
 		[]FeederDelegation{},
 		[]MissCounter{},
 		[]AggregateExchangeRatePrevote{},
-		[]AggregateExchangeRateVote{})
+		[]AggregateExchangeRateVote{},
+		[]ObserverExemption{},
+		[]ValidatorAlertConfig{})
 }
 
 // ValidateGenesis validates the oracle genesis state
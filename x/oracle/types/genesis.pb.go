@@ -32,6 +32,8 @@ type GenesisState struct {
 	MissCounters                  []MissCounter                  `protobuf:"bytes,4,rep,name=miss_counters,json=missCounters,proto3" json:"miss_counters"`
 	AggregateExchangeRatePrevotes []AggregateExchangeRatePrevote `protobuf:"bytes,5,rep,name=aggregate_exchange_rate_prevotes,json=aggregateExchangeRatePrevotes,proto3" json:"aggregate_exchange_rate_prevotes"`
 	AggregateExchangeRateVotes    []AggregateExchangeRateVote    `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
+	ObserverExemptions            []ObserverExemption            `protobuf:"bytes,7,rep,name=observer_exemptions,json=observerExemptions,proto3" json:"observer_exemptions"`
+	ValidatorAlertConfigs         []ValidatorAlertConfig         `protobuf:"bytes,8,rep,name=validator_alert_configs,json=validatorAlertConfigs,proto3" json:"validator_alert_configs"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -109,6 +111,20 @@ func (m *GenesisState) GetAggregateExchangeRateVotes() []AggregateExchangeRateVo
 	return nil
 }
 
+func (m *GenesisState) GetObserverExemptions() []ObserverExemption {
+	if m != nil {
+		return m.ObserverExemptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorAlertConfigs() []ValidatorAlertConfig {
+	if m != nil {
+		return m.ValidatorAlertConfigs
+	}
+	return nil
+}
+
 // FeederDelegation is the address for where oracle feeder authority are
 // delegated to. By default this struct is only used at genesis to feed in
 // default feeder addresses.
@@ -218,47 +234,164 @@ func (m *MissCounter) GetMissCounter() uint64 {
 	return 0
 }
 
+// ObserverExemption defines the height until which an observer is exempt from
+// slashing and validator address pair used in oracle module's genesis state
+type ObserverExemption struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	ExemptUntil      int64  `protobuf:"varint,2,opt,name=exempt_until,json=exemptUntil,proto3" json:"exempt_until,omitempty"`
+}
+
+func (m *ObserverExemption) Reset()         { *m = ObserverExemption{} }
+func (m *ObserverExemption) String() string { return proto.CompactTextString(m) }
+func (*ObserverExemption) ProtoMessage()    {}
+func (*ObserverExemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb93724cfbd1d6a0, []int{3}
+}
+func (m *ObserverExemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObserverExemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ObserverExemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ObserverExemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObserverExemption.Merge(m, src)
+}
+func (m *ObserverExemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObserverExemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObserverExemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObserverExemption proto.InternalMessageInfo
+
+func (m *ObserverExemption) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ObserverExemption) GetExemptUntil() int64 {
+	if m != nil {
+		return m.ExemptUntil
+	}
+	return 0
+}
+
+// ValidatorAlertConfig defines the miss rate a validator declared to accept and
+// validator address pair used in oracle module's genesis state
+type ValidatorAlertConfig struct {
+	ValidatorAddress string            `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Config           OracleAlertConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config"`
+}
+
+func (m *ValidatorAlertConfig) Reset()         { *m = ValidatorAlertConfig{} }
+func (m *ValidatorAlertConfig) String() string { return proto.CompactTextString(m) }
+func (*ValidatorAlertConfig) ProtoMessage()    {}
+func (*ValidatorAlertConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fb93724cfbd1d6a0, []int{4}
+}
+func (m *ValidatorAlertConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorAlertConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorAlertConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorAlertConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorAlertConfig.Merge(m, src)
+}
+func (m *ValidatorAlertConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorAlertConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorAlertConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorAlertConfig proto.InternalMessageInfo
+
+func (m *ValidatorAlertConfig) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *ValidatorAlertConfig) GetConfig() OracleAlertConfig {
+	if m != nil {
+		return m.Config
+	}
+	return OracleAlertConfig{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kujira.oracle.GenesisState")
 	proto.RegisterType((*FeederDelegation)(nil), "kujira.oracle.FeederDelegation")
 	proto.RegisterType((*MissCounter)(nil), "kujira.oracle.MissCounter")
+	proto.RegisterType((*ObserverExemption)(nil), "kujira.oracle.ObserverExemption")
+	proto.RegisterType((*ValidatorAlertConfig)(nil), "kujira.oracle.ValidatorAlertConfig")
 }
 
 func init() { proto.RegisterFile("kujira/oracle/genesis.proto", fileDescriptor_fb93724cfbd1d6a0) }
 
 var fileDescriptor_fb93724cfbd1d6a0 = []byte{
-	// 482 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xd1, 0x6a, 0x13, 0x41,
-	0x14, 0x86, 0xb3, 0x6d, 0x2c, 0x38, 0x49, 0x4a, 0x3b, 0x28, 0x84, 0x95, 0x6e, 0x62, 0x40, 0x08,
-	0x16, 0x77, 0x69, 0xfb, 0x04, 0x8d, 0xad, 0x5e, 0x88, 0x50, 0xd6, 0xe0, 0x85, 0x20, 0xcb, 0xc9,
-	0xee, 0xc9, 0x76, 0x35, 0xbb, 0xb3, 0xce, 0x99, 0x84, 0xea, 0x53, 0xf8, 0x1c, 0x5e, 0xfb, 0x10,
-	0xbd, 0xec, 0xa5, 0x57, 0x2a, 0xc9, 0x8b, 0x48, 0x66, 0xb6, 0xcd, 0x66, 0x6d, 0xc5, 0xab, 0x84,
-	0xf3, 0x7f, 0xe7, 0xff, 0x7f, 0x76, 0x66, 0xd8, 0xa3, 0x8f, 0xd3, 0x0f, 0x89, 0x04, 0x4f, 0x48,
-	0x08, 0x27, 0xe8, 0xc5, 0x98, 0x21, 0x25, 0xe4, 0xe6, 0x52, 0x28, 0xc1, 0x5b, 0x46, 0x74, 0x8d,
-	0x68, 0x3f, 0x88, 0x45, 0x2c, 0xb4, 0xe2, 0x2d, 0xff, 0x19, 0xc8, 0xb6, 0xd7, 0x1d, 0xcc, 0x4f,
-	0xa1, 0x39, 0xa1, 0xa0, 0x54, 0x90, 0x37, 0x02, 0x42, 0x6f, 0x76, 0x30, 0x42, 0x05, 0x07, 0x5e,
-	0x28, 0x92, 0xcc, 0xe8, 0xbd, 0xef, 0x75, 0xd6, 0x7c, 0x69, 0x22, 0xdf, 0x28, 0x50, 0xc8, 0x8f,
-	0xd8, 0x56, 0x0e, 0x12, 0x52, 0x6a, 0x5b, 0x5d, 0xab, 0xdf, 0x38, 0x7c, 0xe8, 0xae, 0x55, 0x70,
-	0xcf, 0xb4, 0x38, 0xa8, 0x5f, 0xfe, 0xec, 0xd4, 0xfc, 0x02, 0xe5, 0x43, 0xc6, 0xc7, 0x88, 0x11,
-	0xca, 0x20, 0xc2, 0x09, 0xc6, 0xa0, 0x12, 0x91, 0x51, 0x7b, 0xa3, 0xbb, 0xd9, 0x6f, 0x1c, 0x76,
-	0x2a, 0x06, 0x2f, 0x34, 0x78, 0x72, 0xc3, 0x15, 0x56, 0xbb, 0xe3, 0xca, 0x9c, 0x78, 0xc8, 0xb6,
-	0xf1, 0x22, 0x3c, 0x87, 0x2c, 0xc6, 0x40, 0x82, 0x42, 0x6a, 0x6f, 0x6a, 0xc7, 0x6e, 0xc5, 0xf1,
-	0xb4, 0x80, 0x7c, 0x50, 0x38, 0x9c, 0xe6, 0x13, 0x1c, 0xd8, 0x4b, 0xcb, 0x6f, 0xbf, 0x3a, 0xfc,
-	0x2f, 0x89, 0xfc, 0x16, 0x96, 0x66, 0xc4, 0x4f, 0x59, 0x2b, 0x4d, 0x88, 0x82, 0x50, 0x4c, 0x33,
-	0x85, 0x92, 0xda, 0x75, 0x9d, 0x61, 0x57, 0x32, 0x5e, 0x27, 0x44, 0xcf, 0x0d, 0x52, 0x14, 0x6e,
-	0xa6, 0xab, 0x11, 0xf1, 0x2f, 0xac, 0x0b, 0x71, 0x2c, 0x97, 0xdd, 0x31, 0x58, 0x6b, 0x1d, 0xe4,
-	0x12, 0x67, 0x62, 0xd9, 0xfe, 0x9e, 0x76, 0xde, 0xaf, 0x38, 0x1f, 0x5f, 0xaf, 0x95, 0xbb, 0x9e,
-	0x99, 0x9d, 0x22, 0x6a, 0x0f, 0xfe, 0xc1, 0x10, 0xff, 0xc4, 0xf6, 0xee, 0xca, 0x36, 0xc1, 0x5b,
-	0x3a, 0xb8, 0xff, 0x3f, 0xc1, 0x6f, 0x57, 0xa9, 0x36, 0xdc, 0x05, 0x50, 0x6f, 0xcc, 0x76, 0xaa,
-	0xe7, 0xc8, 0x9f, 0xb0, 0xed, 0xe2, 0x12, 0x40, 0x14, 0x49, 0x24, 0x73, 0x83, 0xee, 0xfb, 0x2d,
-	0x33, 0x3d, 0x36, 0x43, 0xbe, 0xcf, 0x76, 0x67, 0x30, 0x49, 0x22, 0x50, 0x62, 0x45, 0x6e, 0x68,
-	0x72, 0xe7, 0x46, 0x28, 0xe0, 0xde, 0x7b, 0xd6, 0x28, 0x7d, 0xf9, 0xdb, 0x77, 0xad, 0xdb, 0x77,
-	0xf9, 0x63, 0xd6, 0x2c, 0x9f, 0xac, 0xce, 0xa8, 0xfb, 0x8d, 0xd2, 0xb1, 0x0d, 0x4e, 0x2e, 0xe7,
-	0x8e, 0x75, 0x35, 0x77, 0xac, 0xdf, 0x73, 0xc7, 0xfa, 0xba, 0x70, 0x6a, 0x57, 0x0b, 0xa7, 0xf6,
-	0x63, 0xe1, 0xd4, 0xde, 0x3d, 0x8d, 0x13, 0x75, 0x3e, 0x1d, 0xb9, 0xa1, 0x48, 0xbd, 0x21, 0x42,
-	0xfa, 0xec, 0x95, 0x79, 0x63, 0xa1, 0x90, 0xe8, 0x5d, 0x5c, 0x3f, 0x35, 0xf5, 0x39, 0x47, 0x1a,
-	0x6d, 0xe9, 0xa7, 0x74, 0xf4, 0x27, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x13, 0xee, 0x53, 0xca, 0x03,
-	0x00, 0x00,
+	// 598 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcd, 0x6e, 0xd3, 0x4e,
+	0x14, 0xc5, 0xe3, 0x7e, 0xe4, 0xff, 0x67, 0xd2, 0x54, 0xed, 0xd0, 0x8a, 0xc8, 0xa8, 0x6e, 0x08,
+	0x42, 0xaa, 0xa8, 0x88, 0xd5, 0x76, 0x8f, 0xd4, 0x2f, 0x58, 0x20, 0x44, 0x65, 0x4a, 0x91, 0x90,
+	0x90, 0x35, 0x71, 0x6e, 0x5c, 0x83, 0xed, 0x31, 0x73, 0x27, 0x51, 0x61, 0xcb, 0x0b, 0xf0, 0x1c,
+	0x3c, 0x49, 0x97, 0x5d, 0xb2, 0x02, 0xd4, 0xbe, 0x00, 0x8f, 0x80, 0x3c, 0x33, 0xa1, 0xae, 0xe3,
+	0x22, 0xba, 0x4a, 0x74, 0xcf, 0xb9, 0xe7, 0x77, 0xe5, 0x7b, 0x35, 0xe4, 0xee, 0xfb, 0xe1, 0xbb,
+	0x48, 0x30, 0x97, 0x0b, 0x16, 0xc4, 0xe0, 0x86, 0x90, 0x02, 0x46, 0xd8, 0xcd, 0x04, 0x97, 0x9c,
+	0x36, 0xb5, 0xd8, 0xd5, 0xa2, 0xbd, 0x14, 0xf2, 0x90, 0x2b, 0xc5, 0xcd, 0xff, 0x69, 0x93, 0x6d,
+	0x5f, 0x4d, 0xd0, 0x3f, 0x46, 0x73, 0x02, 0x8e, 0x09, 0x47, 0xb7, 0xc7, 0x10, 0xdc, 0xd1, 0x46,
+	0x0f, 0x24, 0xdb, 0x70, 0x03, 0x1e, 0xa5, 0x5a, 0xef, 0xfc, 0x9a, 0x25, 0x73, 0x4f, 0x35, 0xf2,
+	0xa5, 0x64, 0x12, 0xe8, 0x16, 0xa9, 0x67, 0x4c, 0xb0, 0x04, 0x5b, 0x56, 0xdb, 0x5a, 0x6b, 0x6c,
+	0x2e, 0x77, 0xaf, 0x8c, 0xd0, 0x3d, 0x50, 0xe2, 0xce, 0xcc, 0xe9, 0xf7, 0xd5, 0x9a, 0x67, 0xac,
+	0xf4, 0x90, 0xd0, 0x01, 0x40, 0x1f, 0x84, 0xdf, 0x87, 0x18, 0x42, 0x26, 0x23, 0x9e, 0x62, 0x6b,
+	0xaa, 0x3d, 0xbd, 0xd6, 0xd8, 0x5c, 0x2d, 0x05, 0x3c, 0x51, 0xc6, 0xbd, 0x3f, 0x3e, 0x13, 0xb5,
+	0x38, 0x28, 0xd5, 0x91, 0x06, 0x64, 0x1e, 0x4e, 0x82, 0x63, 0x96, 0x86, 0xe0, 0x0b, 0x26, 0x01,
+	0x5b, 0xd3, 0x2a, 0xb1, 0x5d, 0x4a, 0xdc, 0x37, 0x26, 0x8f, 0x49, 0x38, 0x1c, 0x66, 0x31, 0xec,
+	0xd8, 0x79, 0xe4, 0xd7, 0x1f, 0xab, 0x74, 0x42, 0x42, 0xaf, 0x09, 0x85, 0x1a, 0xd2, 0x7d, 0xd2,
+	0x4c, 0x22, 0x44, 0x3f, 0xe0, 0xc3, 0x54, 0x82, 0xc0, 0xd6, 0x8c, 0x62, 0xd8, 0x25, 0xc6, 0xf3,
+	0x08, 0x71, 0x57, 0x5b, 0xcc, 0xc0, 0x73, 0xc9, 0x65, 0x09, 0xe9, 0x27, 0xd2, 0x66, 0x61, 0x28,
+	0xf2, 0xd9, 0xc1, 0xbf, 0x32, 0xb5, 0x9f, 0x09, 0x18, 0xf1, 0x7c, 0xfa, 0x59, 0x95, 0xbc, 0x5e,
+	0x4a, 0xde, 0x1e, 0xb7, 0x15, 0x67, 0x3d, 0xd0, 0x3d, 0x06, 0xb5, 0xc2, 0xfe, 0xe2, 0x41, 0xfa,
+	0x81, 0xac, 0x5c, 0xc7, 0xd6, 0xe0, 0xba, 0x02, 0xaf, 0xfd, 0x0b, 0xf8, 0xe8, 0x92, 0x6a, 0xb3,
+	0xeb, 0x0c, 0x48, 0x5f, 0x93, 0xdb, 0xbc, 0x87, 0x20, 0x46, 0x20, 0x7c, 0x38, 0x81, 0x24, 0xd3,
+	0x1b, 0xff, 0xaf, 0x72, 0x3f, 0x2f, 0x8c, 0x73, 0x7f, 0x6c, 0x34, 0x00, 0xca, 0xcb, 0x02, 0x52,
+	0x46, 0xee, 0x8c, 0x58, 0x1c, 0xf5, 0x99, 0xe4, 0xc2, 0x67, 0x31, 0x08, 0xe9, 0x07, 0x3c, 0x1d,
+	0x44, 0x21, 0xb6, 0xfe, 0x57, 0xe1, 0xf7, 0x4b, 0xe1, 0x47, 0x63, 0xf7, 0x76, 0x6e, 0xde, 0x55,
+	0x5e, 0x93, 0xbf, 0x3c, 0xaa, 0xd0, 0xb0, 0x33, 0x20, 0x0b, 0xe5, 0x1b, 0xa4, 0x0f, 0xc8, 0xbc,
+	0x39, 0x60, 0xd6, 0xef, 0x0b, 0x40, 0x7d, 0xfd, 0xb7, 0xbc, 0xa6, 0xae, 0x6e, 0xeb, 0x22, 0x5d,
+	0x27, 0x8b, 0x85, 0xe9, 0x8c, 0x73, 0x4a, 0x39, 0x17, 0x2e, 0x61, 0xba, 0xde, 0x79, 0x4b, 0x1a,
+	0x85, 0xab, 0xa9, 0xee, 0xb5, 0xaa, 0x7b, 0xe9, 0x3d, 0x32, 0x57, 0xbc, 0x4a, 0xc5, 0x98, 0xf1,
+	0x1a, 0x85, 0x93, 0xeb, 0x04, 0x64, 0x71, 0xe2, 0xc3, 0xde, 0x18, 0xa2, 0x77, 0xe7, 0x0f, 0x53,
+	0x19, 0xc5, 0x0a, 0x32, 0xed, 0x35, 0x74, 0xed, 0x55, 0x5e, 0xea, 0x7c, 0xb6, 0xc8, 0x52, 0xd5,
+	0x17, 0xbe, 0x19, 0xe8, 0x31, 0xa9, 0xeb, 0x25, 0x2a, 0x44, 0xc5, 0x81, 0xa8, 0x9f, 0xc9, 0x05,
+	0x9a, 0xae, 0x9d, 0xbd, 0xd3, 0x73, 0xc7, 0x3a, 0x3b, 0x77, 0xac, 0x9f, 0xe7, 0x8e, 0xf5, 0xe5,
+	0xc2, 0xa9, 0x9d, 0x5d, 0x38, 0xb5, 0x6f, 0x17, 0x4e, 0xed, 0xcd, 0xc3, 0x30, 0x92, 0xc7, 0xc3,
+	0x5e, 0x37, 0xe0, 0x89, 0x7b, 0x08, 0x2c, 0x79, 0xf4, 0x4c, 0x3f, 0x85, 0x01, 0x17, 0xe0, 0x9e,
+	0x8c, 0x5f, 0x44, 0xf9, 0x31, 0x03, 0xec, 0xd5, 0xd5, 0x8b, 0xb7, 0xf5, 0x7b, 0x00, 0x3c, 0xff,
+	0xc4, 0x16, 0x71, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -281,6 +414,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValidatorAlertConfigs) > 0 {
+		for iNdEx := len(m.ValidatorAlertConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorAlertConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ObserverExemptions) > 0 {
+		for iNdEx := len(m.ObserverExemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ObserverExemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.AggregateExchangeRateVotes) > 0 {
 		for iNdEx := len(m.AggregateExchangeRateVotes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -436,6 +597,81 @@ func (m *MissCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ObserverExemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObserverExemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObserverExemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExemptUntil != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExemptUntil))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorAlertConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorAlertConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorAlertConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -485,6 +721,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ObserverExemptions) > 0 {
+		for _, e := range m.ObserverExemptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorAlertConfigs) > 0 {
+		for _, e := range m.ValidatorAlertConfigs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -521,6 +769,37 @@ func (m *MissCounter) Size() (n int) {
 	return n
 }
 
+func (m *ObserverExemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ExemptUntil != 0 {
+		n += 1 + sovGenesis(uint64(m.ExemptUntil))
+	}
+	return n
+}
+
+func (m *ValidatorAlertConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -759,6 +1038,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObserverExemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObserverExemptions = append(m.ObserverExemptions, ObserverExemption{})
+			if err := m.ObserverExemptions[len(m.ObserverExemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAlertConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAlertConfigs = append(m.ValidatorAlertConfigs, ValidatorAlertConfig{})
+			if err := m.ValidatorAlertConfigs[len(m.ValidatorAlertConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -995,6 +1342,222 @@ func (m *MissCounter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ObserverExemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObserverExemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObserverExemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptUntil", wireType)
+			}
+			m.ExemptUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExemptUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorAlertConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorAlertConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorAlertConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,9 +2,12 @@ package types
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
 	"gopkg.in/yaml.v2"
 
@@ -15,21 +18,109 @@ import (
 
 var _ yaml.Marshaler = AggregateVoteHash{}
 
+// Aggregate vote hash preimage format
+const (
+	// VoteHashFieldSeparator separates the salt, exchange rates and voter in the preimage
+	VoteHashFieldSeparator = ":"
+	// ExchangeRateSeparator separates the exchange rate entries of an aggregate vote
+	ExchangeRateSeparator = ","
+	// SaltLength is the required length of the hex encoded salt
+	SaltLength = 64
+	// ExchangeRateOrder is the order of the exchange rate entries in the canonical form
+	ExchangeRateOrder = "ascending by denom"
+)
+
 // AggregateVoteHash is hash value to hide vote exchange rates
 // which is formatted as hex string in SHA256("{salt}:{exchange rate}{denom},...,{exchange rate}{denom}:{voter}")
 type AggregateVoteHash []byte
 
-// GetAggregateVoteHash computes hash value of ExchangeRateVote
+// GetAggregateVoteHash computes hash value of ExchangeRateVote with the default commitment hash algorithm
 // to avoid redundant DecCoins stringify operation, use string argument
 func GetAggregateVoteHash(salt string, exchangeRatesStr string, voter sdk.ValAddress) AggregateVoteHash {
-	hash := tmhash.NewTruncated()
-	sourceStr := fmt.Sprintf("%s:%s:%s", salt, exchangeRatesStr, voter.String())
-	_, err := hash.Write([]byte(sourceStr))
+	hash, err := GetAggregateVoteHashWithAlgo(DefaultCommitmentHashAlgo, salt, exchangeRatesStr, voter)
 	if err != nil {
 		panic(err)
 	}
-	bz := hash.Sum(nil)
-	return bz
+	return hash
+}
+
+// GetAggregateVoteHashWithAlgo computes hash value of ExchangeRateVote with the given commitment hash algorithm
+func GetAggregateVoteHashWithAlgo(algo string, salt string, exchangeRatesStr string, voter sdk.ValAddress) (AggregateVoteHash, error) {
+	sourceStr := strings.Join([]string{salt, exchangeRatesStr, voter.String()}, VoteHashFieldSeparator)
+
+	switch algo {
+	case CommitmentHashAlgoSHA256Truncated:
+		return tmhash.SumTruncated([]byte(sourceStr)), nil
+	case CommitmentHashAlgoSHA256:
+		bz := sha256.Sum256([]byte(sourceStr))
+		return bz[:], nil
+	default:
+		return nil, fmt.Errorf("unknown commitment hash algorithm: %s", algo)
+	}
+}
+
+// CanonicalExchangeRates serializes the exchange rates in the canonical form of the preimage: the
+// entries ordered by denom, and each exchange rate with all of its sdk.Precision decimal places. The
+// exchange rates hash the same regardless of the order and the formatting they were given in, as long
+// as the canonical form is also what is revealed.
+func CanonicalExchangeRates(tuples ExchangeRateTuples) string {
+	sorted := make(ExchangeRateTuples, len(tuples))
+	copy(sorted, tuples)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Denom < sorted[j].Denom
+	})
+
+	entries := make([]string, len(sorted))
+	for i, tuple := range sorted {
+		entries[i] = tuple.ExchangeRate.String() + tuple.Denom
+	}
+
+	return strings.Join(entries, ExchangeRateSeparator)
+}
+
+// GetCanonicalAggregateVoteHash computes hash value of the exchange rates in their canonical form with
+// the given commitment hash algorithm
+func GetCanonicalAggregateVoteHash(algo string, salt string, tuples ExchangeRateTuples, voter sdk.ValAddress) (AggregateVoteHash, error) {
+	return GetAggregateVoteHashWithAlgo(algo, salt, CanonicalExchangeRates(tuples), voter)
+}
+
+// CommitmentHashSize returns the length in bytes of the hashes of the commitment hash algorithm
+func CommitmentHashSize(algo string) (int, error) {
+	switch algo {
+	case CommitmentHashAlgoSHA256Truncated:
+		return tmhash.TruncatedSize, nil
+	case CommitmentHashAlgoSHA256:
+		return sha256.Size, nil
+	default:
+		return 0, fmt.Errorf("commitment hash algorithm must be %s or %s: %s",
+			CommitmentHashAlgoSHA256Truncated, CommitmentHashAlgoSHA256, algo)
+	}
+}
+
+// GetVoteHashSpec returns a machine readable description of the preimage hashed by GetAggregateVoteHashWithAlgo
+func GetVoteHashSpec(algo string) QueryVoteHashSpecResponse {
+	hashAlgorithm := "sha256, truncated to the first 20 bytes"
+	if algo == CommitmentHashAlgoSHA256 {
+		hashAlgorithm = "sha256"
+	}
+	hashLength, _ := CommitmentHashSize(algo)
+
+	return QueryVoteHashSpecResponse{
+		PreimageFormat:        strings.Join([]string{"{salt}", "{exchange_rates}", "{voter}"}, VoteHashFieldSeparator),
+		Fields:                []string{"salt", "exchange_rates", "voter"},
+		FieldSeparator:        VoteHashFieldSeparator,
+		ExchangeRateFormat:    "{exchange_rate}{denom}",
+		ExchangeRateSeparator: ExchangeRateSeparator,
+		SaltLength:            SaltLength,
+		SaltEncoding:          "hex",
+		VoterEncoding:         "bech32 validator operator address",
+		HashAlgorithm:         hashAlgorithm,
+		HashLength:            uint32(hashLength),
+		HashEncoding:          "hex",
+		CommitmentHashAlgo:    algo,
+		ExchangeRateOrder:     ExchangeRateOrder,
+		ExchangeRateDecimals:  sdk.Precision,
+	}
 }
 
 // AggregateVoteHashFromHexString convert hex string to AggregateVoteHash
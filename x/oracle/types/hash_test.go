@@ -1,7 +1,9 @@
 package types_test
 
 import (
+	"crypto/sha256"
 	"encoding/hex"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -33,6 +35,92 @@ func TestAggregateVoteHash(t *testing.T) {
 	testMarshal(t, &aggregateVoteHash, &res, aggregateVoteHash.Marshal, (&res).Unmarshal)
 }
 
+func TestVoteHashSpec(t *testing.T) {
+	for _, algo := range []string{types.CommitmentHashAlgoSHA256Truncated, types.CommitmentHashAlgoSHA256} {
+		spec := types.GetVoteHashSpec(algo)
+		require.Equal(t, algo, spec.CommitmentHashAlgo)
+		voter := sdk.ValAddress([]byte("addr1_______________"))
+		salt := strings.Repeat("a", int(spec.SaltLength))
+
+		// assemble the preimage from the spec only
+		values := map[string]string{
+			"salt":           salt,
+			"exchange_rates": strings.Join([]string{"100ukrw", "200uusd"}, spec.ExchangeRateSeparator),
+			"voter":          voter.String(),
+		}
+		fields := make([]string, len(spec.Fields))
+		for i, field := range spec.Fields {
+			fields[i] = values[field]
+		}
+		preimage := strings.Join(fields, spec.FieldSeparator)
+
+		sum := sha256.Sum256([]byte(preimage))
+		hash, err := types.GetAggregateVoteHashWithAlgo(algo, salt, "100ukrw,200uusd", voter)
+		require.NoError(t, err)
+		require.Equal(t, hex.EncodeToString(sum[:spec.HashLength]), hash.String())
+		require.Equal(t, "{salt}:{exchange_rates}:{voter}", spec.PreimageFormat)
+		require.Equal(t, types.ExchangeRateOrder, spec.ExchangeRateOrder)
+		require.Equal(t, uint32(sdk.Precision), spec.ExchangeRateDecimals)
+	}
+
+	// the default algorithm is the one used before it became configurable
+	voter := sdk.ValAddress([]byte("addr1_______________"))
+	hash, err := types.GetAggregateVoteHashWithAlgo(types.CommitmentHashAlgoSHA256Truncated, "salt", "100ukrw,200uusd", voter)
+	require.NoError(t, err)
+	require.Equal(t, types.GetAggregateVoteHash("salt", "100ukrw,200uusd", voter), hash)
+}
+
+func TestCanonicalAggregateVoteHash(t *testing.T) {
+	voter := sdk.ValAddress([]byte("addr1_______________"))
+
+	// The same exchange rates in any order and formatting have the same canonical form
+	inputs := []string{
+		"0.75ukuji,9.1uatom,1uusdc",
+		"1uusdc,0.75ukuji,9.1uatom",
+		"9.100uatom,1.000000000000000000uusdc,0.750ukuji",
+	}
+	for _, input := range inputs {
+		tuples, err := types.ParseExchangeRateTuples(input)
+		require.NoError(t, err)
+		require.Equal(t, "9.100000000000000000uatom,0.750000000000000000ukuji,1.000000000000000000uusdc", types.CanonicalExchangeRates(tuples))
+
+		for _, algo := range []string{types.CommitmentHashAlgoSHA256Truncated, types.CommitmentHashAlgoSHA256} {
+			hash, err := types.GetCanonicalAggregateVoteHash(algo, "salt", tuples, voter)
+			require.NoError(t, err)
+			expected, err := types.GetAggregateVoteHashWithAlgo(algo, "salt", types.CanonicalExchangeRates(tuples), voter)
+			require.NoError(t, err)
+			require.Equal(t, expected, hash)
+		}
+	}
+
+	// The canonical form parses back into the same exchange rates
+	tuples, err := types.ParseExchangeRateTuples(inputs[1])
+	require.NoError(t, err)
+	parsed, err := types.ParseExchangeRateTuples(types.CanonicalExchangeRates(tuples))
+	require.NoError(t, err)
+	require.ElementsMatch(t, tuples, parsed)
+
+	// The tuples are not reordered in place
+	require.Equal(t, "uusdc", tuples[0].Denom)
+	require.Equal(t, "", types.CanonicalExchangeRates(nil))
+}
+
+func TestCommitmentHashSize(t *testing.T) {
+	size, err := types.CommitmentHashSize(types.CommitmentHashAlgoSHA256Truncated)
+	require.NoError(t, err)
+	require.Equal(t, 20, size)
+
+	size, err = types.CommitmentHashSize(types.CommitmentHashAlgoSHA256)
+	require.NoError(t, err)
+	require.Equal(t, 32, size)
+
+	_, err = types.CommitmentHashSize("md5")
+	require.Error(t, err)
+
+	_, err = types.GetAggregateVoteHashWithAlgo("md5", "salt", "100ukrw", sdk.ValAddress([]byte("addr1_______________")))
+	require.Error(t, err)
+}
+
 func testMarshal(t *testing.T, original interface{}, res interface{}, marshal func() ([]byte, error), unmarshal func([]byte) error) {
 	bz, err := marshal()
 	require.Nil(t, err)
@@ -12,11 +12,17 @@ const (
 	// StoreKey is the string store representation
 	StoreKey = ModuleName
 
+	// TStoreKey is the string transient store representation
+	TStoreKey = "transient_" + ModuleName
+
 	// RouterKey is the msg router key for the oracle module
 	RouterKey = ModuleName
 
 	// QuerierRoute is the query router key for the oracle module
 	QuerierRoute = ModuleName
+
+	// ConsensusVersion is the consensus version of the oracle module
+	ConsensusVersion = 2
 )
 
 // Keys for oracle store
@@ -32,7 +38,47 @@ const (
 //
 // - 0x05<valAddress_Bytes>: AggregateExchangeRateVote
 //
-// - 0x06<denom_Bytes>: sdk.Dec
+// - 0x06: int64
+//
+// - 0x07<denom_Bytes>: uint64
+//
+// - 0x0B: string
+//
+// - 0x0C<valAddress_Bytes>: sdk.Dec
+//
+// - 0x0D: VotePeriodClock
+//
+// - 0x0E<denom_Bytes>: DenomTallyCounter
+//
+// - 0x0F<valAddress_Bytes>: uint64
+//
+// - 0x10<denom_Bytes><moduleName_Bytes>: []byte{}
+//
+// - 0x11<valAddress_Bytes>: int64
+//
+// - 0x12<valAddress_Bytes>: ValidatorAccuracyCounter
+//
+// - 0x13<valAddress_Bytes>: int64
+//
+// - 0x14<denom_Bytes>: DenomTallyOutcome
+//
+// - 0x15: VotePeriodParticipation
+//
+// - 0x16<valAddress_Bytes>: OracleAlertConfig
+//
+// - 0x17<votePeriod_Bytes><denom_Bytes>: WhitelistChange
+//
+// - 0x18<valAddress_Bytes>: RejectedTuples
+//
+// - 0x19: TallyStats
+//
+// - 0x1A<denom_Bytes>: uint64
+//
+// - 0x1B: uint64
+//
+// - 0x1C<slot_Bytes>: ParticipationPoint
+//
+// - 0x1D<denom_Bytes>: DenomMaxMove
 var (
 	// Keys for store prefixes
 	ExchangeRateKey                 = []byte{0x01} // prefix for each key to a rate
@@ -40,6 +86,39 @@ var (
 	MissCounterKey                  = []byte{0x03} // prefix for each key to a miss counter
 	AggregateExchangeRatePrevoteKey = []byte{0x04} // prefix for each key to a aggregate prevote
 	AggregateExchangeRateVoteKey    = []byte{0x05} // prefix for each key to a aggregate vote
+	WinningPowerKey                 = []byte{0x06} // key for the winning power of the last vote period
+	StaleCounterKey                 = []byte{0x07} // prefix for each key to a stale counter
+	DenomGraceExitKey               = []byte{0x08} // prefix for each key to the vote period a denom leaves its grace window
+	LastSubmissionKey               = []byte{0x09} // prefix for each key to an aggregate vote of the last vote period
+	TallyBoundsKey                  = []byte{0x0A} // prefix for each key to the reward band boundaries of the last tally
+	CommitmentHashAlgoKey           = []byte{0x0B} // key for the commitment hash algorithm in effect
+	SmoothedPowerKey                = []byte{0x0C} // prefix for each key to the smoothed voting power of a validator
+	VotePeriodClockKey              = []byte{0x0D} // key for the vote period clock of timed vote periods
+	DenomTallyCounterKey            = []byte{0x0E} // prefix for each key to the tally outcomes of a denom in the current slash window
+	RevealMissCounterKey            = []byte{0x0F} // prefix for each key to a reveal miss counter
+	RequiredDenomKey                = []byte{0x10} // prefix for each key to a module requiring a denom
+	ObserverKey                     = []byte{0x11} // prefix for each key to the height an observer is exempt from slashing until
+	ValidatorAccuracyCounterKey     = []byte{0x12} // prefix for each key to the in-band submissions of a validator in the recent slash windows
+	FeederChangeHeightKey           = []byte{0x13} // prefix for each key to the height of the last feeder delegation change of a validator
+	DenomTallyOutcomeKey            = []byte{0x14} // prefix for each key to the outcome of the last vote period of a denom
+	VotePeriodParticipationKey      = []byte{0x15} // key for the participation in the last vote period
+	OracleAlertConfigKey            = []byte{0x16} // prefix for each key to the miss rate a validator declared to accept
+	WhitelistChangeKey              = []byte{0x17} // prefix for each key to a logged whitelist change, ordered by vote period
+	RejectedTuplesKey               = []byte{0x18} // prefix for each key to the rejected exchange rates of the last vote of a validator
+	LastTallyStatsKey               = []byte{0x19} // key for the structural counts of the last tally
+	DenomVoterCountKey              = []byte{0x1A} // prefix for each key to the number of voters of the last successful tally of a denom
+	LastUpgradeVotePeriodKey        = []byte{0x1B} // key for the vote period of the last chain upgrade
+	ParticipationPointKey           = []byte{0x1C} // prefix for each key to a point of the participation series, by slot of the ring
+	DenomMaxMoveKey                 = []byte{0x1D} // prefix for each key to the largest exchange rate move of a denom in the current window
+)
+
+// ParticipationSeriesLength is the number of vote periods the participation series keeps,
+// as a ring of slots indexed by the vote period
+const ParticipationSeriesLength = 1000
+
+// Keys for oracle transient store, cleared at the end of every block
+var (
+	VoteTargetsCacheKey = []byte{0x01} // key for the vote targets resolved in the current block
 )
 
 // GetExchangeRateKey - stored by *denom*
@@ -47,6 +126,56 @@ func GetExchangeRateKey(denom string) []byte {
 	return append(ExchangeRateKey, []byte(denom)...)
 }
 
+// GetStaleCounterKey - stored by *denom*
+func GetStaleCounterKey(denom string) []byte {
+	return append(StaleCounterKey, []byte(denom)...)
+}
+
+// GetDenomGraceExitKey - stored by *denom*
+func GetDenomGraceExitKey(denom string) []byte {
+	return append(DenomGraceExitKey, []byte(denom)...)
+}
+
+// GetTallyBoundsKey - stored by *denom*
+func GetTallyBoundsKey(denom string) []byte {
+	return append(TallyBoundsKey, []byte(denom)...)
+}
+
+// GetDenomTallyCounterKey - stored by *denom*
+func GetDenomTallyCounterKey(denom string) []byte {
+	return append(DenomTallyCounterKey, []byte(denom)...)
+}
+
+// GetDenomTallyOutcomeKey - stored by *denom*
+func GetDenomTallyOutcomeKey(denom string) []byte {
+	return append(DenomTallyOutcomeKey, []byte(denom)...)
+}
+
+// GetDenomVoterCountKey - stored by *denom*
+func GetDenomVoterCountKey(denom string) []byte {
+	return append(DenomVoterCountKey, []byte(denom)...)
+}
+
+// GetDenomMaxMoveKey - stored by *denom*
+func GetDenomMaxMoveKey(denom string) []byte {
+	return append(DenomMaxMoveKey, []byte(denom)...)
+}
+
+// GetParticipationPointKey - stored by the slot of the *vote period* in the ring
+func GetParticipationPointKey(votePeriod uint64) []byte {
+	return append(ParticipationPointKey, sdk.Uint64ToBigEndian(votePeriod%ParticipationSeriesLength)...)
+}
+
+// GetRequiredDenomPrefix - stored by *denom*
+func GetRequiredDenomPrefix(denom string) []byte {
+	return append(RequiredDenomKey, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// GetRequiredDenomKey - stored by *denom* and *module* name
+func GetRequiredDenomKey(denom, moduleName string) []byte {
+	return append(GetRequiredDenomPrefix(denom), []byte(moduleName)...)
+}
+
 // GetFeederDelegationKey - stored by *Validator* address
 func GetFeederDelegationKey(v sdk.ValAddress) []byte {
 	return append(FeederDelegationKey, address.MustLengthPrefix(v)...)
@@ -57,6 +186,11 @@ func GetMissCounterKey(v sdk.ValAddress) []byte {
 	return append(MissCounterKey, address.MustLengthPrefix(v)...)
 }
 
+// GetRevealMissCounterKey - stored by *Validator* address
+func GetRevealMissCounterKey(v sdk.ValAddress) []byte {
+	return append(RevealMissCounterKey, address.MustLengthPrefix(v)...)
+}
+
 // GetAggregateExchangeRatePrevoteKey - stored by *Validator* address
 func GetAggregateExchangeRatePrevoteKey(v sdk.ValAddress) []byte {
 	return append(AggregateExchangeRatePrevoteKey, address.MustLengthPrefix(v)...)
@@ -66,3 +200,48 @@ func GetAggregateExchangeRatePrevoteKey(v sdk.ValAddress) []byte {
 func GetAggregateExchangeRateVoteKey(v sdk.ValAddress) []byte {
 	return append(AggregateExchangeRateVoteKey, address.MustLengthPrefix(v)...)
 }
+
+// GetSmoothedPowerKey - stored by *Validator* address
+func GetSmoothedPowerKey(v sdk.ValAddress) []byte {
+	return append(SmoothedPowerKey, address.MustLengthPrefix(v)...)
+}
+
+// GetLastSubmissionKey - stored by *Validator* address
+func GetLastSubmissionKey(v sdk.ValAddress) []byte {
+	return append(LastSubmissionKey, address.MustLengthPrefix(v)...)
+}
+
+// GetObserverKey - stored by *Validator* address
+func GetObserverKey(v sdk.ValAddress) []byte {
+	return append(ObserverKey, address.MustLengthPrefix(v)...)
+}
+
+// GetValidatorAccuracyCounterKey - stored by *Validator* address
+func GetValidatorAccuracyCounterKey(v sdk.ValAddress) []byte {
+	return append(ValidatorAccuracyCounterKey, address.MustLengthPrefix(v)...)
+}
+
+// GetFeederChangeHeightKey - stored by *Validator* address
+func GetFeederChangeHeightKey(v sdk.ValAddress) []byte {
+	return append(FeederChangeHeightKey, address.MustLengthPrefix(v)...)
+}
+
+// GetOracleAlertConfigKey - stored by *Validator* address
+func GetOracleAlertConfigKey(v sdk.ValAddress) []byte {
+	return append(OracleAlertConfigKey, address.MustLengthPrefix(v)...)
+}
+
+// GetRejectedTuplesKey - stored by *Validator* address
+func GetRejectedTuplesKey(v sdk.ValAddress) []byte {
+	return append(RejectedTuplesKey, address.MustLengthPrefix(v)...)
+}
+
+// GetWhitelistChangeKey - stored by *vote period* and *denom*
+func GetWhitelistChangeKey(votePeriod uint64, denom string) []byte {
+	return append(append(WhitelistChangeKey, sdk.Uint64ToBigEndian(votePeriod)...), []byte(denom)...)
+}
+
+// GetWhitelistChangePeriodPrefix - prefix of the whitelist changes of the *vote period*
+func GetWhitelistChangePeriodPrefix(votePeriod uint64) []byte {
+	return append(WhitelistChangeKey, sdk.Uint64ToBigEndian(votePeriod)...)
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"crypto/sha256"
+
 	"github.com/cometbft/cometbft/crypto/tmhash"
 
 	"cosmossdk.io/errors"
@@ -13,6 +15,7 @@ var (
 	_ sdk.Msg = &MsgDelegateFeedConsent{}
 	_ sdk.Msg = &MsgAggregateExchangeRatePrevote{}
 	_ sdk.Msg = &MsgAggregateExchangeRateVote{}
+	_ sdk.Msg = &MsgSetOracleAlertConfig{}
 )
 
 // oracle message types
@@ -20,8 +23,12 @@ const (
 	TypeMsgDelegateFeedConsent          = "delegate_feeder"
 	TypeMsgAggregateExchangeRatePrevote = "aggregate_exchange_rate_prevote"
 	TypeMsgAggregateExchangeRateVote    = "aggregate_exchange_rate_vote"
+	TypeMsgSetOracleAlertConfig         = "set_oracle_alert_config"
 )
 
+// MaxAttestationLength is the maximum length of the attestation of an aggregate vote
+const MaxAttestationLength = 1024
+
 //-------------------------------------------------
 //-------------------------------------------------
 
@@ -62,8 +69,9 @@ func (msg MsgAggregateExchangeRatePrevote) ValidateBasic() error {
 		return errors.Wrapf(ErrInvalidHash, "Invalid vote hash (%s)", err)
 	}
 
-	// HEX encoding doubles the hash length
-	if len(msg.Hash) != tmhash.TruncatedSize*2 {
+	// HEX encoding doubles the hash length. The length required by the
+	// commitment hash algorithm in effect is checked by the msg server.
+	if len(msg.Hash) != tmhash.TruncatedSize*2 && len(msg.Hash) != sha256.Size*2 {
 		return ErrInvalidHashLength
 	}
 
@@ -133,6 +141,10 @@ func (msg MsgAggregateExchangeRateVote) ValidateBasic() error {
 	if err != nil {
 		return errors.Wrap(sdkerrors.ErrInvalidCoins, "failed to parse exchange rates string cause: "+err.Error())
 	}
+	// A blank exchange rates string parses without error, but into no exchange rate
+	if len(exchangeRates) == 0 {
+		return errors.Wrap(sdkerrors.ErrUnknownRequest, "must provide at least one oracle exchange rate")
+	}
 
 	for _, exchangeRate := range exchangeRates {
 		// Check overflow bit length
@@ -141,7 +153,7 @@ func (msg MsgAggregateExchangeRateVote) ValidateBasic() error {
 		}
 	}
 
-	if len(msg.Salt) != 64 {
+	if len(msg.Salt) != SaltLength {
 		return ErrInvalidSaltLength
 	}
 	_, err = AggregateVoteHashFromHexString(msg.Salt)
@@ -149,6 +161,10 @@ func (msg MsgAggregateExchangeRateVote) ValidateBasic() error {
 		return errors.Wrap(ErrInvalidSaltFormat, "salt must be a valid hex string")
 	}
 
+	if len(msg.Attestation) > MaxAttestationLength {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "attestation can not exceed %d characters", MaxAttestationLength)
+	}
+
 	return nil
 }
 
@@ -195,3 +211,46 @@ func (msg MsgDelegateFeedConsent) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgSetOracleAlertConfig creates a MsgSetOracleAlertConfig instance
+func NewMsgSetOracleAlertConfig(operatorAddress sdk.ValAddress, maxMissRate sdk.Dec) *MsgSetOracleAlertConfig {
+	return &MsgSetOracleAlertConfig{
+		Operator:    operatorAddress.String(),
+		MaxMissRate: maxMissRate,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgSetOracleAlertConfig) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgSetOracleAlertConfig) Type() string { return TypeMsgSetOracleAlertConfig }
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgSetOracleAlertConfig) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgSetOracleAlertConfig) GetSigners() []sdk.AccAddress {
+	operator, err := sdk.ValAddressFromBech32(msg.Operator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{sdk.AccAddress(operator)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgSetOracleAlertConfig) ValidateBasic() error {
+	_, err := sdk.ValAddressFromBech32(msg.Operator)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid operator address (%s)", err)
+	}
+
+	if msg.MaxMissRate.IsNil() || msg.MaxMissRate.IsNegative() || msg.MaxMissRate.GT(sdk.OneDec()) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "max miss rate must be between 0 and 1, is %s", msg.MaxMissRate)
+	}
+
+	return nil
+}
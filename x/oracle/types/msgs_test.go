@@ -38,6 +38,34 @@ func TestMsgFeederDelegation(t *testing.T) {
 	}
 }
 
+func TestMsgSetOracleAlertConfig(t *testing.T) {
+	operator := sdk.ValAddress([]byte("addr1_______________"))
+
+	tests := []struct {
+		operator    sdk.ValAddress
+		maxMissRate sdk.Dec
+		expectPass  bool
+	}{
+		{operator, sdk.NewDecWithPrec(1, 1), true},
+		{operator, sdk.ZeroDec(), true},
+		{operator, sdk.OneDec(), true},
+		{operator, sdk.NewDecWithPrec(-1, 1), false},
+		{operator, sdk.NewDecWithPrec(11, 1), false},
+		{operator, sdk.Dec{}, false},
+		{sdk.ValAddress{}, sdk.NewDecWithPrec(1, 1), false},
+	}
+
+	for i, tc := range tests {
+		msg := types.NewMsgSetOracleAlertConfig(tc.operator, tc.maxMissRate)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+			require.Equal(t, []sdk.AccAddress{sdk.AccAddress(operator)}, msg.GetSigners())
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 func TestMsgAggregateExchangeRatePrevote(t *testing.T) {
 	addrs := []sdk.AccAddress{
 		sdk.AccAddress([]byte("addr1_______________")),
@@ -91,6 +119,7 @@ func TestMsgAggregateExchangeRateVote(t *testing.T) {
 		{addrs[0], sdk.ValAddress(addrs[0]), "fc246cf5a18c7a650a6a226ebc589d49a9a814d6f1f586405e8726e5cf2a7d80", overFlowExchangeRates, false},
 		{sdk.AccAddress{}, sdk.ValAddress(addrs[0]), "fc246cf5a18c7a650a6a226ebc589d49a9a814d6f1f586405e8726e5cf2a7d80", exchangeRates, false},
 		{addrs[0], sdk.ValAddress(addrs[0]), "fc246cf5a18c7a650a6a226ebc589d49a9a814d6f1f586405e8726e5cf2a7d80", "", false},
+		{addrs[0], sdk.ValAddress(addrs[0]), "fc246cf5a18c7a650a6a226ebc589d49a9a814d6f1f586405e8726e5cf2a7d80", " ", false},
 		{addrs[0], sdk.ValAddress(addrs[0]), "", randSeq(4097), false},
 		{addrs[0], sdk.ValAddress{}, "fc246cf5a18c7a650a6a226ebc589d49a9a814d6f1f586405e8726e5cf2a7d80", abstainExchangeRates, false},
 		{addrs[0], sdk.ValAddress(addrs[0]), "", abstainExchangeRates, false},
@@ -104,6 +133,13 @@ func TestMsgAggregateExchangeRateVote(t *testing.T) {
 			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
 		}
 	}
+
+	// the attestation is optional, but bounded in length
+	msg := types.NewMsgAggregateExchangeRateVote("fc246cf5a18c7a650a6a226ebc589d49a9a814d6f1f586405e8726e5cf2a7d80", exchangeRates, addrs[0], sdk.ValAddress(addrs[0]))
+	msg.Attestation = randSeq(types.MaxAttestationLength)
+	require.Nil(t, msg.ValidateBasic())
+	msg.Attestation = randSeq(types.MaxAttestationLength + 1)
+	require.NotNil(t, msg.ValidateBasic())
 }
 
 var letters = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")
@@ -9,15 +9,19 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -35,6 +39,93 @@ type Params struct {
 	SlashFraction            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction" yaml:"slash_fraction"`
 	SlashWindow              uint64                                 `protobuf:"varint,7,opt,name=slash_window,json=slashWindow,proto3" json:"slash_window,omitempty" yaml:"slash_window"`
 	MinValidPerWindow        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_valid_per_window,json=minValidPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_valid_per_window" yaml:"min_valid_per_window"`
+	// exclude_jailed_from_threshold removes the power of jailed validators from
+	// the denominator used to check VoteThreshold.
+	ExcludeJailedFromThreshold bool `protobuf:"varint,9,opt,name=exclude_jailed_from_threshold,json=excludeJailedFromThreshold,proto3" json:"exclude_jailed_from_threshold,omitempty" yaml:"exclude_jailed_from_threshold"`
+	// aggregation_method selects how the exchange rate of a ballot is derived,
+	// either "median" (power weighted median) or "mode" (highest power bucket).
+	AggregationMethod string `protobuf:"bytes,10,opt,name=aggregation_method,json=aggregationMethod,proto3" json:"aggregation_method,omitempty" yaml:"aggregation_method"`
+	// mode_bucket_precision defines the number of decimal places exchange rates
+	// are rounded to when grouped into buckets by the "mode" aggregation method.
+	ModeBucketPrecision uint64 `protobuf:"varint,11,opt,name=mode_bucket_precision,json=modeBucketPrecision,proto3" json:"mode_bucket_precision,omitempty" yaml:"mode_bucket_precision"`
+	// auto_delist_after_stale_windows removes a denom from the whitelist once it
+	// failed to tally for that many consecutive vote periods. Zero disables it.
+	AutoDelistAfterStaleWindows uint64 `protobuf:"varint,12,opt,name=auto_delist_after_stale_windows,json=autoDelistAfterStaleWindows,proto3" json:"auto_delist_after_stale_windows,omitempty" yaml:"auto_delist_after_stale_windows"`
+	// denom_grace_periods defines the number of vote periods a newly whitelisted
+	// denom is exempt from miss counting, so feeders have time to add it.
+	DenomGracePeriods uint64 `protobuf:"varint,13,opt,name=denom_grace_periods,json=denomGracePeriods,proto3" json:"denom_grace_periods,omitempty" yaml:"denom_grace_periods"`
+	// max_carry_forward_periods defines the number of consecutive vote periods the
+	// exchange rate of a denom failing to tally is kept. Zero disables it.
+	MaxCarryForwardPeriods uint64 `protobuf:"varint,14,opt,name=max_carry_forward_periods,json=maxCarryForwardPeriods,proto3" json:"max_carry_forward_periods,omitempty" yaml:"max_carry_forward_periods"`
+	// progressive_slashing scales the slash fraction with how far the valid vote
+	// rate of a validator fell below min_valid_per_window, from
+	// progressive_slash_floor up to slash_fraction.
+	ProgressiveSlashing bool `protobuf:"varint,15,opt,name=progressive_slashing,json=progressiveSlashing,proto3" json:"progressive_slashing,omitempty" yaml:"progressive_slashing"`
+	// progressive_slash_floor defines the slash fraction of a validator just
+	// below min_valid_per_window when progressive_slashing is enabled.
+	ProgressiveSlashFloor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=progressive_slash_floor,json=progressiveSlashFloor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"progressive_slash_floor" yaml:"progressive_slash_floor"`
+	// commitment_hash_algo selects the hash algorithm of the aggregate prevote
+	// commitment, either "sha256_truncated" (first 20 bytes) or "sha256". A
+	// change only takes effect at the end of a vote period.
+	CommitmentHashAlgo string `protobuf:"bytes,17,opt,name=commitment_hash_algo,json=commitmentHashAlgo,proto3" json:"commitment_hash_algo,omitempty" yaml:"commitment_hash_algo"`
+	// power_smoothing_windows defines the number of vote periods the voting power
+	// weighting the votes is exponentially smoothed over. Zero disables it.
+	PowerSmoothingWindows uint64 `protobuf:"varint,18,opt,name=power_smoothing_windows,json=powerSmoothingWindows,proto3" json:"power_smoothing_windows,omitempty" yaml:"power_smoothing_windows"`
+	// vote_period_duration closes a vote period with the first block whose time
+	// crosses a multiple of the duration, instead of every vote_period blocks.
+	// Zero keeps the block count.
+	VotePeriodDuration time.Duration `protobuf:"bytes,19,opt,name=vote_period_duration,json=votePeriodDuration,proto3,stdduration" json:"vote_period_duration" yaml:"vote_period_duration"`
+	// max_power_share caps the voting power weighting each vote of a ballot at
+	// this share of the power of the ballot. The excess is dropped, not
+	// redistributed. Zero disables it.
+	MaxPowerShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,20,opt,name=max_power_share,json=maxPowerShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_power_share" yaml:"max_power_share"`
+	// reveal_miss_weight defines the weight a vote period in which a validator
+	// prevoted but did not reveal its vote counts with against min_valid_per_window,
+	// a full miss counting with weight one.
+	RevealMissWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,21,opt,name=reveal_miss_weight,json=revealMissWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reveal_miss_weight" yaml:"reveal_miss_weight"`
+	// accuracy_weighted_rewards additionally weights the reward share of each
+	// ballot winner by how close its votes were to the tallied exchange rates.
+	AccuracyWeightedRewards bool `protobuf:"varint,22,opt,name=accuracy_weighted_rewards,json=accuracyWeightedRewards,proto3" json:"accuracy_weighted_rewards,omitempty" yaml:"accuracy_weighted_rewards"`
+	// max_event_denoms_per_block defines the number of denoms per-denom end
+	// block events are emitted for. Above it, they are coalesced into a single
+	// summary event. Zero disables it.
+	MaxEventDenomsPerBlock uint64 `protobuf:"varint,23,opt,name=max_event_denoms_per_block,json=maxEventDenomsPerBlock,proto3" json:"max_event_denoms_per_block,omitempty" yaml:"max_event_denoms_per_block"`
+	// feeder_change_cooldown_blocks defines the number of blocks after a change
+	// of the feeder delegation of a validator, during which it cannot be changed
+	// again. Zero disables it.
+	FeederChangeCooldownBlocks uint64 `protobuf:"varint,24,opt,name=feeder_change_cooldown_blocks,json=feederChangeCooldownBlocks,proto3" json:"feeder_change_cooldown_blocks,omitempty" yaml:"feeder_change_cooldown_blocks"`
+	// reveal_grace_blocks defines the number of blocks at the start of a vote
+	// period in which a vote can still be revealed against a prevote of two vote
+	// periods before, when it landed just past its reveal window. Zero disables it.
+	RevealGraceBlocks uint64 `protobuf:"varint,25,opt,name=reveal_grace_blocks,json=revealGraceBlocks,proto3" json:"reveal_grace_blocks,omitempty" yaml:"reveal_grace_blocks"`
+	// legacy_rate_events defines whether the exchange_rate_update attribute
+	// events are emitted along with the typed EventOracleUpdate, for the
+	// consumers which have not moved to the latter yet.
+	LegacyRateEvents bool `protobuf:"varint,26,opt,name=legacy_rate_events,json=legacyRateEvents,proto3" json:"legacy_rate_events,omitempty" yaml:"legacy_rate_events"`
+	// whitelist_change_retention defines the number of vote periods the denoms
+	// added to or removed from the whitelist are logged for. Zero disables it.
+	WhitelistChangeRetention uint64 `protobuf:"varint,27,opt,name=whitelist_change_retention,json=whitelistChangeRetention,proto3" json:"whitelist_change_retention,omitempty" yaml:"whitelist_change_retention"`
+	// max_denoms_per_vote defines the number of exchange rates a vote may contain
+	// at most. It never rejects a vote on all the vote targets. Zero disables it.
+	MaxDenomsPerVote uint64 `protobuf:"varint,28,opt,name=max_denoms_per_vote,json=maxDenomsPerVote,proto3" json:"max_denoms_per_vote,omitempty" yaml:"max_denoms_per_vote"`
+	// oracle_fee_share defines the share of the fees collected which is moved
+	// to the oracle reward pool every block, before they are distributed. Zero
+	// disables it.
+	OracleFeeShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,29,opt,name=oracle_fee_share,json=oracleFeeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"oracle_fee_share" yaml:"oracle_fee_share"`
+	// bound ahead of the current one at most. Once set, votes have to be bound to
+	// the vote period they are revealed in. Zero disables it.
+	MaxVoteFutureDrift uint64 `protobuf:"varint,30,opt,name=max_vote_future_drift,json=maxVoteFutureDrift,proto3" json:"max_vote_future_drift,omitempty" yaml:"max_vote_future_drift"`
+	// post_upgrade_grace_periods defines the number of vote periods, starting with
+	// the one of a chain upgrade, in which misses are not counted. Zero disables it.
+	PostUpgradeGracePeriods uint64 `protobuf:"varint,31,opt,name=post_upgrade_grace_periods,json=postUpgradeGracePeriods,proto3" json:"post_upgrade_grace_periods,omitempty" yaml:"post_upgrade_grace_periods"`
+	// max_move_window defines the number of vote periods the largest move of the
+	// exchange rate of each denom is tracked over, before it is reset. Zero
+	// disables the tracking.
+	MaxMoveWindow uint64 `protobuf:"varint,32,opt,name=max_move_window,json=maxMoveWindow,proto3" json:"max_move_window,omitempty" yaml:"max_move_window"`
+	// even_median_rule defines the weighted median of a ballot whose power splits
+	// exactly in half between two votes, e.g. an even number of votes of equal
+	// power: "lower", "upper" or "average" of the two middle votes.
+	EvenMedianRule string `protobuf:"bytes,33,opt,name=even_median_rule,json=evenMedianRule,proto3" json:"even_median_rule,omitempty" yaml:"even_median_rule"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -97,9 +188,167 @@ func (m *Params) GetSlashWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetExcludeJailedFromThreshold() bool {
+	if m != nil {
+		return m.ExcludeJailedFromThreshold
+	}
+	return false
+}
+
+func (m *Params) GetAggregationMethod() string {
+	if m != nil {
+		return m.AggregationMethod
+	}
+	return ""
+}
+
+func (m *Params) GetModeBucketPrecision() uint64 {
+	if m != nil {
+		return m.ModeBucketPrecision
+	}
+	return 0
+}
+
+func (m *Params) GetAutoDelistAfterStaleWindows() uint64 {
+	if m != nil {
+		return m.AutoDelistAfterStaleWindows
+	}
+	return 0
+}
+
+func (m *Params) GetDenomGracePeriods() uint64 {
+	if m != nil {
+		return m.DenomGracePeriods
+	}
+	return 0
+}
+
+func (m *Params) GetMaxCarryForwardPeriods() uint64 {
+	if m != nil {
+		return m.MaxCarryForwardPeriods
+	}
+	return 0
+}
+
+func (m *Params) GetProgressiveSlashing() bool {
+	if m != nil {
+		return m.ProgressiveSlashing
+	}
+	return false
+}
+
+func (m *Params) GetCommitmentHashAlgo() string {
+	if m != nil {
+		return m.CommitmentHashAlgo
+	}
+	return ""
+}
+
+func (m *Params) GetPowerSmoothingWindows() uint64 {
+	if m != nil {
+		return m.PowerSmoothingWindows
+	}
+	return 0
+}
+
+func (m *Params) GetVotePeriodDuration() time.Duration {
+	if m != nil {
+		return m.VotePeriodDuration
+	}
+	return 0
+}
+
+func (m *Params) GetAccuracyWeightedRewards() bool {
+	if m != nil {
+		return m.AccuracyWeightedRewards
+	}
+	return false
+}
+
+func (m *Params) GetMaxEventDenomsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxEventDenomsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetFeederChangeCooldownBlocks() uint64 {
+	if m != nil {
+		return m.FeederChangeCooldownBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetRevealGraceBlocks() uint64 {
+	if m != nil {
+		return m.RevealGraceBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetLegacyRateEvents() bool {
+	if m != nil {
+		return m.LegacyRateEvents
+	}
+	return false
+}
+
+func (m *Params) GetWhitelistChangeRetention() uint64 {
+	if m != nil {
+		return m.WhitelistChangeRetention
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDenomsPerVote() uint64 {
+	if m != nil {
+		return m.MaxDenomsPerVote
+	}
+	return 0
+}
+
+func (m *Params) GetMaxVoteFutureDrift() uint64 {
+	if m != nil {
+		return m.MaxVoteFutureDrift
+	}
+	return 0
+}
+
+func (m *Params) GetPostUpgradeGracePeriods() uint64 {
+	if m != nil {
+		return m.PostUpgradeGracePeriods
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMoveWindow() uint64 {
+	if m != nil {
+		return m.MaxMoveWindow
+	}
+	return 0
+}
+
+func (m *Params) GetEvenMedianRule() string {
+	if m != nil {
+		return m.EvenMedianRule
+	}
+	return ""
+}
+
 // Denom - the object to hold configurations of each denom
 type Denom struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// vote_period_multiplier defines every how many vote periods the denom is
+	// tallied. In between, its exchange rate is kept and votes on it are not
+	// required. Zero and one tally it every vote period.
+	VotePeriodMultiplier uint64 `protobuf:"varint,2,opt,name=vote_period_multiplier,json=votePeriodMultiplier,proto3" json:"vote_period_multiplier,omitempty" yaml:"vote_period_multiplier,omitempty"`
+	// quote_denom defines the whitelisted denom the exchange rate of the denom is
+	// quoted in. Empty quotes it in USD, like all denoms by default.
+	QuoteDenom string `protobuf:"bytes,3,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty" yaml:"quote_denom,omitempty"`
+	// tracks defines the whitelisted denom whose exchange rate the denom mirrors
+	// each vote period, e.g. a wrapped asset tracking its underlying. The denom
+	// then has no ballot of its own and votes on it are not required.
+	Tracks string `protobuf:"bytes,4,opt,name=tracks,proto3" json:"tracks,omitempty" yaml:"tracks,omitempty"`
 }
 
 func (m *Denom) Reset()      { *m = Denom{} }
@@ -251,482 +500,4780 @@ func (m *ExchangeRateTuple) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExchangeRateTuple proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*Params)(nil), "kujira.oracle.Params")
-	proto.RegisterType((*Denom)(nil), "kujira.oracle.Denom")
-	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "kujira.oracle.AggregateExchangeRatePrevote")
-	proto.RegisterType((*AggregateExchangeRateVote)(nil), "kujira.oracle.AggregateExchangeRateVote")
-	proto.RegisterType((*ExchangeRateTuple)(nil), "kujira.oracle.ExchangeRateTuple")
+// TallyBounds - struct to store the reward band boundaries of the last tally of a denom,
+// and the power of the votes within them
+type TallyBounds struct {
+	LowerBound github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=lower_bound,json=lowerBound,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"lower_bound" yaml:"lower_bound"`
+	UpperBound github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=upper_bound,json=upperBound,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"upper_bound" yaml:"upper_bound"`
+	// rated_power defines the power of the votes of the ballot rating the denom,
+	// i.e. not abstaining.
+	RatedPower int64 `protobuf:"varint,3,opt,name=rated_power,json=ratedPower,proto3" json:"rated_power,omitempty" yaml:"rated_power"`
+	// in_band_power defines the power of the rating votes within the bounds.
+	InBandPower int64 `protobuf:"varint,4,opt,name=in_band_power,json=inBandPower,proto3" json:"in_band_power,omitempty" yaml:"in_band_power"`
 }
 
-func init() { proto.RegisterFile("kujira/oracle/oracle.proto", fileDescriptor_8fffe8fb5ee63325) }
-
-var fileDescriptor_8fffe8fb5ee63325 = []byte{
-	// 737 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x41, 0x6f, 0xd3, 0x48,
-	0x14, 0x8e, 0xb7, 0x6d, 0xb6, 0x99, 0x24, 0xbb, 0xad, 0x37, 0xbb, 0xeb, 0xcd, 0xae, 0xe2, 0xec,
-	0xac, 0x5a, 0x55, 0x2b, 0x35, 0x56, 0xe1, 0x80, 0xc8, 0x0d, 0x2b, 0x94, 0x03, 0x20, 0x45, 0x56,
-	0x55, 0x04, 0x97, 0x68, 0x6c, 0x0f, 0xf1, 0x10, 0xdb, 0x13, 0xcd, 0x38, 0x4d, 0x7b, 0xe1, 0xcc,
-	0x05, 0xc4, 0x91, 0x63, 0xcf, 0xdc, 0xe1, 0x37, 0xf4, 0xd8, 0x23, 0xe2, 0x60, 0xa0, 0xbd, 0x70,
-	0xce, 0x2f, 0x40, 0x33, 0xe3, 0xb4, 0x4e, 0x93, 0x03, 0x15, 0xa7, 0xf4, 0xbd, 0xef, 0xcd, 0xf7,
-	0xbd, 0xf9, 0xde, 0x9b, 0x1a, 0xd4, 0x07, 0xa3, 0x67, 0x84, 0x21, 0x8b, 0x32, 0xe4, 0x85, 0x38,
-	0xfb, 0x69, 0x0d, 0x19, 0x4d, 0xa8, 0x5e, 0x55, 0x58, 0x4b, 0x25, 0xeb, 0xb5, 0x3e, 0xed, 0x53,
-	0x89, 0x58, 0xe2, 0x2f, 0x55, 0x54, 0x6f, 0x78, 0x94, 0x47, 0x94, 0x5b, 0x2e, 0xe2, 0xd8, 0x3a,
-	0xd8, 0x71, 0x71, 0x82, 0x76, 0x2c, 0x8f, 0x92, 0x58, 0xe1, 0xf0, 0x55, 0x11, 0x14, 0xbb, 0x88,
-	0xa1, 0x88, 0xeb, 0xb7, 0x40, 0xf9, 0x80, 0x26, 0xb8, 0x37, 0xc4, 0x8c, 0x50, 0xdf, 0xd0, 0x9a,
-	0xda, 0xd6, 0xb2, 0xfd, 0xc7, 0x24, 0x35, 0xf5, 0x23, 0x14, 0x85, 0x6d, 0x98, 0x03, 0xa1, 0x03,
-	0x44, 0xd4, 0x95, 0x81, 0x1e, 0x83, 0x5f, 0x24, 0x96, 0x04, 0x0c, 0xf3, 0x80, 0x86, 0xbe, 0xf1,
-	0x53, 0x53, 0xdb, 0x2a, 0xd9, 0xf7, 0x4e, 0x52, 0xb3, 0xf0, 0x31, 0x35, 0x37, 0xfb, 0x24, 0x09,
-	0x46, 0x6e, 0xcb, 0xa3, 0x91, 0x95, 0xb5, 0xa3, 0x7e, 0xb6, 0xb9, 0x3f, 0xb0, 0x92, 0xa3, 0x21,
-	0xe6, 0xad, 0x0e, 0xf6, 0x26, 0xa9, 0xf9, 0x7b, 0x4e, 0xe9, 0x82, 0x0d, 0x3a, 0x55, 0x91, 0xd8,
-	0x9b, 0xc6, 0x3a, 0x06, 0x65, 0x86, 0xc7, 0x88, 0xf9, 0x3d, 0x17, 0xc5, 0xbe, 0xb1, 0x24, 0xc5,
-	0x3a, 0xd7, 0x16, 0xcb, 0xae, 0x95, 0xa3, 0x82, 0x0e, 0x50, 0x91, 0x8d, 0x62, 0x5f, 0xf7, 0x40,
-	0x3d, 0xc3, 0x7c, 0xc2, 0x13, 0x46, 0xdc, 0x51, 0x42, 0x68, 0xdc, 0x1b, 0x93, 0xd8, 0xa7, 0x63,
-	0x63, 0x59, 0xda, 0xb3, 0x31, 0x49, 0xcd, 0x7f, 0x67, 0x78, 0x16, 0xd4, 0x42, 0xc7, 0x50, 0x60,
-	0x27, 0x87, 0x3d, 0x92, 0x90, 0xfe, 0x18, 0x94, 0xc6, 0x01, 0x49, 0x70, 0x48, 0x78, 0x62, 0xac,
-	0x34, 0x97, 0xb6, 0xca, 0x37, 0x6a, 0xad, 0x99, 0xc1, 0xb6, 0x3a, 0x38, 0xa6, 0x91, 0xbd, 0x21,
-	0xee, 0x37, 0x49, 0xcd, 0x35, 0xa5, 0x76, 0x71, 0x08, 0xbe, 0xfd, 0x64, 0x96, 0x64, 0xc9, 0x03,
-	0xc2, 0x13, 0xe7, 0x92, 0x4d, 0x8c, 0x85, 0x87, 0x88, 0x07, 0xbd, 0xa7, 0x0c, 0x79, 0x42, 0xd2,
-	0x28, 0xfe, 0xd8, 0x58, 0x66, 0xd9, 0xa0, 0x53, 0x95, 0x89, 0xdd, 0x2c, 0xd6, 0xdb, 0xa0, 0xa2,
-	0x2a, 0x32, 0x87, 0x7e, 0x96, 0x0e, 0xfd, 0x39, 0x49, 0xcd, 0xdf, 0xf2, 0xe7, 0xa7, 0x9e, 0x94,
-	0x65, 0x98, 0xd9, 0xf0, 0x1c, 0xd4, 0x22, 0x12, 0xf7, 0x0e, 0x50, 0x48, 0x7c, 0xb1, 0x63, 0x53,
-	0x8e, 0x55, 0xd9, 0xf1, 0xc3, 0x6b, 0x77, 0xfc, 0xb7, 0x52, 0x5c, 0xc4, 0x09, 0x9d, 0xf5, 0x88,
-	0xc4, 0xfb, 0x22, 0xdb, 0xc5, 0x4c, 0xe9, 0xb7, 0x57, 0xdf, 0x1c, 0x9b, 0x85, 0xaf, 0xc7, 0xa6,
-	0x06, 0xdb, 0x60, 0x45, 0xba, 0xa9, 0xff, 0x07, 0x96, 0x63, 0x14, 0x61, 0xf9, 0x0e, 0x4a, 0xf6,
-	0xaf, 0x93, 0xd4, 0x2c, 0x2b, 0x52, 0x91, 0x85, 0x8e, 0x04, 0xdb, 0x95, 0x17, 0xc7, 0x66, 0x21,
-	0x3b, 0x5b, 0x80, 0xef, 0x34, 0xf0, 0xcf, 0x9d, 0x7e, 0x9f, 0xe1, 0x3e, 0x4a, 0xf0, 0xdd, 0x43,
-	0x2f, 0x40, 0x71, 0x1f, 0x3b, 0x28, 0xc1, 0x5d, 0x86, 0xc5, 0x0a, 0x0b, 0xce, 0x00, 0xf1, 0x60,
-	0x9e, 0x53, 0x64, 0xa1, 0x23, 0x41, 0x7d, 0x13, 0xac, 0x88, 0x62, 0x96, 0xbd, 0xa2, 0xb5, 0x49,
-	0x6a, 0x56, 0x2e, 0xdf, 0x05, 0x83, 0x8e, 0x82, 0xa5, 0xdf, 0x23, 0x37, 0x22, 0x49, 0xcf, 0x0d,
-	0xa9, 0x37, 0x90, 0xef, 0x60, 0xd6, 0xef, 0x1c, 0x2a, 0xfc, 0x96, 0xa1, 0x2d, 0xa2, 0x2b, 0x7d,
-	0x7f, 0xd1, 0xc0, 0x5f, 0x0b, 0xfb, 0xde, 0x17, 0x4d, 0xbf, 0xd4, 0x40, 0x0d, 0x67, 0xc9, 0x1e,
-	0x43, 0xe2, 0x69, 0x8e, 0x86, 0x21, 0xe6, 0x86, 0x26, 0xd7, 0xb5, 0x79, 0x65, 0x5d, 0xf3, 0xe7,
-	0xf7, 0x44, 0xa1, 0x7d, 0x3b, 0x5b, 0xdd, 0x6c, 0x28, 0x8b, 0xb8, 0xc4, 0x16, 0xeb, 0x73, 0x27,
-	0xb9, 0xa3, 0xe3, 0xb9, 0xdc, 0xf7, 0xfa, 0x73, 0xe5, 0x8e, 0xef, 0x35, 0xb0, 0x3e, 0x27, 0x20,
-	0xb8, 0x7c, 0x31, 0xed, 0x6c, 0x22, 0x39, 0x2e, 0x99, 0x86, 0x8e, 0x82, 0xf5, 0x01, 0xa8, 0xce,
-	0xb4, 0x9d, 0x69, 0xef, 0x5e, 0x7b, 0x31, 0x6b, 0x0b, 0x3c, 0x80, 0x4e, 0x25, 0x7f, 0xcd, 0xd9,
-	0xc6, 0xed, 0xce, 0xc9, 0x59, 0x43, 0x3b, 0x3d, 0x6b, 0x68, 0x9f, 0xcf, 0x1a, 0xda, 0xeb, 0xf3,
-	0x46, 0xe1, 0xf4, 0xbc, 0x51, 0xf8, 0x70, 0xde, 0x28, 0x3c, 0xf9, 0x3f, 0xa7, 0xba, 0x87, 0x51,
-	0xb4, 0x7d, 0x5f, 0x7d, 0x2c, 0x3c, 0xca, 0xb0, 0x75, 0x38, 0xfd, 0x66, 0x48, 0x75, 0xb7, 0x28,
-	0xff, 0xdd, 0xdf, 0xfc, 0x16, 0x00, 0x00, 0xff, 0xff, 0x17, 0x91, 0xdd, 0x52, 0x51, 0x06, 0x00,
-	0x00,
+func (m *TallyBounds) Reset()         { *m = TallyBounds{} }
+func (m *TallyBounds) String() string { return proto.CompactTextString(m) }
+func (*TallyBounds) ProtoMessage()    {}
+func (*TallyBounds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fffe8fb5ee63325, []int{5}
 }
-
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *TallyBounds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TallyBounds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TallyBounds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
+}
+func (m *TallyBounds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TallyBounds.Merge(m, src)
+}
+func (m *TallyBounds) XXX_Size() int {
+	return m.Size()
+}
+func (m *TallyBounds) XXX_DiscardUnknown() {
+	xxx_messageInfo_TallyBounds.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_TallyBounds proto.InternalMessageInfo
+
+// DenomTallyCounter - struct to store the number of vote periods a denom
+// tallied and failed to tally in the current slash window
+type DenomTallyCounter struct {
+	SuccessPeriods uint64 `protobuf:"varint,1,opt,name=success_periods,json=successPeriods,proto3" json:"success_periods,omitempty" yaml:"success_periods"`
+	FailedPeriods  uint64 `protobuf:"varint,2,opt,name=failed_periods,json=failedPeriods,proto3" json:"failed_periods,omitempty" yaml:"failed_periods"`
+}
+
+func (m *DenomTallyCounter) Reset()         { *m = DenomTallyCounter{} }
+func (m *DenomTallyCounter) String() string { return proto.CompactTextString(m) }
+func (*DenomTallyCounter) ProtoMessage()    {}
+func (*DenomTallyCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fffe8fb5ee63325, []int{6}
+}
+func (m *DenomTallyCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomTallyCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTallyCounter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.VotePeriod != that1.VotePeriod {
-		return false
+}
+func (m *DenomTallyCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTallyCounter.Merge(m, src)
+}
+func (m *DenomTallyCounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTallyCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTallyCounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomTallyCounter proto.InternalMessageInfo
+
+func (m *DenomTallyCounter) GetSuccessPeriods() uint64 {
+	if m != nil {
+		return m.SuccessPeriods
 	}
-	if !this.VoteThreshold.Equal(that1.VoteThreshold) {
-		return false
+	return 0
+}
+
+func (m *DenomTallyCounter) GetFailedPeriods() uint64 {
+	if m != nil {
+		return m.FailedPeriods
 	}
-	if !this.RewardBand.Equal(that1.RewardBand) {
-		return false
+	return 0
+}
+
+// DenomTallyOutcome - struct to store the outcome of the last vote period of a
+// denom and why it did not tally
+type DenomTallyOutcome struct {
+	// reason defines the outcome, one of "success", "below_threshold", "failed",
+	// "stale", "resting" or "tracking".
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty" yaml:"reason"`
+	// vote_period defines the vote period of the outcome.
+	VotePeriod uint64 `protobuf:"varint,2,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty" yaml:"vote_period"`
+	// achieved_fraction defines the share of the power the vote threshold is
+	// measured against, which voted on the denom.
+	AchievedFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=achieved_fraction,json=achievedFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"achieved_fraction" yaml:"achieved_fraction"`
+	// required_fraction defines the vote threshold in effect.
+	RequiredFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=required_fraction,json=requiredFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"required_fraction" yaml:"required_fraction"`
+}
+
+func (m *DenomTallyOutcome) Reset()         { *m = DenomTallyOutcome{} }
+func (m *DenomTallyOutcome) String() string { return proto.CompactTextString(m) }
+func (*DenomTallyOutcome) ProtoMessage()    {}
+func (*DenomTallyOutcome) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fffe8fb5ee63325, []int{7}
+}
+func (m *DenomTallyOutcome) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomTallyOutcome) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTallyOutcome.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if this.RewardDistributionWindow != that1.RewardDistributionWindow {
-		return false
+}
+func (m *DenomTallyOutcome) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTallyOutcome.Merge(m, src)
+}
+func (m *DenomTallyOutcome) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTallyOutcome) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTallyOutcome.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomTallyOutcome proto.InternalMessageInfo
+
+func (m *DenomTallyOutcome) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if len(this.Whitelist) != len(that1.Whitelist) {
-		return false
+	return ""
+}
+
+func (m *DenomTallyOutcome) GetVotePeriod() uint64 {
+	if m != nil {
+		return m.VotePeriod
 	}
-	for i := range this.Whitelist {
-		if !this.Whitelist[i].Equal(&that1.Whitelist[i]) {
-			return false
+	return 0
+}
+
+// ValidatorAccuracyCounter - struct to store the number of exchange rates a
+// validator submitted on tallied denoms, and how many of them were within the
+// reward band, in the current and the previous slash window
+type ValidatorAccuracyCounter struct {
+	Submissions               uint64 `protobuf:"varint,1,opt,name=submissions,proto3" json:"submissions,omitempty" yaml:"submissions"`
+	InBandSubmissions         uint64 `protobuf:"varint,2,opt,name=in_band_submissions,json=inBandSubmissions,proto3" json:"in_band_submissions,omitempty" yaml:"in_band_submissions"`
+	PreviousSubmissions       uint64 `protobuf:"varint,3,opt,name=previous_submissions,json=previousSubmissions,proto3" json:"previous_submissions,omitempty" yaml:"previous_submissions"`
+	PreviousInBandSubmissions uint64 `protobuf:"varint,4,opt,name=previous_in_band_submissions,json=previousInBandSubmissions,proto3" json:"previous_in_band_submissions,omitempty" yaml:"previous_in_band_submissions"`
+}
+
+func (m *ValidatorAccuracyCounter) Reset()         { *m = ValidatorAccuracyCounter{} }
+func (m *ValidatorAccuracyCounter) String() string { return proto.CompactTextString(m) }
+func (*ValidatorAccuracyCounter) ProtoMessage()    {}
+func (*ValidatorAccuracyCounter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fffe8fb5ee63325, []int{8}
+}
+func (m *ValidatorAccuracyCounter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorAccuracyCounter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorAccuracyCounter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if !this.SlashFraction.Equal(that1.SlashFraction) {
-		return false
+}
+func (m *ValidatorAccuracyCounter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorAccuracyCounter.Merge(m, src)
+}
+func (m *ValidatorAccuracyCounter) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorAccuracyCounter) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorAccuracyCounter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorAccuracyCounter proto.InternalMessageInfo
+
+func (m *ValidatorAccuracyCounter) GetSubmissions() uint64 {
+	if m != nil {
+		return m.Submissions
 	}
-	if this.SlashWindow != that1.SlashWindow {
-		return false
+	return 0
+}
+
+func (m *ValidatorAccuracyCounter) GetInBandSubmissions() uint64 {
+	if m != nil {
+		return m.InBandSubmissions
 	}
-	if !this.MinValidPerWindow.Equal(that1.MinValidPerWindow) {
-		return false
+	return 0
+}
+
+func (m *ValidatorAccuracyCounter) GetPreviousSubmissions() uint64 {
+	if m != nil {
+		return m.PreviousSubmissions
 	}
-	return true
+	return 0
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *ValidatorAccuracyCounter) GetPreviousInBandSubmissions() uint64 {
+	if m != nil {
+		return m.PreviousInBandSubmissions
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// VotePeriodClock tracks the vote periods closed by block time, when
+// vote_period_duration is set.
+type VotePeriodClock struct {
+	// period defines the number of the current vote period.
+	Period uint64 `protobuf:"varint,1,opt,name=period,proto3" json:"period,omitempty"`
+	// start_height defines the first block of the current vote period.
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// previous_start_height defines the first block of the previous vote period.
+	PreviousStartHeight int64 `protobuf:"varint,3,opt,name=previous_start_height,json=previousStartHeight,proto3" json:"previous_start_height,omitempty"`
+	// boundary defines the number of vote period durations since the unix epoch
+	// at the start of the current vote period.
+	Boundary int64 `protobuf:"varint,4,opt,name=boundary,proto3" json:"boundary,omitempty"`
+	// slash_window_periods defines the number of vote periods closed in the
+	// current slash window.
+	SlashWindowPeriods uint64 `protobuf:"varint,5,opt,name=slash_window_periods,json=slashWindowPeriods,proto3" json:"slash_window_periods,omitempty"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MinValidPerWindow.Size()
-		i -= size
-		if _, err := m.MinValidPerWindow.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	if m.SlashWindow != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.SlashWindow))
-		i--
-		dAtA[i] = 0x38
-	}
-	{
-		size := m.SlashFraction.Size()
-		i -= size
-		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.Whitelist) > 0 {
-		for iNdEx := len(m.Whitelist) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Whitelist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if m.RewardDistributionWindow != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.RewardDistributionWindow))
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size := m.RewardBand.Size()
-		i -= size
-		if _, err := m.RewardBand.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.VoteThreshold.Size()
-		i -= size
-		if _, err := m.VoteThreshold.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *VotePeriodClock) Reset()         { *m = VotePeriodClock{} }
+func (m *VotePeriodClock) String() string { return proto.CompactTextString(m) }
+func (*VotePeriodClock) ProtoMessage()    {}
+func (*VotePeriodClock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fffe8fb5ee63325, []int{9}
+}
+func (m *VotePeriodClock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotePeriodClock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotePeriodClock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if m.VotePeriod != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.VotePeriod))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *Denom) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *VotePeriodClock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotePeriodClock.Merge(m, src)
 }
-
-func (m *Denom) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VotePeriodClock) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotePeriodClock) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotePeriodClock.DiscardUnknown(m)
 }
 
-func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_VotePeriodClock proto.InternalMessageInfo
+
+func (m *VotePeriodClock) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *AggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VotePeriodClock) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *AggregateExchangeRatePrevote) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VotePeriodClock) GetPreviousStartHeight() int64 {
+	if m != nil {
+		return m.PreviousStartHeight
+	}
+	return 0
 }
 
-func (m *AggregateExchangeRatePrevote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SubmitBlock != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.SubmitBlock))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Voter)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+func (m *VotePeriodClock) GetBoundary() int64 {
+	if m != nil {
+		return m.Boundary
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *AggregateExchangeRateVote) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VotePeriodClock) GetSlashWindowPeriods() uint64 {
+	if m != nil {
+		return m.SlashWindowPeriods
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *AggregateExchangeRateVote) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// VotePeriodParticipation - struct to store the participation in the last
+// tallied vote period, both measured when it was tallied
+type VotePeriodParticipation struct {
+	BondedPower      int64  `protobuf:"varint,1,opt,name=bonded_power,json=bondedPower,proto3" json:"bonded_power,omitempty" yaml:"bonded_power"`
+	BondedValidators uint64 `protobuf:"varint,2,opt,name=bonded_validators,json=bondedValidators,proto3" json:"bonded_validators,omitempty" yaml:"bonded_validators"`
+	VotedPower       int64  `protobuf:"varint,3,opt,name=voted_power,json=votedPower,proto3" json:"voted_power,omitempty" yaml:"voted_power"`
+	Voters           uint64 `protobuf:"varint,4,opt,name=voters,proto3" json:"voters,omitempty" yaml:"voters"`
 }
 
-func (m *AggregateExchangeRateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Voter) > 0 {
-		i -= len(m.Voter)
-		copy(dAtA[i:], m.Voter)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Voter)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ExchangeRateTuples) > 0 {
-		for iNdEx := len(m.ExchangeRateTuples) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ExchangeRateTuples[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintOracle(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *VotePeriodParticipation) Reset()         { *m = VotePeriodParticipation{} }
+func (m *VotePeriodParticipation) String() string { return proto.CompactTextString(m) }
+func (*VotePeriodParticipation) ProtoMessage()    {}
+func (*VotePeriodParticipation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fffe8fb5ee63325, []int{10}
+}
+func (m *VotePeriodParticipation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotePeriodParticipation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotePeriodParticipation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *VotePeriodParticipation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotePeriodParticipation.Merge(m, src)
+}
+func (m *VotePeriodParticipation) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotePeriodParticipation) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotePeriodParticipation.DiscardUnknown(m)
 }
 
-func (m *ExchangeRateTuple) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VotePeriodParticipation proto.InternalMessageInfo
+
+func (m *VotePeriodParticipation) GetBondedPower() int64 {
+	if m != nil {
+		return m.BondedPower
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ExchangeRateTuple) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VotePeriodParticipation) GetBondedValidators() uint64 {
+	if m != nil {
+		return m.BondedValidators
+	}
+	return 0
 }
 
-func (m *ExchangeRateTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ExchangeRate.Size()
-		i -= size
-		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *VotePeriodParticipation) GetVotedPower() int64 {
+	if m != nil {
+		return m.VotedPower
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOracle(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *VotePeriodParticipation) GetVoters() uint64 {
+	if m != nil {
+		return m.Voters
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+// OracleAlertConfig - struct to store the miss rate a validator declared to
+// accept within a slash window, for monitoring services to alert on
+type OracleAlertConfig struct {
+	MaxMissRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=max_miss_rate,json=maxMissRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_miss_rate" yaml:"max_miss_rate"`
+}
+
+func (m *OracleAlertConfig) Reset()         { *m = OracleAlertConfig{} }
+func (m *OracleAlertConfig) String() string { return proto.CompactTextString(m) }
+func (*OracleAlertConfig) ProtoMessage()    {}
+func (*OracleAlertConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fffe8fb5ee63325, []int{11}
+}
+func (m *OracleAlertConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleAlertConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleAlertConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.VotePeriod != 0 {
-		n += 1 + sovOracle(uint64(m.VotePeriod))
+}
+func (m *OracleAlertConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleAlertConfig.Merge(m, src)
+}
+func (m *OracleAlertConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleAlertConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleAlertConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleAlertConfig proto.InternalMessageInfo
+
+// WhitelistChange - struct to store a denom added to or removed from the
+// whitelist, along with the vote period the change was recorded in
+type WhitelistChange struct {
+	Denom      string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	VotePeriod uint64 `protobuf:"varint,2,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty" yaml:"vote_period"`
+	Added      bool   `protobuf:"varint,3,opt,name=added,proto3" json:"added,omitempty" yaml:"added"`
+}
+
+func (m *WhitelistChange) Reset()         { *m = WhitelistChange{} }
+func (m *WhitelistChange) String() string { return proto.CompactTextString(m) }
+func (*WhitelistChange) ProtoMessage()    {}
+func (*WhitelistChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fffe8fb5ee63325, []int{12}
+}
+func (m *WhitelistChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WhitelistChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WhitelistChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.VoteThreshold.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	l = m.RewardBand.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.RewardDistributionWindow != 0 {
-		n += 1 + sovOracle(uint64(m.RewardDistributionWindow))
+}
+func (m *WhitelistChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WhitelistChange.Merge(m, src)
+}
+func (m *WhitelistChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *WhitelistChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_WhitelistChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WhitelistChange proto.InternalMessageInfo
+
+func (m *WhitelistChange) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.Whitelist) > 0 {
-		for _, e := range m.Whitelist {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
+	return ""
+}
+
+func (m *WhitelistChange) GetVotePeriod() uint64 {
+	if m != nil {
+		return m.VotePeriod
+	}
+	return 0
+}
+
+func (m *WhitelistChange) GetAdded() bool {
+	if m != nil {
+		return m.Added
+	}
+	return false
+}
+
+// RejectedTuples - struct to store the exchange rates of the last vote of a
+// validator which are left out of the tally, and the vote period of the vote
+type RejectedTuples struct {
+	VotePeriod uint64          `protobuf:"varint,1,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty" yaml:"vote_period"`
+	Tuples     []RejectedTuple `protobuf:"bytes,2,rep,name=tuples,proto3" json:"tuples" yaml:"tuples"`
+}
+
+func (m *RejectedTuples) Reset()         { *m = RejectedTuples{} }
+func (m *RejectedTuples) String() string { return proto.CompactTextString(m) }
+func (*RejectedTuples) ProtoMessage()    {}
+func (*RejectedTuples) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fffe8fb5ee63325, []int{13}
+}
+func (m *RejectedTuples) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RejectedTuples) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RejectedTuples.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = m.SlashFraction.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	if m.SlashWindow != 0 {
-		n += 1 + sovOracle(uint64(m.SlashWindow))
+}
+func (m *RejectedTuples) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RejectedTuples.Merge(m, src)
+}
+func (m *RejectedTuples) XXX_Size() int {
+	return m.Size()
+}
+func (m *RejectedTuples) XXX_DiscardUnknown() {
+	xxx_messageInfo_RejectedTuples.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RejectedTuples proto.InternalMessageInfo
+
+func (m *RejectedTuples) GetVotePeriod() uint64 {
+	if m != nil {
+		return m.VotePeriod
 	}
-	l = m.MinValidPerWindow.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
+	return 0
 }
 
-func (m *Denom) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RejectedTuples) GetTuples() []RejectedTuple {
+	if m != nil {
+		return m.Tuples
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	return nil
+}
+
+// RejectedTuple - an exchange rate of a vote left out of the tally
+type RejectedTuple struct {
+	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty" yaml:"reason"`
+}
+
+func (m *RejectedTuple) Reset()         { *m = RejectedTuple{} }
+func (m *RejectedTuple) String() string { return proto.CompactTextString(m) }
+func (*RejectedTuple) ProtoMessage()    {}
+func (*RejectedTuple) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fffe8fb5ee63325, []int{14}
+}
+func (m *RejectedTuple) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RejectedTuple) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RejectedTuple.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *RejectedTuple) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RejectedTuple.Merge(m, src)
+}
+func (m *RejectedTuple) XXX_Size() int {
+	return m.Size()
+}
+func (m *RejectedTuple) XXX_DiscardUnknown() {
+	xxx_messageInfo_RejectedTuple.DiscardUnknown(m)
 }
 
-func (m *AggregateExchangeRatePrevote) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_RejectedTuple proto.InternalMessageInfo
+
+func (m *RejectedTuple) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	return ""
+}
+
+func (m *RejectedTuple) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	return ""
+}
+
+// TallyStats - struct to store the structural counts of the ballots of the
+// last tally, sorted by denom, for operators to gauge the load of the tally
+type TallyStats struct {
+	VotePeriod uint64            `protobuf:"varint,1,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty" yaml:"vote_period"`
+	Denoms     []DenomTallyStats `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms" yaml:"denoms"`
+}
+
+func (m *TallyStats) Reset()         { *m = TallyStats{} }
+func (m *TallyStats) String() string { return proto.CompactTextString(m) }
+func (*TallyStats) ProtoMessage()    {}
+func (*TallyStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fffe8fb5ee63325, []int{15}
+}
+func (m *TallyStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TallyStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TallyStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.SubmitBlock != 0 {
-		n += 1 + sovOracle(uint64(m.SubmitBlock))
+}
+func (m *TallyStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TallyStats.Merge(m, src)
+}
+func (m *TallyStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *TallyStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_TallyStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TallyStats proto.InternalMessageInfo
+
+func (m *TallyStats) GetVotePeriod() uint64 {
+	if m != nil {
+		return m.VotePeriod
 	}
-	return n
+	return 0
 }
 
-func (m *AggregateExchangeRateVote) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TallyStats) GetDenoms() []DenomTallyStats {
+	if m != nil {
+		return m.Denoms
 	}
-	var l int
-	_ = l
-	if len(m.ExchangeRateTuples) > 0 {
-		for _, e := range m.ExchangeRateTuples {
-			l = e.Size()
-			n += 1 + l + sovOracle(uint64(l))
+	return nil
+}
+
+// DenomTallyStats - the counts of the ballot of a denom in a tally
+type DenomTallyStats struct {
+	Denom        string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Votes        uint64 `protobuf:"varint,2,opt,name=votes,proto3" json:"votes,omitempty" yaml:"votes"`
+	AbstainVotes uint64 `protobuf:"varint,3,opt,name=abstain_votes,json=abstainVotes,proto3" json:"abstain_votes,omitempty" yaml:"abstain_votes"`
+	Tallied      bool   `protobuf:"varint,4,opt,name=tallied,proto3" json:"tallied,omitempty" yaml:"tallied"`
+	CappedVotes  uint64 `protobuf:"varint,5,opt,name=capped_votes,json=cappedVotes,proto3" json:"capped_votes,omitempty" yaml:"capped_votes"`
+}
+
+func (m *DenomTallyStats) Reset()         { *m = DenomTallyStats{} }
+func (m *DenomTallyStats) String() string { return proto.CompactTextString(m) }
+func (*DenomTallyStats) ProtoMessage()    {}
+func (*DenomTallyStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fffe8fb5ee63325, []int{16}
+}
+func (m *DenomTallyStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomTallyStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTallyStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+}
+func (m *DenomTallyStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTallyStats.Merge(m, src)
+}
+func (m *DenomTallyStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTallyStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTallyStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomTallyStats proto.InternalMessageInfo
+
+func (m *DenomTallyStats) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return n
+	return ""
 }
 
-func (m *ExchangeRateTuple) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DenomTallyStats) GetVotes() uint64 {
+	if m != nil {
+		return m.Votes
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovOracle(uint64(l))
+	return 0
+}
+
+func (m *DenomTallyStats) GetAbstainVotes() uint64 {
+	if m != nil {
+		return m.AbstainVotes
+	}
+	return 0
+}
+
+func (m *DenomTallyStats) GetTallied() bool {
+	if m != nil {
+		return m.Tallied
+	}
+	return false
+}
+
+func (m *DenomTallyStats) GetCappedVotes() uint64 {
+	if m != nil {
+		return m.CappedVotes
+	}
+	return 0
+}
+
+// ParticipationPoint - struct to store the share of the bonded power which
+// voted in a vote period, as a point of the participation series
+type ParticipationPoint struct {
+	VotePeriod         uint64                                 `protobuf:"varint,1,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty" yaml:"vote_period"`
+	ParticipationRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=participation_ratio,json=participationRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"participation_ratio" yaml:"participation_ratio"`
+}
+
+func (m *ParticipationPoint) Reset()         { *m = ParticipationPoint{} }
+func (m *ParticipationPoint) String() string { return proto.CompactTextString(m) }
+func (*ParticipationPoint) ProtoMessage()    {}
+func (*ParticipationPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fffe8fb5ee63325, []int{17}
+}
+func (m *ParticipationPoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipationPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipationPoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParticipationPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipationPoint.Merge(m, src)
+}
+func (m *ParticipationPoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipationPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipationPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParticipationPoint proto.InternalMessageInfo
+
+func (m *ParticipationPoint) GetVotePeriod() uint64 {
+	if m != nil {
+		return m.VotePeriod
+	}
+	return 0
+}
+
+// DenomMaxMove - struct to store the largest absolute change of the exchange
+// rate of a denom between consecutive vote periods in the current window
+type DenomMaxMove struct {
+	// window_start defines the first vote period of the window.
+	WindowStart uint64 `protobuf:"varint,1,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty" yaml:"window_start"`
+	// max_move_percent defines |rate / previous rate - 1| in percent.
+	MaxMovePercent github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_move_percent,json=maxMovePercent,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_move_percent" yaml:"max_move_percent"`
+	// vote_period defines the vote period of the move.
+	VotePeriod uint64 `protobuf:"varint,3,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty" yaml:"vote_period"`
+}
+
+func (m *DenomMaxMove) Reset()         { *m = DenomMaxMove{} }
+func (m *DenomMaxMove) String() string { return proto.CompactTextString(m) }
+func (*DenomMaxMove) ProtoMessage()    {}
+func (*DenomMaxMove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fffe8fb5ee63325, []int{18}
+}
+func (m *DenomMaxMove) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomMaxMove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomMaxMove.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomMaxMove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomMaxMove.Merge(m, src)
+}
+func (m *DenomMaxMove) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomMaxMove) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomMaxMove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomMaxMove proto.InternalMessageInfo
+
+func (m *DenomMaxMove) GetWindowStart() uint64 {
+	if m != nil {
+		return m.WindowStart
+	}
+	return 0
+}
+
+func (m *DenomMaxMove) GetVotePeriod() uint64 {
+	if m != nil {
+		return m.VotePeriod
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "kujira.oracle.Params")
+	proto.RegisterType((*Denom)(nil), "kujira.oracle.Denom")
+	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "kujira.oracle.AggregateExchangeRatePrevote")
+	proto.RegisterType((*AggregateExchangeRateVote)(nil), "kujira.oracle.AggregateExchangeRateVote")
+	proto.RegisterType((*ExchangeRateTuple)(nil), "kujira.oracle.ExchangeRateTuple")
+	proto.RegisterType((*TallyBounds)(nil), "kujira.oracle.TallyBounds")
+	proto.RegisterType((*DenomTallyCounter)(nil), "kujira.oracle.DenomTallyCounter")
+	proto.RegisterType((*DenomTallyOutcome)(nil), "kujira.oracle.DenomTallyOutcome")
+	proto.RegisterType((*ValidatorAccuracyCounter)(nil), "kujira.oracle.ValidatorAccuracyCounter")
+	proto.RegisterType((*VotePeriodClock)(nil), "kujira.oracle.VotePeriodClock")
+	proto.RegisterType((*VotePeriodParticipation)(nil), "kujira.oracle.VotePeriodParticipation")
+	proto.RegisterType((*OracleAlertConfig)(nil), "kujira.oracle.OracleAlertConfig")
+	proto.RegisterType((*WhitelistChange)(nil), "kujira.oracle.WhitelistChange")
+	proto.RegisterType((*RejectedTuples)(nil), "kujira.oracle.RejectedTuples")
+	proto.RegisterType((*RejectedTuple)(nil), "kujira.oracle.RejectedTuple")
+	proto.RegisterType((*TallyStats)(nil), "kujira.oracle.TallyStats")
+	proto.RegisterType((*DenomTallyStats)(nil), "kujira.oracle.DenomTallyStats")
+	proto.RegisterType((*ParticipationPoint)(nil), "kujira.oracle.ParticipationPoint")
+	proto.RegisterType((*DenomMaxMove)(nil), "kujira.oracle.DenomMaxMove")
+}
+
+func init() { proto.RegisterFile("kujira/oracle/oracle.proto", fileDescriptor_8fffe8fb5ee63325) }
+
+var fileDescriptor_8fffe8fb5ee63325 = []byte{
+	// 2549 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x59, 0xcb, 0x6f, 0x1c, 0xc7,
+	0xd1, 0xe7, 0x50, 0x14, 0x4d, 0xf5, 0xf2, 0xb5, 0x43, 0x52, 0x1c, 0x52, 0x32, 0x87, 0x6e, 0xdb,
+	0xb2, 0xec, 0xcf, 0x26, 0x3f, 0xdb, 0x07, 0x27, 0x42, 0x02, 0x44, 0x4b, 0x9a, 0x7e, 0xc8, 0x8c,
+	0x99, 0x96, 0x22, 0x21, 0xbe, 0x4c, 0x7a, 0x67, 0x9a, 0xbb, 0x23, 0xcd, 0x4c, 0xaf, 0xbb, 0x67,
+	0xf8, 0x38, 0x24, 0xb9, 0xe4, 0x60, 0x04, 0x08, 0x90, 0x43, 0x12, 0x18, 0x39, 0xf9, 0x9c, 0x7b,
+	0xf2, 0x37, 0xf8, 0x10, 0x04, 0x3e, 0x06, 0x41, 0xb0, 0x4e, 0xe4, 0x4b, 0x72, 0x0a, 0xb0, 0xa7,
+	0x1c, 0x83, 0xae, 0xee, 0xd9, 0xed, 0x9d, 0x5d, 0x2a, 0xa2, 0x78, 0x22, 0xbb, 0x7e, 0xd5, 0x55,
+	0xd5, 0x35, 0xd5, 0xf5, 0xe8, 0x45, 0xeb, 0x8f, 0x8a, 0x87, 0xb1, 0xa0, 0xdb, 0x5c, 0xd0, 0x30,
+	0x61, 0xe6, 0xcf, 0x56, 0x47, 0xf0, 0x9c, 0xbb, 0x73, 0x1a, 0xdb, 0xd2, 0xc4, 0xf5, 0xe5, 0x16,
+	0x6f, 0x71, 0x40, 0xb6, 0xd5, 0x7f, 0x9a, 0x69, 0x7d, 0x23, 0xe4, 0x32, 0xe5, 0x72, 0xbb, 0x49,
+	0x25, 0xdb, 0x3e, 0x7a, 0xb3, 0xc9, 0x72, 0xfa, 0xe6, 0x76, 0xc8, 0xe3, 0xac, 0xc4, 0x5b, 0x9c,
+	0xb7, 0x12, 0xb6, 0x0d, 0xab, 0x66, 0x71, 0xb8, 0x1d, 0x15, 0x82, 0xe6, 0x31, 0x37, 0x38, 0xfe,
+	0xb7, 0x87, 0xa6, 0x0f, 0xa8, 0xa0, 0xa9, 0x74, 0xdf, 0x41, 0xb5, 0x23, 0x9e, 0xb3, 0xa0, 0xc3,
+	0x44, 0xcc, 0x23, 0xcf, 0xd9, 0x74, 0x6e, 0x4e, 0x35, 0xae, 0xf6, 0xba, 0xbe, 0x7b, 0x4a, 0xd3,
+	0xe4, 0x16, 0xb6, 0x40, 0x4c, 0x90, 0x5a, 0x1d, 0xc0, 0xc2, 0xcd, 0xd0, 0x3c, 0x60, 0x79, 0x5b,
+	0x30, 0xd9, 0xe6, 0x49, 0xe4, 0x4d, 0x6e, 0x3a, 0x37, 0xaf, 0x34, 0xde, 0xfb, 0xb2, 0xeb, 0x4f,
+	0xfc, 0xb5, 0xeb, 0xdf, 0x68, 0xc5, 0x79, 0xbb, 0x68, 0x6e, 0x85, 0x3c, 0xdd, 0x36, 0xe6, 0xea,
+	0x3f, 0x6f, 0xc8, 0xe8, 0xd1, 0x76, 0x7e, 0xda, 0x61, 0x72, 0x6b, 0x97, 0x85, 0xbd, 0xae, 0xbf,
+	0x62, 0x69, 0xea, 0x4b, 0xc3, 0x64, 0x4e, 0x11, 0xee, 0x95, 0x6b, 0x97, 0xa1, 0x9a, 0x60, 0xc7,
+	0x54, 0x44, 0x41, 0x93, 0x66, 0x91, 0x77, 0x09, 0x94, 0xed, 0x9e, 0x5b, 0x99, 0x39, 0x96, 0x25,
+	0x0a, 0x13, 0xa4, 0x57, 0x0d, 0x9a, 0x45, 0x6e, 0x88, 0xd6, 0x0d, 0x16, 0xc5, 0x32, 0x17, 0x71,
+	0xb3, 0x50, 0x7e, 0x0b, 0x8e, 0xe3, 0x2c, 0xe2, 0xc7, 0xde, 0x14, 0xb8, 0xe7, 0xe5, 0x5e, 0xd7,
+	0x7f, 0x61, 0x48, 0xce, 0x18, 0x5e, 0x4c, 0x3c, 0x0d, 0xee, 0x5a, 0xd8, 0x03, 0x80, 0xdc, 0x1f,
+	0xa1, 0x2b, 0xc7, 0xed, 0x38, 0x67, 0x49, 0x2c, 0x73, 0xef, 0xf2, 0xe6, 0xa5, 0x9b, 0xb5, 0xb7,
+	0x96, 0xb7, 0x86, 0x3e, 0xfc, 0xd6, 0x2e, 0xcb, 0x78, 0xda, 0x78, 0x59, 0x9d, 0xaf, 0xd7, 0xf5,
+	0x17, 0xb5, 0xb6, 0xfe, 0x26, 0xfc, 0xfb, 0xaf, 0xfd, 0x2b, 0xc0, 0xf2, 0x51, 0x2c, 0x73, 0x32,
+	0x90, 0xa6, 0x3e, 0x8b, 0x4c, 0xa8, 0x6c, 0x07, 0x87, 0x82, 0x86, 0x4a, 0xa5, 0x37, 0x7d, 0xb1,
+	0xcf, 0x32, 0x2c, 0x0d, 0x93, 0x39, 0x20, 0xec, 0x99, 0xb5, 0x7b, 0x0b, 0xcd, 0x6a, 0x0e, 0xe3,
+	0xa1, 0xe7, 0xc0, 0x43, 0xab, 0xbd, 0xae, 0xbf, 0x64, 0xef, 0x2f, 0x7d, 0x52, 0x83, 0xa5, 0x71,
+	0xc3, 0x4f, 0xd1, 0x72, 0x1a, 0x67, 0xc1, 0x11, 0x4d, 0xe2, 0x48, 0xc5, 0x58, 0x29, 0x63, 0x06,
+	0x2c, 0xde, 0x3f, 0xb7, 0xc5, 0xd7, 0xb4, 0xc6, 0x71, 0x32, 0x31, 0xa9, 0xa7, 0x71, 0x76, 0x5f,
+	0x51, 0x0f, 0x98, 0x30, 0xfa, 0x1f, 0xa1, 0xe7, 0xd9, 0x49, 0x98, 0x14, 0x11, 0x0b, 0x1e, 0xd2,
+	0x38, 0x61, 0x51, 0x70, 0x28, 0x78, 0x6a, 0x45, 0xf4, 0x95, 0x4d, 0xe7, 0xe6, 0x4c, 0xe3, 0x66,
+	0xaf, 0xeb, 0xbf, 0xa4, 0x45, 0x3f, 0x91, 0x1d, 0x93, 0x75, 0x83, 0x7f, 0x08, 0xf0, 0x9e, 0xe0,
+	0xe9, 0x20, 0x7e, 0x3f, 0x42, 0x2e, 0x6d, 0xb5, 0x04, 0x6b, 0xc1, 0x45, 0x0c, 0x52, 0x96, 0xb7,
+	0x79, 0xe4, 0x21, 0x38, 0xea, 0xf3, 0xbd, 0xae, 0xbf, 0xa6, 0x35, 0x8c, 0xf2, 0x60, 0x52, 0xb7,
+	0x88, 0xfb, 0x40, 0x73, 0xef, 0xa1, 0x95, 0x94, 0x47, 0x2c, 0x68, 0x16, 0xe1, 0x23, 0x96, 0x07,
+	0x1d, 0xc1, 0xc2, 0x58, 0xaa, 0xaf, 0x5d, 0x03, 0xff, 0x6f, 0xf6, 0xba, 0xfe, 0x75, 0xe3, 0x8d,
+	0x71, 0x6c, 0x98, 0x2c, 0x29, 0x7a, 0x03, 0xc8, 0x07, 0x25, 0xd5, 0xed, 0x20, 0x9f, 0x16, 0x39,
+	0x0f, 0x22, 0x88, 0xa5, 0x80, 0x1e, 0xe6, 0x4c, 0x04, 0x32, 0xa7, 0x09, 0x33, 0x6e, 0x94, 0xde,
+	0x2c, 0xc8, 0x7f, 0xad, 0xd7, 0xf5, 0x6f, 0x18, 0x83, 0x9f, 0xbc, 0x01, 0x93, 0x6b, 0x8a, 0x63,
+	0x17, 0x18, 0x6e, 0x2b, 0xfc, 0xae, 0x82, 0xf5, 0x17, 0x90, 0xee, 0xf7, 0xd1, 0x52, 0xa4, 0xc2,
+	0x38, 0x68, 0x09, 0x1a, 0x96, 0x89, 0x46, 0x7a, 0x73, 0xa0, 0x65, 0xa3, 0xd7, 0xf5, 0xd7, 0xb5,
+	0x96, 0x31, 0x4c, 0x98, 0xd4, 0x81, 0xfa, 0x9e, 0x22, 0xea, 0xa4, 0x24, 0xdd, 0x00, 0xad, 0xa5,
+	0xf4, 0x24, 0x08, 0xa9, 0x10, 0xa7, 0xc1, 0x21, 0x17, 0x70, 0x3b, 0x4b, 0xa9, 0xf3, 0x20, 0xf5,
+	0xa5, 0x5e, 0xd7, 0xdf, 0x34, 0xbe, 0x39, 0x8b, 0x15, 0x93, 0xab, 0x29, 0x3d, 0xd9, 0x51, 0xd0,
+	0x9e, 0x46, 0x4a, 0x05, 0x04, 0x2d, 0x77, 0x04, 0x6f, 0x09, 0x26, 0x65, 0x7c, 0xc4, 0x02, 0x08,
+	0xe7, 0x38, 0x6b, 0x79, 0x0b, 0x10, 0x2a, 0xfe, 0x20, 0x0a, 0xc7, 0x71, 0x61, 0xb2, 0x64, 0x91,
+	0xef, 0x1a, 0xaa, 0xfb, 0x99, 0x83, 0x56, 0x47, 0xd8, 0x83, 0xc3, 0x84, 0x73, 0xe1, 0x2d, 0x42,
+	0x80, 0x1c, 0x9c, 0xfb, 0x2e, 0x6c, 0x9c, 0x61, 0x85, 0x16, 0x8b, 0xc9, 0x4a, 0xd5, 0x90, 0x3d,
+	0x45, 0x77, 0x7f, 0x80, 0x96, 0x43, 0x9e, 0xa6, 0x71, 0x9e, 0xb2, 0x2c, 0x0f, 0xda, 0x6a, 0x03,
+	0x4d, 0x5a, 0xdc, 0xab, 0x83, 0x19, 0xd6, 0xf1, 0xc6, 0x71, 0x61, 0xe2, 0x0e, 0xc8, 0xef, 0x53,
+	0xd9, 0xbe, 0x9d, 0xb4, 0xb8, 0xfb, 0x09, 0x5a, 0xed, 0xf0, 0x63, 0x15, 0x17, 0x29, 0xe7, 0xb9,
+	0x3a, 0x70, 0x3f, 0x98, 0x5c, 0xf8, 0x20, 0xd8, 0x32, 0x77, 0x3c, 0xa3, 0x32, 0x57, 0x21, 0x77,
+	0x4b, 0xa0, 0x0c, 0x9f, 0x1c, 0x2d, 0x5b, 0x05, 0x2a, 0x28, 0xcb, 0x9c, 0xb7, 0xb4, 0xe9, 0xdc,
+	0xac, 0xbd, 0xb5, 0xb6, 0xa5, 0xeb, 0xe0, 0x56, 0x59, 0x07, 0xb7, 0x76, 0x0d, 0x43, 0xe3, 0x15,
+	0x93, 0x58, 0xaf, 0x8d, 0x54, 0xb9, 0xbe, 0x10, 0xfc, 0xf9, 0xd7, 0xbe, 0x43, 0xdc, 0x41, 0xc9,
+	0x2b, 0x37, 0xbb, 0x1d, 0xb4, 0xa0, 0x22, 0xc7, 0x18, 0xdb, 0xa6, 0x82, 0x79, 0xcb, 0xe0, 0x9f,
+	0xf7, 0xcf, 0xfd, 0x99, 0xae, 0x0e, 0x02, 0xd1, 0x12, 0x87, 0xc9, 0x5c, 0x4a, 0x4f, 0x0e, 0xe0,
+	0xc8, 0x6a, 0xed, 0x9e, 0x22, 0x57, 0xb0, 0x23, 0x46, 0x93, 0x20, 0x8d, 0xa5, 0x0c, 0x8e, 0x59,
+	0xdc, 0x6a, 0xe7, 0xde, 0x0a, 0x28, 0xbd, 0x73, 0x6e, 0xa5, 0x6b, 0x65, 0xed, 0xaa, 0x4a, 0xc4,
+	0x64, 0x51, 0x13, 0xf7, 0x63, 0x29, 0x1f, 0x00, 0xc9, 0xfd, 0x31, 0x5a, 0xa3, 0x61, 0x58, 0x08,
+	0x1a, 0x9e, 0x1a, 0x2e, 0x16, 0x05, 0xba, 0xb2, 0x49, 0xef, 0x2a, 0x44, 0xbd, 0x75, 0xa3, 0xce,
+	0x64, 0xc5, 0x64, 0xb5, 0xc4, 0x1e, 0x18, 0x88, 0x68, 0xc4, 0xa5, 0x68, 0x5d, 0x9d, 0x9f, 0x1d,
+	0xa9, 0x60, 0x82, 0x2b, 0x2d, 0x21, 0x73, 0x37, 0x13, 0x1e, 0x3e, 0xf2, 0x56, 0xab, 0x25, 0xf7,
+	0x6c, 0x5e, 0x7d, 0x6b, 0xdf, 0x55, 0x18, 0xd4, 0x46, 0x79, 0xc0, 0x44, 0x43, 0x01, 0x2a, 0xd3,
+	0x1f, 0x32, 0x16, 0x31, 0x11, 0x84, 0x6d, 0x9a, 0xb5, 0x58, 0x10, 0x72, 0x9e, 0x44, 0xfc, 0x38,
+	0xd3, 0x1b, 0xa5, 0xe7, 0x81, 0x16, 0x2b, 0xd3, 0x3f, 0x91, 0x1d, 0x93, 0x75, 0x8d, 0xef, 0x00,
+	0xbc, 0x63, 0x50, 0xd0, 0x05, 0x39, 0xcd, 0xb8, 0x56, 0xe7, 0x2b, 0xa3, 0x62, 0xad, 0x9a, 0xd3,
+	0xc6, 0x30, 0x61, 0x52, 0xd7, 0x54, 0x48, 0x6a, 0x46, 0xde, 0x1d, 0xe4, 0x26, 0xac, 0xa5, 0x9c,
+	0x2a, 0x68, 0xce, 0xf4, 0xd9, 0xa5, 0xb7, 0x0e, 0xae, 0xb7, 0x2a, 0xc7, 0x28, 0x0f, 0x26, 0x8b,
+	0x9a, 0x48, 0x68, 0xce, 0xc0, 0x2d, 0x52, 0xf5, 0x37, 0xfd, 0x66, 0xa1, 0x3c, 0x9d, 0x60, 0x39,
+	0xcb, 0xe0, 0xde, 0x5c, 0xab, 0x3a, 0xfb, 0x6c, 0x5e, 0x4c, 0xbc, 0x3e, 0xa8, 0xdd, 0x40, 0x4a,
+	0xc8, 0xdd, 0x47, 0x4b, 0xea, 0x2b, 0x59, 0xdf, 0x47, 0xdd, 0x22, 0xef, 0x7a, 0xd5, 0x03, 0x63,
+	0x98, 0x30, 0x59, 0x4c, 0xe9, 0x49, 0xff, 0xf3, 0xdd, 0xe7, 0x39, 0x73, 0x25, 0x5a, 0xd4, 0x5d,
+	0x51, 0x70, 0xc8, 0x98, 0xb9, 0x70, 0xcf, 0x43, 0xec, 0x7f, 0x70, 0xee, 0xd8, 0x5f, 0xd5, 0x9a,
+	0xab, 0xf2, 0x30, 0x99, 0xd7, 0xa4, 0x3d, 0xc6, 0xf4, 0x95, 0xbb, 0x8b, 0x56, 0x94, 0x79, 0x90,
+	0x19, 0x0e, 0x8b, 0xbc, 0x10, 0x2c, 0x88, 0x44, 0x7c, 0x98, 0x7b, 0x1b, 0x23, 0x15, 0x76, 0x1c,
+	0x1b, 0x26, 0x6e, 0x4a, 0x4f, 0x94, 0xf9, 0x7b, 0x40, 0xdd, 0x55, 0x44, 0xb7, 0x89, 0xd6, 0x3b,
+	0x5c, 0xe6, 0x41, 0xd1, 0x69, 0x09, 0x1a, 0xb1, 0x4a, 0xd5, 0xf3, 0xab, 0xde, 0x3f, 0x9b, 0x17,
+	0x93, 0x55, 0x05, 0xfe, 0x50, 0x63, 0x43, 0x25, 0xb0, 0xa1, 0xb3, 0x53, 0xca, 0x8f, 0xca, 0x22,
+	0xec, 0x6d, 0x82, 0xe0, 0xf5, 0xe1, 0x7c, 0x63, 0x31, 0xe8, 0x7c, 0xb3, 0xcf, 0x8f, 0x4c, 0x5d,
+	0x76, 0xdf, 0x45, 0x8b, 0x2a, 0x84, 0x82, 0x94, 0x45, 0x31, 0xcd, 0x02, 0x51, 0x24, 0xcc, 0x7b,
+	0x01, 0x3c, 0x7e, 0x6d, 0xe0, 0xc3, 0x2a, 0x07, 0x26, 0xf3, 0x8a, 0xb4, 0x0f, 0x14, 0x52, 0x24,
+	0xec, 0xd6, 0xcc, 0xe7, 0x5f, 0xf8, 0x13, 0xff, 0xfc, 0xc2, 0x77, 0xf0, 0x6f, 0x26, 0xd1, 0x65,
+	0xf8, 0xa8, 0xee, 0x8b, 0x68, 0x2a, 0xa3, 0x29, 0x83, 0x49, 0xe3, 0x4a, 0x63, 0xa1, 0xd7, 0xf5,
+	0x6b, 0x5a, 0x9c, 0xa2, 0x62, 0x02, 0xa0, 0x4b, 0xd1, 0x55, 0x3b, 0x25, 0xa7, 0x45, 0x92, 0xc7,
+	0x9d, 0x24, 0x66, 0x02, 0x86, 0x8c, 0xa9, 0xc6, 0xff, 0xf5, 0xba, 0xfe, 0x2b, 0xa3, 0xa9, 0x7b,
+	0xc0, 0xf7, 0x3a, 0x4f, 0xe3, 0x9c, 0xa5, 0x9d, 0xfc, 0x14, 0x93, 0xe5, 0x41, 0x0a, 0xdf, 0xef,
+	0x33, 0xb8, 0xb7, 0x51, 0xed, 0xd3, 0x42, 0xed, 0x85, 0x00, 0x34, 0xf3, 0x84, 0xf5, 0x55, 0x2d,
+	0xd0, 0x16, 0x86, 0x80, 0xae, 0x8f, 0xf2, 0x36, 0x9a, 0xce, 0x05, 0x55, 0x77, 0x7b, 0xaa, 0xea,
+	0x1b, 0x4d, 0xb7, 0x37, 0x1a, 0xd6, 0x5b, 0xb3, 0x9f, 0x7d, 0xe1, 0x4f, 0x18, 0xbf, 0x4c, 0xe0,
+	0x3f, 0x38, 0xe8, 0xfa, 0x6d, 0xd3, 0xdd, 0xb1, 0x77, 0x4f, 0xf4, 0x25, 0x53, 0xd7, 0xf5, 0x40,
+	0x30, 0x65, 0xb6, 0x72, 0x97, 0xaa, 0xaf, 0xa3, 0xee, 0x52, 0x54, 0x4c, 0x00, 0x74, 0x6f, 0xa0,
+	0xcb, 0x8a, 0x59, 0x98, 0x11, 0x6c, 0xb1, 0xd7, 0xf5, 0x67, 0x07, 0xde, 0x11, 0x98, 0x68, 0x18,
+	0x9a, 0xf5, 0xa2, 0x99, 0xc6, 0xb9, 0xc9, 0xad, 0x97, 0x46, 0x9a, 0x75, 0x0b, 0x55, 0xcd, 0x3a,
+	0x2c, 0x21, 0x0d, 0x55, 0xec, 0xfe, 0x87, 0x83, 0xd6, 0xc6, 0xda, 0x0d, 0x17, 0xf6, 0x97, 0x0e,
+	0x5a, 0x66, 0x27, 0x65, 0xc6, 0x50, 0x09, 0x29, 0x2f, 0x3a, 0x09, 0x93, 0x9e, 0x03, 0xb3, 0xce,
+	0x66, 0x65, 0xd6, 0xb1, 0xf7, 0xdf, 0x53, 0x8c, 0x8d, 0x6f, 0x0f, 0x97, 0xe7, 0x71, 0xb2, 0xd4,
+	0x08, 0xe4, 0x8e, 0xec, 0x94, 0xc4, 0x65, 0x23, 0xb4, 0xa7, 0xf5, 0x4f, 0xe5, 0x8c, 0x7f, 0x74,
+	0x50, 0x7d, 0x44, 0x81, 0x92, 0xa5, 0x23, 0xc6, 0xa9, 0xca, 0x02, 0x32, 0x26, 0x1a, 0x76, 0x1f,
+	0xa1, 0xb9, 0x21, 0xb3, 0x8d, 0xee, 0xbd, 0x73, 0x67, 0xac, 0xe5, 0x31, 0x3e, 0xc0, 0x64, 0xd6,
+	0x3e, 0x66, 0xc5, 0xf0, 0xbf, 0x4d, 0xa2, 0xda, 0x3d, 0x9a, 0x24, 0xa7, 0x0d, 0x5e, 0x64, 0x91,
+	0x54, 0xa3, 0x73, 0x02, 0xcd, 0x45, 0x53, 0xad, 0x3d, 0xe7, 0x62, 0xa3, 0xb3, 0x25, 0x0a, 0x13,
+	0x04, 0x2b, 0xd0, 0xa3, 0xd4, 0x14, 0x9d, 0x4e, 0x5f, 0xcd, 0xe4, 0xc5, 0xd4, 0x58, 0xa2, 0x30,
+	0x41, 0xb0, 0xd2, 0x6a, 0xde, 0x41, 0x35, 0xe5, 0x82, 0x48, 0x37, 0x4c, 0x10, 0xc3, 0x97, 0xec,
+	0x17, 0x0b, 0x0b, 0x54, 0xa3, 0xbd, 0x5a, 0x41, 0x27, 0xe5, 0x7e, 0x07, 0xcd, 0xc5, 0x19, 0x8c,
+	0xfc, 0x66, 0xeb, 0x14, 0x6c, 0xf5, 0x06, 0x3e, 0x1e, 0x82, 0x31, 0xa9, 0xc5, 0x99, 0x7a, 0x13,
+	0x80, 0xdd, 0xb7, 0x66, 0x3e, 0x2b, 0xdd, 0xfb, 0x3b, 0x07, 0xd5, 0x21, 0x01, 0x80, 0x8f, 0x77,
+	0x78, 0x91, 0xa9, 0xbb, 0xb5, 0x83, 0x16, 0x64, 0x11, 0x86, 0x4c, 0xca, 0x7e, 0x3e, 0x77, 0xaa,
+	0x69, 0xb7, 0xc2, 0x80, 0xc9, 0xbc, 0xa1, 0x94, 0xb9, 0xfb, 0x7b, 0x68, 0xfe, 0x50, 0x8f, 0x96,
+	0xa5, 0x0c, 0x9d, 0xef, 0xd6, 0x06, 0xf3, 0xf8, 0x30, 0x8e, 0xc9, 0x9c, 0x26, 0x18, 0x09, 0xf8,
+	0x5f, 0x93, 0xb6, 0x71, 0x1f, 0x17, 0x79, 0xc8, 0x53, 0xe6, 0xbe, 0x8a, 0xa6, 0x05, 0xa3, 0x92,
+	0x67, 0xe6, 0xe3, 0xd7, 0x7b, 0x5d, 0x7f, 0xae, 0xec, 0x42, 0x14, 0x1d, 0x13, 0xc3, 0x50, 0x7d,
+	0x10, 0x9a, 0x7c, 0xea, 0x07, 0xa1, 0x63, 0x54, 0xa7, 0x61, 0x3b, 0x66, 0x47, 0x30, 0x18, 0x9b,
+	0xc7, 0x07, 0x9d, 0x56, 0x3f, 0x3c, 0x77, 0x10, 0x78, 0x65, 0x3b, 0x59, 0x11, 0x88, 0xc9, 0x62,
+	0x49, 0xeb, 0x3f, 0x41, 0x1c, 0xa3, 0xba, 0x60, 0x9f, 0x16, 0xb1, 0xb0, 0x15, 0x4f, 0x5d, 0x4c,
+	0xf1, 0x88, 0x40, 0x68, 0x8d, 0x35, 0xad, 0x54, 0x8c, 0x1f, 0x4f, 0x22, 0x0f, 0x9e, 0x14, 0x68,
+	0xce, 0xc5, 0x6d, 0xd3, 0xdd, 0x96, 0xf1, 0xf0, 0x2d, 0xa4, 0xd3, 0xa7, 0x54, 0x93, 0xb5, 0x1c,
+	0x7d, 0x58, 0xb3, 0xc0, 0x32, 0xd3, 0xea, 0x95, 0xea, 0x1f, 0xcb, 0x40, 0xb4, 0x25, 0x4c, 0x56,
+	0xbb, 0xa7, 0x31, 0x4c, 0x98, 0xd4, 0x75, 0xcc, 0xde, 0xb5, 0xe4, 0xc1, 0xc8, 0xca, 0x8e, 0x62,
+	0x5e, 0xc8, 0x21, 0x81, 0x3a, 0xfb, 0x0f, 0x8d, 0xac, 0xa3, 0x5c, 0x30, 0xb2, 0x6a, 0xb2, 0x2d,
+	0xb3, 0x8d, 0xae, 0xf7, 0xb9, 0xc7, 0x19, 0xab, 0x1f, 0xca, 0x5e, 0xe9, 0x75, 0xfd, 0x17, 0x2b,
+	0xb2, 0xc7, 0x5a, 0xbd, 0x56, 0xc2, 0x1f, 0x54, 0xad, 0xc7, 0x7f, 0x76, 0xd0, 0xc2, 0xfd, 0x7e,
+	0x94, 0xed, 0x40, 0x3b, 0x7f, 0x15, 0x4d, 0xdb, 0xef, 0x95, 0xc4, 0xac, 0xdc, 0x17, 0xd0, 0xac,
+	0xcc, 0xa9, 0xc8, 0x83, 0xb6, 0x1e, 0x90, 0x94, 0xcb, 0x2e, 0x91, 0x1a, 0xd0, 0xde, 0x07, 0x92,
+	0xfb, 0x16, 0x5a, 0x19, 0x1c, 0xd3, 0xe6, 0x85, 0x3c, 0x62, 0x1d, 0xd6, 0xda, 0xb3, 0x8e, 0x66,
+	0x20, 0x0f, 0x51, 0x71, 0xaa, 0x73, 0x06, 0xe9, 0xaf, 0xdd, 0xff, 0x47, 0xcb, 0xf6, 0x0b, 0x57,
+	0xff, 0xde, 0x5e, 0x06, 0xc3, 0x5c, 0xeb, 0xb9, 0xab, 0xbc, 0xa1, 0x3f, 0x9f, 0x44, 0xab, 0x83,
+	0x03, 0x1d, 0x50, 0x91, 0xc7, 0x61, 0xdc, 0xa1, 0xe5, 0x6b, 0x5a, 0x93, 0x67, 0x51, 0x3f, 0xb9,
+	0x39, 0x90, 0xa1, 0xac, 0x02, 0x6d, 0xa3, 0x98, 0xd4, 0xf4, 0x52, 0xa7, 0xb7, 0x0f, 0x50, 0xdd,
+	0xa0, 0x47, 0x65, 0x4c, 0x96, 0x41, 0x73, 0x7d, 0x10, 0xd8, 0x23, 0x2c, 0x98, 0x2c, 0x6a, 0x5a,
+	0x3f, 0x92, 0xfb, 0x8f, 0xc2, 0x67, 0xa6, 0x58, 0x0b, 0x34, 0x39, 0xc0, 0xd8, 0xf0, 0x2a, 0x9a,
+	0x56, 0x2b, 0x51, 0x06, 0x80, 0x95, 0x67, 0x34, 0x1d, 0x13, 0xc3, 0x80, 0x7f, 0x86, 0xea, 0x1f,
+	0x43, 0xf9, 0xbf, 0x9d, 0x30, 0x91, 0xef, 0xf0, 0xec, 0x30, 0x6e, 0xb9, 0x0f, 0xd1, 0x1c, 0xb4,
+	0xa6, 0x6a, 0x24, 0x85, 0xa2, 0xe9, 0x5c, 0xac, 0x68, 0x0e, 0x09, 0xc3, 0xa4, 0xa6, 0xba, 0xdc,
+	0x58, 0x4a, 0x55, 0x33, 0x55, 0x1a, 0x5f, 0x78, 0x30, 0x3c, 0xc1, 0x3c, 0x75, 0x71, 0x7f, 0xe6,
+	0x24, 0x79, 0x03, 0x5d, 0xa6, 0x51, 0xc4, 0xf4, 0xfb, 0xf5, 0x8c, 0xad, 0x00, 0xc8, 0x98, 0x68,
+	0x18, 0xff, 0xd6, 0x41, 0xf3, 0x84, 0x3d, 0x64, 0x61, 0xce, 0x22, 0xd3, 0xc4, 0x3c, 0xf3, 0x4b,
+	0xfd, 0x1d, 0x34, 0x6d, 0xda, 0xaf, 0x49, 0x68, 0xbf, 0xae, 0x57, 0xda, 0xaf, 0x21, 0x3d, 0x8d,
+	0x15, 0xd3, 0x7a, 0x99, 0xcf, 0xa6, 0x77, 0xaa, 0xf6, 0x55, 0xff, 0xd3, 0x44, 0x73, 0x43, 0xfc,
+	0x4f, 0xed, 0xb2, 0x41, 0x09, 0x9a, 0xfc, 0x1f, 0x25, 0x08, 0xff, 0xda, 0x41, 0x08, 0xca, 0xd7,
+	0xdd, 0x9c, 0xe6, 0x17, 0x38, 0xf8, 0x3e, 0x9a, 0x06, 0xdd, 0xe5, 0xc1, 0x37, 0xc6, 0xbd, 0xb1,
+	0x0f, 0x14, 0x55, 0x8f, 0xae, 0xf7, 0x62, 0x62, 0x84, 0xe0, 0x5f, 0x4c, 0xa2, 0x85, 0xca, 0x96,
+	0xa7, 0x3e, 0xbd, 0xe9, 0x40, 0xcb, 0x0b, 0x59, 0xe9, 0x40, 0xa5, 0xe9, 0x40, 0xa5, 0xfb, 0x5d,
+	0x34, 0x47, 0x9b, 0x32, 0xa7, 0xea, 0x09, 0x1b, 0xf8, 0x75, 0x92, 0xb6, 0x7a, 0x94, 0x21, 0x18,
+	0x93, 0x59, 0xb3, 0xbe, 0x0f, 0xdb, 0x5f, 0x47, 0xcf, 0xe5, 0x34, 0x49, 0x62, 0x16, 0xc1, 0x05,
+	0x9c, 0x69, 0xb8, 0xbd, 0xae, 0x3f, 0x6f, 0xbe, 0xa4, 0x06, 0x30, 0x29, 0x59, 0x54, 0xb6, 0x09,
+	0x69, 0xa7, 0xa3, 0xd2, 0x01, 0xe8, 0xba, 0x5c, 0x1d, 0x07, 0x6c, 0x14, 0x93, 0x9a, 0x5e, 0x82,
+	0x26, 0xfc, 0x27, 0x07, 0xb9, 0x43, 0xb9, 0xeb, 0x80, 0xc7, 0x59, 0xfe, 0xec, 0xdf, 0xea, 0x27,
+	0x68, 0xa9, 0x63, 0x8b, 0x0b, 0xe0, 0xad, 0xcd, 0xc4, 0xca, 0x47, 0xe7, 0xbe, 0xff, 0xa6, 0x44,
+	0x8e, 0x11, 0x89, 0x89, 0x3b, 0x44, 0x25, 0x40, 0xfc, 0x8f, 0x83, 0x66, 0xe1, 0xdb, 0xee, 0xeb,
+	0x39, 0x58, 0xf9, 0xc6, 0x64, 0x74, 0xa8, 0x12, 0x9e, 0x53, 0xf5, 0x8d, 0x8d, 0x62, 0x52, 0xd3,
+	0x4b, 0xa8, 0x1a, 0xea, 0xbd, 0xa2, 0x3f, 0x60, 0x77, 0x98, 0x08, 0x59, 0x96, 0x9b, 0x83, 0x3c,
+	0xf3, 0x7b, 0x45, 0x55, 0x1e, 0x26, 0xf3, 0x66, 0x62, 0x3f, 0xd0, 0x84, 0xaa, 0xe7, 0x2f, 0x3d,
+	0xad, 0xe7, 0x1b, 0xbb, 0x5f, 0x3e, 0xde, 0x70, 0xbe, 0x7a, 0xbc, 0xe1, 0xfc, 0xfd, 0xf1, 0x86,
+	0xf3, 0xab, 0x6f, 0x36, 0x26, 0xbe, 0xfa, 0x66, 0x63, 0xe2, 0x2f, 0xdf, 0x6c, 0x4c, 0x7c, 0xf2,
+	0x9a, 0x65, 0xe5, 0x3d, 0x46, 0xd3, 0x37, 0xee, 0xe8, 0xdf, 0x2d, 0x43, 0x2e, 0xd8, 0xf6, 0x49,
+	0xf9, 0xf3, 0x25, 0x58, 0xdb, 0x9c, 0x86, 0x37, 0xd6, 0xb7, 0xff, 0x3b, 0x00, 0xd4, 0xfb, 0xcc,
+	0x30, 0xdc, 0x1c, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.VotePeriod != that1.VotePeriod {
+		return false
+	}
+	if !this.VoteThreshold.Equal(that1.VoteThreshold) {
+		return false
+	}
+	if !this.RewardBand.Equal(that1.RewardBand) {
+		return false
+	}
+	if this.RewardDistributionWindow != that1.RewardDistributionWindow {
+		return false
+	}
+	if len(this.Whitelist) != len(that1.Whitelist) {
+		return false
+	}
+	for i := range this.Whitelist {
+		if !this.Whitelist[i].Equal(&that1.Whitelist[i]) {
+			return false
+		}
+	}
+	if !this.SlashFraction.Equal(that1.SlashFraction) {
+		return false
+	}
+	if this.SlashWindow != that1.SlashWindow {
+		return false
+	}
+	if !this.MinValidPerWindow.Equal(that1.MinValidPerWindow) {
+		return false
+	}
+	if this.ExcludeJailedFromThreshold != that1.ExcludeJailedFromThreshold {
+		return false
+	}
+	if this.AggregationMethod != that1.AggregationMethod {
+		return false
+	}
+	if this.ModeBucketPrecision != that1.ModeBucketPrecision {
+		return false
+	}
+	if this.AutoDelistAfterStaleWindows != that1.AutoDelistAfterStaleWindows {
+		return false
+	}
+	if this.DenomGracePeriods != that1.DenomGracePeriods {
+		return false
+	}
+	if this.MaxCarryForwardPeriods != that1.MaxCarryForwardPeriods {
+		return false
+	}
+	if this.ProgressiveSlashing != that1.ProgressiveSlashing {
+		return false
+	}
+	if !this.ProgressiveSlashFloor.Equal(that1.ProgressiveSlashFloor) {
+		return false
+	}
+	if this.CommitmentHashAlgo != that1.CommitmentHashAlgo {
+		return false
+	}
+	if this.PowerSmoothingWindows != that1.PowerSmoothingWindows {
+		return false
+	}
+	if this.VotePeriodDuration != that1.VotePeriodDuration {
+		return false
+	}
+	if !this.MaxPowerShare.Equal(that1.MaxPowerShare) {
+		return false
+	}
+	if !this.RevealMissWeight.Equal(that1.RevealMissWeight) {
+		return false
+	}
+	if this.AccuracyWeightedRewards != that1.AccuracyWeightedRewards {
+		return false
+	}
+	if this.MaxEventDenomsPerBlock != that1.MaxEventDenomsPerBlock {
+		return false
+	}
+	if this.FeederChangeCooldownBlocks != that1.FeederChangeCooldownBlocks {
+		return false
+	}
+	if this.RevealGraceBlocks != that1.RevealGraceBlocks {
+		return false
+	}
+	if this.LegacyRateEvents != that1.LegacyRateEvents {
+		return false
+	}
+	if this.WhitelistChangeRetention != that1.WhitelistChangeRetention {
+		return false
+	}
+	if this.MaxDenomsPerVote != that1.MaxDenomsPerVote {
+		return false
+	}
+	if !this.OracleFeeShare.Equal(that1.OracleFeeShare) {
+		return false
+	}
+	if this.MaxVoteFutureDrift != that1.MaxVoteFutureDrift {
+		return false
+	}
+	if this.PostUpgradeGracePeriods != that1.PostUpgradeGracePeriods {
+		return false
+	}
+	if this.MaxMoveWindow != that1.MaxMoveWindow {
+		return false
+	}
+	if this.EvenMedianRule != that1.EvenMedianRule {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvenMedianRule) > 0 {
+		i -= len(m.EvenMedianRule)
+		copy(dAtA[i:], m.EvenMedianRule)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.EvenMedianRule)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.MaxMoveWindow != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxMoveWindow))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.PostUpgradeGracePeriods != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PostUpgradeGracePeriods))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.MaxVoteFutureDrift != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxVoteFutureDrift))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	{
+		size := m.OracleFeeShare.Size()
+		i -= size
+		if _, err := m.OracleFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
+	if m.MaxDenomsPerVote != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxDenomsPerVote))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if m.WhitelistChangeRetention != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.WhitelistChangeRetention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.LegacyRateEvents {
+		i--
+		if m.LegacyRateEvents {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if m.RevealGraceBlocks != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RevealGraceBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.FeederChangeCooldownBlocks != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.FeederChangeCooldownBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.MaxEventDenomsPerBlock != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxEventDenomsPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.AccuracyWeightedRewards {
+		i--
+		if m.AccuracyWeightedRewards {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	{
+		size := m.RevealMissWeight.Size()
+		i -= size
+		if _, err := m.RevealMissWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	{
+		size := m.MaxPowerShare.Size()
+		i -= size
+		if _, err := m.MaxPowerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotePeriodDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotePeriodDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintOracle(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if m.PowerSmoothingWindows != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PowerSmoothingWindows))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.CommitmentHashAlgo) > 0 {
+		i -= len(m.CommitmentHashAlgo)
+		copy(dAtA[i:], m.CommitmentHashAlgo)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.CommitmentHashAlgo)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	{
+		size := m.ProgressiveSlashFloor.Size()
+		i -= size
+		if _, err := m.ProgressiveSlashFloor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.ProgressiveSlashing {
+		i--
+		if m.ProgressiveSlashing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MaxCarryForwardPeriods != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MaxCarryForwardPeriods))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.DenomGracePeriods != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.DenomGracePeriods))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.AutoDelistAfterStaleWindows != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.AutoDelistAfterStaleWindows))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ModeBucketPrecision != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ModeBucketPrecision))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.AggregationMethod) > 0 {
+		i -= len(m.AggregationMethod)
+		copy(dAtA[i:], m.AggregationMethod)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.AggregationMethod)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ExcludeJailedFromThreshold {
+		i--
+		if m.ExcludeJailedFromThreshold {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.MinValidPerWindow.Size()
+		i -= size
+		if _, err := m.MinValidPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.SlashWindow != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SlashWindow))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Whitelist) > 0 {
+		for iNdEx := len(m.Whitelist) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Whitelist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.RewardDistributionWindow != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RewardDistributionWindow))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.RewardBand.Size()
+		i -= size
+		if _, err := m.RewardBand.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.VoteThreshold.Size()
+		i -= size
+		if _, err := m.VoteThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.VotePeriod != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VotePeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Denom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Denom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tracks) > 0 {
+		i -= len(m.Tracks)
+		copy(dAtA[i:], m.Tracks)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Tracks)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.VotePeriodMultiplier != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VotePeriodMultiplier))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateExchangeRatePrevote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregateExchangeRatePrevote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SubmitBlock != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SubmitBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AggregateExchangeRateVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateExchangeRateVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AggregateExchangeRateVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ExchangeRateTuples) > 0 {
+		for iNdEx := len(m.ExchangeRateTuples) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRateTuples[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExchangeRateTuple) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeRateTuple) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeRateTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TallyBounds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyBounds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyBounds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InBandPower != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.InBandPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RatedPower != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.RatedPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.UpperBound.Size()
+		i -= size
+		if _, err := m.UpperBound.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.LowerBound.Size()
+		i -= size
+		if _, err := m.LowerBound.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomTallyCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTallyCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTallyCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailedPeriods != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.FailedPeriods))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SuccessPeriods != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SuccessPeriods))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomTallyOutcome) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTallyOutcome) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTallyOutcome) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RequiredFraction.Size()
+		i -= size
+		if _, err := m.RequiredFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.AchievedFraction.Size()
+		i -= size
+		if _, err := m.AchievedFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.VotePeriod != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VotePeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorAccuracyCounter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorAccuracyCounter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorAccuracyCounter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PreviousInBandSubmissions != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PreviousInBandSubmissions))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PreviousSubmissions != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PreviousSubmissions))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InBandSubmissions != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.InBandSubmissions))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Submissions != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Submissions))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotePeriodClock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotePeriodClock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotePeriodClock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SlashWindowPeriods != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.SlashWindowPeriods))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Boundary != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Boundary))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PreviousStartHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PreviousStartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Period != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotePeriodParticipation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotePeriodParticipation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotePeriodParticipation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Voters != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Voters))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.VotedPower != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VotedPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BondedValidators != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.BondedValidators))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BondedPower != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.BondedPower))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleAlertConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleAlertConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleAlertConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxMissRate.Size()
+		i -= size
+		if _, err := m.MaxMissRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *WhitelistChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WhitelistChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WhitelistChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Added {
+		i--
+		if m.Added {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VotePeriod != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VotePeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RejectedTuples) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RejectedTuples) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RejectedTuples) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tuples) > 0 {
+		for iNdEx := len(m.Tuples) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tuples[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.VotePeriod != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VotePeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RejectedTuple) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RejectedTuple) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RejectedTuple) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TallyStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TallyStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Denoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.VotePeriod != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VotePeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomTallyStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTallyStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTallyStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CappedVotes != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.CappedVotes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Tallied {
+		i--
+		if m.Tallied {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AbstainVotes != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.AbstainVotes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Votes != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Votes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ParticipationPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipationPoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipationPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ParticipationRatio.Size()
+		i -= size
+		if _, err := m.ParticipationRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.VotePeriod != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VotePeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomMaxMove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomMaxMove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomMaxMove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotePeriod != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.VotePeriod))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MaxMovePercent.Size()
+		i -= size
+		if _, err := m.MaxMovePercent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.WindowStart != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.WindowStart))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOracle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotePeriod != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriod))
+	}
+	l = m.VoteThreshold.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.RewardBand.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.RewardDistributionWindow != 0 {
+		n += 1 + sovOracle(uint64(m.RewardDistributionWindow))
+	}
+	if len(m.Whitelist) > 0 {
+		for _, e := range m.Whitelist {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.SlashWindow != 0 {
+		n += 1 + sovOracle(uint64(m.SlashWindow))
+	}
+	l = m.MinValidPerWindow.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.ExcludeJailedFromThreshold {
+		n += 2
+	}
+	l = len(m.AggregationMethod)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.ModeBucketPrecision != 0 {
+		n += 1 + sovOracle(uint64(m.ModeBucketPrecision))
+	}
+	if m.AutoDelistAfterStaleWindows != 0 {
+		n += 1 + sovOracle(uint64(m.AutoDelistAfterStaleWindows))
+	}
+	if m.DenomGracePeriods != 0 {
+		n += 1 + sovOracle(uint64(m.DenomGracePeriods))
+	}
+	if m.MaxCarryForwardPeriods != 0 {
+		n += 1 + sovOracle(uint64(m.MaxCarryForwardPeriods))
+	}
+	if m.ProgressiveSlashing {
+		n += 2
+	}
+	l = m.ProgressiveSlashFloor.Size()
+	n += 2 + l + sovOracle(uint64(l))
+	l = len(m.CommitmentHashAlgo)
+	if l > 0 {
+		n += 2 + l + sovOracle(uint64(l))
+	}
+	if m.PowerSmoothingWindows != 0 {
+		n += 2 + sovOracle(uint64(m.PowerSmoothingWindows))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotePeriodDuration)
+	n += 2 + l + sovOracle(uint64(l))
+	l = m.MaxPowerShare.Size()
+	n += 2 + l + sovOracle(uint64(l))
+	l = m.RevealMissWeight.Size()
+	n += 2 + l + sovOracle(uint64(l))
+	if m.AccuracyWeightedRewards {
+		n += 3
+	}
+	if m.MaxEventDenomsPerBlock != 0 {
+		n += 2 + sovOracle(uint64(m.MaxEventDenomsPerBlock))
+	}
+	if m.FeederChangeCooldownBlocks != 0 {
+		n += 2 + sovOracle(uint64(m.FeederChangeCooldownBlocks))
+	}
+	if m.RevealGraceBlocks != 0 {
+		n += 2 + sovOracle(uint64(m.RevealGraceBlocks))
+	}
+	if m.LegacyRateEvents {
+		n += 3
+	}
+	if m.WhitelistChangeRetention != 0 {
+		n += 2 + sovOracle(uint64(m.WhitelistChangeRetention))
+	}
+	if m.MaxDenomsPerVote != 0 {
+		n += 2 + sovOracle(uint64(m.MaxDenomsPerVote))
+	}
+	l = m.OracleFeeShare.Size()
+	n += 2 + l + sovOracle(uint64(l))
+	if m.MaxVoteFutureDrift != 0 {
+		n += 2 + sovOracle(uint64(m.MaxVoteFutureDrift))
+	}
+	if m.PostUpgradeGracePeriods != 0 {
+		n += 2 + sovOracle(uint64(m.PostUpgradeGracePeriods))
+	}
+	if m.MaxMoveWindow != 0 {
+		n += 2 + sovOracle(uint64(m.MaxMoveWindow))
+	}
+	l = len(m.EvenMedianRule)
+	if l > 0 {
+		n += 2 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *Denom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.VotePeriodMultiplier != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriodMultiplier))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Tracks)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *AggregateExchangeRatePrevote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.SubmitBlock != 0 {
+		n += 1 + sovOracle(uint64(m.SubmitBlock))
+	}
+	return n
+}
+
+func (m *AggregateExchangeRateVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExchangeRateTuples) > 0 {
+		for _, e := range m.ExchangeRateTuples {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *ExchangeRateTuple) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *TallyBounds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LowerBound.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.UpperBound.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.RatedPower != 0 {
+		n += 1 + sovOracle(uint64(m.RatedPower))
+	}
+	if m.InBandPower != 0 {
+		n += 1 + sovOracle(uint64(m.InBandPower))
+	}
+	return n
+}
+
+func (m *DenomTallyCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SuccessPeriods != 0 {
+		n += 1 + sovOracle(uint64(m.SuccessPeriods))
+	}
+	if m.FailedPeriods != 0 {
+		n += 1 + sovOracle(uint64(m.FailedPeriods))
+	}
+	return n
+}
+
+func (m *DenomTallyOutcome) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.VotePeriod != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriod))
+	}
+	l = m.AchievedFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.RequiredFraction.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *ValidatorAccuracyCounter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Submissions != 0 {
+		n += 1 + sovOracle(uint64(m.Submissions))
+	}
+	if m.InBandSubmissions != 0 {
+		n += 1 + sovOracle(uint64(m.InBandSubmissions))
+	}
+	if m.PreviousSubmissions != 0 {
+		n += 1 + sovOracle(uint64(m.PreviousSubmissions))
+	}
+	if m.PreviousInBandSubmissions != 0 {
+		n += 1 + sovOracle(uint64(m.PreviousInBandSubmissions))
+	}
+	return n
+}
+
+func (m *VotePeriodClock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Period != 0 {
+		n += 1 + sovOracle(uint64(m.Period))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovOracle(uint64(m.StartHeight))
+	}
+	if m.PreviousStartHeight != 0 {
+		n += 1 + sovOracle(uint64(m.PreviousStartHeight))
+	}
+	if m.Boundary != 0 {
+		n += 1 + sovOracle(uint64(m.Boundary))
+	}
+	if m.SlashWindowPeriods != 0 {
+		n += 1 + sovOracle(uint64(m.SlashWindowPeriods))
+	}
+	return n
+}
+
+func (m *VotePeriodParticipation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BondedPower != 0 {
+		n += 1 + sovOracle(uint64(m.BondedPower))
+	}
+	if m.BondedValidators != 0 {
+		n += 1 + sovOracle(uint64(m.BondedValidators))
+	}
+	if m.VotedPower != 0 {
+		n += 1 + sovOracle(uint64(m.VotedPower))
+	}
+	if m.Voters != 0 {
+		n += 1 + sovOracle(uint64(m.Voters))
+	}
+	return n
+}
+
+func (m *OracleAlertConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxMissRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *WhitelistChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.VotePeriod != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriod))
+	}
+	if m.Added {
+		n += 2
+	}
+	return n
+}
+
+func (m *RejectedTuples) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotePeriod != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriod))
+	}
+	if len(m.Tuples) > 0 {
+		for _, e := range m.Tuples {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RejectedTuple) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	return n
+}
+
+func (m *TallyStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotePeriod != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriod))
+	}
+	if len(m.Denoms) > 0 {
+		for _, e := range m.Denoms {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomTallyStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	if m.Votes != 0 {
+		n += 1 + sovOracle(uint64(m.Votes))
+	}
+	if m.AbstainVotes != 0 {
+		n += 1 + sovOracle(uint64(m.AbstainVotes))
+	}
+	if m.Tallied {
+		n += 2
+	}
+	if m.CappedVotes != 0 {
+		n += 1 + sovOracle(uint64(m.CappedVotes))
+	}
+	return n
+}
+
+func (m *ParticipationPoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotePeriod != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriod))
+	}
+	l = m.ParticipationRatio.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *DenomMaxMove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowStart != 0 {
+		n += 1 + sovOracle(uint64(m.WindowStart))
+	}
+	l = m.MaxMovePercent.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.VotePeriod != 0 {
+		n += 1 + sovOracle(uint64(m.VotePeriod))
+	}
+	return n
+}
+
+func sovOracle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOracle(x uint64) (n int) {
+	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributionWindow", wireType)
+			}
+			m.RewardDistributionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardDistributionWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Whitelist = append(m.Whitelist, Denom{})
+			if err := m.Whitelist[len(m.Whitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
+			}
+			m.SlashWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeJailedFromThreshold", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeJailedFromThreshold = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregationMethod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregationMethod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModeBucketPrecision", wireType)
+			}
+			m.ModeBucketPrecision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ModeBucketPrecision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoDelistAfterStaleWindows", wireType)
+			}
+			m.AutoDelistAfterStaleWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoDelistAfterStaleWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomGracePeriods", wireType)
+			}
+			m.DenomGracePeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DenomGracePeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCarryForwardPeriods", wireType)
+			}
+			m.MaxCarryForwardPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCarryForwardPeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressiveSlashing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProgressiveSlashing = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressiveSlashFloor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProgressiveSlashFloor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitmentHashAlgo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitmentHashAlgo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerSmoothingWindows", wireType)
+			}
+			m.PowerSmoothingWindows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PowerSmoothingWindows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriodDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VotePeriodDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPowerShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPowerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealMissWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RevealMissWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccuracyWeightedRewards", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AccuracyWeightedRewards = bool(v != 0)
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEventDenomsPerBlock", wireType)
+			}
+			m.MaxEventDenomsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEventDenomsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederChangeCooldownBlocks", wireType)
+			}
+			m.FeederChangeCooldownBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeederChangeCooldownBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealGraceBlocks", wireType)
+			}
+			m.RevealGraceBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealGraceBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyRateEvents", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LegacyRateEvents = bool(v != 0)
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistChangeRetention", wireType)
+			}
+			m.WhitelistChangeRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WhitelistChangeRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDenomsPerVote", wireType)
+			}
+			m.MaxDenomsPerVote = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDenomsPerVote |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVoteFutureDrift", wireType)
+			}
+			m.MaxVoteFutureDrift = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVoteFutureDrift |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostUpgradeGracePeriods", wireType)
+			}
+			m.PostUpgradeGracePeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostUpgradeGracePeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMoveWindow", wireType)
+			}
+			m.MaxMoveWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMoveWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvenMedianRule", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvenMedianRule = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Denom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriodMultiplier", wireType)
+			}
+			m.VotePeriodMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriodMultiplier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tracks", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tracks = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+			}
+			m.SubmitBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateTuples = append(m.ExchangeRateTuples, ExchangeRateTuple{})
+			if err := m.ExchangeRateTuples[len(m.ExchangeRateTuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TallyBounds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TallyBounds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TallyBounds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerBound", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LowerBound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperBound", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpperBound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RatedPower", wireType)
+			}
+			m.RatedPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RatedPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InBandPower", wireType)
+			}
+			m.InBandPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InBandPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomTallyCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTallyCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTallyCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessPeriods", wireType)
+			}
+			m.SuccessPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuccessPeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedPeriods", wireType)
+			}
+			m.FailedPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedPeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomTallyOutcome) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTallyOutcome: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTallyOutcome: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AchievedFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AchievedFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequiredFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorAccuracyCounter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorAccuracyCounter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorAccuracyCounter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submissions", wireType)
+			}
+			m.Submissions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Submissions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InBandSubmissions", wireType)
+			}
+			m.InBandSubmissions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InBandSubmissions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousSubmissions", wireType)
+			}
+			m.PreviousSubmissions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousSubmissions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousInBandSubmissions", wireType)
+			}
+			m.PreviousInBandSubmissions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousInBandSubmissions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VotePeriodClock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotePeriodClock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotePeriodClock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStartHeight", wireType)
+			}
+			m.PreviousStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Boundary", wireType)
+			}
+			m.Boundary = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Boundary |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindowPeriods", wireType)
+			}
+			m.SlashWindowPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashWindowPeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.ExchangeRate.Size()
-	n += 1 + l + sovOracle(uint64(l))
-	return n
-}
 
-func sovOracle(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozOracle(x uint64) (n int) {
-	return sovOracle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *VotePeriodParticipation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -749,17 +5296,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: VotePeriodParticipation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VotePeriodParticipation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedPower", wireType)
 			}
-			m.VotePeriod = 0
+			m.BondedPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -769,14 +5316,121 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotePeriod |= uint64(b&0x7F) << shift
+				m.BondedPower |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedValidators", wireType)
+			}
+			m.BondedValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BondedValidators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotedPower", wireType)
+			}
+			m.VotedPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotedPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
+			}
+			m.Voters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Voters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleAlertConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleAlertConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleAlertConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -804,13 +5458,63 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VoteThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxMissRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WhitelistChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WhitelistChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WhitelistChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -838,15 +5542,102 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Added", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Added = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RejectedTuples) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RejectedTuples: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RejectedTuples: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardDistributionWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
 			}
-			m.RewardDistributionWindow = 0
+			m.VotePeriod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -856,14 +5647,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RewardDistributionWindow |= uint64(b&0x7F) << shift
+				m.VotePeriod |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tuples", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -890,14 +5681,64 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Whitelist = append(m.Whitelist, Denom{})
-			if err := m.Whitelist[len(m.Whitelist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tuples = append(m.Tuples, RejectedTuple{})
+			if err := m.Tuples[len(m.Tuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RejectedTuple) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RejectedTuple: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RejectedTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -925,32 +5766,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashWindow", wireType)
-			}
-			m.SlashWindow = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOracle
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SlashWindow |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinValidPerWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -978,9 +5798,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinValidPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1003,7 +5821,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Denom) Unmarshal(dAtA []byte) error {
+func (m *TallyStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1026,17 +5844,36 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Denom: wiretype end group for non-group")
+			return fmt.Errorf("proto: TallyStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Denom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TallyStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1046,23 +5883,25 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOracle
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOracle
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Denoms = append(m.Denoms, DenomTallyStats{})
+			if err := m.Denoms[len(m.Denoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1085,7 +5924,7 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
+func (m *DenomTallyStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1108,15 +5947,15 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateExchangeRatePrevote: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomTallyStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateExchangeRatePrevote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomTallyStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1144,13 +5983,13 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
 			}
-			var stringLen uint64
+			m.Votes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1160,29 +5999,55 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Votes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbstainVotes", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
+			m.AbstainVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AbstainVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tallied", wireType)
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tallied = bool(v != 0)
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CappedVotes", wireType)
 			}
-			m.SubmitBlock = 0
+			m.CappedVotes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1192,7 +6057,7 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubmitBlock |= uint64(b&0x7F) << shift
+				m.CappedVotes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1218,7 +6083,7 @@ func (m *AggregateExchangeRatePrevote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
+func (m *ParticipationPoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1241,17 +6106,17 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParticipationPoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateExchangeRateVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParticipationPoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateTuples", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
 			}
-			var msglen int
+			m.VotePeriod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1261,29 +6126,14 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.VotePeriod |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExchangeRateTuples = append(m.ExchangeRateTuples, ExchangeRateTuple{})
-			if err := m.ExchangeRateTuples[len(m.ExchangeRateTuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipationRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1311,7 +6161,9 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			if err := m.ParticipationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1334,7 +6186,7 @@ func (m *AggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
+func (m *DenomMaxMove) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1357,17 +6209,17 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExchangeRateTuple: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomMaxMove: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExchangeRateTuple: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomMaxMove: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
 			}
-			var stringLen uint64
+			m.WindowStart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOracle
@@ -1377,27 +6229,14 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.WindowStart |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOracle
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOracle
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMovePercent", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1425,10 +6264,29 @@ func (m *ExchangeRateTuple) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxMovePercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
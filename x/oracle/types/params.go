@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	"gopkg.in/yaml.v2"
 
@@ -9,32 +11,130 @@ import (
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
+// Aggregation methods
+const (
+	AggregationMethodMedian = "median"
+	AggregationMethodMode   = "mode"
+)
+
+// Rules picking the weighted median of a ballot whose power splits exactly in half
+const (
+	EvenMedianRuleLower   = "lower"
+	EvenMedianRuleUpper   = "upper"
+	EvenMedianRuleAverage = "average"
+)
+
+// Commitment hash algorithms
+const (
+	CommitmentHashAlgoSHA256Truncated = "sha256_truncated"
+	CommitmentHashAlgoSHA256          = "sha256"
+)
+
 // Parameter keys
 var (
-	KeyVotePeriod               = []byte("VotePeriod")
-	KeyVoteThreshold            = []byte("VoteThreshold")
-	KeyRewardBand               = []byte("RewardBand")
-	KeyRewardDistributionWindow = []byte("RewardDistributionWindow")
-	KeyWhitelist                = []byte("Whitelist")
-	KeySlashFraction            = []byte("SlashFraction")
-	KeySlashWindow              = []byte("SlashWindow")
-	KeyMinValidPerWindow        = []byte("MinValidPerWindow")
+	KeyVotePeriod                  = []byte("VotePeriod")
+	KeyVoteThreshold               = []byte("VoteThreshold")
+	KeyRewardBand                  = []byte("RewardBand")
+	KeyRewardDistributionWindow    = []byte("RewardDistributionWindow")
+	KeyWhitelist                   = []byte("Whitelist")
+	KeySlashFraction               = []byte("SlashFraction")
+	KeySlashWindow                 = []byte("SlashWindow")
+	KeyMinValidPerWindow           = []byte("MinValidPerWindow")
+	KeyExcludeJailedFromThreshold  = []byte("ExcludeJailedFromThreshold")
+	KeyAggregationMethod           = []byte("AggregationMethod")
+	KeyModeBucketPrecision         = []byte("ModeBucketPrecision")
+	KeyAutoDelistAfterStaleWindows = []byte("AutoDelistAfterStaleWindows")
+	KeyDenomGracePeriods           = []byte("DenomGracePeriods")
+	KeyMaxCarryForwardPeriods      = []byte("MaxCarryForwardPeriods")
+	KeyProgressiveSlashing         = []byte("ProgressiveSlashing")
+	KeyProgressiveSlashFloor       = []byte("ProgressiveSlashFloor")
+	KeyCommitmentHashAlgo          = []byte("CommitmentHashAlgo")
+	KeyPowerSmoothingWindows       = []byte("PowerSmoothingWindows")
+	KeyVotePeriodDuration          = []byte("VotePeriodDuration")
+	KeyMaxPowerShare               = []byte("MaxPowerShare")
+	KeyRevealMissWeight            = []byte("RevealMissWeight")
+	KeyAccuracyWeightedRewards     = []byte("AccuracyWeightedRewards")
+	KeyMaxEventDenomsPerBlock      = []byte("MaxEventDenomsPerBlock")
+	KeyFeederChangeCooldownBlocks  = []byte("FeederChangeCooldownBlocks")
+	KeyRevealGraceBlocks           = []byte("RevealGraceBlocks")
+	KeyLegacyRateEvents            = []byte("LegacyRateEvents")
+	KeyWhitelistChangeRetention    = []byte("WhitelistChangeRetention")
+	KeyMaxDenomsPerVote            = []byte("MaxDenomsPerVote")
+	KeyOracleFeeShare              = []byte("OracleFeeShare")
+	KeyMaxVoteFutureDrift          = []byte("MaxVoteFutureDrift")
+	KeyPostUpgradeGracePeriods     = []byte("PostUpgradeGracePeriods")
+	KeyMaxMoveWindow               = []byte("MaxMoveWindow")
+	KeyEvenMedianRule              = []byte("EvenMedianRule")
+)
+
+// Optional features reported by the ModuleInfo query
+const (
+	FeatureAggregationMethod          = "aggregation_method"
+	FeatureCommitmentHashAlgo         = "commitment_hash_algo"
+	FeatureTimedVotePeriods           = "timed_vote_periods"
+	FeatureVotePeriodMultipliers      = "vote_period_multipliers"
+	FeatureQuoteDenoms                = "quote_denoms"
+	FeatureTrackedDenoms              = "tracked_denoms"
+	FeaturePowerSmoothing             = "power_smoothing"
+	FeaturePowerCap                   = "power_cap"
+	FeatureExcludeJailedFromThreshold = "exclude_jailed_from_threshold"
+	FeatureProgressiveSlashing        = "progressive_slashing"
+	FeatureAccuracyWeightedRewards    = "accuracy_weighted_rewards"
+	FeatureAutoDelist                 = "auto_delist"
+	FeatureDenomGrace                 = "denom_grace"
+	FeatureCarryForward               = "carry_forward"
+	FeatureEventCoalescing            = "event_coalescing"
+	FeatureFeederChangeCooldown       = "feeder_change_cooldown"
+	FeatureRevealGrace                = "reveal_grace"
+	FeatureLegacyRateEvents           = "legacy_rate_events"
+	FeatureWhitelistChangeLog         = "whitelist_change_log"
+	FeatureVoteDenomCap               = "vote_denom_cap"
+	FeatureOracleFeeShare             = "oracle_fee_share"
+	FeatureVoteFutureDrift            = "vote_future_drift"
+	FeaturePostUpgradeGrace           = "post_upgrade_grace"
+	FeatureMaxMoveWindow              = "max_move_window"
+	FeatureEvenMedianRule             = "even_median_rule"
 )
 
 // Default parameter values
 const (
-	DefaultVotePeriod               = uint64(14)       // 30 seconds
-	DefaultSlashWindow              = uint64(274000)   // window for a week
-	DefaultRewardDistributionWindow = uint64(14250000) // window for a year
+	DefaultVotePeriod                  = uint64(14)       // 30 seconds
+	DefaultSlashWindow                 = uint64(274000)   // window for a week
+	DefaultRewardDistributionWindow    = uint64(14250000) // window for a year
+	DefaultModeBucketPrecision         = uint64(6)        // 6 decimal places
+	DefaultAutoDelistAfterStaleWindows = uint64(0)        // disabled
+	DefaultDenomGracePeriods           = uint64(0)        // no grace
+	DefaultMaxCarryForwardPeriods      = uint64(0)        // disabled
+	DefaultPowerSmoothingWindows       = uint64(0)        // disabled
+	DefaultVotePeriodDuration          = time.Duration(0) // block count
+	DefaultMaxEventDenomsPerBlock      = uint64(0)        // unlimited
+	DefaultFeederChangeCooldownBlocks  = uint64(0)        // disabled
+	DefaultRevealGraceBlocks           = uint64(0)        // strict reveal window
+	DefaultWhitelistChangeRetention    = uint64(0)        // disabled
+	DefaultMaxDenomsPerVote            = uint64(0)        // unlimited
+	DefaultMaxVoteFutureDrift          = uint64(0)        // disabled
+	DefaultPostUpgradeGracePeriods     = uint64(0)        // no grace
+	DefaultMaxMoveWindow               = uint64(0)        // disabled
 )
 
 // Default parameter values
 var (
-	DefaultVoteThreshold     = sdk.NewDecWithPrec(50, 2) // 50%
-	DefaultRewardBand        = sdk.NewDecWithPrec(2, 2)  // 2% (-1, 1)
-	DefaultWhitelist         = DenomList{}
-	DefaultSlashFraction     = sdk.NewDecWithPrec(1, 4) // 0.01%
-	DefaultMinValidPerWindow = sdk.NewDecWithPrec(5, 2) // 5%
+	DefaultVoteThreshold              = sdk.NewDecWithPrec(50, 2) // 50%
+	DefaultRewardBand                 = sdk.NewDecWithPrec(2, 2)  // 2% (-1, 1)
+	DefaultWhitelist                  = DenomList{}
+	DefaultSlashFraction              = sdk.NewDecWithPrec(1, 4) // 0.01%
+	DefaultMinValidPerWindow          = sdk.NewDecWithPrec(5, 2) // 5%
+	DefaultExcludeJailedFromThreshold = false
+	DefaultAggregationMethod          = AggregationMethodMedian
+	DefaultEvenMedianRule             = EvenMedianRuleLower
+	DefaultProgressiveSlashing        = false
+	DefaultProgressiveSlashFloor      = sdk.NewDecWithPrec(1, 5) // 0.001%
+	DefaultCommitmentHashAlgo         = CommitmentHashAlgoSHA256Truncated
+	DefaultMaxPowerShare              = sdk.ZeroDec() // disabled
+	DefaultRevealMissWeight           = sdk.OneDec()  // counted as a full miss
+	DefaultAccuracyWeightedRewards    = false
+	DefaultLegacyRateEvents           = true
+	DefaultOracleFeeShare             = sdk.ZeroDec() // disabled
 )
 
 var _ paramstypes.ParamSet = &Params{}
@@ -42,14 +142,39 @@ var _ paramstypes.ParamSet = &Params{}
 // DefaultParams creates default oracle module parameters
 func DefaultParams() Params {
 	return Params{
-		VotePeriod:               DefaultVotePeriod,
-		VoteThreshold:            DefaultVoteThreshold,
-		RewardBand:               DefaultRewardBand,
-		RewardDistributionWindow: DefaultRewardDistributionWindow,
-		Whitelist:                DefaultWhitelist,
-		SlashFraction:            DefaultSlashFraction,
-		SlashWindow:              DefaultSlashWindow,
-		MinValidPerWindow:        DefaultMinValidPerWindow,
+		VotePeriod:                  DefaultVotePeriod,
+		VoteThreshold:               DefaultVoteThreshold,
+		RewardBand:                  DefaultRewardBand,
+		RewardDistributionWindow:    DefaultRewardDistributionWindow,
+		Whitelist:                   DefaultWhitelist,
+		SlashFraction:               DefaultSlashFraction,
+		SlashWindow:                 DefaultSlashWindow,
+		MinValidPerWindow:           DefaultMinValidPerWindow,
+		ExcludeJailedFromThreshold:  DefaultExcludeJailedFromThreshold,
+		AggregationMethod:           DefaultAggregationMethod,
+		ModeBucketPrecision:         DefaultModeBucketPrecision,
+		AutoDelistAfterStaleWindows: DefaultAutoDelistAfterStaleWindows,
+		DenomGracePeriods:           DefaultDenomGracePeriods,
+		MaxCarryForwardPeriods:      DefaultMaxCarryForwardPeriods,
+		ProgressiveSlashing:         DefaultProgressiveSlashing,
+		ProgressiveSlashFloor:       DefaultProgressiveSlashFloor,
+		CommitmentHashAlgo:          DefaultCommitmentHashAlgo,
+		PowerSmoothingWindows:       DefaultPowerSmoothingWindows,
+		VotePeriodDuration:          DefaultVotePeriodDuration,
+		MaxPowerShare:               DefaultMaxPowerShare,
+		RevealMissWeight:            DefaultRevealMissWeight,
+		AccuracyWeightedRewards:     DefaultAccuracyWeightedRewards,
+		MaxEventDenomsPerBlock:      DefaultMaxEventDenomsPerBlock,
+		FeederChangeCooldownBlocks:  DefaultFeederChangeCooldownBlocks,
+		RevealGraceBlocks:           DefaultRevealGraceBlocks,
+		LegacyRateEvents:            DefaultLegacyRateEvents,
+		WhitelistChangeRetention:    DefaultWhitelistChangeRetention,
+		MaxDenomsPerVote:            DefaultMaxDenomsPerVote,
+		OracleFeeShare:              DefaultOracleFeeShare,
+		MaxVoteFutureDrift:          DefaultMaxVoteFutureDrift,
+		PostUpgradeGracePeriods:     DefaultPostUpgradeGracePeriods,
+		MaxMoveWindow:               DefaultMaxMoveWindow,
+		EvenMedianRule:              DefaultEvenMedianRule,
 	}
 }
 
@@ -70,6 +195,31 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeySlashFraction, &p.SlashFraction, validateSlashFraction),
 		paramstypes.NewParamSetPair(KeySlashWindow, &p.SlashWindow, validateSlashWindow),
 		paramstypes.NewParamSetPair(KeyMinValidPerWindow, &p.MinValidPerWindow, validateMinValidPerWindow),
+		paramstypes.NewParamSetPair(KeyExcludeJailedFromThreshold, &p.ExcludeJailedFromThreshold, validateBool),
+		paramstypes.NewParamSetPair(KeyAggregationMethod, &p.AggregationMethod, validateAggregationMethod),
+		paramstypes.NewParamSetPair(KeyModeBucketPrecision, &p.ModeBucketPrecision, validateModeBucketPrecision),
+		paramstypes.NewParamSetPair(KeyAutoDelistAfterStaleWindows, &p.AutoDelistAfterStaleWindows, validateAutoDelistAfterStaleWindows),
+		paramstypes.NewParamSetPair(KeyDenomGracePeriods, &p.DenomGracePeriods, validateDenomGracePeriods),
+		paramstypes.NewParamSetPair(KeyMaxCarryForwardPeriods, &p.MaxCarryForwardPeriods, validateMaxCarryForwardPeriods),
+		paramstypes.NewParamSetPair(KeyProgressiveSlashing, &p.ProgressiveSlashing, validateBool),
+		paramstypes.NewParamSetPair(KeyProgressiveSlashFloor, &p.ProgressiveSlashFloor, validateSlashFraction),
+		paramstypes.NewParamSetPair(KeyCommitmentHashAlgo, &p.CommitmentHashAlgo, validateCommitmentHashAlgo),
+		paramstypes.NewParamSetPair(KeyPowerSmoothingWindows, &p.PowerSmoothingWindows, validatePowerSmoothingWindows),
+		paramstypes.NewParamSetPair(KeyVotePeriodDuration, &p.VotePeriodDuration, validateVotePeriodDuration),
+		paramstypes.NewParamSetPair(KeyMaxPowerShare, &p.MaxPowerShare, validateMaxPowerShare),
+		paramstypes.NewParamSetPair(KeyRevealMissWeight, &p.RevealMissWeight, validateRevealMissWeight),
+		paramstypes.NewParamSetPair(KeyAccuracyWeightedRewards, &p.AccuracyWeightedRewards, validateBool),
+		paramstypes.NewParamSetPair(KeyMaxEventDenomsPerBlock, &p.MaxEventDenomsPerBlock, validateMaxEventDenomsPerBlock),
+		paramstypes.NewParamSetPair(KeyFeederChangeCooldownBlocks, &p.FeederChangeCooldownBlocks, validateFeederChangeCooldownBlocks),
+		paramstypes.NewParamSetPair(KeyRevealGraceBlocks, &p.RevealGraceBlocks, validateRevealGraceBlocks),
+		paramstypes.NewParamSetPair(KeyLegacyRateEvents, &p.LegacyRateEvents, validateBool),
+		paramstypes.NewParamSetPair(KeyWhitelistChangeRetention, &p.WhitelistChangeRetention, validateWhitelistChangeRetention),
+		paramstypes.NewParamSetPair(KeyMaxDenomsPerVote, &p.MaxDenomsPerVote, validateMaxDenomsPerVote),
+		paramstypes.NewParamSetPair(KeyOracleFeeShare, &p.OracleFeeShare, validateOracleFeeShare),
+		paramstypes.NewParamSetPair(KeyMaxVoteFutureDrift, &p.MaxVoteFutureDrift, validateMaxVoteFutureDrift),
+		paramstypes.NewParamSetPair(KeyPostUpgradeGracePeriods, &p.PostUpgradeGracePeriods, validatePostUpgradeGracePeriods),
+		paramstypes.NewParamSetPair(KeyMaxMoveWindow, &p.MaxMoveWindow, validateMaxMoveWindow),
+		paramstypes.NewParamSetPair(KeyEvenMedianRule, &p.EvenMedianRule, validateEvenMedianRule),
 	}
 }
 
@@ -79,17 +229,58 @@ func (p Params) String() string {
 	return string(out)
 }
 
+// Features returns the optional features of the module and whether they are enabled by the
+// params, or their setting where it is not a switch. Features the module does not support are
+// left out, so clients tell them apart from disabled ones.
+func (p Params) Features() map[string]string {
+	multipliers, quoteDenoms, trackedDenoms := false, false, false
+	for _, denom := range p.Whitelist {
+		multipliers = multipliers || denom.VotePeriodMultiplier > 1
+		quoteDenoms = quoteDenoms || denom.QuoteDenom != ""
+		trackedDenoms = trackedDenoms || denom.Tracks != ""
+	}
+
+	return map[string]string{
+		FeatureAggregationMethod:          p.AggregationMethod,
+		FeatureCommitmentHashAlgo:         p.CommitmentHashAlgo,
+		FeatureTimedVotePeriods:           strconv.FormatBool(p.VotePeriodDuration > 0),
+		FeatureVotePeriodMultipliers:      strconv.FormatBool(multipliers),
+		FeatureQuoteDenoms:                strconv.FormatBool(quoteDenoms),
+		FeatureTrackedDenoms:              strconv.FormatBool(trackedDenoms),
+		FeaturePowerSmoothing:             strconv.FormatBool(p.PowerSmoothingWindows > 0),
+		FeaturePowerCap:                   strconv.FormatBool(p.MaxPowerShare.IsPositive()),
+		FeatureExcludeJailedFromThreshold: strconv.FormatBool(p.ExcludeJailedFromThreshold),
+		FeatureProgressiveSlashing:        strconv.FormatBool(p.ProgressiveSlashing),
+		FeatureAccuracyWeightedRewards:    strconv.FormatBool(p.AccuracyWeightedRewards),
+		FeatureAutoDelist:                 strconv.FormatBool(p.AutoDelistAfterStaleWindows > 0),
+		FeatureDenomGrace:                 strconv.FormatBool(p.DenomGracePeriods > 0),
+		FeatureCarryForward:               strconv.FormatBool(p.MaxCarryForwardPeriods > 0),
+		FeatureEventCoalescing:            strconv.FormatBool(p.MaxEventDenomsPerBlock > 0),
+		FeatureFeederChangeCooldown:       strconv.FormatBool(p.FeederChangeCooldownBlocks > 0),
+		FeatureRevealGrace:                strconv.FormatBool(p.RevealGraceBlocks > 0),
+		FeatureLegacyRateEvents:           strconv.FormatBool(p.LegacyRateEvents),
+		FeatureWhitelistChangeLog:         strconv.FormatBool(p.WhitelistChangeRetention > 0),
+		FeatureVoteDenomCap:               strconv.FormatBool(p.MaxDenomsPerVote > 0),
+		FeatureOracleFeeShare:             strconv.FormatBool(p.OracleFeeShare.IsPositive()),
+		FeatureVoteFutureDrift:            strconv.FormatBool(p.MaxVoteFutureDrift > 0),
+		FeaturePostUpgradeGrace:           strconv.FormatBool(p.PostUpgradeGracePeriods > 0),
+		FeatureMaxMoveWindow:              strconv.FormatBool(p.MaxMoveWindow > 0),
+		FeatureEvenMedianRule:             p.EvenMedianRule,
+	}
+}
+
 // Validate performs basic validation on oracle parameters.
 func (p Params) Validate() error {
 	if p.VotePeriod == 0 {
 		return fmt.Errorf("oracle parameter VotePeriod must be > 0, is %d", p.VotePeriod)
 	}
-	if p.VoteThreshold.LTE(sdk.NewDecWithPrec(33, 2)) {
-		return fmt.Errorf("oracle parameter VoteThreshold must be greater than 33 percent")
+	// A threshold above 1 can never be reached by any ballot, halting all price updates
+	if p.VoteThreshold.IsNil() || p.VoteThreshold.LTE(sdk.NewDecWithPrec(33, 2)) || p.VoteThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("oracle parameter VoteThreshold must be between (0.33, 1], is %s", p.VoteThreshold)
 	}
 
-	if p.RewardBand.GT(sdk.OneDec()) || p.RewardBand.IsNegative() {
-		return fmt.Errorf("oracle parameter RewardBand must be between [0, 1]")
+	if p.RewardBand.IsNil() || p.RewardBand.GT(sdk.OneDec()) || p.RewardBand.IsNegative() {
+		return fmt.Errorf("oracle parameter RewardBand must be between [0, 1], is %s", p.RewardBand)
 	}
 
 	if p.RewardDistributionWindow < p.VotePeriod {
@@ -108,11 +299,54 @@ func (p Params) Validate() error {
 		return fmt.Errorf("oracle parameter MinValidPerWindow must be between [0, 1]")
 	}
 
+	if p.ProgressiveSlashFloor.IsNegative() || p.ProgressiveSlashFloor.GT(p.SlashFraction) {
+		return fmt.Errorf("oracle parameter ProgressiveSlashFloor must be between [0, SlashFraction]")
+	}
+
+	if err := validateAggregationMethod(p.AggregationMethod); err != nil {
+		return fmt.Errorf("oracle parameter AggregationMethod is invalid: %s", err)
+	}
+
+	if err := validateEvenMedianRule(p.EvenMedianRule); err != nil {
+		return fmt.Errorf("oracle parameter EvenMedianRule is invalid: %s", err)
+	}
+
+	if p.ModeBucketPrecision > sdk.Precision {
+		return fmt.Errorf("oracle parameter ModeBucketPrecision must be between [0, %d]", sdk.Precision)
+	}
+
+	if err := validateCommitmentHashAlgo(p.CommitmentHashAlgo); err != nil {
+		return fmt.Errorf("oracle parameter CommitmentHashAlgo is invalid: %s", err)
+	}
+
+	if p.MaxPowerShare.IsNil() || p.MaxPowerShare.GT(sdk.OneDec()) || p.MaxPowerShare.IsNegative() {
+		return fmt.Errorf("oracle parameter MaxPowerShare must be between [0, 1], is %s", p.MaxPowerShare)
+	}
+
+	if p.RevealMissWeight.IsNil() || p.RevealMissWeight.GT(sdk.OneDec()) || p.RevealMissWeight.IsNegative() {
+		return fmt.Errorf("oracle parameter RevealMissWeight must be between [0, 1], is %s", p.RevealMissWeight)
+	}
+
+	if p.OracleFeeShare.IsNil() || p.OracleFeeShare.GT(sdk.OneDec()) || p.OracleFeeShare.IsNegative() {
+		return fmt.Errorf("oracle parameter OracleFeeShare must be between [0, 1], is %s", p.OracleFeeShare)
+	}
+
+	// A grace as long as the vote period would let every prevote be revealed a vote period late
+	if p.RevealGraceBlocks >= p.VotePeriod {
+		return fmt.Errorf("oracle parameter RevealGraceBlocks must be less than VotePeriod, is %d", p.RevealGraceBlocks)
+	}
+
 	for _, denom := range p.Whitelist {
 		if len(denom.Name) == 0 {
 			return fmt.Errorf("oracle parameter Whitelist Denom must have name")
 		}
 	}
+	if err := p.Whitelist.validateQuoteDenoms(); err != nil {
+		return fmt.Errorf("oracle parameter Whitelist is invalid: %s", err)
+	}
+	if err := p.Whitelist.validateTrackedDenoms(); err != nil {
+		return fmt.Errorf("oracle parameter Whitelist is invalid: %s", err)
+	}
 	return nil
 }
 
@@ -135,12 +369,16 @@ func validateVoteThreshold(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
+	if v.IsNil() {
+		return fmt.Errorf("vote threshold must be set")
+	}
+
 	if v.LT(sdk.NewDecWithPrec(33, 2)) {
 		return fmt.Errorf("vote threshold must be bigger than 33%%: %s", v)
 	}
 
 	if v.GT(sdk.OneDec()) {
-		return fmt.Errorf("vote threshold too large: %s", v)
+		return fmt.Errorf("vote threshold must not exceed 1, no ballot could pass: %s", v)
 	}
 
 	return nil
@@ -152,6 +390,10 @@ func validateRewardBand(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
+	if v.IsNil() {
+		return fmt.Errorf("reward band must be set")
+	}
+
 	if v.IsNegative() {
 		return fmt.Errorf("reward band must be positive: %s", v)
 	}
@@ -188,7 +430,11 @@ func validateWhitelist(i interface{}) error {
 		}
 	}
 
-	return nil
+	if err := v.validateQuoteDenoms(); err != nil {
+		return err
+	}
+
+	return v.validateTrackedDenoms()
 }
 
 func validateSlashFraction(i interface{}) error {
@@ -237,3 +483,248 @@ func validateMinValidPerWindow(i interface{}) error {
 
 	return nil
 }
+
+func validateBool(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateAggregationMethod(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v != AggregationMethodMedian && v != AggregationMethodMode {
+		return fmt.Errorf("aggregation method must be %s or %s: %s", AggregationMethodMedian, AggregationMethodMode, v)
+	}
+
+	return nil
+}
+
+func validateModeBucketPrecision(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > sdk.Precision {
+		return fmt.Errorf("mode bucket precision is too large: %d", v)
+	}
+
+	return nil
+}
+
+func validateAutoDelistAfterStaleWindows(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateDenomGracePeriods(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxCarryForwardPeriods(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateCommitmentHashAlgo(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, err := CommitmentHashSize(v); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validatePowerSmoothingWindows(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxEventDenomsPerBlock(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateFeederChangeCooldownBlocks(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateRevealGraceBlocks(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateVotePeriodDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("vote period duration must be positive or zero: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxPowerShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("max power share must be set")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("max power share must be positive or zero: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max power share is too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateRevealMissWeight(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("reveal miss weight must be set")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("reveal miss weight must be positive or zero: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("reveal miss weight is too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateWhitelistChangeRetention(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxDenomsPerVote(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxVoteFutureDrift(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePostUpgradeGracePeriods(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateEvenMedianRule(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v != EvenMedianRuleLower && v != EvenMedianRuleUpper && v != EvenMedianRuleAverage {
+		return fmt.Errorf("even median rule must be %s, %s or %s: %s", EvenMedianRuleLower, EvenMedianRuleUpper, EvenMedianRuleAverage, v)
+	}
+
+	return nil
+}
+
+func validateMaxMoveWindow(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateOracleFeeShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("oracle fee share must be set")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("oracle fee share must be positive or zero: %s", v)
+	}
+
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("oracle fee share is too large: %s", v)
+	}
+
+	return nil
+}
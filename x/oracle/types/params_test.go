@@ -3,6 +3,7 @@ package types_test
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -57,9 +58,90 @@ func TestParamsEqual(t *testing.T) {
 	err = p7.Validate()
 	require.Error(t, err)
 
+	// unknown aggregation method
+	p8 := types.DefaultParams()
+	p8.AggregationMethod = "mean"
+	err = p8.Validate()
+	require.Error(t, err)
+
+	// unknown even median rule
+	p8 = types.DefaultParams()
+	p8.EvenMedianRule = "middle"
+	err = p8.Validate()
+	require.ErrorContains(t, err, "EvenMedianRule is invalid")
+
+	// too precise mode buckets
+	p9 := types.DefaultParams()
+	p9.ModeBucketPrecision = sdk.Precision + 1
+	err = p9.Validate()
+	require.Error(t, err)
+
+	// progressive slash floor above slash fraction
+	p10 := types.DefaultParams()
+	p10.ProgressiveSlashFloor = p10.SlashFraction.Add(sdk.SmallestDec())
+	err = p10.Validate()
+	require.Error(t, err)
+
+	// unknown commitment hash algorithm
 	p11 := types.DefaultParams()
-	require.NotNil(t, p11.ParamSetPairs())
-	require.NotNil(t, p11.String())
+	p11.CommitmentHashAlgo = "md5"
+	err = p11.Validate()
+	require.Error(t, err)
+
+	// vote threshold no ballot can reach
+	p12 := types.DefaultParams()
+	p12.VoteThreshold = sdk.NewDecWithPrec(101, 2)
+	err = p12.Validate()
+	require.ErrorContains(t, err, "VoteThreshold must be between (0.33, 1]")
+
+	// reward band above 1
+	p13 := types.DefaultParams()
+	p13.RewardBand = sdk.NewDecWithPrec(101, 2)
+	err = p13.Validate()
+	require.ErrorContains(t, err, "RewardBand must be between [0, 1]")
+
+	// unset vote threshold
+	p14 := types.DefaultParams()
+	p14.VoteThreshold = sdk.Dec{}
+	err = p14.Validate()
+	require.Error(t, err)
+
+	// max power share above 1
+	p15 := types.DefaultParams()
+	p15.MaxPowerShare = sdk.NewDecWithPrec(101, 2)
+	err = p15.Validate()
+	require.Error(t, err)
+
+	// reveal miss weight above 1
+	p16 := types.DefaultParams()
+	p16.RevealMissWeight = sdk.NewDecWithPrec(101, 2)
+	err = p16.Validate()
+	require.ErrorContains(t, err, "RevealMissWeight must be between [0, 1]")
+
+	// quote denom not whitelisted
+	p17 := types.DefaultParams()
+	p17.Whitelist = types.DenomList{{Name: "lp", QuoteDenom: "atom"}}
+	err = p17.Validate()
+	require.ErrorContains(t, err, "quote denom atom of lp is not whitelisted")
+	p17.Whitelist = types.DenomList{{Name: "wbtc", Tracks: "wbtc"}}
+	err = p17.Validate()
+	require.ErrorContains(t, err, "tracked denoms of wbtc form a cycle")
+
+	// reveal grace as long as the vote period
+	p18 := types.DefaultParams()
+	p18.RevealGraceBlocks = p18.VotePeriod
+	err = p18.Validate()
+	require.ErrorContains(t, err, "RevealGraceBlocks must be less than VotePeriod")
+
+	// oracle fee share above 1
+	p19 := types.DefaultParams()
+	p19.OracleFeeShare = sdk.NewDecWithPrec(101, 2)
+	err = p19.Validate()
+	require.ErrorContains(t, err, "OracleFeeShare must be between [0, 1]")
+
+	p20 := types.DefaultParams()
+	require.NotNil(t, p20.ParamSetPairs())
+	require.NotNil(t, p20.String())
 }
 
 func TestValidate(t *testing.T) {
@@ -75,9 +157,13 @@ func TestValidate(t *testing.T) {
 			require.Error(t, pair.ValidatorFn(uint64(0)))
 		case bytes.Compare(types.KeyVoteThreshold, pair.Key) == 0:
 			require.NoError(t, pair.ValidatorFn(sdk.NewDecWithPrec(33, 2)))
+			require.NoError(t, pair.ValidatorFn(sdk.OneDec()))
 			require.Error(t, pair.ValidatorFn("invalid"))
+			require.Error(t, pair.ValidatorFn(sdk.ZeroDec()))
+			require.Error(t, pair.ValidatorFn(sdk.NewDecWithPrec(-1, 2)))
 			require.Error(t, pair.ValidatorFn(sdk.NewDecWithPrec(32, 2)))
 			require.Error(t, pair.ValidatorFn(sdk.NewDecWithPrec(101, 2)))
+			require.Error(t, pair.ValidatorFn(sdk.Dec{}))
 		case bytes.Compare(types.KeyRewardBand, pair.Key) == 0 ||
 			bytes.Compare(types.KeySlashFraction, pair.Key) == 0 ||
 			bytes.Compare(types.KeyMinValidPerWindow, pair.Key) == 0:
@@ -85,12 +171,150 @@ func TestValidate(t *testing.T) {
 			require.Error(t, pair.ValidatorFn("invalid"))
 			require.Error(t, pair.ValidatorFn(sdk.NewDecWithPrec(-1, 2)))
 			require.Error(t, pair.ValidatorFn(sdk.NewDecWithPrec(101, 2)))
+		case bytes.Compare(types.KeyExcludeJailedFromThreshold, pair.Key) == 0 ||
+			bytes.Compare(types.KeyAccuracyWeightedRewards, pair.Key) == 0 ||
+			bytes.Compare(types.KeyLegacyRateEvents, pair.Key) == 0:
+			require.NoError(t, pair.ValidatorFn(true))
+			require.Error(t, pair.ValidatorFn("invalid"))
+		case bytes.Compare(types.KeyEvenMedianRule, pair.Key) == 0:
+			require.NoError(t, pair.ValidatorFn(types.EvenMedianRuleLower))
+			require.NoError(t, pair.ValidatorFn(types.EvenMedianRuleUpper))
+			require.NoError(t, pair.ValidatorFn(types.EvenMedianRuleAverage))
+			require.Error(t, pair.ValidatorFn(""))
+			require.Error(t, pair.ValidatorFn(1))
+		case bytes.Compare(types.KeyAggregationMethod, pair.Key) == 0:
+			require.NoError(t, pair.ValidatorFn(types.AggregationMethodMedian))
+			require.NoError(t, pair.ValidatorFn(types.AggregationMethodMode))
+			require.Error(t, pair.ValidatorFn("mean"))
+			require.Error(t, pair.ValidatorFn(uint64(1)))
+		case bytes.Compare(types.KeyModeBucketPrecision, pair.Key) == 0:
+			require.NoError(t, pair.ValidatorFn(uint64(0)))
+			require.NoError(t, pair.ValidatorFn(uint64(sdk.Precision)))
+			require.Error(t, pair.ValidatorFn(uint64(sdk.Precision+1)))
+			require.Error(t, pair.ValidatorFn("invalid"))
+		case bytes.Compare(types.KeyAutoDelistAfterStaleWindows, pair.Key) == 0:
+			require.NoError(t, pair.ValidatorFn(uint64(0)))
+			require.NoError(t, pair.ValidatorFn(uint64(10)))
+			require.Error(t, pair.ValidatorFn("invalid"))
+		case bytes.Compare(types.KeyDenomGracePeriods, pair.Key) == 0:
+			require.NoError(t, pair.ValidatorFn(uint64(0)))
+			require.NoError(t, pair.ValidatorFn(uint64(100)))
+			require.Error(t, pair.ValidatorFn("invalid"))
+		case bytes.Compare(types.KeyProgressiveSlashing, pair.Key) == 0:
+			require.NoError(t, pair.ValidatorFn(true))
+			require.Error(t, pair.ValidatorFn("invalid"))
+		case bytes.Compare(types.KeyProgressiveSlashFloor, pair.Key) == 0:
+			require.NoError(t, pair.ValidatorFn(sdk.ZeroDec()))
+			require.NoError(t, pair.ValidatorFn(sdk.NewDecWithPrec(1, 5)))
+			require.Error(t, pair.ValidatorFn("invalid"))
+			require.Error(t, pair.ValidatorFn(sdk.NewDecWithPrec(-1, 5)))
+			require.Error(t, pair.ValidatorFn(sdk.NewDecWithPrec(101, 2)))
+		case bytes.Compare(types.KeyCommitmentHashAlgo, pair.Key) == 0:
+			require.NoError(t, pair.ValidatorFn(types.CommitmentHashAlgoSHA256Truncated))
+			require.NoError(t, pair.ValidatorFn(types.CommitmentHashAlgoSHA256))
+			require.Error(t, pair.ValidatorFn("md5"))
+			require.Error(t, pair.ValidatorFn(""))
+			require.Error(t, pair.ValidatorFn(1))
+		case bytes.Compare(types.KeyPowerSmoothingWindows, pair.Key) == 0:
+			require.NoError(t, pair.ValidatorFn(uint64(0)))
+			require.NoError(t, pair.ValidatorFn(uint64(9)))
+			require.Error(t, pair.ValidatorFn("invalid"))
+		case bytes.Compare(types.KeyWhitelistChangeRetention, pair.Key) == 0 ||
+			bytes.Compare(types.KeyMaxDenomsPerVote, pair.Key) == 0 ||
+			bytes.Compare(types.KeyMaxVoteFutureDrift, pair.Key) == 0 ||
+			bytes.Compare(types.KeyPostUpgradeGracePeriods, pair.Key) == 0 ||
+			bytes.Compare(types.KeyMaxMoveWindow, pair.Key) == 0:
+			require.NoError(t, pair.ValidatorFn(uint64(0)))
+			require.NoError(t, pair.ValidatorFn(uint64(1000)))
+			require.Error(t, pair.ValidatorFn("invalid"))
+		case bytes.Compare(types.KeyOracleFeeShare, pair.Key) == 0:
+			require.NoError(t, pair.ValidatorFn(sdk.ZeroDec()))
+			require.NoError(t, pair.ValidatorFn(sdk.NewDecWithPrec(1, 1)))
+			require.NoError(t, pair.ValidatorFn(sdk.OneDec()))
+			require.Error(t, pair.ValidatorFn(sdk.NewDecWithPrec(-1, 2)))
+			require.Error(t, pair.ValidatorFn(sdk.NewDecWithPrec(101, 2)))
+			require.Error(t, pair.ValidatorFn(sdk.Dec{}))
+			require.Error(t, pair.ValidatorFn("invalid"))
+		case bytes.Compare(types.KeyMaxPowerShare, pair.Key) == 0:
+			require.NoError(t, pair.ValidatorFn(sdk.ZeroDec()))
+			require.NoError(t, pair.ValidatorFn(sdk.NewDecWithPrec(2, 1)))
+			require.NoError(t, pair.ValidatorFn(sdk.OneDec()))
+			require.Error(t, pair.ValidatorFn(sdk.NewDecWithPrec(-1, 2)))
+			require.Error(t, pair.ValidatorFn(sdk.NewDecWithPrec(101, 2)))
+			require.Error(t, pair.ValidatorFn(sdk.Dec{}))
+			require.Error(t, pair.ValidatorFn("invalid"))
+		case bytes.Compare(types.KeyRevealMissWeight, pair.Key) == 0:
+			require.NoError(t, pair.ValidatorFn(sdk.ZeroDec()))
+			require.NoError(t, pair.ValidatorFn(sdk.NewDecWithPrec(5, 1)))
+			require.NoError(t, pair.ValidatorFn(sdk.OneDec()))
+			require.Error(t, pair.ValidatorFn(sdk.NewDecWithPrec(-1, 2)))
+			require.Error(t, pair.ValidatorFn(sdk.NewDecWithPrec(101, 2)))
+			require.Error(t, pair.ValidatorFn(sdk.Dec{}))
+			require.Error(t, pair.ValidatorFn("invalid"))
+		case bytes.Compare(types.KeyVotePeriodDuration, pair.Key) == 0:
+			require.NoError(t, pair.ValidatorFn(time.Duration(0)))
+			require.NoError(t, pair.ValidatorFn(30*time.Second))
+			require.Error(t, pair.ValidatorFn(-time.Second))
+			require.Error(t, pair.ValidatorFn("invalid"))
+		case bytes.Compare(types.KeyMaxCarryForwardPeriods, pair.Key) == 0:
+			require.NoError(t, pair.ValidatorFn(uint64(0)))
+			require.NoError(t, pair.ValidatorFn(uint64(3)))
+			require.Error(t, pair.ValidatorFn("invalid"))
+		case bytes.Compare(types.KeyMaxEventDenomsPerBlock, pair.Key) == 0 ||
+			bytes.Compare(types.KeyFeederChangeCooldownBlocks, pair.Key) == 0 ||
+			bytes.Compare(types.KeyRevealGraceBlocks, pair.Key) == 0:
+			require.NoError(t, pair.ValidatorFn(uint64(0)))
+			require.NoError(t, pair.ValidatorFn(uint64(20)))
+			require.Error(t, pair.ValidatorFn("invalid"))
 		case bytes.Compare(types.KeyWhitelist, pair.Key) == 0:
 			require.NoError(t, pair.ValidatorFn(types.DenomList{}))
 			require.Error(t, pair.ValidatorFn("invalid"))
 			require.Error(t, pair.ValidatorFn(types.DenomList{
 				{Name: ""},
 			}))
+			require.NoError(t, pair.ValidatorFn(types.DenomList{
+				{Name: "lp", QuoteDenom: "atom"},
+				{Name: "atom", QuoteDenom: "usdc"},
+				{Name: "usdc"},
+			}))
+			// quote denom not whitelisted
+			require.Error(t, pair.ValidatorFn(types.DenomList{
+				{Name: "lp", QuoteDenom: "atom"},
+			}))
+			// quote denoms not ending in USD
+			require.Error(t, pair.ValidatorFn(types.DenomList{
+				{Name: "lp", QuoteDenom: "lp"},
+			}))
+			require.Error(t, pair.ValidatorFn(types.DenomList{
+				{Name: "lp", QuoteDenom: "atom"},
+				{Name: "atom", QuoteDenom: "usdc"},
+				{Name: "usdc", QuoteDenom: "lp"},
+			}))
+			require.NoError(t, pair.ValidatorFn(types.DenomList{
+				{Name: "stwbtc", Tracks: "wbtc"},
+				{Name: "wbtc", Tracks: "btc"},
+				{Name: "btc"},
+			}))
+			// tracked denom not whitelisted
+			require.Error(t, pair.ValidatorFn(types.DenomList{
+				{Name: "wbtc", Tracks: "btc"},
+			}))
+			// tracked denom quoted in another denom
+			require.Error(t, pair.ValidatorFn(types.DenomList{
+				{Name: "wbtc", Tracks: "btc", QuoteDenom: "usdc"},
+				{Name: "btc"},
+				{Name: "usdc"},
+			}))
+			// tracking denom with a vote period multiplier
+			require.Error(t, pair.ValidatorFn(types.DenomList{
+				{Name: "wbtc", Tracks: "btc", VotePeriodMultiplier: 2},
+				{Name: "btc"},
+			}))
+			// tracked denoms forming a cycle
+			require.Error(t, pair.ValidatorFn(types.DenomList{
+				{Name: "wbtc", Tracks: "btc"},
+				{Name: "btc", Tracks: "wbtc"},
+			}))
 		}
 	}
 }
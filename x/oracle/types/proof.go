@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+	tmcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ExchangeRateProofPath is the ABCI query path returning an exchange rate as stored, along
+// with its Merkle proof if requested, for the key of GetExchangeRateKey
+const ExchangeRateProofPath = "/store/" + StoreKey + "/key"
+
+// ExchangeRateProofKeyPath returns the Merkle key path of the exchange rate of the denom,
+// from the app hash through the oracle store
+func ExchangeRateProofKeyPath(denom string) string {
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(StoreKey), merkle.KeyEncodingURL).
+		AppendKey(GetExchangeRateKey(denom), merkle.KeyEncodingHex)
+
+	return keyPath.String()
+}
+
+// VerifyExchangeRateProof verifies the proof of the exchange rate of the denom against the app
+// hash. The proof of a query at height H is verified against the app hash of the block H+1,
+// which commits the state of the block H.
+func VerifyExchangeRateProof(proof *tmcrypto.ProofOps, appHash []byte, denom string, exchangeRate sdk.Dec) error {
+	if proof == nil {
+		return fmt.Errorf("no proof of the exchange rate of %s", denom)
+	}
+
+	value, err := (&sdk.DecProto{Dec: exchangeRate}).Marshal()
+	if err != nil {
+		return err
+	}
+
+	return rootmulti.DefaultProofRuntime().VerifyValue(proof, appHash, ExchangeRateProofKeyPath(denom), value)
+}
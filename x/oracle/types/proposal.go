@@ -0,0 +1,176 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+// MaxObserverExemptionBlocks is the maximum number of blocks an observer is exempt from
+// slashing for, about a month at six second blocks
+const MaxObserverExemptionBlocks = 432000
+
+const (
+	// ProposalTypeRenameDenom defines the type for a RenameDenomProposal
+	ProposalTypeRenameDenom = "RenameDenom"
+	// ProposalTypeDelistDenom defines the type for a DelistDenomProposal
+	ProposalTypeDelistDenom = "DelistDenom"
+	// ProposalTypeSetObserver defines the type for a SetObserverProposal
+	ProposalTypeSetObserver = "SetObserver"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeRenameDenom)
+	govtypes.RegisterProposalType(ProposalTypeDelistDenom)
+	govtypes.RegisterProposalType(ProposalTypeSetObserver)
+}
+
+var (
+	_ govtypes.Content = &RenameDenomProposal{}
+	_ govtypes.Content = &DelistDenomProposal{}
+	_ govtypes.Content = &SetObserverProposal{}
+)
+
+// NewRenameDenomProposal creates a new RenameDenomProposal instance
+func NewRenameDenomProposal(title, description, oldDenom, newDenom string) *RenameDenomProposal {
+	return &RenameDenomProposal{
+		Title:       title,
+		Description: description,
+		OldDenom:    oldDenom,
+		NewDenom:    newDenom,
+	}
+}
+
+// GetTitle returns the title of the proposal
+func (p *RenameDenomProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p *RenameDenomProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p *RenameDenomProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *RenameDenomProposal) ProposalType() string { return ProposalTypeRenameDenom }
+
+// ValidateBasic validates the proposal
+func (p *RenameDenomProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if len(p.OldDenom) == 0 || len(p.NewDenom) == 0 {
+		return errors.Wrap(ErrInvalidDenom, "old and new denom must be set")
+	}
+
+	if p.OldDenom == p.NewDenom {
+		return errors.Wrapf(ErrInvalidDenom, "new denom must differ from the old denom %s", p.OldDenom)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (p RenameDenomProposal) String() string {
+	return fmt.Sprintf(`Rename Denom Proposal:
+  Title:       %s
+  Description: %s
+  Old Denom:   %s
+  New Denom:   %s
+`, p.Title, p.Description, p.OldDenom, p.NewDenom)
+}
+
+// NewDelistDenomProposal creates a new DelistDenomProposal instance
+func NewDelistDenomProposal(title, description, denom string) *DelistDenomProposal {
+	return &DelistDenomProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+	}
+}
+
+// GetTitle returns the title of the proposal
+func (p *DelistDenomProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p *DelistDenomProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p *DelistDenomProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *DelistDenomProposal) ProposalType() string { return ProposalTypeDelistDenom }
+
+// ValidateBasic validates the proposal
+func (p *DelistDenomProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if len(p.Denom) == 0 {
+		return errors.Wrap(ErrInvalidDenom, "denom must be set")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (p DelistDenomProposal) String() string {
+	return fmt.Sprintf(`Delist Denom Proposal:
+  Title:       %s
+  Description: %s
+  Denom:       %s
+`, p.Title, p.Description, p.Denom)
+}
+
+// NewSetObserverProposal creates a new SetObserverProposal instance
+func NewSetObserverProposal(title, description string, validator sdk.ValAddress, exemptionBlocks uint64) *SetObserverProposal {
+	return &SetObserverProposal{
+		Title:           title,
+		Description:     description,
+		Validator:       validator.String(),
+		ExemptionBlocks: exemptionBlocks,
+	}
+}
+
+// GetTitle returns the title of the proposal
+func (p *SetObserverProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of the proposal
+func (p *SetObserverProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of the proposal
+func (p *SetObserverProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal
+func (p *SetObserverProposal) ProposalType() string { return ProposalTypeSetObserver }
+
+// ValidateBasic validates the proposal
+func (p *SetObserverProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if _, err := sdk.ValAddressFromBech32(p.Validator); err != nil {
+		return errors.Wrap(ErrInvalidValidator, err.Error())
+	}
+
+	if p.ExemptionBlocks > MaxObserverExemptionBlocks {
+		return errors.Wrapf(ErrInvalidExemption, "exemption of %d blocks exceeds %d", p.ExemptionBlocks, MaxObserverExemptionBlocks)
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface
+func (p SetObserverProposal) String() string {
+	return fmt.Sprintf(`Set Observer Proposal:
+  Title:            %s
+  Description:      %s
+  Validator:        %s
+  Exemption Blocks: %d
+`, p.Title, p.Description, p.Validator, p.ExemptionBlocks)
+}
@@ -0,0 +1,39 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Team-Kujira/core/x/oracle/types"
+)
+
+func TestRenameDenomProposalValidateBasic(t *testing.T) {
+	require.NoError(t, types.NewRenameDenomProposal("title", "description", "ibc/AAA", "ibc/BBB").ValidateBasic())
+
+	require.Error(t, types.NewRenameDenomProposal("", "description", "ibc/AAA", "ibc/BBB").ValidateBasic())
+	require.Error(t, types.NewRenameDenomProposal("title", "", "ibc/AAA", "ibc/BBB").ValidateBasic())
+	require.ErrorIs(t, types.NewRenameDenomProposal("title", "description", "", "ibc/BBB").ValidateBasic(), types.ErrInvalidDenom)
+	require.ErrorIs(t, types.NewRenameDenomProposal("title", "description", "ibc/AAA", "").ValidateBasic(), types.ErrInvalidDenom)
+	require.ErrorIs(t, types.NewRenameDenomProposal("title", "description", "ibc/AAA", "ibc/AAA").ValidateBasic(), types.ErrInvalidDenom)
+}
+
+func TestDelistDenomProposalValidateBasic(t *testing.T) {
+	require.NoError(t, types.NewDelistDenomProposal("title", "description", "ibc/AAA").ValidateBasic())
+
+	require.Error(t, types.NewDelistDenomProposal("", "description", "ibc/AAA").ValidateBasic())
+	require.Error(t, types.NewDelistDenomProposal("title", "", "ibc/AAA").ValidateBasic())
+	require.ErrorIs(t, types.NewDelistDenomProposal("title", "description", "").ValidateBasic(), types.ErrInvalidDenom)
+}
+
+func TestSetObserverProposalValidateBasic(t *testing.T) {
+	validator := sdk.ValAddress([]byte("validator"))
+	require.NoError(t, types.NewSetObserverProposal("title", "description", validator, 100).ValidateBasic())
+	require.NoError(t, types.NewSetObserverProposal("title", "description", validator, 0).ValidateBasic())
+
+	require.Error(t, types.NewSetObserverProposal("", "description", validator, 100).ValidateBasic())
+	require.ErrorIs(t, types.NewSetObserverProposal("title", "description", nil, 100).ValidateBasic(), types.ErrInvalidValidator)
+	require.ErrorIs(t, types.NewSetObserverProposal("title", "description", validator, types.MaxObserverExemptionBlocks+1).ValidateBasic(), types.ErrInvalidExemption)
+}
@@ -18,6 +18,19 @@ const (
 	QueryAggregateVotes    = "aggregateVotes"
 )
 
+// DefaultMedianFlipMove is the relative move of the median the MedianFlipCost query estimates
+// the cost of by default
+var DefaultMedianFlipMove = sdk.NewDecWithPrec(5, 2)
+
+// DefaultHistogramBuckets is the number of buckets of the BallotHistogram query by default
+const DefaultHistogramBuckets = 20
+
+// MaxHistogramBuckets is the most buckets the BallotHistogram query splits a ballot into
+const MaxHistogramBuckets = 1000
+
+// DefaultParticipationSeriesLimit is the number of vote periods the ParticipationSeries query returns by default
+const DefaultParticipationSeriesLimit = 100
+
 // QueryExchangeRateParams defines the params for the following queries:
 // - 'custom/oracle/exchange_rate'
 type QueryExchangeRateParams struct {
@@ -8,22 +8,27 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -75,6 +80,25 @@ var xxx_messageInfo_QueryExchangeRateRequest proto.InternalMessageInfo
 type QueryExchangeRateResponse struct {
 	// exchange_rate defines the exchange rate of whitelisted assets
 	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate"`
+	// carried_forward defines whether the denom failed to tally in the last vote period
+	// and the exchange rate was carried forward from an earlier one.
+	CarriedForward bool `protobuf:"varint,2,opt,name=carried_forward,json=carriedForward,proto3" json:"carried_forward,omitempty"`
+	// carried_periods defines the number of consecutive vote periods the exchange rate was carried forward.
+	CarriedPeriods uint64 `protobuf:"varint,3,opt,name=carried_periods,json=carriedPeriods,proto3" json:"carried_periods,omitempty"`
+	// age_periods defines the number of vote periods since the denom was last tallied.
+	// 0 means it was tallied at the end of the last vote period.
+	AgePeriods uint64 `protobuf:"varint,4,opt,name=age_periods,json=agePeriods,proto3" json:"age_periods,omitempty"`
+	// quote_denom defines the denom the exchange rate is quoted in, USD if empty.
+	QuoteDenom string `protobuf:"bytes,5,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// usd_exchange_rate defines the exchange rate converted to USD through the
+	// exchange rates of the quote denoms, zero if one of them is missing.
+	UsdExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=usd_exchange_rate,json=usdExchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"usd_exchange_rate"`
+	// voter_count defines the number of distinct validators which rated the denom
+	// in its last successful tally, i.e. its votes less the abstaining ones.
+	VoterCount uint64 `protobuf:"varint,7,opt,name=voter_count,json=voterCount,proto3" json:"voter_count,omitempty"`
+	// tracks defines the denom whose exchange rate the denom mirrors, empty if it
+	// is tallied from its own ballot. The other fields then derive from that denom.
+	Tracks string `protobuf:"bytes,8,opt,name=tracks,proto3" json:"tracks,omitempty"`
 }
 
 func (m *QueryExchangeRateResponse) Reset()         { *m = QueryExchangeRateResponse{} }
@@ -110,6 +134,48 @@ func (m *QueryExchangeRateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryExchangeRateResponse proto.InternalMessageInfo
 
+func (m *QueryExchangeRateResponse) GetCarriedForward() bool {
+	if m != nil {
+		return m.CarriedForward
+	}
+	return false
+}
+
+func (m *QueryExchangeRateResponse) GetCarriedPeriods() uint64 {
+	if m != nil {
+		return m.CarriedPeriods
+	}
+	return 0
+}
+
+func (m *QueryExchangeRateResponse) GetAgePeriods() uint64 {
+	if m != nil {
+		return m.AgePeriods
+	}
+	return 0
+}
+
+func (m *QueryExchangeRateResponse) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *QueryExchangeRateResponse) GetVoterCount() uint64 {
+	if m != nil {
+		return m.VoterCount
+	}
+	return 0
+}
+
+func (m *QueryExchangeRateResponse) GetTracks() string {
+	if m != nil {
+		return m.Tracks
+	}
+	return ""
+}
+
 // QueryExchangeRatesRequest is the request type for the Query/ExchangeRates RPC method.
 type QueryExchangeRatesRequest struct {
 }
@@ -152,6 +218,8 @@ var xxx_messageInfo_QueryExchangeRatesRequest proto.InternalMessageInfo
 type QueryExchangeRatesResponse struct {
 	// exchange_rates defines a list of the exchange rate for all whitelisted denoms.
 	ExchangeRates github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=exchange_rates,json=exchangeRates,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"exchange_rates"`
+	// age_periods defines the age of each exchange rate, in the order of exchange_rates.
+	AgePeriods []ExchangeRateAge `protobuf:"bytes,2,rep,name=age_periods,json=agePeriods,proto3" json:"age_periods"`
 }
 
 func (m *QueryExchangeRatesResponse) Reset()         { *m = QueryExchangeRatesResponse{} }
@@ -194,6 +262,68 @@ func (m *QueryExchangeRatesResponse) GetExchangeRates() github_com_cosmos_cosmos
 	return nil
 }
 
+func (m *QueryExchangeRatesResponse) GetAgePeriods() []ExchangeRateAge {
+	if m != nil {
+		return m.AgePeriods
+	}
+	return nil
+}
+
+// ExchangeRateAge defines the age of the exchange rate of a denom.
+type ExchangeRateAge struct {
+	// denom defines the denomination of the exchange rate.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// age_periods defines the number of vote periods since the denom was last tallied.
+	AgePeriods uint64 `protobuf:"varint,2,opt,name=age_periods,json=agePeriods,proto3" json:"age_periods,omitempty"`
+}
+
+func (m *ExchangeRateAge) Reset()         { *m = ExchangeRateAge{} }
+func (m *ExchangeRateAge) String() string { return proto.CompactTextString(m) }
+func (*ExchangeRateAge) ProtoMessage()    {}
+func (*ExchangeRateAge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{4}
+}
+func (m *ExchangeRateAge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeRateAge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeRateAge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeRateAge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRateAge.Merge(m, src)
+}
+func (m *ExchangeRateAge) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeRateAge) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRateAge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRateAge proto.InternalMessageInfo
+
+func (m *ExchangeRateAge) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ExchangeRateAge) GetAgePeriods() uint64 {
+	if m != nil {
+		return m.AgePeriods
+	}
+	return 0
+}
+
 // QueryActivesRequest is the request type for the Query/Actives RPC method.
 type QueryActivesRequest struct {
 }
@@ -202,7 +332,7 @@ func (m *QueryActivesRequest) Reset()         { *m = QueryActivesRequest{} }
 func (m *QueryActivesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryActivesRequest) ProtoMessage()    {}
 func (*QueryActivesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b180a0d90a2c8cf7, []int{4}
+	return fileDescriptor_b180a0d90a2c8cf7, []int{5}
 }
 func (m *QueryActivesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -242,7 +372,7 @@ func (m *QueryActivesResponse) Reset()         { *m = QueryActivesResponse{} }
 func (m *QueryActivesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryActivesResponse) ProtoMessage()    {}
 func (*QueryActivesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b180a0d90a2c8cf7, []int{5}
+	return fileDescriptor_b180a0d90a2c8cf7, []int{6}
 }
 func (m *QueryActivesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,7 +416,7 @@ func (m *QueryVoteTargetsRequest) Reset()         { *m = QueryVoteTargetsRequest
 func (m *QueryVoteTargetsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteTargetsRequest) ProtoMessage()    {}
 func (*QueryVoteTargetsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b180a0d90a2c8cf7, []int{6}
+	return fileDescriptor_b180a0d90a2c8cf7, []int{7}
 }
 func (m *QueryVoteTargetsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -327,7 +457,7 @@ func (m *QueryVoteTargetsResponse) Reset()         { *m = QueryVoteTargetsRespon
 func (m *QueryVoteTargetsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteTargetsResponse) ProtoMessage()    {}
 func (*QueryVoteTargetsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b180a0d90a2c8cf7, []int{7}
+	return fileDescriptor_b180a0d90a2c8cf7, []int{8}
 }
 func (m *QueryVoteTargetsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -373,7 +503,7 @@ func (m *QueryFeederDelegationRequest) Reset()         { *m = QueryFeederDelegat
 func (m *QueryFeederDelegationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFeederDelegationRequest) ProtoMessage()    {}
 func (*QueryFeederDelegationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b180a0d90a2c8cf7, []int{8}
+	return fileDescriptor_b180a0d90a2c8cf7, []int{9}
 }
 func (m *QueryFeederDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -413,7 +543,7 @@ func (m *QueryFeederDelegationResponse) Reset()         { *m = QueryFeederDelega
 func (m *QueryFeederDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFeederDelegationResponse) ProtoMessage()    {}
 func (*QueryFeederDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b180a0d90a2c8cf7, []int{9}
+	return fileDescriptor_b180a0d90a2c8cf7, []int{10}
 }
 func (m *QueryFeederDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,7 +589,7 @@ func (m *QueryMissCounterRequest) Reset()         { *m = QueryMissCounterRequest
 func (m *QueryMissCounterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMissCounterRequest) ProtoMessage()    {}
 func (*QueryMissCounterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b180a0d90a2c8cf7, []int{10}
+	return fileDescriptor_b180a0d90a2c8cf7, []int{11}
 }
 func (m *QueryMissCounterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -493,13 +623,16 @@ var xxx_messageInfo_QueryMissCounterRequest proto.InternalMessageInfo
 type QueryMissCounterResponse struct {
 	// miss_counter defines the oracle miss counter of a validator
 	MissCounter uint64 `protobuf:"varint,1,opt,name=miss_counter,json=missCounter,proto3" json:"miss_counter,omitempty"`
+	// reveal_miss_counter defines the number of the missed vote periods in which
+	// the validator prevoted but did not reveal its vote.
+	RevealMissCounter uint64 `protobuf:"varint,2,opt,name=reveal_miss_counter,json=revealMissCounter,proto3" json:"reveal_miss_counter,omitempty"`
 }
 
 func (m *QueryMissCounterResponse) Reset()         { *m = QueryMissCounterResponse{} }
 func (m *QueryMissCounterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMissCounterResponse) ProtoMessage()    {}
 func (*QueryMissCounterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b180a0d90a2c8cf7, []int{11}
+	return fileDescriptor_b180a0d90a2c8cf7, []int{12}
 }
 func (m *QueryMissCounterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -535,6 +668,191 @@ func (m *QueryMissCounterResponse) GetMissCounter() uint64 {
 	return 0
 }
 
+func (m *QueryMissCounterResponse) GetRevealMissCounter() uint64 {
+	if m != nil {
+		return m.RevealMissCounter
+	}
+	return 0
+}
+
+// QueryMissCountersRequest is the request type for the Query/MissCounters RPC method.
+type QueryMissCountersRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMissCountersRequest) Reset()         { *m = QueryMissCountersRequest{} }
+func (m *QueryMissCountersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMissCountersRequest) ProtoMessage()    {}
+func (*QueryMissCountersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{13}
+}
+func (m *QueryMissCountersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMissCountersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMissCountersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMissCountersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissCountersRequest.Merge(m, src)
+}
+func (m *QueryMissCountersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMissCountersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissCountersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMissCountersRequest proto.InternalMessageInfo
+
+func (m *QueryMissCountersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMissCountersResponse is response type for the
+// Query/MissCounters RPC method.
+type QueryMissCountersResponse struct {
+	// miss_counters defines the miss counters of the validators with missed votes.
+	MissCounters []MissCounterStatus `protobuf:"bytes,1,rep,name=miss_counters,json=missCounters,proto3" json:"miss_counters"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMissCountersResponse) Reset()         { *m = QueryMissCountersResponse{} }
+func (m *QueryMissCountersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMissCountersResponse) ProtoMessage()    {}
+func (*QueryMissCountersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{14}
+}
+func (m *QueryMissCountersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMissCountersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMissCountersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMissCountersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissCountersResponse.Merge(m, src)
+}
+func (m *QueryMissCountersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMissCountersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissCountersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMissCountersResponse proto.InternalMessageInfo
+
+func (m *QueryMissCountersResponse) GetMissCounters() []MissCounterStatus {
+	if m != nil {
+		return m.MissCounters
+	}
+	return nil
+}
+
+func (m *QueryMissCountersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// MissCounterStatus defines the miss counter of a validator in the current slash window.
+type MissCounterStatus struct {
+	// validator_addr defines the validator address.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	// miss_counter defines the oracle miss counter of the validator.
+	MissCounter uint64 `protobuf:"varint,2,opt,name=miss_counter,json=missCounter,proto3" json:"miss_counter,omitempty"`
+	// at_risk defines whether the validator already missed too many votes to
+	// reach min_valid_per_window by the end of the slash window. With timed vote
+	// periods, the window is measured by the vote periods closed so far.
+	AtRisk bool `protobuf:"varint,3,opt,name=at_risk,json=atRisk,proto3" json:"at_risk,omitempty"`
+	// reveal_miss_counter defines the number of the missed vote periods in which
+	// the validator prevoted but did not reveal its vote.
+	RevealMissCounter uint64 `protobuf:"varint,4,opt,name=reveal_miss_counter,json=revealMissCounter,proto3" json:"reveal_miss_counter,omitempty"`
+}
+
+func (m *MissCounterStatus) Reset()         { *m = MissCounterStatus{} }
+func (m *MissCounterStatus) String() string { return proto.CompactTextString(m) }
+func (*MissCounterStatus) ProtoMessage()    {}
+func (*MissCounterStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{15}
+}
+func (m *MissCounterStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MissCounterStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MissCounterStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MissCounterStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MissCounterStatus.Merge(m, src)
+}
+func (m *MissCounterStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MissCounterStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MissCounterStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MissCounterStatus proto.InternalMessageInfo
+
+func (m *MissCounterStatus) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+func (m *MissCounterStatus) GetMissCounter() uint64 {
+	if m != nil {
+		return m.MissCounter
+	}
+	return 0
+}
+
+func (m *MissCounterStatus) GetAtRisk() bool {
+	if m != nil {
+		return m.AtRisk
+	}
+	return false
+}
+
+func (m *MissCounterStatus) GetRevealMissCounter() uint64 {
+	if m != nil {
+		return m.RevealMissCounter
+	}
+	return 0
+}
+
 // QueryAggregatePrevoteRequest is the request type for the Query/AggregatePrevote RPC method.
 type QueryAggregatePrevoteRequest struct {
 	// validator defines the validator address to query for.
@@ -545,7 +863,7 @@ func (m *QueryAggregatePrevoteRequest) Reset()         { *m = QueryAggregatePrev
 func (m *QueryAggregatePrevoteRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevoteRequest) ProtoMessage()    {}
 func (*QueryAggregatePrevoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b180a0d90a2c8cf7, []int{12}
+	return fileDescriptor_b180a0d90a2c8cf7, []int{16}
 }
 func (m *QueryAggregatePrevoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -585,7 +903,7 @@ func (m *QueryAggregatePrevoteResponse) Reset()         { *m = QueryAggregatePre
 func (m *QueryAggregatePrevoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevoteResponse) ProtoMessage()    {}
 func (*QueryAggregatePrevoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b180a0d90a2c8cf7, []int{13}
+	return fileDescriptor_b180a0d90a2c8cf7, []int{17}
 }
 func (m *QueryAggregatePrevoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -629,7 +947,7 @@ func (m *QueryAggregatePrevotesRequest) Reset()         { *m = QueryAggregatePre
 func (m *QueryAggregatePrevotesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevotesRequest) ProtoMessage()    {}
 func (*QueryAggregatePrevotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b180a0d90a2c8cf7, []int{14}
+	return fileDescriptor_b180a0d90a2c8cf7, []int{18}
 }
 func (m *QueryAggregatePrevotesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -669,7 +987,7 @@ func (m *QueryAggregatePrevotesResponse) Reset()         { *m = QueryAggregatePr
 func (m *QueryAggregatePrevotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevotesResponse) ProtoMessage()    {}
 func (*QueryAggregatePrevotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b180a0d90a2c8cf7, []int{15}
+	return fileDescriptor_b180a0d90a2c8cf7, []int{19}
 }
 func (m *QueryAggregatePrevotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -715,7 +1033,7 @@ func (m *QueryAggregateVoteRequest) Reset()         { *m = QueryAggregateVoteReq
 func (m *QueryAggregateVoteRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVoteRequest) ProtoMessage()    {}
 func (*QueryAggregateVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b180a0d90a2c8cf7, []int{16}
+	return fileDescriptor_b180a0d90a2c8cf7, []int{20}
 }
 func (m *QueryAggregateVoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -755,7 +1073,7 @@ func (m *QueryAggregateVoteResponse) Reset()         { *m = QueryAggregateVoteRe
 func (m *QueryAggregateVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVoteResponse) ProtoMessage()    {}
 func (*QueryAggregateVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b180a0d90a2c8cf7, []int{17}
+	return fileDescriptor_b180a0d90a2c8cf7, []int{21}
 }
 func (m *QueryAggregateVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -799,7 +1117,7 @@ func (m *QueryAggregateVotesRequest) Reset()         { *m = QueryAggregateVotesR
 func (m *QueryAggregateVotesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVotesRequest) ProtoMessage()    {}
 func (*QueryAggregateVotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b180a0d90a2c8cf7, []int{18}
+	return fileDescriptor_b180a0d90a2c8cf7, []int{22}
 }
 func (m *QueryAggregateVotesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -839,7 +1157,7 @@ func (m *QueryAggregateVotesResponse) Reset()         { *m = QueryAggregateVotes
 func (m *QueryAggregateVotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVotesResponse) ProtoMessage()    {}
 func (*QueryAggregateVotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b180a0d90a2c8cf7, []int{19}
+	return fileDescriptor_b180a0d90a2c8cf7, []int{23}
 }
 func (m *QueryAggregateVotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -883,7 +1201,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b180a0d90a2c8cf7, []int{20}
+	return fileDescriptor_b180a0d90a2c8cf7, []int{24}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -922,7 +1240,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b180a0d90a2c8cf7, []int{21}
+	return fileDescriptor_b180a0d90a2c8cf7, []int{25}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -958,1461 +1276,20382 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-func init() {
-	proto.RegisterType((*QueryExchangeRateRequest)(nil), "kujira.oracle.QueryExchangeRateRequest")
-	proto.RegisterType((*QueryExchangeRateResponse)(nil), "kujira.oracle.QueryExchangeRateResponse")
-	proto.RegisterType((*QueryExchangeRatesRequest)(nil), "kujira.oracle.QueryExchangeRatesRequest")
-	proto.RegisterType((*QueryExchangeRatesResponse)(nil), "kujira.oracle.QueryExchangeRatesResponse")
-	proto.RegisterType((*QueryActivesRequest)(nil), "kujira.oracle.QueryActivesRequest")
-	proto.RegisterType((*QueryActivesResponse)(nil), "kujira.oracle.QueryActivesResponse")
-	proto.RegisterType((*QueryVoteTargetsRequest)(nil), "kujira.oracle.QueryVoteTargetsRequest")
-	proto.RegisterType((*QueryVoteTargetsResponse)(nil), "kujira.oracle.QueryVoteTargetsResponse")
-	proto.RegisterType((*QueryFeederDelegationRequest)(nil), "kujira.oracle.QueryFeederDelegationRequest")
-	proto.RegisterType((*QueryFeederDelegationResponse)(nil), "kujira.oracle.QueryFeederDelegationResponse")
-	proto.RegisterType((*QueryMissCounterRequest)(nil), "kujira.oracle.QueryMissCounterRequest")
-	proto.RegisterType((*QueryMissCounterResponse)(nil), "kujira.oracle.QueryMissCounterResponse")
-	proto.RegisterType((*QueryAggregatePrevoteRequest)(nil), "kujira.oracle.QueryAggregatePrevoteRequest")
-	proto.RegisterType((*QueryAggregatePrevoteResponse)(nil), "kujira.oracle.QueryAggregatePrevoteResponse")
-	proto.RegisterType((*QueryAggregatePrevotesRequest)(nil), "kujira.oracle.QueryAggregatePrevotesRequest")
-	proto.RegisterType((*QueryAggregatePrevotesResponse)(nil), "kujira.oracle.QueryAggregatePrevotesResponse")
-	proto.RegisterType((*QueryAggregateVoteRequest)(nil), "kujira.oracle.QueryAggregateVoteRequest")
-	proto.RegisterType((*QueryAggregateVoteResponse)(nil), "kujira.oracle.QueryAggregateVoteResponse")
-	proto.RegisterType((*QueryAggregateVotesRequest)(nil), "kujira.oracle.QueryAggregateVotesRequest")
-	proto.RegisterType((*QueryAggregateVotesResponse)(nil), "kujira.oracle.QueryAggregateVotesResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "kujira.oracle.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "kujira.oracle.QueryParamsResponse")
+// QueryRewardEstimateRequest is the request type for the Query/RewardEstimate RPC method.
+type QueryRewardEstimateRequest struct {
+	// validator_addr optionally defines the validator to project the reward for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-func init() { proto.RegisterFile("kujira/oracle/query.proto", fileDescriptor_b180a0d90a2c8cf7) }
-
-var fileDescriptor_b180a0d90a2c8cf7 = []byte{
-	// 1045 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0xbd, 0xd0, 0xa6, 0xf4, 0x39, 0x36, 0xc9, 0x90, 0x16, 0x67, 0x9b, 0xda, 0xed, 0xd0,
-	0x24, 0xc6, 0x49, 0x76, 0x5b, 0x07, 0x84, 0x14, 0xa9, 0x12, 0x49, 0x03, 0x87, 0x02, 0xa2, 0x98,
-	0x12, 0x24, 0x0e, 0x98, 0x89, 0x3d, 0x6c, 0x97, 0xc6, 0x1e, 0x77, 0x67, 0x6d, 0xa5, 0xaa, 0x2a,
-	0xa4, 0x9e, 0x90, 0x38, 0x50, 0xa9, 0x12, 0x57, 0xc2, 0x15, 0xf1, 0x35, 0x90, 0x7a, 0xac, 0xc4,
-	0x05, 0x71, 0x28, 0x28, 0xe1, 0xc0, 0xc7, 0x40, 0x3b, 0xf3, 0x76, 0xbd, 0x6b, 0xaf, 0x63, 0x93,
-	0x9e, 0x36, 0x9e, 0xf7, 0xf6, 0xff, 0x7e, 0xf3, 0xcf, 0xcc, 0x7b, 0x36, 0xcc, 0xdf, 0xed, 0x7e,
-	0xe3, 0x7a, 0xcc, 0x16, 0x1e, 0x6b, 0xec, 0x71, 0xfb, 0x5e, 0x97, 0x7b, 0xf7, 0xad, 0x8e, 0x27,
-	0x7c, 0x41, 0x72, 0x3a, 0x64, 0xe9, 0x90, 0x39, 0xe7, 0x08, 0x47, 0xa8, 0x88, 0x1d, 0xfc, 0xa5,
-	0x93, 0xcc, 0x05, 0x47, 0x08, 0x67, 0x8f, 0xdb, 0xac, 0xe3, 0xda, 0xac, 0xdd, 0x16, 0x3e, 0xf3,
-	0x5d, 0xd1, 0x96, 0x18, 0x35, 0x93, 0xea, 0xfa, 0x81, 0xb1, 0x62, 0x43, 0xc8, 0x96, 0x90, 0xf6,
-	0x2e, 0x93, 0xdc, 0xee, 0x5d, 0xdb, 0xe5, 0x3e, 0xbb, 0x66, 0x37, 0x84, 0xdb, 0xd6, 0x71, 0xba,
-	0x01, 0x85, 0x4f, 0x02, 0x9a, 0xf7, 0xf6, 0x1b, 0x77, 0x58, 0xdb, 0xe1, 0x35, 0xe6, 0xf3, 0x1a,
-	0xbf, 0xd7, 0xe5, 0xd2, 0x27, 0x73, 0x70, 0xba, 0xc9, 0xdb, 0xa2, 0x55, 0x30, 0x2e, 0x19, 0xe5,
-	0xb3, 0x35, 0xfd, 0x61, 0xe3, 0x95, 0xef, 0x0e, 0x4a, 0x99, 0x7f, 0x0f, 0x4a, 0x19, 0xda, 0x81,
-	0xf9, 0x94, 0x77, 0x65, 0x47, 0xb4, 0x25, 0x27, 0x9f, 0x42, 0x8e, 0xe3, 0x7a, 0xdd, 0x63, 0x3e,
-	0xd7, 0x22, 0x5b, 0xd6, 0xd3, 0xe7, 0xa5, 0xcc, 0x9f, 0xcf, 0x4b, 0x4b, 0x8e, 0xeb, 0xdf, 0xe9,
-	0xee, 0x5a, 0x0d, 0xd1, 0xb2, 0x11, 0x51, 0x3f, 0xd6, 0x64, 0xf3, 0xae, 0xed, 0xdf, 0xef, 0x70,
-	0x69, 0x6d, 0xf3, 0x46, 0x6d, 0x9a, 0xc7, 0xc4, 0xe9, 0x85, 0x94, 0x8a, 0x12, 0x71, 0xe9, 0x8f,
-	0x06, 0x98, 0x69, 0x51, 0x04, 0xda, 0x87, 0x7c, 0x02, 0x48, 0x16, 0x8c, 0x4b, 0x2f, 0x97, 0xb3,
-	0xd5, 0x05, 0x4b, 0x17, 0xb6, 0x02, 0x8b, 0x2c, 0xb4, 0x28, 0xa8, 0x7d, 0x43, 0xb8, 0xed, 0xad,
-	0xf5, 0x80, 0xf7, 0x97, 0xbf, 0x4a, 0x2b, 0x93, 0xf1, 0x06, 0xef, 0xc8, 0x5a, 0x2e, 0x0e, 0x2d,
-	0xe9, 0x39, 0x78, 0x4d, 0x71, 0x6d, 0x36, 0x7c, 0xb7, 0xd7, 0xe7, 0xbd, 0x0a, 0x73, 0xc9, 0x65,
-	0x04, 0x2d, 0xc0, 0x19, 0xa6, 0x97, 0x14, 0xe1, 0xd9, 0x5a, 0xf8, 0x91, 0xce, 0xc3, 0xeb, 0xea,
-	0x8d, 0x1d, 0xe1, 0xf3, 0xdb, 0xcc, 0x73, 0xb8, 0x1f, 0x89, 0x5d, 0xc7, 0xff, 0x63, 0x22, 0x84,
-	0x82, 0x97, 0x61, 0xba, 0x27, 0x7c, 0x5e, 0xf7, 0xf5, 0x3a, 0xaa, 0x66, 0x7b, 0xfd, 0x54, 0xfa,
-	0x31, 0x2c, 0xa8, 0xd7, 0xdf, 0xe7, 0xbc, 0xc9, 0xbd, 0x6d, 0xbe, 0xc7, 0x1d, 0x75, 0xc4, 0xc2,
-	0xa3, 0xb0, 0x08, 0xf9, 0x1e, 0xdb, 0x73, 0x9b, 0xcc, 0x17, 0x5e, 0x9d, 0x35, 0x9b, 0x1e, 0x9e,
-	0x89, 0x5c, 0xb4, 0xba, 0xd9, 0x6c, 0x7a, 0xb1, 0xb3, 0xf1, 0x2e, 0x5c, 0x1c, 0x21, 0x88, 0x50,
-	0x25, 0xc8, 0x7e, 0xad, 0x62, 0x71, 0x39, 0xd0, 0x4b, 0x81, 0x16, 0xbd, 0x89, 0x9b, 0xfd, 0xc8,
-	0x95, 0xf2, 0x86, 0xe8, 0xb6, 0x7d, 0xee, 0x9d, 0x98, 0x26, 0x74, 0x27, 0xa1, 0xd5, 0x77, 0xa7,
-	0xe5, 0x4a, 0x59, 0x6f, 0xe8, 0x75, 0x25, 0x75, 0xaa, 0x96, 0x6d, 0xf5, 0x53, 0x23, 0x77, 0x36,
-	0x1d, 0xc7, 0x0b, 0xf6, 0xc1, 0x6f, 0x79, 0x3c, 0x70, 0xef, 0xc4, 0x3c, 0xdf, 0xa2, 0x3b, 0xc3,
-	0x82, 0x08, 0xf5, 0x25, 0xcc, 0xb2, 0x30, 0x56, 0xef, 0xe8, 0xa0, 0x12, 0xcd, 0x56, 0x57, 0xac,
-	0x44, 0xc7, 0xb0, 0x22, 0x8d, 0xf8, 0xb1, 0x47, 0xbd, 0xad, 0x53, 0xc1, 0xf1, 0xad, 0xcd, 0xb0,
-	0x81, 0x3a, 0xb4, 0x34, 0x02, 0x20, 0x3a, 0x4f, 0x8f, 0x0c, 0x28, 0x8e, 0xca, 0x40, 0xc6, 0xaf,
-	0x80, 0x0c, 0x31, 0x86, 0x97, 0xea, 0x04, 0x90, 0xb3, 0x83, 0x90, 0x92, 0x7e, 0x88, 0xd7, 0x3d,
-	0x7a, 0x7b, 0xe7, 0x45, 0x4c, 0x97, 0xd8, 0x1e, 0x06, 0xd4, 0x70, 0x37, 0x9f, 0x41, 0xbe, 0xbf,
-	0x9b, 0x98, 0xdd, 0xe5, 0x49, 0x76, 0xb2, 0xd3, 0xdf, 0x46, 0x8e, 0xc5, 0xe5, 0xe9, 0x42, 0x5a,
-	0xd1, 0xc8, 0xe5, 0x1e, 0x5c, 0x48, 0x8d, 0x22, 0xd3, 0xe7, 0xf0, 0x6a, 0x92, 0x29, 0xb4, 0xf7,
-	0xff, 0x42, 0xe5, 0x13, 0x50, 0x92, 0xce, 0x01, 0x51, 0x75, 0x6f, 0x31, 0x8f, 0xb5, 0x22, 0x9a,
-	0x9b, 0xd8, 0xa7, 0xc2, 0x55, 0xa4, 0x58, 0x87, 0xa9, 0x8e, 0x5a, 0x41, 0x47, 0xce, 0x0d, 0x14,
-	0xd7, 0xe9, 0x58, 0x09, 0x53, 0xab, 0xbf, 0x65, 0xe1, 0xb4, 0x12, 0x23, 0x3f, 0x18, 0x30, 0x1d,
-	0xc7, 0x22, 0xcb, 0x03, 0xef, 0x8f, 0x9a, 0x3f, 0x66, 0x79, 0x7c, 0xa2, 0x46, 0xa4, 0xab, 0x8f,
-	0x7e, 0xff, 0xe7, 0xc9, 0x4b, 0x4b, 0xe4, 0x4a, 0x38, 0x03, 0xd5, 0xa8, 0x92, 0xf6, 0x03, 0xf5,
-	0x7c, 0x68, 0x27, 0x1a, 0x3f, 0xf9, 0xde, 0x80, 0x5c, 0x62, 0x46, 0x90, 0xb1, 0x95, 0x42, 0x8f,
-	0xcc, 0x37, 0x27, 0xc8, 0x44, 0xa8, 0x45, 0x05, 0x55, 0x22, 0x17, 0x07, 0xa0, 0x92, 0x53, 0x88,
-	0x78, 0x70, 0x06, 0x27, 0x00, 0xa1, 0x69, 0xe2, 0xc9, 0xa9, 0x61, 0xbe, 0x71, 0x6c, 0x0e, 0x96,
-	0x2e, 0xaa, 0xd2, 0x05, 0x72, 0x7e, 0xa0, 0x34, 0x0e, 0x12, 0xf2, 0xb3, 0x01, 0x33, 0x83, 0x9d,
-	0x99, 0xac, 0xa4, 0x29, 0x8f, 0x18, 0x08, 0xe6, 0xea, 0x64, 0xc9, 0xc8, 0x53, 0x55, 0x3c, 0xab,
-	0xa4, 0x12, 0xf2, 0x44, 0x77, 0x54, 0xda, 0x0f, 0x92, 0xb7, 0xf8, 0xa1, 0xad, 0x67, 0x00, 0x79,
-	0x6c, 0x40, 0x36, 0xd6, 0xaf, 0xc9, 0x52, 0x5a, 0xc5, 0xe1, 0xe1, 0x60, 0x2e, 0x8f, 0xcd, 0x43,
-	0xa8, 0xab, 0x0a, 0xaa, 0x42, 0xca, 0x93, 0x40, 0x05, 0xe3, 0x80, 0xfc, 0x6a, 0xc0, 0xcc, 0x60,
-	0x3f, 0x4c, 0xb7, 0x6d, 0xc4, 0xa4, 0x48, 0xb7, 0x6d, 0xd4, 0x14, 0xa0, 0xd7, 0x15, 0xe1, 0x3b,
-	0xe4, 0xed, 0x49, 0x08, 0x87, 0x7a, 0x31, 0xf9, 0xc9, 0x80, 0xd9, 0xa1, 0xf6, 0x4d, 0x26, 0x42,
-	0x88, 0x8e, 0xdb, 0xda, 0x84, 0xd9, 0x48, 0xbc, 0xa6, 0x88, 0x97, 0xc9, 0x62, 0x0a, 0xf1, 0xf0,
-	0xb0, 0x20, 0x07, 0x06, 0xe4, 0x12, 0xbd, 0x2f, 0xfd, 0x26, 0xa6, 0xf5, 0xff, 0xf4, 0x9b, 0x98,
-	0xda, 0xdb, 0xe9, 0x86, 0xa2, 0x7a, 0x8b, 0x54, 0x63, 0x54, 0x4d, 0x77, 0xac, 0x8f, 0xca, 0xc4,
-	0x27, 0x06, 0xe4, 0x93, 0xed, 0x99, 0x8c, 0xaf, 0x1c, 0xd9, 0x57, 0x99, 0x24, 0x15, 0x29, 0x2b,
-	0x8a, 0xf2, 0x0a, 0xa1, 0xc7, 0x7a, 0xa7, 0x8d, 0x73, 0x60, 0x4a, 0xb7, 0x5d, 0x72, 0x39, 0xad,
-	0x42, 0xa2, 0xaf, 0x9b, 0xf4, 0xb8, 0x14, 0x2c, 0x7e, 0x5e, 0x15, 0x9f, 0x21, 0xf9, 0xb0, 0xb8,
-	0xee, 0xe3, 0x5b, 0xdb, 0x4f, 0x0f, 0x8b, 0xc6, 0xb3, 0xc3, 0xa2, 0xf1, 0xf7, 0x61, 0xd1, 0x78,
-	0x7c, 0x54, 0xcc, 0x3c, 0x3b, 0x2a, 0x66, 0xfe, 0x38, 0x2a, 0x66, 0xbe, 0xa8, 0xc4, 0xbe, 0x11,
-	0xdf, 0xe6, 0xac, 0xb5, 0xf6, 0x81, 0xfe, 0x15, 0xd2, 0x10, 0x1e, 0xb7, 0xf7, 0x43, 0x19, 0xf5,
-	0xcd, 0x78, 0x77, 0x4a, 0xfd, 0xd8, 0x58, 0xff, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xbb, 0x7b, 0x5e,
-	0xf5, 0x08, 0x0d, 0x00, 0x00,
+func (m *QueryRewardEstimateRequest) Reset()         { *m = QueryRewardEstimateRequest{} }
+func (m *QueryRewardEstimateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardEstimateRequest) ProtoMessage()    {}
+func (*QueryRewardEstimateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{26}
+}
+func (m *QueryRewardEstimateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardEstimateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardEstimateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardEstimateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardEstimateRequest.Merge(m, src)
+}
+func (m *QueryRewardEstimateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardEstimateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardEstimateRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryRewardEstimateRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+// QueryRewardEstimateResponse is response type for the
+// Query/RewardEstimate RPC method.
+type QueryRewardEstimateResponse struct {
+	// reward_per_power defines the reward released next period for each unit of winning voting power.
+	RewardPerPower github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=reward_per_power,json=rewardPerPower,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"reward_per_power"`
+	// winning_power defines the total voting power of the ballot winners of the last period.
+	WinningPower int64 `protobuf:"varint,2,opt,name=winning_power,json=winningPower,proto3" json:"winning_power,omitempty"`
+	// validator_reward defines the projected reward of the requested validator, if any.
+	ValidatorReward github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=validator_reward,json=validatorReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"validator_reward"`
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// ExchangeRate returns exchange rate of a denom
-	ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
-	// ExchangeRates returns exchange rates of all denoms
-	ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
-	// Actives returns all active denoms
-	Actives(ctx context.Context, in *QueryActivesRequest, opts ...grpc.CallOption) (*QueryActivesResponse, error)
-	// FeederDelegation returns feeder delegation of a validator
-	FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error)
-	// MissCounter returns oracle miss counter of a validator
-	MissCounter(ctx context.Context, in *QueryMissCounterRequest, opts ...grpc.CallOption) (*QueryMissCounterResponse, error)
-	// AggregatePrevote returns an aggregate prevote of a validator
-	AggregatePrevote(ctx context.Context, in *QueryAggregatePrevoteRequest, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error)
-	// AggregatePrevotes returns aggregate prevotes of all validators
-	AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotesRequest, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error)
-	// AggregateVote returns an aggregate vote of a validator
-	AggregateVote(ctx context.Context, in *QueryAggregateVoteRequest, opts ...grpc.CallOption) (*QueryAggregateVoteResponse, error)
-	// AggregateVotes returns aggregate votes of all validators
-	AggregateVotes(ctx context.Context, in *QueryAggregateVotesRequest, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error)
-	// Params queries all parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryRewardEstimateResponse) Reset()         { *m = QueryRewardEstimateResponse{} }
+func (m *QueryRewardEstimateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardEstimateResponse) ProtoMessage()    {}
+func (*QueryRewardEstimateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{27}
+}
+func (m *QueryRewardEstimateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardEstimateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardEstimateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardEstimateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardEstimateResponse.Merge(m, src)
+}
+func (m *QueryRewardEstimateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardEstimateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardEstimateResponse.DiscardUnknown(m)
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+var xxx_messageInfo_QueryRewardEstimateResponse proto.InternalMessageInfo
+
+func (m *QueryRewardEstimateResponse) GetRewardPerPower() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardPerPower
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryRewardEstimateResponse) GetWinningPower() int64 {
+	if m != nil {
+		return m.WinningPower
+	}
+	return 0
 }
 
-func (c *queryClient) ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error) {
-	out := new(QueryExchangeRateResponse)
-	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/ExchangeRate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRewardEstimateResponse) GetValidatorReward() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.ValidatorReward
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error) {
-	out := new(QueryExchangeRatesResponse)
-	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/ExchangeRates", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryVoteHashSpecRequest is the request type for the Query/VoteHashSpec RPC method.
+type QueryVoteHashSpecRequest struct {
+}
+
+func (m *QueryVoteHashSpecRequest) Reset()         { *m = QueryVoteHashSpecRequest{} }
+func (m *QueryVoteHashSpecRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteHashSpecRequest) ProtoMessage()    {}
+func (*QueryVoteHashSpecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{28}
+}
+func (m *QueryVoteHashSpecRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteHashSpecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteHashSpecRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryVoteHashSpecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteHashSpecRequest.Merge(m, src)
+}
+func (m *QueryVoteHashSpecRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteHashSpecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteHashSpecRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Actives(ctx context.Context, in *QueryActivesRequest, opts ...grpc.CallOption) (*QueryActivesResponse, error) {
-	out := new(QueryActivesResponse)
-	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/Actives", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVoteHashSpecRequest proto.InternalMessageInfo
+
+// QueryVoteHashSpecResponse is response type for the
+// Query/VoteHashSpec RPC method.
+type QueryVoteHashSpecResponse struct {
+	// preimage_format defines the template of the hashed preimage, e.g. "{salt}:{exchange_rates}:{voter}".
+	PreimageFormat string `protobuf:"bytes,1,opt,name=preimage_format,json=preimageFormat,proto3" json:"preimage_format,omitempty"`
+	// fields defines the order of the fields in the preimage.
+	Fields []string `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	// field_separator defines the separator placed between the preimage fields.
+	FieldSeparator string `protobuf:"bytes,3,opt,name=field_separator,json=fieldSeparator,proto3" json:"field_separator,omitempty"`
+	// exchange_rate_format defines the template of a single exchange rate entry.
+	ExchangeRateFormat string `protobuf:"bytes,4,opt,name=exchange_rate_format,json=exchangeRateFormat,proto3" json:"exchange_rate_format,omitempty"`
+	// exchange_rate_separator defines the separator placed between exchange rate entries.
+	ExchangeRateSeparator string `protobuf:"bytes,5,opt,name=exchange_rate_separator,json=exchangeRateSeparator,proto3" json:"exchange_rate_separator,omitempty"`
+	// salt_length defines the required length of the hex encoded salt.
+	SaltLength uint32 `protobuf:"varint,6,opt,name=salt_length,json=saltLength,proto3" json:"salt_length,omitempty"`
+	// salt_encoding defines the encoding of the salt.
+	SaltEncoding string `protobuf:"bytes,7,opt,name=salt_encoding,json=saltEncoding,proto3" json:"salt_encoding,omitempty"`
+	// voter_encoding defines the encoding of the voter address.
+	VoterEncoding string `protobuf:"bytes,8,opt,name=voter_encoding,json=voterEncoding,proto3" json:"voter_encoding,omitempty"`
+	// hash_algorithm defines the algorithm applied to the preimage.
+	HashAlgorithm string `protobuf:"bytes,9,opt,name=hash_algorithm,json=hashAlgorithm,proto3" json:"hash_algorithm,omitempty"`
+	// hash_length defines the length of the hash in bytes.
+	HashLength uint32 `protobuf:"varint,10,opt,name=hash_length,json=hashLength,proto3" json:"hash_length,omitempty"`
+	// hash_encoding defines the encoding of the hash in MsgAggregateExchangeRatePrevote.
+	HashEncoding string `protobuf:"bytes,11,opt,name=hash_encoding,json=hashEncoding,proto3" json:"hash_encoding,omitempty"`
+	// commitment_hash_algo defines the identifier of the hash algorithm in effect, as set in the params.
+	CommitmentHashAlgo string `protobuf:"bytes,12,opt,name=commitment_hash_algo,json=commitmentHashAlgo,proto3" json:"commitment_hash_algo,omitempty"`
+	// exchange_rate_order defines the order of the exchange rate entries in the canonical form, which
+	// feeders should both hash and reveal so that the same exchange rates always hash the same.
+	ExchangeRateOrder string `protobuf:"bytes,13,opt,name=exchange_rate_order,json=exchangeRateOrder,proto3" json:"exchange_rate_order,omitempty"`
+	// exchange_rate_decimals defines the number of decimal places of an exchange rate in the canonical form.
+	ExchangeRateDecimals uint32 `protobuf:"varint,14,opt,name=exchange_rate_decimals,json=exchangeRateDecimals,proto3" json:"exchange_rate_decimals,omitempty"`
+}
+
+func (m *QueryVoteHashSpecResponse) Reset()         { *m = QueryVoteHashSpecResponse{} }
+func (m *QueryVoteHashSpecResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVoteHashSpecResponse) ProtoMessage()    {}
+func (*QueryVoteHashSpecResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{29}
+}
+func (m *QueryVoteHashSpecResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVoteHashSpecResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVoteHashSpecResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryVoteHashSpecResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVoteHashSpecResponse.Merge(m, src)
+}
+func (m *QueryVoteHashSpecResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVoteHashSpecResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVoteHashSpecResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error) {
-	out := new(QueryFeederDelegationResponse)
-	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/FeederDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVoteHashSpecResponse proto.InternalMessageInfo
+
+func (m *QueryVoteHashSpecResponse) GetPreimageFormat() string {
+	if m != nil {
+		return m.PreimageFormat
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) MissCounter(ctx context.Context, in *QueryMissCounterRequest, opts ...grpc.CallOption) (*QueryMissCounterResponse, error) {
-	out := new(QueryMissCounterResponse)
-	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/MissCounter", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVoteHashSpecResponse) GetFields() []string {
+	if m != nil {
+		return m.Fields
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) AggregatePrevote(ctx context.Context, in *QueryAggregatePrevoteRequest, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error) {
-	out := new(QueryAggregatePrevoteResponse)
-	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/AggregatePrevote", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVoteHashSpecResponse) GetFieldSeparator() string {
+	if m != nil {
+		return m.FieldSeparator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotesRequest, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error) {
-	out := new(QueryAggregatePrevotesResponse)
-	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/AggregatePrevotes", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVoteHashSpecResponse) GetExchangeRateFormat() string {
+	if m != nil {
+		return m.ExchangeRateFormat
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) AggregateVote(ctx context.Context, in *QueryAggregateVoteRequest, opts ...grpc.CallOption) (*QueryAggregateVoteResponse, error) {
-	out := new(QueryAggregateVoteResponse)
-	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/AggregateVote", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVoteHashSpecResponse) GetExchangeRateSeparator() string {
+	if m != nil {
+		return m.ExchangeRateSeparator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) AggregateVotes(ctx context.Context, in *QueryAggregateVotesRequest, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error) {
-	out := new(QueryAggregateVotesResponse)
-	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/AggregateVotes", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVoteHashSpecResponse) GetSaltLength() uint32 {
+	if m != nil {
+		return m.SaltLength
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVoteHashSpecResponse) GetSaltEncoding() string {
+	if m != nil {
+		return m.SaltEncoding
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// ExchangeRate returns exchange rate of a denom
-	ExchangeRate(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
-	// ExchangeRates returns exchange rates of all denoms
-	ExchangeRates(context.Context, *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error)
-	// Actives returns all active denoms
-	Actives(context.Context, *QueryActivesRequest) (*QueryActivesResponse, error)
-	// FeederDelegation returns feeder delegation of a validator
-	FeederDelegation(context.Context, *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error)
-	// MissCounter returns oracle miss counter of a validator
-	MissCounter(context.Context, *QueryMissCounterRequest) (*QueryMissCounterResponse, error)
-	// AggregatePrevote returns an aggregate prevote of a validator
-	AggregatePrevote(context.Context, *QueryAggregatePrevoteRequest) (*QueryAggregatePrevoteResponse, error)
-	// AggregatePrevotes returns aggregate prevotes of all validators
-	AggregatePrevotes(context.Context, *QueryAggregatePrevotesRequest) (*QueryAggregatePrevotesResponse, error)
-	// AggregateVote returns an aggregate vote of a validator
-	AggregateVote(context.Context, *QueryAggregateVoteRequest) (*QueryAggregateVoteResponse, error)
-	// AggregateVotes returns aggregate votes of all validators
-	AggregateVotes(context.Context, *QueryAggregateVotesRequest) (*QueryAggregateVotesResponse, error)
-	// Params queries all parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+func (m *QueryVoteHashSpecResponse) GetVoterEncoding() string {
+	if m != nil {
+		return m.VoterEncoding
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryVoteHashSpecResponse) GetHashAlgorithm() string {
+	if m != nil {
+		return m.HashAlgorithm
+	}
+	return ""
 }
 
-func (*UnimplementedQueryServer) ExchangeRate(ctx context.Context, req *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRate not implemented")
+func (m *QueryVoteHashSpecResponse) GetHashLength() uint32 {
+	if m != nil {
+		return m.HashLength
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) ExchangeRates(ctx context.Context, req *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRates not implemented")
+
+func (m *QueryVoteHashSpecResponse) GetHashEncoding() string {
+	if m != nil {
+		return m.HashEncoding
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) Actives(ctx context.Context, req *QueryActivesRequest) (*QueryActivesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Actives not implemented")
+
+func (m *QueryVoteHashSpecResponse) GetCommitmentHashAlgo() string {
+	if m != nil {
+		return m.CommitmentHashAlgo
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) FeederDelegation(ctx context.Context, req *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FeederDelegation not implemented")
+
+func (m *QueryVoteHashSpecResponse) GetExchangeRateOrder() string {
+	if m != nil {
+		return m.ExchangeRateOrder
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) MissCounter(ctx context.Context, req *QueryMissCounterRequest) (*QueryMissCounterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MissCounter not implemented")
+
+func (m *QueryVoteHashSpecResponse) GetExchangeRateDecimals() uint32 {
+	if m != nil {
+		return m.ExchangeRateDecimals
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) AggregatePrevote(ctx context.Context, req *QueryAggregatePrevoteRequest) (*QueryAggregatePrevoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevote not implemented")
+
+// QueryIsFeederAuthorizedRequest is the request type for the Query/IsFeederAuthorized RPC method.
+type QueryIsFeederAuthorizedRequest struct {
+	// validator_addr defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	// feeder_addr defines the candidate feeder address.
+	FeederAddr string `protobuf:"bytes,2,opt,name=feeder_addr,json=feederAddr,proto3" json:"feeder_addr,omitempty"`
 }
-func (*UnimplementedQueryServer) AggregatePrevotes(ctx context.Context, req *QueryAggregatePrevotesRequest) (*QueryAggregatePrevotesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevotes not implemented")
+
+func (m *QueryIsFeederAuthorizedRequest) Reset()         { *m = QueryIsFeederAuthorizedRequest{} }
+func (m *QueryIsFeederAuthorizedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIsFeederAuthorizedRequest) ProtoMessage()    {}
+func (*QueryIsFeederAuthorizedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{30}
 }
-func (*UnimplementedQueryServer) AggregateVote(ctx context.Context, req *QueryAggregateVoteRequest) (*QueryAggregateVoteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregateVote not implemented")
+func (m *QueryIsFeederAuthorizedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) AggregateVotes(ctx context.Context, req *QueryAggregateVotesRequest) (*QueryAggregateVotesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AggregateVotes not implemented")
+func (m *QueryIsFeederAuthorizedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsFeederAuthorizedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryIsFeederAuthorizedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsFeederAuthorizedRequest.Merge(m, src)
+}
+func (m *QueryIsFeederAuthorizedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsFeederAuthorizedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsFeederAuthorizedRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryIsFeederAuthorizedRequest proto.InternalMessageInfo
+
+// QueryIsFeederAuthorizedResponse is response type for the
+// Query/IsFeederAuthorized RPC method.
+type QueryIsFeederAuthorizedResponse struct {
+	// authorized defines whether votes of the feeder would be accepted.
+	Authorized bool `protobuf:"varint,1,opt,name=authorized,proto3" json:"authorized,omitempty"`
+	// authorized_feeder defines the feeder currently delegated by the validator.
+	AuthorizedFeeder string `protobuf:"bytes,2,opt,name=authorized_feeder,json=authorizedFeeder,proto3" json:"authorized_feeder,omitempty"`
+	// reason defines why the feeder is not authorized, if so.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func _Query_ExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryExchangeRateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ExchangeRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kujira.oracle.Query/ExchangeRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ExchangeRate(ctx, req.(*QueryExchangeRateRequest))
+func (m *QueryIsFeederAuthorizedResponse) Reset()         { *m = QueryIsFeederAuthorizedResponse{} }
+func (m *QueryIsFeederAuthorizedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIsFeederAuthorizedResponse) ProtoMessage()    {}
+func (*QueryIsFeederAuthorizedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{31}
+}
+func (m *QueryIsFeederAuthorizedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsFeederAuthorizedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsFeederAuthorizedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryIsFeederAuthorizedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsFeederAuthorizedResponse.Merge(m, src)
+}
+func (m *QueryIsFeederAuthorizedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsFeederAuthorizedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsFeederAuthorizedResponse.DiscardUnknown(m)
 }
 
-func _Query_ExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryExchangeRatesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ExchangeRates(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kujira.oracle.Query/ExchangeRates",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ExchangeRates(ctx, req.(*QueryExchangeRatesRequest))
+var xxx_messageInfo_QueryIsFeederAuthorizedResponse proto.InternalMessageInfo
+
+func (m *QueryIsFeederAuthorizedResponse) GetAuthorized() bool {
+	if m != nil {
+		return m.Authorized
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_Actives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryActivesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Actives(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kujira.oracle.Query/Actives",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Actives(ctx, req.(*QueryActivesRequest))
+func (m *QueryIsFeederAuthorizedResponse) GetAuthorizedFeeder() string {
+	if m != nil {
+		return m.AuthorizedFeeder
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_FeederDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFeederDelegationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FeederDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kujira.oracle.Query/FeederDelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FeederDelegation(ctx, req.(*QueryFeederDelegationRequest))
+func (m *QueryIsFeederAuthorizedResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_MissCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMissCounterRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MissCounter(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kujira.oracle.Query/MissCounter",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MissCounter(ctx, req.(*QueryMissCounterRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryDenomBackingPowerRequest is the request type for the Query/DenomBackingPower RPC method.
+type QueryDenomBackingPowerRequest struct {
+	// denom optionally restricts the response to a single denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func _Query_AggregatePrevote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAggregatePrevoteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AggregatePrevote(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kujira.oracle.Query/AggregatePrevote",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregatePrevote(ctx, req.(*QueryAggregatePrevoteRequest))
+func (m *QueryDenomBackingPowerRequest) Reset()         { *m = QueryDenomBackingPowerRequest{} }
+func (m *QueryDenomBackingPowerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomBackingPowerRequest) ProtoMessage()    {}
+func (*QueryDenomBackingPowerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{32}
+}
+func (m *QueryDenomBackingPowerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomBackingPowerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomBackingPowerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDenomBackingPowerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomBackingPowerRequest.Merge(m, src)
+}
+func (m *QueryDenomBackingPowerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomBackingPowerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomBackingPowerRequest.DiscardUnknown(m)
 }
 
-func _Query_AggregatePrevotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAggregatePrevotesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AggregatePrevotes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kujira.oracle.Query/AggregatePrevotes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregatePrevotes(ctx, req.(*QueryAggregatePrevotesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryDenomBackingPowerRequest proto.InternalMessageInfo
+
+// QueryDenomBackingPowerResponse is response type for the
+// Query/DenomBackingPower RPC method.
+type QueryDenomBackingPowerResponse struct {
+	// backing_powers defines the voting power backing each denom, sorted by denom.
+	BackingPowers []DenomBackingPower `protobuf:"bytes,1,rep,name=backing_powers,json=backingPowers,proto3" json:"backing_powers"`
 }
 
-func _Query_AggregateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAggregateVoteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AggregateVote(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kujira.oracle.Query/AggregateVote",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregateVote(ctx, req.(*QueryAggregateVoteRequest))
+func (m *QueryDenomBackingPowerResponse) Reset()         { *m = QueryDenomBackingPowerResponse{} }
+func (m *QueryDenomBackingPowerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomBackingPowerResponse) ProtoMessage()    {}
+func (*QueryDenomBackingPowerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{33}
+}
+func (m *QueryDenomBackingPowerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomBackingPowerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomBackingPowerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryDenomBackingPowerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomBackingPowerResponse.Merge(m, src)
+}
+func (m *QueryDenomBackingPowerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomBackingPowerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomBackingPowerResponse.DiscardUnknown(m)
 }
 
-func _Query_AggregateVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAggregateVotesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AggregateVotes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kujira.oracle.Query/AggregateVotes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AggregateVotes(ctx, req.(*QueryAggregateVotesRequest))
+var xxx_messageInfo_QueryDenomBackingPowerResponse proto.InternalMessageInfo
+
+func (m *QueryDenomBackingPowerResponse) GetBackingPowers() []DenomBackingPower {
+	if m != nil {
+		return m.BackingPowers
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// DenomBackingPower defines the voting power of the votes submitted for a denom.
+type DenomBackingPower struct {
+	// denom defines the voted denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// power defines the summed voting power of the bonded validators that voted for the denom.
+	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *DenomBackingPower) Reset()         { *m = DenomBackingPower{} }
+func (m *DenomBackingPower) String() string { return proto.CompactTextString(m) }
+func (*DenomBackingPower) ProtoMessage()    {}
+func (*DenomBackingPower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{34}
+}
+func (m *DenomBackingPower) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomBackingPower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomBackingPower.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+}
+func (m *DenomBackingPower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomBackingPower.Merge(m, src)
+}
+func (m *DenomBackingPower) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomBackingPower) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomBackingPower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomBackingPower proto.InternalMessageInfo
+
+func (m *DenomBackingPower) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kujira.oracle.Query/Params",
+	return ""
+}
+
+func (m *DenomBackingPower) GetPower() int64 {
+	if m != nil {
+		return m.Power
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return 0
+}
+
+// QueryUpcomingGraceExitsRequest is the request type for the Query/UpcomingGraceExits RPC method.
+type QueryUpcomingGraceExitsRequest struct {
+}
+
+func (m *QueryUpcomingGraceExitsRequest) Reset()         { *m = QueryUpcomingGraceExitsRequest{} }
+func (m *QueryUpcomingGraceExitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUpcomingGraceExitsRequest) ProtoMessage()    {}
+func (*QueryUpcomingGraceExitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{35}
+}
+func (m *QueryUpcomingGraceExitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpcomingGraceExitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpcomingGraceExitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryUpcomingGraceExitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpcomingGraceExitsRequest.Merge(m, src)
+}
+func (m *QueryUpcomingGraceExitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpcomingGraceExitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpcomingGraceExitsRequest.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kujira.oracle.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ExchangeRate",
-			Handler:    _Query_ExchangeRate_Handler,
-		},
-		{
-			MethodName: "ExchangeRates",
-			Handler:    _Query_ExchangeRates_Handler,
-		},
-		{
-			MethodName: "Actives",
-			Handler:    _Query_Actives_Handler,
-		},
-		{
-			MethodName: "FeederDelegation",
-			Handler:    _Query_FeederDelegation_Handler,
-		},
-		{
-			MethodName: "MissCounter",
-			Handler:    _Query_MissCounter_Handler,
-		},
-		{
-			MethodName: "AggregatePrevote",
-			Handler:    _Query_AggregatePrevote_Handler,
-		},
-		{
-			MethodName: "AggregatePrevotes",
-			Handler:    _Query_AggregatePrevotes_Handler,
-		},
-		{
-			MethodName: "AggregateVote",
-			Handler:    _Query_AggregateVote_Handler,
-		},
-		{
-			MethodName: "AggregateVotes",
-			Handler:    _Query_AggregateVotes_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "kujira/oracle/query.proto",
+var xxx_messageInfo_QueryUpcomingGraceExitsRequest proto.InternalMessageInfo
+
+// QueryUpcomingGraceExitsResponse is response type for the
+// Query/UpcomingGraceExits RPC method.
+type QueryUpcomingGraceExitsResponse struct {
+	// grace_exits defines the denoms in their grace window, the soonest exit first.
+	GraceExits []DenomGraceExit `protobuf:"bytes,1,rep,name=grace_exits,json=graceExits,proto3" json:"grace_exits"`
 }
 
-func (m *QueryExchangeRateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryUpcomingGraceExitsResponse) Reset()         { *m = QueryUpcomingGraceExitsResponse{} }
+func (m *QueryUpcomingGraceExitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUpcomingGraceExitsResponse) ProtoMessage()    {}
+func (*QueryUpcomingGraceExitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{36}
+}
+func (m *QueryUpcomingGraceExitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUpcomingGraceExitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUpcomingGraceExitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryUpcomingGraceExitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUpcomingGraceExitsResponse.Merge(m, src)
+}
+func (m *QueryUpcomingGraceExitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUpcomingGraceExitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUpcomingGraceExitsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryExchangeRateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryUpcomingGraceExitsResponse proto.InternalMessageInfo
+
+func (m *QueryUpcomingGraceExitsResponse) GetGraceExits() []DenomGraceExit {
+	if m != nil {
+		return m.GraceExits
+	}
+	return nil
 }
 
-func (m *QueryExchangeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+// DenomGraceExit defines when a denom leaves its grace window.
+type DenomGraceExit struct {
+	// denom defines the newly whitelisted denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// exit_period defines the vote period from which missing the denom is counted.
+	ExitPeriod uint64 `protobuf:"varint,2,opt,name=exit_period,json=exitPeriod,proto3" json:"exit_period,omitempty"`
+	// exit_height defines the first block height of exit_period, zero if the
+	// vote periods are timed.
+	ExitHeight int64 `protobuf:"varint,3,opt,name=exit_height,json=exitHeight,proto3" json:"exit_height,omitempty"`
+}
+
+func (m *DenomGraceExit) Reset()         { *m = DenomGraceExit{} }
+func (m *DenomGraceExit) String() string { return proto.CompactTextString(m) }
+func (*DenomGraceExit) ProtoMessage()    {}
+func (*DenomGraceExit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{37}
+}
+func (m *DenomGraceExit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomGraceExit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomGraceExit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DenomGraceExit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomGraceExit.Merge(m, src)
+}
+func (m *DenomGraceExit) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomGraceExit) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomGraceExit.DiscardUnknown(m)
 }
 
-func (m *QueryExchangeRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DenomGraceExit proto.InternalMessageInfo
+
+func (m *DenomGraceExit) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryExchangeRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DenomGraceExit) GetExitPeriod() uint64 {
+	if m != nil {
+		return m.ExitPeriod
+	}
+	return 0
 }
 
-func (m *QueryExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ExchangeRate.Size()
-		i -= size
-		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *DenomGraceExit) GetExitHeight() int64 {
+	if m != nil {
+		return m.ExitHeight
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryExchangeRatesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// QueryLightClientStateRequest is the request type for the Query/LightClientState RPC method.
+type QueryLightClientStateRequest struct {
+}
+
+func (m *QueryLightClientStateRequest) Reset()         { *m = QueryLightClientStateRequest{} }
+func (m *QueryLightClientStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLightClientStateRequest) ProtoMessage()    {}
+func (*QueryLightClientStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{38}
+}
+func (m *QueryLightClientStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLightClientStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLightClientStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryLightClientStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLightClientStateRequest.Merge(m, src)
+}
+func (m *QueryLightClientStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLightClientStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLightClientStateRequest.DiscardUnknown(m)
 }
 
-func (m *QueryExchangeRatesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryLightClientStateRequest proto.InternalMessageInfo
+
+// QueryLightClientStateResponse is response type for the
+// Query/LightClientState RPC method. All fields are read at the same height,
+// so they can be proven against the app hash of that height.
+type QueryLightClientStateResponse struct {
+	// height defines the block height the state was read at.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// params defines the parameters of the module. They live in the store of
+	// x/params, not the oracle store, and are proven separately against it.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// exchange_rates defines the exchange rates of the active denoms.
+	ExchangeRates github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=exchange_rates,json=exchangeRates,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"exchange_rates"`
+	// vote_period defines the index of the current vote period, height / params.vote_period
+	// unless the vote periods are timed.
+	VotePeriod uint64 `protobuf:"varint,4,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty"`
+	// store_name defines the store the keys below are proven in, with an ABCI store query setting prove.
+	StoreName string `protobuf:"bytes,5,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	// exchange_rate_keys defines the store key of each exchange rate, in the order of exchange_rates.
+	ExchangeRateKeys [][]byte `protobuf:"bytes,6,rep,name=exchange_rate_keys,json=exchangeRateKeys,proto3" json:"exchange_rate_keys,omitempty"`
+	// vote_period_clock_key defines the store key of the clock the vote period is read from,
+	// empty unless the vote periods are timed, the vote period then being derived from the height.
+	VotePeriodClockKey []byte `protobuf:"bytes,7,opt,name=vote_period_clock_key,json=votePeriodClockKey,proto3" json:"vote_period_clock_key,omitempty"`
 }
 
-func (m *QueryExchangeRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryLightClientStateResponse) Reset()         { *m = QueryLightClientStateResponse{} }
+func (m *QueryLightClientStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLightClientStateResponse) ProtoMessage()    {}
+func (*QueryLightClientStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{39}
+}
+func (m *QueryLightClientStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLightClientStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLightClientStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLightClientStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLightClientStateResponse.Merge(m, src)
+}
+func (m *QueryLightClientStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLightClientStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLightClientStateResponse.DiscardUnknown(m)
 }
 
-func (m *QueryExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLightClientStateResponse proto.InternalMessageInfo
+
+func (m *QueryLightClientStateResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryLightClientStateResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
 }
 
-func (m *QueryExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExchangeRates) > 0 {
-		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *QueryLightClientStateResponse) GetExchangeRates() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.ExchangeRates
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryActivesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryLightClientStateResponse) GetVotePeriod() uint64 {
+	if m != nil {
+		return m.VotePeriod
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryActivesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryLightClientStateResponse) GetStoreName() string {
+	if m != nil {
+		return m.StoreName
+	}
+	return ""
 }
 
-func (m *QueryActivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryLightClientStateResponse) GetExchangeRateKeys() [][]byte {
+	if m != nil {
+		return m.ExchangeRateKeys
+	}
+	return nil
 }
 
-func (m *QueryActivesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryLightClientStateResponse) GetVotePeriodClockKey() []byte {
+	if m != nil {
+		return m.VotePeriodClockKey
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryActivesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidatorRateDeviationRequest is the request type for the Query/ValidatorRateDeviation RPC method.
+type QueryValidatorRateDeviationRequest struct {
+	// validator_addr defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-func (m *QueryActivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Actives) > 0 {
-		for iNdEx := len(m.Actives) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Actives[iNdEx])
-			copy(dAtA[i:], m.Actives[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Actives[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryValidatorRateDeviationRequest) Reset()         { *m = QueryValidatorRateDeviationRequest{} }
+func (m *QueryValidatorRateDeviationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorRateDeviationRequest) ProtoMessage()    {}
+func (*QueryValidatorRateDeviationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{40}
+}
+func (m *QueryValidatorRateDeviationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorRateDeviationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorRateDeviationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryVoteTargetsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryValidatorRateDeviationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorRateDeviationRequest.Merge(m, src)
 }
-
-func (m *QueryVoteTargetsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValidatorRateDeviationRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryVoteTargetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryValidatorRateDeviationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorRateDeviationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryVoteTargetsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_QueryValidatorRateDeviationRequest proto.InternalMessageInfo
 
-func (m *QueryVoteTargetsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidatorRateDeviationResponse is response type for the
+// Query/ValidatorRateDeviation RPC method.
+type QueryValidatorRateDeviationResponse struct {
+	// deviations defines the deviation of each denom the validator submitted and which tallied, sorted by denom.
+	Deviations []RateDeviation `protobuf:"bytes,1,rep,name=deviations,proto3" json:"deviations"`
 }
 
-func (m *QueryVoteTargetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.VoteTargets) > 0 {
-		for iNdEx := len(m.VoteTargets) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.VoteTargets[iNdEx])
-			copy(dAtA[i:], m.VoteTargets[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.VoteTargets[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryValidatorRateDeviationResponse) Reset()         { *m = QueryValidatorRateDeviationResponse{} }
+func (m *QueryValidatorRateDeviationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorRateDeviationResponse) ProtoMessage()    {}
+func (*QueryValidatorRateDeviationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{41}
+}
+func (m *QueryValidatorRateDeviationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorRateDeviationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorRateDeviationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidatorRateDeviationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorRateDeviationResponse.Merge(m, src)
+}
+func (m *QueryValidatorRateDeviationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorRateDeviationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorRateDeviationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFeederDelegationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorRateDeviationResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorRateDeviationResponse) GetDeviations() []RateDeviation {
+	if m != nil {
+		return m.Deviations
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFeederDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RateDeviation defines how far a submitted rate was from the tallied rate of a denom.
+type RateDeviation struct {
+	// denom defines the voted denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// submitted defines the exchange rate submitted by the validator.
+	Submitted github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=submitted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"submitted"`
+	// finalized defines the tallied exchange rate.
+	Finalized github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=finalized,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"finalized"`
+	// percent_diff defines (submitted - finalized) / finalized in percent.
+	PercentDiff github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=percent_diff,json=percentDiff,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"percent_diff"`
+	// in_reward_band defines whether the submitted rate was within the reward band of the tally.
+	InRewardBand bool `protobuf:"varint,5,opt,name=in_reward_band,json=inRewardBand,proto3" json:"in_reward_band,omitempty"`
 }
 
-func (m *QueryFeederDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *RateDeviation) Reset()         { *m = RateDeviation{} }
+func (m *RateDeviation) String() string { return proto.CompactTextString(m) }
+func (*RateDeviation) ProtoMessage()    {}
+func (*RateDeviation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{42}
 }
-
-func (m *QueryFeederDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RateDeviation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateDeviation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateDeviation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryFeederDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RateDeviation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateDeviation.Merge(m, src)
+}
+func (m *RateDeviation) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateDeviation) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateDeviation.DiscardUnknown(m)
 }
 
-func (m *QueryFeederDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FeederAddr) > 0 {
-		i -= len(m.FeederAddr)
-		copy(dAtA[i:], m.FeederAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeederAddr)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_RateDeviation proto.InternalMessageInfo
+
+func (m *RateDeviation) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryMissCounterRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RateDeviation) GetInRewardBand() bool {
+	if m != nil {
+		return m.InRewardBand
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryMissCounterRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryValidatorMissingDenomsRequest is the request type for the Query/ValidatorMissingDenoms RPC method.
+type QueryValidatorMissingDenomsRequest struct {
+	// validator_addr defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-func (m *QueryMissCounterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryValidatorMissingDenomsRequest) Reset()         { *m = QueryValidatorMissingDenomsRequest{} }
+func (m *QueryValidatorMissingDenomsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorMissingDenomsRequest) ProtoMessage()    {}
+func (*QueryValidatorMissingDenomsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{43}
 }
-
-func (m *QueryMissCounterResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorMissingDenomsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorMissingDenomsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorMissingDenomsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryValidatorMissingDenomsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorMissingDenomsRequest.Merge(m, src)
+}
+func (m *QueryValidatorMissingDenomsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorMissingDenomsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorMissingDenomsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryMissCounterResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryValidatorMissingDenomsRequest proto.InternalMessageInfo
+
+// QueryValidatorMissingDenomsResponse is response type for the
+// Query/ValidatorMissingDenoms RPC method.
+type QueryValidatorMissingDenomsResponse struct {
+	// missing_denoms defines the active denoms without a vote of the validator, sorted by denom.
+	MissingDenoms []string `protobuf:"bytes,1,rep,name=missing_denoms,json=missingDenoms,proto3" json:"missing_denoms,omitempty"`
 }
 
-func (m *QueryMissCounterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MissCounter != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MissCounter))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryValidatorMissingDenomsResponse) Reset()         { *m = QueryValidatorMissingDenomsResponse{} }
+func (m *QueryValidatorMissingDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorMissingDenomsResponse) ProtoMessage()    {}
+func (*QueryValidatorMissingDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{44}
+}
+func (m *QueryValidatorMissingDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorMissingDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorMissingDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryValidatorMissingDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorMissingDenomsResponse.Merge(m, src)
+}
+func (m *QueryValidatorMissingDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorMissingDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorMissingDenomsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAggregatePrevoteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidatorMissingDenomsResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorMissingDenomsResponse) GetMissingDenoms() []string {
+	if m != nil {
+		return m.MissingDenoms
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAggregatePrevoteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDenomTallySuccessRateRequest is the request type for the Query/DenomTallySuccessRate RPC method.
+type QueryDenomTallySuccessRateRequest struct {
+	// denom optionally restricts the response to a single denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryAggregatePrevoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryDenomTallySuccessRateRequest) Reset()         { *m = QueryDenomTallySuccessRateRequest{} }
+func (m *QueryDenomTallySuccessRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomTallySuccessRateRequest) ProtoMessage()    {}
+func (*QueryDenomTallySuccessRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{45}
 }
-
-func (m *QueryAggregatePrevoteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDenomTallySuccessRateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomTallySuccessRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomTallySuccessRateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryDenomTallySuccessRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomTallySuccessRateRequest.Merge(m, src)
+}
+func (m *QueryDenomTallySuccessRateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomTallySuccessRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomTallySuccessRateRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAggregatePrevoteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryDenomTallySuccessRateRequest proto.InternalMessageInfo
+
+// QueryDenomTallySuccessRateResponse is response type for the
+// Query/DenomTallySuccessRate RPC method.
+type QueryDenomTallySuccessRateResponse struct {
+	// success_rates defines the tally outcomes of each denom, sorted by denom.
+	SuccessRates []DenomTallySuccessRate `protobuf:"bytes,1,rep,name=success_rates,json=successRates,proto3" json:"success_rates"`
 }
 
-func (m *QueryAggregatePrevoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.AggregatePrevote.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryDenomTallySuccessRateResponse) Reset()         { *m = QueryDenomTallySuccessRateResponse{} }
+func (m *QueryDenomTallySuccessRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomTallySuccessRateResponse) ProtoMessage()    {}
+func (*QueryDenomTallySuccessRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{46}
+}
+func (m *QueryDenomTallySuccessRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomTallySuccessRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomTallySuccessRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAggregatePrevotesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDenomTallySuccessRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomTallySuccessRateResponse.Merge(m, src)
 }
-
-func (m *QueryAggregatePrevotesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDenomTallySuccessRateResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryAggregatePrevotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryDenomTallySuccessRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomTallySuccessRateResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAggregatePrevotesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDenomTallySuccessRateResponse proto.InternalMessageInfo
+
+func (m *QueryDenomTallySuccessRateResponse) GetSuccessRates() []DenomTallySuccessRate {
+	if m != nil {
+		return m.SuccessRates
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAggregatePrevotesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DenomTallySuccessRate defines the tally outcomes of a denom in the current slash window.
+type DenomTallySuccessRate struct {
+	// denom defines the vote target.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// success_periods defines the number of vote periods the denom tallied.
+	SuccessPeriods uint64 `protobuf:"varint,2,opt,name=success_periods,json=successPeriods,proto3" json:"success_periods,omitempty"`
+	// failed_periods defines the number of vote periods the denom failed to tally.
+	FailedPeriods uint64 `protobuf:"varint,3,opt,name=failed_periods,json=failedPeriods,proto3" json:"failed_periods,omitempty"`
 }
 
-func (m *QueryAggregatePrevotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AggregatePrevotes) > 0 {
-		for iNdEx := len(m.AggregatePrevotes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AggregatePrevotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *DenomTallySuccessRate) Reset()         { *m = DenomTallySuccessRate{} }
+func (m *DenomTallySuccessRate) String() string { return proto.CompactTextString(m) }
+func (*DenomTallySuccessRate) ProtoMessage()    {}
+func (*DenomTallySuccessRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{47}
+}
+func (m *DenomTallySuccessRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomTallySuccessRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomTallySuccessRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAggregateVoteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *DenomTallySuccessRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomTallySuccessRate.Merge(m, src)
+}
+func (m *DenomTallySuccessRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomTallySuccessRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomTallySuccessRate.DiscardUnknown(m)
 }
 
-func (m *QueryAggregateVoteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_DenomTallySuccessRate proto.InternalMessageInfo
+
+func (m *DenomTallySuccessRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *QueryAggregateVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *DenomTallySuccessRate) GetSuccessPeriods() uint64 {
+	if m != nil {
+		return m.SuccessPeriods
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryAggregateVoteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DenomTallySuccessRate) GetFailedPeriods() uint64 {
+	if m != nil {
+		return m.FailedPeriods
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAggregateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPendingRevealsRequest is the request type for the Query/PendingReveals RPC method.
+type QueryPendingRevealsRequest struct {
 }
 
-func (m *QueryAggregateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.AggregateVote.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPendingRevealsRequest) Reset()         { *m = QueryPendingRevealsRequest{} }
+func (m *QueryPendingRevealsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRevealsRequest) ProtoMessage()    {}
+func (*QueryPendingRevealsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{48}
+}
+func (m *QueryPendingRevealsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRevealsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRevealsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAggregateVotesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPendingRevealsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRevealsRequest.Merge(m, src)
 }
-
-func (m *QueryAggregateVotesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPendingRevealsRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryAggregateVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryPendingRevealsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRevealsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAggregateVotesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_QueryPendingRevealsRequest proto.InternalMessageInfo
 
-func (m *QueryAggregateVotesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPendingRevealsResponse is response type for the
+// Query/PendingReveals RPC method.
+type QueryPendingRevealsResponse struct {
+	// pending_reveals defines the prevotes awaiting their vote, sorted by voter.
+	PendingReveals []PendingReveal `protobuf:"bytes,1,rep,name=pending_reveals,json=pendingReveals,proto3" json:"pending_reveals"`
 }
 
-func (m *QueryAggregateVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AggregateVotes) > 0 {
-		for iNdEx := len(m.AggregateVotes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AggregateVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryPendingRevealsResponse) Reset()         { *m = QueryPendingRevealsResponse{} }
+func (m *QueryPendingRevealsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRevealsResponse) ProtoMessage()    {}
+func (*QueryPendingRevealsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{49}
+}
+func (m *QueryPendingRevealsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRevealsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRevealsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPendingRevealsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRevealsResponse.Merge(m, src)
+}
+func (m *QueryPendingRevealsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRevealsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRevealsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingRevealsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingRevealsResponse) GetPendingReveals() []PendingReveal {
+	if m != nil {
+		return m.PendingReveals
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PendingReveal defines a prevote which can be revealed in the current vote period.
+type PendingReveal struct {
+	// voter defines the validator which submitted the prevote.
+	Voter string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	// submit_block defines the height the prevote was submitted at.
+	SubmitBlock uint64 `protobuf:"varint,2,opt,name=submit_block,json=submitBlock,proto3" json:"submit_block,omitempty"`
+	// remaining_blocks defines the number of blocks after the queried height in
+	// which the vote can still be revealed, zero if the vote periods are timed.
+	RemainingBlocks uint64 `protobuf:"varint,3,opt,name=remaining_blocks,json=remainingBlocks,proto3" json:"remaining_blocks,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PendingReveal) Reset()         { *m = PendingReveal{} }
+func (m *PendingReveal) String() string { return proto.CompactTextString(m) }
+func (*PendingReveal) ProtoMessage()    {}
+func (*PendingReveal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{50}
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PendingReveal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *PendingReveal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingReveal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *PendingReveal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingReveal.Merge(m, src)
 }
-func (m *QueryExchangeRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *PendingReveal) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingReveal) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingReveal.DiscardUnknown(m)
 }
 
-func (m *QueryExchangeRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_PendingReveal proto.InternalMessageInfo
+
+func (m *PendingReveal) GetVoter() string {
+	if m != nil {
+		return m.Voter
 	}
-	var l int
-	_ = l
-	l = m.ExchangeRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryExchangeRatesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PendingReveal) GetSubmitBlock() uint64 {
+	if m != nil {
+		return m.SubmitBlock
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *QueryExchangeRatesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ExchangeRates) > 0 {
-		for _, e := range m.ExchangeRates {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *PendingReveal) GetRemainingBlocks() uint64 {
+	if m != nil {
+		return m.RemainingBlocks
 	}
-	return n
+	return 0
 }
 
-func (m *QueryActivesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// QueryRequiredDenomsRequest is the request type for the Query/RequiredDenoms RPC method.
+type QueryRequiredDenomsRequest struct {
 }
 
-func (m *QueryActivesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Actives) > 0 {
-		for _, s := range m.Actives {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryRequiredDenomsRequest) Reset()         { *m = QueryRequiredDenomsRequest{} }
+func (m *QueryRequiredDenomsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRequiredDenomsRequest) ProtoMessage()    {}
+func (*QueryRequiredDenomsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{51}
+}
+func (m *QueryRequiredDenomsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRequiredDenomsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRequiredDenomsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryRequiredDenomsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequiredDenomsRequest.Merge(m, src)
+}
+func (m *QueryRequiredDenomsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRequiredDenomsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequiredDenomsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryVoteTargetsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_QueryRequiredDenomsRequest proto.InternalMessageInfo
+
+// QueryRequiredDenomsResponse is response type for the
+// Query/RequiredDenoms RPC method.
+type QueryRequiredDenomsResponse struct {
+	// required_denoms defines the required denoms, sorted by denom.
+	RequiredDenoms []RequiredDenom `protobuf:"bytes,1,rep,name=required_denoms,json=requiredDenoms,proto3" json:"required_denoms"`
 }
 
-func (m *QueryVoteTargetsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.VoteTargets) > 0 {
-		for _, s := range m.VoteTargets {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryRequiredDenomsResponse) Reset()         { *m = QueryRequiredDenomsResponse{} }
+func (m *QueryRequiredDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRequiredDenomsResponse) ProtoMessage()    {}
+func (*QueryRequiredDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{52}
+}
+func (m *QueryRequiredDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRequiredDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRequiredDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
 }
-
-func (m *QueryFeederDelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryRequiredDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequiredDenomsResponse.Merge(m, src)
+}
+func (m *QueryRequiredDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRequiredDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequiredDenomsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFeederDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FeederAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryRequiredDenomsResponse proto.InternalMessageInfo
+
+func (m *QueryRequiredDenomsResponse) GetRequiredDenoms() []RequiredDenom {
+	if m != nil {
+		return m.RequiredDenoms
 	}
-	return n
+	return nil
 }
 
-func (m *QueryMissCounterRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// RequiredDenom defines a denom other modules require fresh exchange rates of.
+type RequiredDenom struct {
+	// denom defines the required denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// modules defines the names of the modules requiring the denom, sorted by name.
+	Modules []string `protobuf:"bytes,2,rep,name=modules,proto3" json:"modules,omitempty"`
 }
 
-func (m *QueryMissCounterResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MissCounter != 0 {
-		n += 1 + sovQuery(uint64(m.MissCounter))
+func (m *RequiredDenom) Reset()         { *m = RequiredDenom{} }
+func (m *RequiredDenom) String() string { return proto.CompactTextString(m) }
+func (*RequiredDenom) ProtoMessage()    {}
+func (*RequiredDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{53}
+}
+func (m *RequiredDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequiredDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequiredDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *RequiredDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequiredDenom.Merge(m, src)
+}
+func (m *RequiredDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequiredDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequiredDenom.DiscardUnknown(m)
 }
 
-func (m *QueryAggregatePrevoteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_RequiredDenom proto.InternalMessageInfo
+
+func (m *RequiredDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return n
+	return ""
 }
 
-func (m *QueryAggregatePrevoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RequiredDenom) GetModules() []string {
+	if m != nil {
+		return m.Modules
 	}
-	var l int
-	_ = l
-	l = m.AggregatePrevote.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryAggregatePrevotesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// QueryDenomScheduleRequest is the request type for the Query/DenomSchedule RPC method.
+type QueryDenomScheduleRequest struct {
+	// denom defines the whitelisted denom to query for, all if empty.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryAggregatePrevotesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.AggregatePrevotes) > 0 {
-		for _, e := range m.AggregatePrevotes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryDenomScheduleRequest) Reset()         { *m = QueryDenomScheduleRequest{} }
+func (m *QueryDenomScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomScheduleRequest) ProtoMessage()    {}
+func (*QueryDenomScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{54}
+}
+func (m *QueryDenomScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryDenomScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomScheduleRequest.Merge(m, src)
+}
+func (m *QueryDenomScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomScheduleRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAggregateVoteRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryDenomScheduleRequest proto.InternalMessageInfo
+
+// QueryDenomScheduleResponse is response type for the
+// Query/DenomSchedule RPC method.
+type QueryDenomScheduleResponse struct {
+	// current_period defines the vote period of the queried height.
+	CurrentPeriod uint64 `protobuf:"varint,1,opt,name=current_period,json=currentPeriod,proto3" json:"current_period,omitempty"`
+	// schedules defines the schedule of each denom, the soonest due first.
+	Schedules []DenomSchedule `protobuf:"bytes,2,rep,name=schedules,proto3" json:"schedules"`
+}
+
+func (m *QueryDenomScheduleResponse) Reset()         { *m = QueryDenomScheduleResponse{} }
+func (m *QueryDenomScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomScheduleResponse) ProtoMessage()    {}
+func (*QueryDenomScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{55}
+}
+func (m *QueryDenomScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryDenomScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomScheduleResponse.Merge(m, src)
+}
+func (m *QueryDenomScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryDenomScheduleResponse) GetCurrentPeriod() uint64 {
+	if m != nil {
+		return m.CurrentPeriod
 	}
-	return n
+	return 0
 }
 
-func (m *QueryAggregateVoteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDenomScheduleResponse) GetSchedules() []DenomSchedule {
+	if m != nil {
+		return m.Schedules
 	}
-	var l int
-	_ = l
-	l = m.AggregateVote.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryAggregateVotesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// DenomSchedule defines when votes on a denom are next required.
+type DenomSchedule struct {
+	// denom defines the whitelisted denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// vote_period_multiplier defines every how many vote periods the denom is tallied.
+	VotePeriodMultiplier uint64 `protobuf:"varint,2,opt,name=vote_period_multiplier,json=votePeriodMultiplier,proto3" json:"vote_period_multiplier,omitempty"`
+	// next_period defines the first vote period from the current one on, which
+	// tallies the denom and counts missing it, i.e. after its grace window.
+	NextPeriod uint64 `protobuf:"varint,3,opt,name=next_period,json=nextPeriod,proto3" json:"next_period,omitempty"`
+	// in_grace defines whether the denom is in its grace window.
+	InGrace bool `protobuf:"varint,4,opt,name=in_grace,json=inGrace,proto3" json:"in_grace,omitempty"`
+	// remaining_blocks defines the number of blocks after the queried height up
+	// to the last block of next_period, zero if the vote periods are timed.
+	RemainingBlocks uint64 `protobuf:"varint,5,opt,name=remaining_blocks,json=remainingBlocks,proto3" json:"remaining_blocks,omitempty"`
+	// remaining_time defines the time from the queried block until the boundary
+	// closing next_period, zero unless the vote periods are timed.
+	RemainingTime time.Duration `protobuf:"bytes,6,opt,name=remaining_time,json=remainingTime,proto3,stdduration" json:"remaining_time"`
+}
+
+func (m *DenomSchedule) Reset()         { *m = DenomSchedule{} }
+func (m *DenomSchedule) String() string { return proto.CompactTextString(m) }
+func (*DenomSchedule) ProtoMessage()    {}
+func (*DenomSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{56}
+}
+func (m *DenomSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *DenomSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomSchedule.Merge(m, src)
+}
+func (m *DenomSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomSchedule.DiscardUnknown(m)
 }
 
-func (m *QueryAggregateVotesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_DenomSchedule proto.InternalMessageInfo
+
+func (m *DenomSchedule) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	var l int
-	_ = l
-	if len(m.AggregateVotes) > 0 {
-		for _, e := range m.AggregateVotes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *DenomSchedule) GetVotePeriodMultiplier() uint64 {
+	if m != nil {
+		return m.VotePeriodMultiplier
+	}
+	return 0
+}
+
+func (m *DenomSchedule) GetNextPeriod() uint64 {
+	if m != nil {
+		return m.NextPeriod
+	}
+	return 0
+}
+
+func (m *DenomSchedule) GetInGrace() bool {
+	if m != nil {
+		return m.InGrace
+	}
+	return false
+}
+
+func (m *DenomSchedule) GetRemainingBlocks() uint64 {
+	if m != nil {
+		return m.RemainingBlocks
+	}
+	return 0
+}
+
+func (m *DenomSchedule) GetRemainingTime() time.Duration {
+	if m != nil {
+		return m.RemainingTime
+	}
+	return 0
+}
+
+// QueryObserversRequest is the request type for the Query/Observers RPC method.
+type QueryObserversRequest struct {
+}
+
+func (m *QueryObserversRequest) Reset()         { *m = QueryObserversRequest{} }
+func (m *QueryObserversRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryObserversRequest) ProtoMessage()    {}
+func (*QueryObserversRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{57}
+}
+func (m *QueryObserversRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryObserversRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryObserversRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryObserversRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryObserversRequest.Merge(m, src)
+}
+func (m *QueryObserversRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryObserversRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryObserversRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryObserversRequest proto.InternalMessageInfo
+
+// QueryObserversResponse is response type for the
+// Query/Observers RPC method.
+type QueryObserversResponse struct {
+	// observers defines the observers, the soonest expiring first.
+	Observers []Observer `protobuf:"bytes,1,rep,name=observers,proto3" json:"observers"`
+}
+
+func (m *QueryObserversResponse) Reset()         { *m = QueryObserversResponse{} }
+func (m *QueryObserversResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryObserversResponse) ProtoMessage()    {}
+func (*QueryObserversResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{58}
+}
+func (m *QueryObserversResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryObserversResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryObserversResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryObserversResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryObserversResponse.Merge(m, src)
+}
+func (m *QueryObserversResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryObserversResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryObserversResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryObserversResponse proto.InternalMessageInfo
+
+func (m *QueryObserversResponse) GetObservers() []Observer {
+	if m != nil {
+		return m.Observers
+	}
+	return nil
+}
+
+// Observer defines a validator exempt from slashing for missing votes.
+type Observer struct {
+	// validator defines the operator address of the observer.
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	// exempt_until_height defines the first block height the observer is no
+	// longer exempt at.
+	ExemptUntilHeight int64 `protobuf:"varint,2,opt,name=exempt_until_height,json=exemptUntilHeight,proto3" json:"exempt_until_height,omitempty"`
+}
+
+func (m *Observer) Reset()         { *m = Observer{} }
+func (m *Observer) String() string { return proto.CompactTextString(m) }
+func (*Observer) ProtoMessage()    {}
+func (*Observer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{59}
+}
+func (m *Observer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Observer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Observer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Observer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Observer.Merge(m, src)
+}
+func (m *Observer) XXX_Size() int {
+	return m.Size()
+}
+func (m *Observer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Observer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Observer proto.InternalMessageInfo
+
+func (m *Observer) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *Observer) GetExemptUntilHeight() int64 {
+	if m != nil {
+		return m.ExemptUntilHeight
+	}
+	return 0
+}
+
+// QueryBandMembershipStatsRequest is the request type for the Query/BandMembershipStats RPC method.
+type QueryBandMembershipStatsRequest struct {
+	// denom defines the denom to query for, all tallied in the last vote period if empty.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryBandMembershipStatsRequest) Reset()         { *m = QueryBandMembershipStatsRequest{} }
+func (m *QueryBandMembershipStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBandMembershipStatsRequest) ProtoMessage()    {}
+func (*QueryBandMembershipStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{60}
+}
+func (m *QueryBandMembershipStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBandMembershipStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBandMembershipStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBandMembershipStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBandMembershipStatsRequest.Merge(m, src)
+}
+func (m *QueryBandMembershipStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBandMembershipStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBandMembershipStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBandMembershipStatsRequest proto.InternalMessageInfo
+
+// QueryBandMembershipStatsResponse is response type for the
+// Query/BandMembershipStats RPC method.
+type QueryBandMembershipStatsResponse struct {
+	// stats defines the band membership of each denom, sorted by denom.
+	Stats []BandMembershipStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats"`
+}
+
+func (m *QueryBandMembershipStatsResponse) Reset()         { *m = QueryBandMembershipStatsResponse{} }
+func (m *QueryBandMembershipStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBandMembershipStatsResponse) ProtoMessage()    {}
+func (*QueryBandMembershipStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{61}
+}
+func (m *QueryBandMembershipStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBandMembershipStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBandMembershipStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBandMembershipStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBandMembershipStatsResponse.Merge(m, src)
+}
+func (m *QueryBandMembershipStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBandMembershipStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBandMembershipStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBandMembershipStatsResponse proto.InternalMessageInfo
+
+func (m *QueryBandMembershipStatsResponse) GetStats() []BandMembershipStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+// BandMembershipStats defines the power of the votes of the last tally of a
+// denom within its reward band.
+type BandMembershipStats struct {
+	// denom defines the tallied denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rated_power defines the power of the votes rating the denom, i.e. not abstaining.
+	RatedPower int64 `protobuf:"varint,2,opt,name=rated_power,json=ratedPower,proto3" json:"rated_power,omitempty"`
+	// in_band_power defines the power of the rating votes within the reward band.
+	InBandPower int64 `protobuf:"varint,3,opt,name=in_band_power,json=inBandPower,proto3" json:"in_band_power,omitempty"`
+	// in_band_fraction defines the share of the rated power within the reward band.
+	InBandFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=in_band_fraction,json=inBandFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"in_band_fraction"`
+}
+
+func (m *BandMembershipStats) Reset()         { *m = BandMembershipStats{} }
+func (m *BandMembershipStats) String() string { return proto.CompactTextString(m) }
+func (*BandMembershipStats) ProtoMessage()    {}
+func (*BandMembershipStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{62}
+}
+func (m *BandMembershipStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BandMembershipStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BandMembershipStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BandMembershipStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BandMembershipStats.Merge(m, src)
+}
+func (m *BandMembershipStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *BandMembershipStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_BandMembershipStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BandMembershipStats proto.InternalMessageInfo
+
+func (m *BandMembershipStats) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *BandMembershipStats) GetRatedPower() int64 {
+	if m != nil {
+		return m.RatedPower
+	}
+	return 0
+}
+
+func (m *BandMembershipStats) GetInBandPower() int64 {
+	if m != nil {
+		return m.InBandPower
+	}
+	return 0
+}
+
+// QueryValidatorAccuracyRankingRequest is the request type for the Query/ValidatorAccuracyRanking RPC method.
+type QueryValidatorAccuracyRankingRequest struct {
+	// limit defines the number of validators to return, all if zero.
+	Limit uint64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// ascending ranks the least accurate validators first.
+	Ascending bool `protobuf:"varint,2,opt,name=ascending,proto3" json:"ascending,omitempty"`
+}
+
+func (m *QueryValidatorAccuracyRankingRequest) Reset()         { *m = QueryValidatorAccuracyRankingRequest{} }
+func (m *QueryValidatorAccuracyRankingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorAccuracyRankingRequest) ProtoMessage()    {}
+func (*QueryValidatorAccuracyRankingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{63}
+}
+func (m *QueryValidatorAccuracyRankingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorAccuracyRankingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorAccuracyRankingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorAccuracyRankingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorAccuracyRankingRequest.Merge(m, src)
+}
+func (m *QueryValidatorAccuracyRankingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorAccuracyRankingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorAccuracyRankingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorAccuracyRankingRequest proto.InternalMessageInfo
+
+// QueryValidatorAccuracyRankingResponse is response type for the
+// Query/ValidatorAccuracyRanking RPC method.
+type QueryValidatorAccuracyRankingResponse struct {
+	// ranking defines the accuracy of the validators, the most accurate first
+	// unless ascending was requested.
+	Ranking []ValidatorAccuracy `protobuf:"bytes,1,rep,name=ranking,proto3" json:"ranking"`
+}
+
+func (m *QueryValidatorAccuracyRankingResponse) Reset()         { *m = QueryValidatorAccuracyRankingResponse{} }
+func (m *QueryValidatorAccuracyRankingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorAccuracyRankingResponse) ProtoMessage()    {}
+func (*QueryValidatorAccuracyRankingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{64}
+}
+func (m *QueryValidatorAccuracyRankingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorAccuracyRankingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorAccuracyRankingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorAccuracyRankingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorAccuracyRankingResponse.Merge(m, src)
+}
+func (m *QueryValidatorAccuracyRankingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorAccuracyRankingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorAccuracyRankingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorAccuracyRankingResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorAccuracyRankingResponse) GetRanking() []ValidatorAccuracy {
+	if m != nil {
+		return m.Ranking
+	}
+	return nil
+}
+
+// ValidatorAccuracy defines the share of the exchange rates a validator
+// submitted on tallied denoms in the current and the previous slash window,
+// which were within the reward band.
+type ValidatorAccuracy struct {
+	// validator_addr defines the validator address.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	// submissions defines the number of exchange rates submitted, abstaining
+	// votes not counted.
+	Submissions uint64 `protobuf:"varint,2,opt,name=submissions,proto3" json:"submissions,omitempty"`
+	// in_band_submissions defines the number of submitted exchange rates within
+	// the reward band.
+	InBandSubmissions uint64 `protobuf:"varint,3,opt,name=in_band_submissions,json=inBandSubmissions,proto3" json:"in_band_submissions,omitempty"`
+	// in_band_fraction defines the share of the submitted exchange rates within
+	// the reward band.
+	InBandFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=in_band_fraction,json=inBandFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"in_band_fraction"`
+}
+
+func (m *ValidatorAccuracy) Reset()         { *m = ValidatorAccuracy{} }
+func (m *ValidatorAccuracy) String() string { return proto.CompactTextString(m) }
+func (*ValidatorAccuracy) ProtoMessage()    {}
+func (*ValidatorAccuracy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{65}
+}
+func (m *ValidatorAccuracy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorAccuracy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorAccuracy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorAccuracy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorAccuracy.Merge(m, src)
+}
+func (m *ValidatorAccuracy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorAccuracy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorAccuracy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorAccuracy proto.InternalMessageInfo
+
+func (m *ValidatorAccuracy) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+func (m *ValidatorAccuracy) GetSubmissions() uint64 {
+	if m != nil {
+		return m.Submissions
+	}
+	return 0
+}
+
+func (m *ValidatorAccuracy) GetInBandSubmissions() uint64 {
+	if m != nil {
+		return m.InBandSubmissions
+	}
+	return 0
+}
+
+// QueryDenomTallyDiagnosisRequest is the request type for the Query/DenomTallyDiagnosis RPC method.
+type QueryDenomTallyDiagnosisRequest struct {
+	// denom defines the denom to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryDenomTallyDiagnosisRequest) Reset()         { *m = QueryDenomTallyDiagnosisRequest{} }
+func (m *QueryDenomTallyDiagnosisRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomTallyDiagnosisRequest) ProtoMessage()    {}
+func (*QueryDenomTallyDiagnosisRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{66}
+}
+func (m *QueryDenomTallyDiagnosisRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomTallyDiagnosisRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomTallyDiagnosisRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomTallyDiagnosisRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomTallyDiagnosisRequest.Merge(m, src)
+}
+func (m *QueryDenomTallyDiagnosisRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomTallyDiagnosisRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomTallyDiagnosisRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomTallyDiagnosisRequest proto.InternalMessageInfo
+
+// QueryDenomTallyDiagnosisResponse is response type for the
+// Query/DenomTallyDiagnosis RPC method.
+type QueryDenomTallyDiagnosisResponse struct {
+	// outcome defines the outcome of the last vote period of the denom.
+	Outcome DenomTallyOutcome `protobuf:"bytes,1,opt,name=outcome,proto3" json:"outcome"`
+}
+
+func (m *QueryDenomTallyDiagnosisResponse) Reset()         { *m = QueryDenomTallyDiagnosisResponse{} }
+func (m *QueryDenomTallyDiagnosisResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomTallyDiagnosisResponse) ProtoMessage()    {}
+func (*QueryDenomTallyDiagnosisResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{67}
+}
+func (m *QueryDenomTallyDiagnosisResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomTallyDiagnosisResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomTallyDiagnosisResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomTallyDiagnosisResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomTallyDiagnosisResponse.Merge(m, src)
+}
+func (m *QueryDenomTallyDiagnosisResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomTallyDiagnosisResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomTallyDiagnosisResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomTallyDiagnosisResponse proto.InternalMessageInfo
+
+func (m *QueryDenomTallyDiagnosisResponse) GetOutcome() DenomTallyOutcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return DenomTallyOutcome{}
+}
+
+// QueryParticipationSummaryRequest is the request type for the Query/ParticipationSummary RPC method.
+type QueryParticipationSummaryRequest struct {
+}
+
+func (m *QueryParticipationSummaryRequest) Reset()         { *m = QueryParticipationSummaryRequest{} }
+func (m *QueryParticipationSummaryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipationSummaryRequest) ProtoMessage()    {}
+func (*QueryParticipationSummaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{68}
+}
+func (m *QueryParticipationSummaryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParticipationSummaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipationSummaryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParticipationSummaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipationSummaryRequest.Merge(m, src)
+}
+func (m *QueryParticipationSummaryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipationSummaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipationSummaryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParticipationSummaryRequest proto.InternalMessageInfo
+
+// QueryParticipationSummaryResponse is response type for the
+// Query/ParticipationSummary RPC method.
+type QueryParticipationSummaryResponse struct {
+	// bonded_power defines the total power of the bonded validators.
+	BondedPower int64 `protobuf:"varint,1,opt,name=bonded_power,json=bondedPower,proto3" json:"bonded_power,omitempty"`
+	// bonded_validators defines the number of bonded validators.
+	BondedValidators uint64 `protobuf:"varint,2,opt,name=bonded_validators,json=bondedValidators,proto3" json:"bonded_validators,omitempty"`
+	// voted_power defines the power of the bonded validators which voted.
+	VotedPower int64 `protobuf:"varint,3,opt,name=voted_power,json=votedPower,proto3" json:"voted_power,omitempty"`
+	// voters defines the number of bonded validators which voted.
+	Voters uint64 `protobuf:"varint,4,opt,name=voters,proto3" json:"voters,omitempty"`
+	// participation_ratio defines the share of the bonded power which voted.
+	ParticipationRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=participation_ratio,json=participationRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"participation_ratio"`
+}
+
+func (m *QueryParticipationSummaryResponse) Reset()         { *m = QueryParticipationSummaryResponse{} }
+func (m *QueryParticipationSummaryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipationSummaryResponse) ProtoMessage()    {}
+func (*QueryParticipationSummaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{69}
+}
+func (m *QueryParticipationSummaryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParticipationSummaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipationSummaryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParticipationSummaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipationSummaryResponse.Merge(m, src)
+}
+func (m *QueryParticipationSummaryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipationSummaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipationSummaryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParticipationSummaryResponse proto.InternalMessageInfo
+
+func (m *QueryParticipationSummaryResponse) GetBondedPower() int64 {
+	if m != nil {
+		return m.BondedPower
+	}
+	return 0
+}
+
+func (m *QueryParticipationSummaryResponse) GetBondedValidators() uint64 {
+	if m != nil {
+		return m.BondedValidators
+	}
+	return 0
+}
+
+func (m *QueryParticipationSummaryResponse) GetVotedPower() int64 {
+	if m != nil {
+		return m.VotedPower
+	}
+	return 0
+}
+
+func (m *QueryParticipationSummaryResponse) GetVoters() uint64 {
+	if m != nil {
+		return m.Voters
+	}
+	return 0
+}
+
+// QueryRawDenomStateRequest is the request type for the Query/RawDenomState RPC method.
+type QueryRawDenomStateRequest struct {
+	// denom defines the denom to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryRawDenomStateRequest) Reset()         { *m = QueryRawDenomStateRequest{} }
+func (m *QueryRawDenomStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRawDenomStateRequest) ProtoMessage()    {}
+func (*QueryRawDenomStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{70}
+}
+func (m *QueryRawDenomStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRawDenomStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRawDenomStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRawDenomStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRawDenomStateRequest.Merge(m, src)
+}
+func (m *QueryRawDenomStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRawDenomStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRawDenomStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRawDenomStateRequest proto.InternalMessageInfo
+
+// QueryRawDenomStateResponse is response type for the
+// Query/RawDenomState RPC method.
+type QueryRawDenomStateResponse struct {
+	// entries defines the store entries of the denom, those not stored left out.
+	Entries []RawStoreEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *QueryRawDenomStateResponse) Reset()         { *m = QueryRawDenomStateResponse{} }
+func (m *QueryRawDenomStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRawDenomStateResponse) ProtoMessage()    {}
+func (*QueryRawDenomStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{71}
+}
+func (m *QueryRawDenomStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRawDenomStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRawDenomStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRawDenomStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRawDenomStateResponse.Merge(m, src)
+}
+func (m *QueryRawDenomStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRawDenomStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRawDenomStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRawDenomStateResponse proto.InternalMessageInfo
+
+func (m *QueryRawDenomStateResponse) GetEntries() []RawStoreEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// RawStoreEntry defines a store entry as persisted.
+type RawStoreEntry struct {
+	// name defines the name of the state stored.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// key defines the hex encoded store key.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// value defines the hex encoded stored value.
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *RawStoreEntry) Reset()         { *m = RawStoreEntry{} }
+func (m *RawStoreEntry) String() string { return proto.CompactTextString(m) }
+func (*RawStoreEntry) ProtoMessage()    {}
+func (*RawStoreEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{72}
+}
+func (m *RawStoreEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RawStoreEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RawStoreEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RawStoreEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RawStoreEntry.Merge(m, src)
+}
+func (m *RawStoreEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *RawStoreEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RawStoreEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RawStoreEntry proto.InternalMessageInfo
+
+func (m *RawStoreEntry) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RawStoreEntry) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *RawStoreEntry) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// QueryMedianFlipCostRequest is the request type for the Query/MedianFlipCost RPC method.
+type QueryMedianFlipCostRequest struct {
+	// denom defines the denom to query for, all denoms with a last ballot if empty.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// move defines the relative move of the median as a decimal, 0.05 if empty.
+	Move string `protobuf:"bytes,2,opt,name=move,proto3" json:"move,omitempty"`
+}
+
+func (m *QueryMedianFlipCostRequest) Reset()         { *m = QueryMedianFlipCostRequest{} }
+func (m *QueryMedianFlipCostRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMedianFlipCostRequest) ProtoMessage()    {}
+func (*QueryMedianFlipCostRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{73}
+}
+func (m *QueryMedianFlipCostRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMedianFlipCostRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMedianFlipCostRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMedianFlipCostRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMedianFlipCostRequest.Merge(m, src)
+}
+func (m *QueryMedianFlipCostRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMedianFlipCostRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMedianFlipCostRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMedianFlipCostRequest proto.InternalMessageInfo
+
+// QueryMedianFlipCostResponse is response type for the
+// Query/MedianFlipCost RPC method.
+type QueryMedianFlipCostResponse struct {
+	// move defines the relative move of the median the costs are estimated for.
+	Move github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=move,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"move"`
+	// flip_costs defines the cost of moving the median of each denom, sorted by denom.
+	FlipCosts []MedianFlipCost `protobuf:"bytes,2,rep,name=flip_costs,json=flipCosts,proto3" json:"flip_costs"`
+}
+
+func (m *QueryMedianFlipCostResponse) Reset()         { *m = QueryMedianFlipCostResponse{} }
+func (m *QueryMedianFlipCostResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMedianFlipCostResponse) ProtoMessage()    {}
+func (*QueryMedianFlipCostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{74}
+}
+func (m *QueryMedianFlipCostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMedianFlipCostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMedianFlipCostResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMedianFlipCostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMedianFlipCostResponse.Merge(m, src)
+}
+func (m *QueryMedianFlipCostResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMedianFlipCostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMedianFlipCostResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMedianFlipCostResponse proto.InternalMessageInfo
+
+func (m *QueryMedianFlipCostResponse) GetFlipCosts() []MedianFlipCost {
+	if m != nil {
+		return m.FlipCosts
+	}
+	return nil
+}
+
+// MedianFlipCost defines the voting power needed to move the median of a denom.
+type MedianFlipCost struct {
+	// denom defines the denom of the ballot.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// median defines the weighted median of the last ballot.
+	Median github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=median,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"median"`
+	// ballot_power defines the power of the last ballot, abstaining votes left out.
+	BallotPower int64 `protobuf:"varint,3,opt,name=ballot_power,json=ballotPower,proto3" json:"ballot_power,omitempty"`
+	// raise_power defines the power which, voting median * (1 + move), raises the median to it.
+	RaisePower int64 `protobuf:"varint,4,opt,name=raise_power,json=raisePower,proto3" json:"raise_power,omitempty"`
+	// lower_power defines the power which, voting median * (1 - move), lowers the median to it.
+	LowerPower int64 `protobuf:"varint,5,opt,name=lower_power,json=lowerPower,proto3" json:"lower_power,omitempty"`
+}
+
+func (m *MedianFlipCost) Reset()         { *m = MedianFlipCost{} }
+func (m *MedianFlipCost) String() string { return proto.CompactTextString(m) }
+func (*MedianFlipCost) ProtoMessage()    {}
+func (*MedianFlipCost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{75}
+}
+func (m *MedianFlipCost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MedianFlipCost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MedianFlipCost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MedianFlipCost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MedianFlipCost.Merge(m, src)
+}
+func (m *MedianFlipCost) XXX_Size() int {
+	return m.Size()
+}
+func (m *MedianFlipCost) XXX_DiscardUnknown() {
+	xxx_messageInfo_MedianFlipCost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MedianFlipCost proto.InternalMessageInfo
+
+func (m *MedianFlipCost) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MedianFlipCost) GetBallotPower() int64 {
+	if m != nil {
+		return m.BallotPower
+	}
+	return 0
+}
+
+func (m *MedianFlipCost) GetRaisePower() int64 {
+	if m != nil {
+		return m.RaisePower
+	}
+	return 0
+}
+
+func (m *MedianFlipCost) GetLowerPower() int64 {
+	if m != nil {
+		return m.LowerPower
+	}
+	return 0
+}
+
+// QueryOracleAlertConfigRequest is the request type for the Query/OracleAlertConfig RPC method.
+type QueryOracleAlertConfigRequest struct {
+	// validator_addr defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryOracleAlertConfigRequest) Reset()         { *m = QueryOracleAlertConfigRequest{} }
+func (m *QueryOracleAlertConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleAlertConfigRequest) ProtoMessage()    {}
+func (*QueryOracleAlertConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{76}
+}
+func (m *QueryOracleAlertConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleAlertConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleAlertConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOracleAlertConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleAlertConfigRequest.Merge(m, src)
+}
+func (m *QueryOracleAlertConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleAlertConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleAlertConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOracleAlertConfigRequest proto.InternalMessageInfo
+
+// QueryOracleAlertConfigResponse is response type for the
+// Query/OracleAlertConfig RPC method.
+type QueryOracleAlertConfigResponse struct {
+	// max_miss_rate defines the miss rate the validator declared to accept.
+	MaxMissRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=max_miss_rate,json=maxMissRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_miss_rate"`
+	// miss_rate defines the weighted misses of the validator in the current slash window
+	// relative to its vote periods.
+	MissRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=miss_rate,json=missRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"miss_rate"`
+}
+
+func (m *QueryOracleAlertConfigResponse) Reset()         { *m = QueryOracleAlertConfigResponse{} }
+func (m *QueryOracleAlertConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleAlertConfigResponse) ProtoMessage()    {}
+func (*QueryOracleAlertConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{77}
+}
+func (m *QueryOracleAlertConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleAlertConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleAlertConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOracleAlertConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleAlertConfigResponse.Merge(m, src)
+}
+func (m *QueryOracleAlertConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleAlertConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleAlertConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOracleAlertConfigResponse proto.InternalMessageInfo
+
+// QueryModuleInfoRequest is the request type for the Query/ModuleInfo RPC method.
+type QueryModuleInfoRequest struct {
+}
+
+func (m *QueryModuleInfoRequest) Reset()         { *m = QueryModuleInfoRequest{} }
+func (m *QueryModuleInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleInfoRequest) ProtoMessage()    {}
+func (*QueryModuleInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{78}
+}
+func (m *QueryModuleInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleInfoRequest.Merge(m, src)
+}
+func (m *QueryModuleInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleInfoRequest proto.InternalMessageInfo
+
+// QueryModuleInfoResponse is response type for the
+// Query/ModuleInfo RPC method.
+type QueryModuleInfoResponse struct {
+	// consensus_version defines the consensus version of the module.
+	ConsensusVersion uint64 `protobuf:"varint,1,opt,name=consensus_version,json=consensusVersion,proto3" json:"consensus_version,omitempty"`
+	// features defines the optional features supported by the module, mapped to whether the
+	// params enable them, "true" or "false", or to their setting. Features not supported are
+	// left out, and new ones may be added.
+	Features map[string]string `protobuf:"bytes,2,rep,name=features,proto3" json:"features,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *QueryModuleInfoResponse) Reset()         { *m = QueryModuleInfoResponse{} }
+func (m *QueryModuleInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleInfoResponse) ProtoMessage()    {}
+func (*QueryModuleInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{79}
+}
+func (m *QueryModuleInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleInfoResponse.Merge(m, src)
+}
+func (m *QueryModuleInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleInfoResponse proto.InternalMessageInfo
+
+func (m *QueryModuleInfoResponse) GetConsensusVersion() uint64 {
+	if m != nil {
+		return m.ConsensusVersion
+	}
+	return 0
+}
+
+func (m *QueryModuleInfoResponse) GetFeatures() map[string]string {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
+// QueryFreshExchangeRateRequest is the request type for the Query/FreshExchangeRate RPC method.
+type QueryFreshExchangeRateRequest struct {
+	// denom defines the denomination to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// max_age_periods defines the number of vote periods since the denom was last tallied
+	// above which the exchange rate is stale. 0 only accepts a rate tallied in the last vote period.
+	MaxAgePeriods uint64 `protobuf:"varint,2,opt,name=max_age_periods,json=maxAgePeriods,proto3" json:"max_age_periods,omitempty"`
+}
+
+func (m *QueryFreshExchangeRateRequest) Reset()         { *m = QueryFreshExchangeRateRequest{} }
+func (m *QueryFreshExchangeRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFreshExchangeRateRequest) ProtoMessage()    {}
+func (*QueryFreshExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{80}
+}
+func (m *QueryFreshExchangeRateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFreshExchangeRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFreshExchangeRateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFreshExchangeRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFreshExchangeRateRequest.Merge(m, src)
+}
+func (m *QueryFreshExchangeRateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFreshExchangeRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFreshExchangeRateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFreshExchangeRateRequest proto.InternalMessageInfo
+
+// QueryFreshExchangeRateResponse is response type for the
+// Query/FreshExchangeRate RPC method.
+type QueryFreshExchangeRateResponse struct {
+	// exchange_rate defines the exchange rate of the denom.
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate"`
+	// age_periods defines the number of vote periods since the denom was last tallied.
+	AgePeriods uint64 `protobuf:"varint,2,opt,name=age_periods,json=agePeriods,proto3" json:"age_periods,omitempty"`
+}
+
+func (m *QueryFreshExchangeRateResponse) Reset()         { *m = QueryFreshExchangeRateResponse{} }
+func (m *QueryFreshExchangeRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFreshExchangeRateResponse) ProtoMessage()    {}
+func (*QueryFreshExchangeRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{81}
+}
+func (m *QueryFreshExchangeRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFreshExchangeRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFreshExchangeRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFreshExchangeRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFreshExchangeRateResponse.Merge(m, src)
+}
+func (m *QueryFreshExchangeRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFreshExchangeRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFreshExchangeRateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFreshExchangeRateResponse proto.InternalMessageInfo
+
+func (m *QueryFreshExchangeRateResponse) GetAgePeriods() uint64 {
+	if m != nil {
+		return m.AgePeriods
+	}
+	return 0
+}
+
+// QueryWhitelistChangesRequest is the request type for the Query/WhitelistChanges RPC method.
+type QueryWhitelistChangesRequest struct {
+	// windows defines the number of vote periods to look back over, including the current one.
+	// 0 returns all the changes retained.
+	Windows uint64 `protobuf:"varint,1,opt,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (m *QueryWhitelistChangesRequest) Reset()         { *m = QueryWhitelistChangesRequest{} }
+func (m *QueryWhitelistChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWhitelistChangesRequest) ProtoMessage()    {}
+func (*QueryWhitelistChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{82}
+}
+func (m *QueryWhitelistChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWhitelistChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWhitelistChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWhitelistChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWhitelistChangesRequest.Merge(m, src)
+}
+func (m *QueryWhitelistChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWhitelistChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWhitelistChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWhitelistChangesRequest proto.InternalMessageInfo
+
+// QueryWhitelistChangesResponse is response type for the
+// Query/WhitelistChanges RPC method.
+type QueryWhitelistChangesResponse struct {
+	// added defines the denoms added to the whitelist, oldest first.
+	Added []WhitelistChange `protobuf:"bytes,1,rep,name=added,proto3" json:"added"`
+	// removed defines the denoms removed from the whitelist, oldest first.
+	Removed []WhitelistChange `protobuf:"bytes,2,rep,name=removed,proto3" json:"removed"`
+}
+
+func (m *QueryWhitelistChangesResponse) Reset()         { *m = QueryWhitelistChangesResponse{} }
+func (m *QueryWhitelistChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWhitelistChangesResponse) ProtoMessage()    {}
+func (*QueryWhitelistChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{83}
+}
+func (m *QueryWhitelistChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWhitelistChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWhitelistChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWhitelistChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWhitelistChangesResponse.Merge(m, src)
+}
+func (m *QueryWhitelistChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWhitelistChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWhitelistChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWhitelistChangesResponse proto.InternalMessageInfo
+
+func (m *QueryWhitelistChangesResponse) GetAdded() []WhitelistChange {
+	if m != nil {
+		return m.Added
+	}
+	return nil
+}
+
+func (m *QueryWhitelistChangesResponse) GetRemoved() []WhitelistChange {
+	if m != nil {
+		return m.Removed
+	}
+	return nil
+}
+
+// QueryRejectedTuplesRequest is the request type for the Query/RejectedTuples RPC method.
+type QueryRejectedTuplesRequest struct {
+	// validator_addr defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryRejectedTuplesRequest) Reset()         { *m = QueryRejectedTuplesRequest{} }
+func (m *QueryRejectedTuplesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRejectedTuplesRequest) ProtoMessage()    {}
+func (*QueryRejectedTuplesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{84}
+}
+func (m *QueryRejectedTuplesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRejectedTuplesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRejectedTuplesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRejectedTuplesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRejectedTuplesRequest.Merge(m, src)
+}
+func (m *QueryRejectedTuplesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRejectedTuplesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRejectedTuplesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRejectedTuplesRequest proto.InternalMessageInfo
+
+// QueryRejectedTuplesResponse is response type for the
+// Query/RejectedTuples RPC method.
+type QueryRejectedTuplesResponse struct {
+	// vote_period defines the vote period the vote was revealed in, 0 if none of its
+	// exchange rates was rejected.
+	VotePeriod uint64 `protobuf:"varint,1,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty"`
+	// tuples defines the rejected exchange rates, in the order of the vote.
+	Tuples []RejectedTuple `protobuf:"bytes,2,rep,name=tuples,proto3" json:"tuples"`
+}
+
+func (m *QueryRejectedTuplesResponse) Reset()         { *m = QueryRejectedTuplesResponse{} }
+func (m *QueryRejectedTuplesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRejectedTuplesResponse) ProtoMessage()    {}
+func (*QueryRejectedTuplesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{85}
+}
+func (m *QueryRejectedTuplesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRejectedTuplesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRejectedTuplesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRejectedTuplesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRejectedTuplesResponse.Merge(m, src)
+}
+func (m *QueryRejectedTuplesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRejectedTuplesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRejectedTuplesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRejectedTuplesResponse proto.InternalMessageInfo
+
+func (m *QueryRejectedTuplesResponse) GetVotePeriod() uint64 {
+	if m != nil {
+		return m.VotePeriod
+	}
+	return 0
+}
+
+func (m *QueryRejectedTuplesResponse) GetTuples() []RejectedTuple {
+	if m != nil {
+		return m.Tuples
+	}
+	return nil
+}
+
+// QueryRevealWindowStatusRequest is the request type for the Query/RevealWindowStatus RPC method.
+type QueryRevealWindowStatusRequest struct {
+	// validator_addr defines the validator address to query for.
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryRevealWindowStatusRequest) Reset()         { *m = QueryRevealWindowStatusRequest{} }
+func (m *QueryRevealWindowStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRevealWindowStatusRequest) ProtoMessage()    {}
+func (*QueryRevealWindowStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{86}
+}
+func (m *QueryRevealWindowStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRevealWindowStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRevealWindowStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRevealWindowStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRevealWindowStatusRequest.Merge(m, src)
+}
+func (m *QueryRevealWindowStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRevealWindowStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRevealWindowStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRevealWindowStatusRequest proto.InternalMessageInfo
+
+// QueryRevealWindowStatusResponse is response type for the
+// Query/RevealWindowStatus RPC method.
+type QueryRevealWindowStatusResponse struct {
+	// vote_period defines the current vote period.
+	VotePeriod uint64 `protobuf:"varint,1,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty"`
+	// has_prevote defines whether the validator has a prevote which is not revealed yet.
+	HasPrevote bool `protobuf:"varint,2,opt,name=has_prevote,json=hasPrevote,proto3" json:"has_prevote,omitempty"`
+	// submit_block defines the height the prevote was submitted at.
+	SubmitBlock uint64 `protobuf:"varint,3,opt,name=submit_block,json=submitBlock,proto3" json:"submit_block,omitempty"`
+	// reveal_open_block defines the first height the vote can be revealed at, zero if
+	// the vote periods are timed and the height is not known yet.
+	RevealOpenBlock uint64 `protobuf:"varint,4,opt,name=reveal_open_block,json=revealOpenBlock,proto3" json:"reveal_open_block,omitempty"`
+	// reveal_close_block defines the last height the vote can be revealed at, including
+	// the reveal grace, zero if the vote periods are timed.
+	RevealCloseBlock uint64 `protobuf:"varint,5,opt,name=reveal_close_block,json=revealCloseBlock,proto3" json:"reveal_close_block,omitempty"`
+	// revealable defines whether the vote can be revealed at the queried height.
+	Revealable bool `protobuf:"varint,6,opt,name=revealable,proto3" json:"revealable,omitempty"`
+	// revealed defines whether the validator revealed a vote in the current vote period.
+	Revealed bool `protobuf:"varint,7,opt,name=revealed,proto3" json:"revealed,omitempty"`
+}
+
+func (m *QueryRevealWindowStatusResponse) Reset()         { *m = QueryRevealWindowStatusResponse{} }
+func (m *QueryRevealWindowStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRevealWindowStatusResponse) ProtoMessage()    {}
+func (*QueryRevealWindowStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{87}
+}
+func (m *QueryRevealWindowStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRevealWindowStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRevealWindowStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRevealWindowStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRevealWindowStatusResponse.Merge(m, src)
+}
+func (m *QueryRevealWindowStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRevealWindowStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRevealWindowStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRevealWindowStatusResponse proto.InternalMessageInfo
+
+func (m *QueryRevealWindowStatusResponse) GetVotePeriod() uint64 {
+	if m != nil {
+		return m.VotePeriod
+	}
+	return 0
+}
+
+func (m *QueryRevealWindowStatusResponse) GetHasPrevote() bool {
+	if m != nil {
+		return m.HasPrevote
+	}
+	return false
+}
+
+func (m *QueryRevealWindowStatusResponse) GetSubmitBlock() uint64 {
+	if m != nil {
+		return m.SubmitBlock
+	}
+	return 0
+}
+
+func (m *QueryRevealWindowStatusResponse) GetRevealOpenBlock() uint64 {
+	if m != nil {
+		return m.RevealOpenBlock
+	}
+	return 0
+}
+
+func (m *QueryRevealWindowStatusResponse) GetRevealCloseBlock() uint64 {
+	if m != nil {
+		return m.RevealCloseBlock
+	}
+	return 0
+}
+
+func (m *QueryRevealWindowStatusResponse) GetRevealable() bool {
+	if m != nil {
+		return m.Revealable
+	}
+	return false
+}
+
+func (m *QueryRevealWindowStatusResponse) GetRevealed() bool {
+	if m != nil {
+		return m.Revealed
+	}
+	return false
+}
+
+// QueryBallotHistogramRequest is the request type for the Query/BallotHistogram RPC method.
+type QueryBallotHistogramRequest struct {
+	// denom defines the denom to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// buckets defines the number of buckets, 20 if zero.
+	Buckets uint32 `protobuf:"varint,2,opt,name=buckets,proto3" json:"buckets,omitempty"`
+}
+
+func (m *QueryBallotHistogramRequest) Reset()         { *m = QueryBallotHistogramRequest{} }
+func (m *QueryBallotHistogramRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBallotHistogramRequest) ProtoMessage()    {}
+func (*QueryBallotHistogramRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{88}
+}
+func (m *QueryBallotHistogramRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBallotHistogramRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBallotHistogramRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBallotHistogramRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBallotHistogramRequest.Merge(m, src)
+}
+func (m *QueryBallotHistogramRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBallotHistogramRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBallotHistogramRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBallotHistogramRequest proto.InternalMessageInfo
+
+// QueryBallotHistogramResponse is response type for the
+// Query/BallotHistogram RPC method.
+type QueryBallotHistogramResponse struct {
+	// edges defines the edges of the buckets, one more than the buckets, from the lowest to the
+	// highest exchange rate voted. A ballot whose votes all have the same rate has a single bucket.
+	Edges []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,rep,name=edges,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"edges"`
+	// powers defines the voting power of each bucket.
+	Powers []int64 `protobuf:"varint,2,rep,packed,name=powers,proto3" json:"powers,omitempty"`
+	// votes defines the number of votes of each bucket.
+	Votes []uint32 `protobuf:"varint,3,rep,packed,name=votes,proto3" json:"votes,omitempty"`
+	// ballot_power defines the power of the last ballot, abstaining votes left out.
+	BallotPower int64 `protobuf:"varint,4,opt,name=ballot_power,json=ballotPower,proto3" json:"ballot_power,omitempty"`
+}
+
+func (m *QueryBallotHistogramResponse) Reset()         { *m = QueryBallotHistogramResponse{} }
+func (m *QueryBallotHistogramResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBallotHistogramResponse) ProtoMessage()    {}
+func (*QueryBallotHistogramResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{89}
+}
+func (m *QueryBallotHistogramResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBallotHistogramResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBallotHistogramResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBallotHistogramResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBallotHistogramResponse.Merge(m, src)
+}
+func (m *QueryBallotHistogramResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBallotHistogramResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBallotHistogramResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBallotHistogramResponse proto.InternalMessageInfo
+
+func (m *QueryBallotHistogramResponse) GetPowers() []int64 {
+	if m != nil {
+		return m.Powers
+	}
+	return nil
+}
+
+func (m *QueryBallotHistogramResponse) GetVotes() []uint32 {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
+}
+
+func (m *QueryBallotHistogramResponse) GetBallotPower() int64 {
+	if m != nil {
+		return m.BallotPower
+	}
+	return 0
+}
+
+// QuerySharedFeedersRequest is the request type for the Query/SharedFeeders RPC method.
+type QuerySharedFeedersRequest struct {
+}
+
+func (m *QuerySharedFeedersRequest) Reset()         { *m = QuerySharedFeedersRequest{} }
+func (m *QuerySharedFeedersRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySharedFeedersRequest) ProtoMessage()    {}
+func (*QuerySharedFeedersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{90}
+}
+func (m *QuerySharedFeedersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySharedFeedersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySharedFeedersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySharedFeedersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySharedFeedersRequest.Merge(m, src)
+}
+func (m *QuerySharedFeedersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySharedFeedersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySharedFeedersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySharedFeedersRequest proto.InternalMessageInfo
+
+// QuerySharedFeedersResponse is response type for the
+// Query/SharedFeeders RPC method.
+type QuerySharedFeedersResponse struct {
+	// groups defines the feeder accounts shared by more than one validator, the largest
+	// groups first.
+	Groups []FeederGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups"`
+}
+
+func (m *QuerySharedFeedersResponse) Reset()         { *m = QuerySharedFeedersResponse{} }
+func (m *QuerySharedFeedersResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySharedFeedersResponse) ProtoMessage()    {}
+func (*QuerySharedFeedersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{91}
+}
+func (m *QuerySharedFeedersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySharedFeedersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySharedFeedersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySharedFeedersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySharedFeedersResponse.Merge(m, src)
+}
+func (m *QuerySharedFeedersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySharedFeedersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySharedFeedersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySharedFeedersResponse proto.InternalMessageInfo
+
+func (m *QuerySharedFeedersResponse) GetGroups() []FeederGroup {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+// FeederGroup defines the validators voting through the same feeder account.
+type FeederGroup struct {
+	// feeder defines the feeder account.
+	Feeder string `protobuf:"bytes,1,opt,name=feeder,proto3" json:"feeder,omitempty"`
+	// validators defines the validators voting through the feeder, sorted by address.
+	Validators []string `protobuf:"bytes,2,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (m *FeederGroup) Reset()         { *m = FeederGroup{} }
+func (m *FeederGroup) String() string { return proto.CompactTextString(m) }
+func (*FeederGroup) ProtoMessage()    {}
+func (*FeederGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{92}
+}
+func (m *FeederGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeederGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeederGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeederGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeederGroup.Merge(m, src)
+}
+func (m *FeederGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeederGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeederGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeederGroup proto.InternalMessageInfo
+
+func (m *FeederGroup) GetFeeder() string {
+	if m != nil {
+		return m.Feeder
+	}
+	return ""
+}
+
+func (m *FeederGroup) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+// QueryLastTallyStatsRequest is the request type for the Query/LastTallyStats RPC method.
+type QueryLastTallyStatsRequest struct {
+}
+
+func (m *QueryLastTallyStatsRequest) Reset()         { *m = QueryLastTallyStatsRequest{} }
+func (m *QueryLastTallyStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLastTallyStatsRequest) ProtoMessage()    {}
+func (*QueryLastTallyStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{93}
+}
+func (m *QueryLastTallyStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastTallyStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastTallyStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLastTallyStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastTallyStatsRequest.Merge(m, src)
+}
+func (m *QueryLastTallyStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastTallyStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastTallyStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLastTallyStatsRequest proto.InternalMessageInfo
+
+// QueryLastTallyStatsResponse is response type for the
+// Query/LastTallyStats RPC method.
+type QueryLastTallyStatsResponse struct {
+	// vote_period defines the vote period of the last tally, zero if none yet.
+	VotePeriod uint64 `protobuf:"varint,1,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty"`
+	// denoms defines the counts of the ballot of each denom, sorted by denom. Resting denoms
+	// and denoms without votes have no ballot.
+	Denoms []DenomTallyStats `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms"`
+}
+
+func (m *QueryLastTallyStatsResponse) Reset()         { *m = QueryLastTallyStatsResponse{} }
+func (m *QueryLastTallyStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLastTallyStatsResponse) ProtoMessage()    {}
+func (*QueryLastTallyStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{94}
+}
+func (m *QueryLastTallyStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastTallyStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastTallyStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLastTallyStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastTallyStatsResponse.Merge(m, src)
+}
+func (m *QueryLastTallyStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastTallyStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastTallyStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLastTallyStatsResponse proto.InternalMessageInfo
+
+func (m *QueryLastTallyStatsResponse) GetVotePeriod() uint64 {
+	if m != nil {
+		return m.VotePeriod
+	}
+	return 0
+}
+
+func (m *QueryLastTallyStatsResponse) GetDenoms() []DenomTallyStats {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+// QueryRecommendedDenomsRequest is the request type for the Query/RecommendedDenoms RPC method.
+type QueryRecommendedDenomsRequest struct {
+}
+
+func (m *QueryRecommendedDenomsRequest) Reset()         { *m = QueryRecommendedDenomsRequest{} }
+func (m *QueryRecommendedDenomsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecommendedDenomsRequest) ProtoMessage()    {}
+func (*QueryRecommendedDenomsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{95}
+}
+func (m *QueryRecommendedDenomsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecommendedDenomsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecommendedDenomsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecommendedDenomsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecommendedDenomsRequest.Merge(m, src)
+}
+func (m *QueryRecommendedDenomsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecommendedDenomsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecommendedDenomsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecommendedDenomsRequest proto.InternalMessageInfo
+
+// QueryRecommendedDenomsResponse is response type for the
+// Query/RecommendedDenoms RPC method.
+type QueryRecommendedDenomsResponse struct {
+	// denoms defines the whitelisted denoms whose miss is counted, sorted. It
+	// includes the denoms resting in the current vote period.
+	Denoms []string `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	// grace_denoms defines the whitelisted denoms still in their activation grace,
+	// which a feeder should start reporting before it ends, sorted.
+	GraceDenoms []string `protobuf:"bytes,2,rep,name=grace_denoms,json=graceDenoms,proto3" json:"grace_denoms,omitempty"`
+}
+
+func (m *QueryRecommendedDenomsResponse) Reset()         { *m = QueryRecommendedDenomsResponse{} }
+func (m *QueryRecommendedDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecommendedDenomsResponse) ProtoMessage()    {}
+func (*QueryRecommendedDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{96}
+}
+func (m *QueryRecommendedDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecommendedDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecommendedDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRecommendedDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecommendedDenomsResponse.Merge(m, src)
+}
+func (m *QueryRecommendedDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecommendedDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecommendedDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRecommendedDenomsResponse proto.InternalMessageInfo
+
+func (m *QueryRecommendedDenomsResponse) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func (m *QueryRecommendedDenomsResponse) GetGraceDenoms() []string {
+	if m != nil {
+		return m.GraceDenoms
+	}
+	return nil
+}
+
+// QueryDenomStatusesRequest is the request type for the Query/DenomStatuses RPC method.
+type QueryDenomStatusesRequest struct {
+}
+
+func (m *QueryDenomStatusesRequest) Reset()         { *m = QueryDenomStatusesRequest{} }
+func (m *QueryDenomStatusesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomStatusesRequest) ProtoMessage()    {}
+func (*QueryDenomStatusesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{97}
+}
+func (m *QueryDenomStatusesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomStatusesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomStatusesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomStatusesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomStatusesRequest.Merge(m, src)
+}
+func (m *QueryDenomStatusesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomStatusesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomStatusesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomStatusesRequest proto.InternalMessageInfo
+
+// DenomStatusBucket defines the whitelisted denoms in a status
+type DenomStatusBucket struct {
+	// status defines the status, one of "active", "stale", "pending" or "failing".
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// count defines the number of denoms in the status.
+	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// denoms defines the denoms in the status, sorted.
+	Denoms []string `protobuf:"bytes,3,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *DenomStatusBucket) Reset()         { *m = DenomStatusBucket{} }
+func (m *DenomStatusBucket) String() string { return proto.CompactTextString(m) }
+func (*DenomStatusBucket) ProtoMessage()    {}
+func (*DenomStatusBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{98}
+}
+func (m *DenomStatusBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomStatusBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomStatusBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomStatusBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomStatusBucket.Merge(m, src)
+}
+func (m *DenomStatusBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomStatusBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomStatusBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomStatusBucket proto.InternalMessageInfo
+
+func (m *DenomStatusBucket) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *DenomStatusBucket) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *DenomStatusBucket) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+// QueryDenomStatusesResponse is response type for the
+// Query/DenomStatuses RPC method.
+type QueryDenomStatusesResponse struct {
+	// buckets defines the denoms of each status, in the order active, stale, pending
+	// and failing. Every whitelisted denom is in exactly one of them.
+	Buckets []DenomStatusBucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets"`
+}
+
+func (m *QueryDenomStatusesResponse) Reset()         { *m = QueryDenomStatusesResponse{} }
+func (m *QueryDenomStatusesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomStatusesResponse) ProtoMessage()    {}
+func (*QueryDenomStatusesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{99}
+}
+func (m *QueryDenomStatusesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomStatusesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomStatusesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomStatusesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomStatusesResponse.Merge(m, src)
+}
+func (m *QueryDenomStatusesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomStatusesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomStatusesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomStatusesResponse proto.InternalMessageInfo
+
+func (m *QueryDenomStatusesResponse) GetBuckets() []DenomStatusBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+// QueryParticipationSeriesRequest is the request type for the Query/ParticipationSeries RPC method.
+type QueryParticipationSeriesRequest struct {
+	// limit defines the number of vote periods to return, 100 if zero.
+	Limit uint64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QueryParticipationSeriesRequest) Reset()         { *m = QueryParticipationSeriesRequest{} }
+func (m *QueryParticipationSeriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipationSeriesRequest) ProtoMessage()    {}
+func (*QueryParticipationSeriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{100}
+}
+func (m *QueryParticipationSeriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParticipationSeriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipationSeriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParticipationSeriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipationSeriesRequest.Merge(m, src)
+}
+func (m *QueryParticipationSeriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipationSeriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipationSeriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParticipationSeriesRequest proto.InternalMessageInfo
+
+func (m *QueryParticipationSeriesRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// QueryParticipationSeriesResponse is response type for the
+// Query/ParticipationSeries RPC method.
+type QueryParticipationSeriesResponse struct {
+	// points defines the participation ratio of the last tallied vote periods, oldest
+	// first. At most the last 1000 vote periods are kept.
+	Points []ParticipationPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points"`
+}
+
+func (m *QueryParticipationSeriesResponse) Reset()         { *m = QueryParticipationSeriesResponse{} }
+func (m *QueryParticipationSeriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParticipationSeriesResponse) ProtoMessage()    {}
+func (*QueryParticipationSeriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{101}
+}
+func (m *QueryParticipationSeriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParticipationSeriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParticipationSeriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParticipationSeriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParticipationSeriesResponse.Merge(m, src)
+}
+func (m *QueryParticipationSeriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParticipationSeriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParticipationSeriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParticipationSeriesResponse proto.InternalMessageInfo
+
+func (m *QueryParticipationSeriesResponse) GetPoints() []ParticipationPoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+// QueryMaxPeriodMoveRequest is the request type for the Query/MaxPeriodMove RPC method.
+type QueryMaxPeriodMoveRequest struct {
+	// denom defines the denom to query for.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryMaxPeriodMoveRequest) Reset()         { *m = QueryMaxPeriodMoveRequest{} }
+func (m *QueryMaxPeriodMoveRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMaxPeriodMoveRequest) ProtoMessage()    {}
+func (*QueryMaxPeriodMoveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{102}
+}
+func (m *QueryMaxPeriodMoveRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMaxPeriodMoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMaxPeriodMoveRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMaxPeriodMoveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMaxPeriodMoveRequest.Merge(m, src)
+}
+func (m *QueryMaxPeriodMoveRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMaxPeriodMoveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMaxPeriodMoveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMaxPeriodMoveRequest proto.InternalMessageInfo
+
+// QueryMaxPeriodMoveResponse is response type for the
+// Query/MaxPeriodMove RPC method.
+type QueryMaxPeriodMoveResponse struct {
+	// max_move_percent defines the largest |rate / previous rate - 1| in percent of the
+	// tallies in the window, zero if the denom did not tally after another tally in it.
+	MaxMovePercent github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=max_move_percent,json=maxMovePercent,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_move_percent"`
+	// vote_period defines the vote period of the largest move, zero if none.
+	VotePeriod uint64 `protobuf:"varint,2,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty"`
+	// window_start defines the first vote period of the current window.
+	WindowStart uint64 `protobuf:"varint,3,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	// window defines the MaxMoveWindow in effect, zero if the tracking is disabled.
+	Window uint64 `protobuf:"varint,4,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *QueryMaxPeriodMoveResponse) Reset()         { *m = QueryMaxPeriodMoveResponse{} }
+func (m *QueryMaxPeriodMoveResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMaxPeriodMoveResponse) ProtoMessage()    {}
+func (*QueryMaxPeriodMoveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b180a0d90a2c8cf7, []int{103}
+}
+func (m *QueryMaxPeriodMoveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMaxPeriodMoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMaxPeriodMoveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMaxPeriodMoveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMaxPeriodMoveResponse.Merge(m, src)
+}
+func (m *QueryMaxPeriodMoveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMaxPeriodMoveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMaxPeriodMoveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMaxPeriodMoveResponse proto.InternalMessageInfo
+
+func (m *QueryMaxPeriodMoveResponse) GetVotePeriod() uint64 {
+	if m != nil {
+		return m.VotePeriod
+	}
+	return 0
+}
+
+func (m *QueryMaxPeriodMoveResponse) GetWindowStart() uint64 {
+	if m != nil {
+		return m.WindowStart
+	}
+	return 0
+}
+
+func (m *QueryMaxPeriodMoveResponse) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryExchangeRateRequest)(nil), "kujira.oracle.QueryExchangeRateRequest")
+	proto.RegisterType((*QueryExchangeRateResponse)(nil), "kujira.oracle.QueryExchangeRateResponse")
+	proto.RegisterType((*QueryExchangeRatesRequest)(nil), "kujira.oracle.QueryExchangeRatesRequest")
+	proto.RegisterType((*QueryExchangeRatesResponse)(nil), "kujira.oracle.QueryExchangeRatesResponse")
+	proto.RegisterType((*ExchangeRateAge)(nil), "kujira.oracle.ExchangeRateAge")
+	proto.RegisterType((*QueryActivesRequest)(nil), "kujira.oracle.QueryActivesRequest")
+	proto.RegisterType((*QueryActivesResponse)(nil), "kujira.oracle.QueryActivesResponse")
+	proto.RegisterType((*QueryVoteTargetsRequest)(nil), "kujira.oracle.QueryVoteTargetsRequest")
+	proto.RegisterType((*QueryVoteTargetsResponse)(nil), "kujira.oracle.QueryVoteTargetsResponse")
+	proto.RegisterType((*QueryFeederDelegationRequest)(nil), "kujira.oracle.QueryFeederDelegationRequest")
+	proto.RegisterType((*QueryFeederDelegationResponse)(nil), "kujira.oracle.QueryFeederDelegationResponse")
+	proto.RegisterType((*QueryMissCounterRequest)(nil), "kujira.oracle.QueryMissCounterRequest")
+	proto.RegisterType((*QueryMissCounterResponse)(nil), "kujira.oracle.QueryMissCounterResponse")
+	proto.RegisterType((*QueryMissCountersRequest)(nil), "kujira.oracle.QueryMissCountersRequest")
+	proto.RegisterType((*QueryMissCountersResponse)(nil), "kujira.oracle.QueryMissCountersResponse")
+	proto.RegisterType((*MissCounterStatus)(nil), "kujira.oracle.MissCounterStatus")
+	proto.RegisterType((*QueryAggregatePrevoteRequest)(nil), "kujira.oracle.QueryAggregatePrevoteRequest")
+	proto.RegisterType((*QueryAggregatePrevoteResponse)(nil), "kujira.oracle.QueryAggregatePrevoteResponse")
+	proto.RegisterType((*QueryAggregatePrevotesRequest)(nil), "kujira.oracle.QueryAggregatePrevotesRequest")
+	proto.RegisterType((*QueryAggregatePrevotesResponse)(nil), "kujira.oracle.QueryAggregatePrevotesResponse")
+	proto.RegisterType((*QueryAggregateVoteRequest)(nil), "kujira.oracle.QueryAggregateVoteRequest")
+	proto.RegisterType((*QueryAggregateVoteResponse)(nil), "kujira.oracle.QueryAggregateVoteResponse")
+	proto.RegisterType((*QueryAggregateVotesRequest)(nil), "kujira.oracle.QueryAggregateVotesRequest")
+	proto.RegisterType((*QueryAggregateVotesResponse)(nil), "kujira.oracle.QueryAggregateVotesResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "kujira.oracle.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "kujira.oracle.QueryParamsResponse")
+	proto.RegisterType((*QueryRewardEstimateRequest)(nil), "kujira.oracle.QueryRewardEstimateRequest")
+	proto.RegisterType((*QueryRewardEstimateResponse)(nil), "kujira.oracle.QueryRewardEstimateResponse")
+	proto.RegisterType((*QueryVoteHashSpecRequest)(nil), "kujira.oracle.QueryVoteHashSpecRequest")
+	proto.RegisterType((*QueryVoteHashSpecResponse)(nil), "kujira.oracle.QueryVoteHashSpecResponse")
+	proto.RegisterType((*QueryIsFeederAuthorizedRequest)(nil), "kujira.oracle.QueryIsFeederAuthorizedRequest")
+	proto.RegisterType((*QueryIsFeederAuthorizedResponse)(nil), "kujira.oracle.QueryIsFeederAuthorizedResponse")
+	proto.RegisterType((*QueryDenomBackingPowerRequest)(nil), "kujira.oracle.QueryDenomBackingPowerRequest")
+	proto.RegisterType((*QueryDenomBackingPowerResponse)(nil), "kujira.oracle.QueryDenomBackingPowerResponse")
+	proto.RegisterType((*DenomBackingPower)(nil), "kujira.oracle.DenomBackingPower")
+	proto.RegisterType((*QueryUpcomingGraceExitsRequest)(nil), "kujira.oracle.QueryUpcomingGraceExitsRequest")
+	proto.RegisterType((*QueryUpcomingGraceExitsResponse)(nil), "kujira.oracle.QueryUpcomingGraceExitsResponse")
+	proto.RegisterType((*DenomGraceExit)(nil), "kujira.oracle.DenomGraceExit")
+	proto.RegisterType((*QueryLightClientStateRequest)(nil), "kujira.oracle.QueryLightClientStateRequest")
+	proto.RegisterType((*QueryLightClientStateResponse)(nil), "kujira.oracle.QueryLightClientStateResponse")
+	proto.RegisterType((*QueryValidatorRateDeviationRequest)(nil), "kujira.oracle.QueryValidatorRateDeviationRequest")
+	proto.RegisterType((*QueryValidatorRateDeviationResponse)(nil), "kujira.oracle.QueryValidatorRateDeviationResponse")
+	proto.RegisterType((*RateDeviation)(nil), "kujira.oracle.RateDeviation")
+	proto.RegisterType((*QueryValidatorMissingDenomsRequest)(nil), "kujira.oracle.QueryValidatorMissingDenomsRequest")
+	proto.RegisterType((*QueryValidatorMissingDenomsResponse)(nil), "kujira.oracle.QueryValidatorMissingDenomsResponse")
+	proto.RegisterType((*QueryDenomTallySuccessRateRequest)(nil), "kujira.oracle.QueryDenomTallySuccessRateRequest")
+	proto.RegisterType((*QueryDenomTallySuccessRateResponse)(nil), "kujira.oracle.QueryDenomTallySuccessRateResponse")
+	proto.RegisterType((*DenomTallySuccessRate)(nil), "kujira.oracle.DenomTallySuccessRate")
+	proto.RegisterType((*QueryPendingRevealsRequest)(nil), "kujira.oracle.QueryPendingRevealsRequest")
+	proto.RegisterType((*QueryPendingRevealsResponse)(nil), "kujira.oracle.QueryPendingRevealsResponse")
+	proto.RegisterType((*PendingReveal)(nil), "kujira.oracle.PendingReveal")
+	proto.RegisterType((*QueryRequiredDenomsRequest)(nil), "kujira.oracle.QueryRequiredDenomsRequest")
+	proto.RegisterType((*QueryRequiredDenomsResponse)(nil), "kujira.oracle.QueryRequiredDenomsResponse")
+	proto.RegisterType((*RequiredDenom)(nil), "kujira.oracle.RequiredDenom")
+	proto.RegisterType((*QueryDenomScheduleRequest)(nil), "kujira.oracle.QueryDenomScheduleRequest")
+	proto.RegisterType((*QueryDenomScheduleResponse)(nil), "kujira.oracle.QueryDenomScheduleResponse")
+	proto.RegisterType((*DenomSchedule)(nil), "kujira.oracle.DenomSchedule")
+	proto.RegisterType((*QueryObserversRequest)(nil), "kujira.oracle.QueryObserversRequest")
+	proto.RegisterType((*QueryObserversResponse)(nil), "kujira.oracle.QueryObserversResponse")
+	proto.RegisterType((*Observer)(nil), "kujira.oracle.Observer")
+	proto.RegisterType((*QueryBandMembershipStatsRequest)(nil), "kujira.oracle.QueryBandMembershipStatsRequest")
+	proto.RegisterType((*QueryBandMembershipStatsResponse)(nil), "kujira.oracle.QueryBandMembershipStatsResponse")
+	proto.RegisterType((*BandMembershipStats)(nil), "kujira.oracle.BandMembershipStats")
+	proto.RegisterType((*QueryValidatorAccuracyRankingRequest)(nil), "kujira.oracle.QueryValidatorAccuracyRankingRequest")
+	proto.RegisterType((*QueryValidatorAccuracyRankingResponse)(nil), "kujira.oracle.QueryValidatorAccuracyRankingResponse")
+	proto.RegisterType((*ValidatorAccuracy)(nil), "kujira.oracle.ValidatorAccuracy")
+	proto.RegisterType((*QueryDenomTallyDiagnosisRequest)(nil), "kujira.oracle.QueryDenomTallyDiagnosisRequest")
+	proto.RegisterType((*QueryDenomTallyDiagnosisResponse)(nil), "kujira.oracle.QueryDenomTallyDiagnosisResponse")
+	proto.RegisterType((*QueryParticipationSummaryRequest)(nil), "kujira.oracle.QueryParticipationSummaryRequest")
+	proto.RegisterType((*QueryParticipationSummaryResponse)(nil), "kujira.oracle.QueryParticipationSummaryResponse")
+	proto.RegisterType((*QueryRawDenomStateRequest)(nil), "kujira.oracle.QueryRawDenomStateRequest")
+	proto.RegisterType((*QueryRawDenomStateResponse)(nil), "kujira.oracle.QueryRawDenomStateResponse")
+	proto.RegisterType((*RawStoreEntry)(nil), "kujira.oracle.RawStoreEntry")
+	proto.RegisterType((*QueryMedianFlipCostRequest)(nil), "kujira.oracle.QueryMedianFlipCostRequest")
+	proto.RegisterType((*QueryMedianFlipCostResponse)(nil), "kujira.oracle.QueryMedianFlipCostResponse")
+	proto.RegisterType((*MedianFlipCost)(nil), "kujira.oracle.MedianFlipCost")
+	proto.RegisterType((*QueryOracleAlertConfigRequest)(nil), "kujira.oracle.QueryOracleAlertConfigRequest")
+	proto.RegisterType((*QueryOracleAlertConfigResponse)(nil), "kujira.oracle.QueryOracleAlertConfigResponse")
+	proto.RegisterType((*QueryModuleInfoRequest)(nil), "kujira.oracle.QueryModuleInfoRequest")
+	proto.RegisterType((*QueryModuleInfoResponse)(nil), "kujira.oracle.QueryModuleInfoResponse")
+	proto.RegisterMapType((map[string]string)(nil), "kujira.oracle.QueryModuleInfoResponse.FeaturesEntry")
+	proto.RegisterType((*QueryFreshExchangeRateRequest)(nil), "kujira.oracle.QueryFreshExchangeRateRequest")
+	proto.RegisterType((*QueryFreshExchangeRateResponse)(nil), "kujira.oracle.QueryFreshExchangeRateResponse")
+	proto.RegisterType((*QueryWhitelistChangesRequest)(nil), "kujira.oracle.QueryWhitelistChangesRequest")
+	proto.RegisterType((*QueryWhitelistChangesResponse)(nil), "kujira.oracle.QueryWhitelistChangesResponse")
+	proto.RegisterType((*QueryRejectedTuplesRequest)(nil), "kujira.oracle.QueryRejectedTuplesRequest")
+	proto.RegisterType((*QueryRejectedTuplesResponse)(nil), "kujira.oracle.QueryRejectedTuplesResponse")
+	proto.RegisterType((*QueryRevealWindowStatusRequest)(nil), "kujira.oracle.QueryRevealWindowStatusRequest")
+	proto.RegisterType((*QueryRevealWindowStatusResponse)(nil), "kujira.oracle.QueryRevealWindowStatusResponse")
+	proto.RegisterType((*QueryBallotHistogramRequest)(nil), "kujira.oracle.QueryBallotHistogramRequest")
+	proto.RegisterType((*QueryBallotHistogramResponse)(nil), "kujira.oracle.QueryBallotHistogramResponse")
+	proto.RegisterType((*QuerySharedFeedersRequest)(nil), "kujira.oracle.QuerySharedFeedersRequest")
+	proto.RegisterType((*QuerySharedFeedersResponse)(nil), "kujira.oracle.QuerySharedFeedersResponse")
+	proto.RegisterType((*FeederGroup)(nil), "kujira.oracle.FeederGroup")
+	proto.RegisterType((*QueryLastTallyStatsRequest)(nil), "kujira.oracle.QueryLastTallyStatsRequest")
+	proto.RegisterType((*QueryLastTallyStatsResponse)(nil), "kujira.oracle.QueryLastTallyStatsResponse")
+	proto.RegisterType((*QueryRecommendedDenomsRequest)(nil), "kujira.oracle.QueryRecommendedDenomsRequest")
+	proto.RegisterType((*QueryRecommendedDenomsResponse)(nil), "kujira.oracle.QueryRecommendedDenomsResponse")
+	proto.RegisterType((*QueryDenomStatusesRequest)(nil), "kujira.oracle.QueryDenomStatusesRequest")
+	proto.RegisterType((*DenomStatusBucket)(nil), "kujira.oracle.DenomStatusBucket")
+	proto.RegisterType((*QueryDenomStatusesResponse)(nil), "kujira.oracle.QueryDenomStatusesResponse")
+	proto.RegisterType((*QueryParticipationSeriesRequest)(nil), "kujira.oracle.QueryParticipationSeriesRequest")
+	proto.RegisterType((*QueryParticipationSeriesResponse)(nil), "kujira.oracle.QueryParticipationSeriesResponse")
+	proto.RegisterType((*QueryMaxPeriodMoveRequest)(nil), "kujira.oracle.QueryMaxPeriodMoveRequest")
+	proto.RegisterType((*QueryMaxPeriodMoveResponse)(nil), "kujira.oracle.QueryMaxPeriodMoveResponse")
+}
+
+func init() { proto.RegisterFile("kujira/oracle/query.proto", fileDescriptor_b180a0d90a2c8cf7) }
+
+var fileDescriptor_b180a0d90a2c8cf7 = []byte{
+	// 4856 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7c, 0xdb, 0x6f, 0x1c, 0xc9,
+	0x75, 0xb7, 0x9a, 0x77, 0x1e, 0x72, 0x86, 0x64, 0x89, 0x92, 0x46, 0x4d, 0x89, 0xa4, 0x5a, 0x37,
+	0x8a, 0x92, 0x38, 0xba, 0xac, 0x3f, 0xef, 0xa7, 0xb5, 0xbd, 0x4b, 0x52, 0xd2, 0xca, 0x2b, 0x31,
+	0xe2, 0x0e, 0x25, 0xed, 0x66, 0x03, 0x78, 0xd2, 0xec, 0x29, 0x0e, 0x7b, 0x39, 0xd3, 0x3d, 0xdb,
+	0xd5, 0x43, 0x4a, 0x5e, 0x6f, 0x82, 0x18, 0x71, 0xb2, 0x41, 0xe0, 0xd8, 0x81, 0x0d, 0x27, 0x46,
+	0x0c, 0x64, 0x03, 0x38, 0x09, 0xe0, 0x04, 0x01, 0x62, 0x20, 0x2f, 0x09, 0x02, 0x24, 0x6f, 0x46,
+	0x9e, 0x8c, 0x18, 0x01, 0x82, 0x00, 0xb1, 0x93, 0xdd, 0x20, 0xc8, 0x9f, 0x11, 0x54, 0xd5, 0xa9,
+	0xbe, 0x4d, 0x35, 0xd9, 0xe4, 0x62, 0xf3, 0xb2, 0x9c, 0x3e, 0x75, 0x2e, 0xbf, 0xaa, 0x53, 0x97,
+	0x53, 0x75, 0xce, 0x0a, 0x4e, 0xef, 0x74, 0xdf, 0x75, 0x03, 0xbb, 0xea, 0x07, 0xb6, 0xd3, 0xa2,
+	0xd5, 0xf7, 0xba, 0x34, 0x78, 0xb1, 0xd4, 0x09, 0xfc, 0xd0, 0x27, 0x25, 0xd9, 0xb4, 0x24, 0x9b,
+	0xcc, 0xe9, 0xa6, 0xdf, 0xf4, 0x45, 0x4b, 0x95, 0xff, 0x92, 0x4c, 0xe6, 0x99, 0xa6, 0xef, 0x37,
+	0x5b, 0xb4, 0x6a, 0x77, 0xdc, 0xaa, 0xed, 0x79, 0x7e, 0x68, 0x87, 0xae, 0xef, 0x31, 0x6c, 0x35,
+	0xd3, 0xda, 0xe5, 0x1f, 0x6c, 0x9b, 0x75, 0x7c, 0xd6, 0xf6, 0x59, 0x75, 0xd3, 0x66, 0xb4, 0xba,
+	0x7b, 0x73, 0x93, 0x86, 0xf6, 0xcd, 0xaa, 0xe3, 0xbb, 0x1e, 0xb6, 0x2f, 0x26, 0xdb, 0x05, 0xae,
+	0x88, 0xab, 0x63, 0x37, 0x5d, 0x4f, 0x18, 0x52, 0xba, 0x10, 0x85, 0xf8, 0xda, 0xec, 0x6e, 0x55,
+	0x1b, 0xdd, 0x20, 0xd1, 0x6e, 0xdd, 0x81, 0xca, 0x9b, 0x5c, 0xc3, 0xbd, 0xe7, 0xce, 0xb6, 0xed,
+	0x35, 0x69, 0xcd, 0x0e, 0x69, 0x8d, 0xbe, 0xd7, 0xa5, 0x2c, 0x24, 0xd3, 0x30, 0xd8, 0xa0, 0x9e,
+	0xdf, 0xae, 0x18, 0xf3, 0xc6, 0xc2, 0x68, 0x4d, 0x7e, 0xdc, 0x19, 0xf9, 0xf0, 0xa3, 0xb9, 0x63,
+	0xff, 0xf3, 0xd1, 0xdc, 0x31, 0xeb, 0xfb, 0xfd, 0x70, 0x5a, 0x23, 0xcc, 0x3a, 0xbe, 0xc7, 0x28,
+	0xd9, 0x80, 0x12, 0x45, 0x7a, 0x3d, 0xb0, 0x43, 0x2a, 0xb5, 0xac, 0x2c, 0xfd, 0xe4, 0xe7, 0x73,
+	0xc7, 0xfe, 0xed, 0xe7, 0x73, 0x97, 0x9a, 0x6e, 0xb8, 0xdd, 0xdd, 0x5c, 0x72, 0xfc, 0x76, 0x15,
+	0xfb, 0x23, 0xff, 0x5c, 0x67, 0x8d, 0x9d, 0x6a, 0xf8, 0xa2, 0x43, 0xd9, 0xd2, 0x5d, 0xea, 0xd4,
+	0xc6, 0x69, 0x42, 0x39, 0xb9, 0x0c, 0x13, 0x8e, 0x1d, 0x04, 0x2e, 0x6d, 0xd4, 0xb7, 0xfc, 0x60,
+	0xcf, 0x0e, 0x1a, 0x95, 0xbe, 0x79, 0x63, 0x61, 0xa4, 0x56, 0x46, 0xf2, 0x7d, 0x49, 0x4d, 0x32,
+	0x76, 0x68, 0xe0, 0xfa, 0x0d, 0x56, 0xe9, 0x9f, 0x37, 0x16, 0x06, 0x22, 0xc6, 0x75, 0x49, 0x25,
+	0x73, 0x30, 0x66, 0x37, 0x69, 0xc4, 0x34, 0x20, 0x98, 0xc0, 0x6e, 0xd2, 0x04, 0xc3, 0x7b, 0x5d,
+	0x3f, 0xa4, 0x75, 0x39, 0x16, 0x83, 0x62, 0x2c, 0x40, 0x90, 0xee, 0x72, 0x0a, 0x79, 0x07, 0xa6,
+	0xba, 0xac, 0x51, 0x4f, 0x77, 0x76, 0xe8, 0x48, 0x9d, 0x9d, 0xe8, 0xb2, 0x46, 0x72, 0x30, 0xb9,
+	0xf1, 0x5d, 0x3f, 0xa4, 0x41, 0xdd, 0xf1, 0xbb, 0x5e, 0x58, 0x19, 0x96, 0xe8, 0x04, 0x69, 0x95,
+	0x53, 0xc8, 0x49, 0x18, 0x0a, 0x03, 0xdb, 0xd9, 0x61, 0x95, 0x11, 0x01, 0x0c, 0xbf, 0xac, 0x19,
+	0x8d, 0x6b, 0x18, 0x3a, 0xd6, 0xfa, 0x77, 0x03, 0x4c, 0x5d, 0x2b, 0x7a, 0xee, 0x39, 0x94, 0x53,
+	0x9d, 0x61, 0x15, 0x63, 0xbe, 0x7f, 0x61, 0xec, 0xd6, 0x99, 0x25, 0x09, 0x7a, 0x89, 0x4f, 0xbc,
+	0x25, 0x9c, 0x72, 0x1c, 0xf7, 0xaa, 0xef, 0x7a, 0x2b, 0xb7, 0x79, 0x5f, 0x7f, 0xf4, 0x8b, 0xb9,
+	0xab, 0xc5, 0xfa, 0xca, 0x65, 0x58, 0xad, 0x94, 0xf4, 0x2e, 0x23, 0xf7, 0xd2, 0xce, 0xe8, 0x13,
+	0x66, 0x67, 0x97, 0x52, 0xcb, 0x6d, 0x29, 0x09, 0x7a, 0xb9, 0x49, 0x57, 0x06, 0xb8, 0xe1, 0xa4,
+	0xcb, 0xac, 0x07, 0x30, 0x91, 0x61, 0xd2, 0xcf, 0xe5, 0xac, 0xf3, 0xfb, 0xb2, 0xce, 0xb7, 0x4e,
+	0xc0, 0x71, 0x31, 0x50, 0xcb, 0x4e, 0xe8, 0xee, 0xc6, 0x03, 0x78, 0x03, 0xa6, 0xd3, 0x64, 0x1c,
+	0xb9, 0x0a, 0x0c, 0xdb, 0x92, 0x24, 0x86, 0x6c, 0xb4, 0xa6, 0x3e, 0xad, 0xd3, 0x70, 0x4a, 0x48,
+	0x3c, 0xf3, 0x43, 0xfa, 0xc4, 0x0e, 0x9a, 0x34, 0x8c, 0x94, 0x7d, 0x11, 0x2a, 0xbd, 0x4d, 0xa8,
+	0xf0, 0x1c, 0x8c, 0x73, 0x67, 0xd7, 0x43, 0x49, 0x47, 0xad, 0x63, 0xbb, 0x31, 0xab, 0xf5, 0x18,
+	0xce, 0x08, 0xf1, 0xfb, 0x94, 0x36, 0x68, 0x70, 0x97, 0xb6, 0x68, 0x53, 0x2c, 0x70, 0xb5, 0x8a,
+	0x2f, 0x42, 0x79, 0xd7, 0x6e, 0xb9, 0x0d, 0x3b, 0xf4, 0x83, 0xba, 0xdd, 0x68, 0x04, 0x38, 0x04,
+	0xa5, 0x88, 0xba, 0xdc, 0x68, 0x04, 0x89, 0x65, 0xfd, 0x1a, 0x9c, 0xcd, 0x51, 0x88, 0xa0, 0xe6,
+	0x60, 0x6c, 0x4b, 0xb4, 0x25, 0xd5, 0x81, 0x24, 0x71, 0x5d, 0xd6, 0x1b, 0xd8, 0xd9, 0x35, 0x97,
+	0x31, 0x31, 0x4d, 0x69, 0x70, 0x64, 0x34, 0x6d, 0xa8, 0xf4, 0xea, 0x8a, 0x47, 0xa7, 0xed, 0x32,
+	0x26, 0x17, 0x07, 0x95, 0xaa, 0x06, 0x6a, 0x63, 0xed, 0x98, 0x95, 0x2c, 0xc1, 0xf1, 0x80, 0xee,
+	0x52, 0xbb, 0x55, 0x4f, 0x71, 0x4a, 0x4f, 0x4f, 0xc9, 0xa6, 0x84, 0x6a, 0x6b, 0xb3, 0xd7, 0x9c,
+	0x72, 0x14, 0xb9, 0x0f, 0x10, 0xef, 0xaf, 0xc2, 0xd8, 0xd8, 0xad, 0x4b, 0xa9, 0x35, 0x21, 0x0f,
+	0x09, 0xb5, 0x32, 0xd6, 0xed, 0xa6, 0xda, 0x4b, 0x6b, 0x09, 0x49, 0xeb, 0xaf, 0x0d, 0x38, 0xad,
+	0x31, 0x82, 0x9d, 0x7a, 0x08, 0xa5, 0x24, 0x54, 0xb5, 0xf8, 0xe6, 0x33, 0xab, 0x20, 0x21, 0xbb,
+	0x11, 0xda, 0x61, 0x97, 0xe1, 0x3a, 0x18, 0x4f, 0xf4, 0x9e, 0x91, 0xd7, 0x53, 0x90, 0xfb, 0x04,
+	0xe4, 0xcb, 0x07, 0x42, 0x96, 0x48, 0x52, 0x98, 0xff, 0xcc, 0x80, 0xa9, 0x1e, 0x93, 0x05, 0xbd,
+	0xd9, 0xe3, 0xa7, 0xbe, 0x5e, 0x3f, 0x9d, 0x82, 0x61, 0x3b, 0xac, 0x07, 0x2e, 0xdb, 0x11, 0xfb,
+	0xf4, 0x48, 0x6d, 0xc8, 0x0e, 0x6b, 0x2e, 0xdb, 0xc9, 0x73, 0xe0, 0x40, 0x9e, 0x03, 0xd5, 0x72,
+	0x58, 0x6e, 0x36, 0x03, 0x3e, 0x71, 0xe9, 0x7a, 0x40, 0xf9, 0x72, 0x39, 0xf2, 0x04, 0xfc, 0x75,
+	0x38, 0x9b, 0xa3, 0x10, 0x1d, 0xf6, 0x15, 0x98, 0xb2, 0x55, 0x5b, 0xbd, 0x23, 0x1b, 0x71, 0x76,
+	0x5c, 0xcd, 0x38, 0x2d, 0xd2, 0x91, 0xdc, 0x9e, 0x50, 0x1f, 0xfa, 0x6f, 0xd2, 0xce, 0xd8, 0xb1,
+	0xe6, 0x72, 0x00, 0x44, 0x1b, 0xc8, 0xd7, 0x0d, 0x98, 0xcd, 0xe3, 0x40, 0x8c, 0xbf, 0x0a, 0xa4,
+	0x07, 0xa3, 0x9a, 0x59, 0x47, 0x00, 0x39, 0x95, 0x05, 0xc9, 0xac, 0x47, 0x38, 0xa7, 0x23, 0xe9,
+	0x67, 0x9f, 0x66, 0xd0, 0x19, 0x98, 0x3a, 0x6d, 0xd8, 0x9b, 0xa7, 0x50, 0x8e, 0x7b, 0x93, 0x18,
+	0xee, 0x85, 0x22, 0x3d, 0x79, 0x16, 0x77, 0xa3, 0x64, 0x27, 0xd5, 0x5b, 0x67, 0x74, 0x46, 0xa3,
+	0x51, 0xde, 0x85, 0x19, 0x6d, 0x2b, 0x62, 0x7a, 0x0b, 0x26, 0xd2, 0x98, 0xd4, 0xf0, 0x1e, 0x16,
+	0x54, 0x39, 0x05, 0x8a, 0x59, 0xd3, 0x40, 0x84, 0xdd, 0x75, 0x3b, 0xb0, 0xdb, 0x11, 0x9a, 0x37,
+	0xe0, 0x78, 0x8a, 0x8a, 0x28, 0x6e, 0xc3, 0x50, 0x47, 0x50, 0x70, 0x44, 0x4e, 0x64, 0x8c, 0x4b,
+	0x76, 0xb4, 0x84, 0xac, 0xd6, 0x1a, 0xf6, 0xbb, 0x46, 0x79, 0xe8, 0x74, 0x8f, 0x85, 0x6e, 0xdb,
+	0xfe, 0x14, 0xbe, 0xfb, 0xfb, 0x3e, 0x98, 0xd1, 0xea, 0x43, 0x8c, 0xef, 0xc3, 0x64, 0x20, 0x5a,
+	0xf8, 0xb9, 0x5b, 0xef, 0xf8, 0x7b, 0x34, 0xc0, 0xa1, 0xfa, 0x0c, 0x02, 0x8c, 0xb2, 0x34, 0xb5,
+	0x4e, 0x83, 0x75, 0x6e, 0x88, 0x9c, 0x87, 0xd2, 0x9e, 0xeb, 0x79, 0xae, 0xd7, 0x44, 0xcb, 0x7c,
+	0x2f, 0xea, 0xaf, 0x8d, 0x23, 0x51, 0x32, 0x7d, 0x0d, 0x26, 0xe3, 0x2e, 0x4b, 0x05, 0x95, 0xfe,
+	0xcf, 0x0a, 0xe1, 0x44, 0x64, 0x4a, 0x8e, 0x97, 0x65, 0x26, 0xe2, 0x81, 0x07, 0x36, 0xdb, 0xde,
+	0xe8, 0x50, 0x47, 0xb9, 0xfd, 0x3f, 0x07, 0xe0, 0xb4, 0xa6, 0x11, 0x47, 0xf6, 0x32, 0x4c, 0x74,
+	0x02, 0xea, 0xb6, 0x79, 0x4c, 0xb3, 0xe5, 0x07, 0x6d, 0x3b, 0x44, 0x5f, 0x95, 0x15, 0xf9, 0xbe,
+	0xa0, 0xf2, 0xa8, 0x71, 0xcb, 0xa5, 0x2d, 0x0c, 0xb1, 0x46, 0x6b, 0xf8, 0xc5, 0x15, 0x88, 0x5f,
+	0x75, 0x46, 0xf9, 0xdc, 0x08, 0xfd, 0x40, 0xec, 0xc6, 0xa3, 0xb5, 0xb2, 0x20, 0x6f, 0x28, 0x2a,
+	0xb9, 0x01, 0xd3, 0xa9, 0x10, 0x51, 0x99, 0x1b, 0x10, 0xdc, 0x24, 0x19, 0xd5, 0xa1, 0xc9, 0xff,
+	0x07, 0xa7, 0xd2, 0x12, 0xb1, 0x09, 0x19, 0x52, 0x9f, 0x48, 0x0a, 0xc5, 0x96, 0xe6, 0x60, 0x8c,
+	0xd9, 0xad, 0xb0, 0xde, 0xa2, 0x5e, 0x33, 0xdc, 0x16, 0x71, 0x75, 0xa9, 0x06, 0x9c, 0xf4, 0x48,
+	0x50, 0xb8, 0x47, 0x05, 0x03, 0xf5, 0x1c, 0xbf, 0xe1, 0x7a, 0x4d, 0x11, 0x24, 0x8f, 0xd6, 0xc6,
+	0x39, 0xf1, 0x1e, 0xd2, 0xc4, 0x24, 0x16, 0x71, 0x74, 0xc4, 0x35, 0x82, 0x93, 0x98, 0x53, 0x93,
+	0x6c, 0xdb, 0x36, 0xdb, 0xae, 0xdb, 0xad, 0xa6, 0x1f, 0xb8, 0xe1, 0x76, 0xbb, 0x32, 0x2a, 0xd9,
+	0x38, 0x75, 0x59, 0x11, 0x39, 0x26, 0xc1, 0x86, 0x98, 0x40, 0x62, 0xe2, 0xa4, 0x18, 0x93, 0x60,
+	0x88, 0xac, 0x8d, 0x49, 0x4c, 0x9c, 0x18, 0x19, 0xbb, 0x01, 0xd3, 0x8e, 0xdf, 0x6e, 0xbb, 0x61,
+	0x9b, 0x7a, 0x61, 0x3d, 0xb2, 0x5b, 0x19, 0x97, 0x63, 0x18, 0xb7, 0x3d, 0x40, 0xe3, 0xfc, 0x2c,
+	0x4c, 0x8f, 0xa1, 0x1f, 0x34, 0x68, 0x50, 0x29, 0x09, 0x81, 0xa9, 0xe4, 0xf8, 0x3d, 0xe6, 0x0d,
+	0xe4, 0x25, 0x38, 0x99, 0xe6, 0x6f, 0x50, 0xc7, 0x6d, 0xdb, 0x2d, 0x56, 0x29, 0x0b, 0xc8, 0xd3,
+	0x49, 0x91, 0xbb, 0xd8, 0x66, 0x05, 0x78, 0x9a, 0x7c, 0x99, 0xc9, 0x08, 0x70, 0xb9, 0x1b, 0x6e,
+	0xfb, 0x81, 0xfb, 0x55, 0xda, 0x38, 0xdc, 0x96, 0x90, 0x8d, 0x13, 0xfb, 0xb2, 0x71, 0x62, 0x62,
+	0xcf, 0xf8, 0x2d, 0x03, 0xe6, 0x72, 0x8d, 0xe2, 0xec, 0x9e, 0x05, 0xb0, 0x23, 0xaa, 0xb0, 0x38,
+	0x52, 0x4b, 0x50, 0xc8, 0x55, 0x98, 0x8a, 0xbf, 0xea, 0xd2, 0x0c, 0x1a, 0x9d, 0x8c, 0x1b, 0xa4,
+	0x7a, 0xbe, 0x02, 0x02, 0x6a, 0x33, 0xdf, 0xc3, 0x09, 0x8e, 0x5f, 0xd6, 0xab, 0x78, 0xd8, 0x8a,
+	0xab, 0xdd, 0x8a, 0xed, 0xec, 0xa8, 0x4d, 0xa1, 0xe8, 0xa5, 0xd8, 0x87, 0xd9, 0x3c, 0x05, 0xd8,
+	0x8f, 0x35, 0x28, 0x6f, 0x4a, 0xba, 0xdc, 0x82, 0xf2, 0x22, 0xbc, 0x1e, 0x0d, 0xea, 0xd4, 0xda,
+	0x4c, 0xd0, 0x98, 0xf5, 0x2a, 0x4c, 0xf5, 0x70, 0xe6, 0x5c, 0x77, 0xa6, 0x61, 0x30, 0xb9, 0xe9,
+	0xc9, 0x0f, 0x6b, 0x1e, 0x11, 0x3f, 0xed, 0x38, 0x7e, 0xdb, 0xf5, 0x9a, 0xaf, 0x07, 0xb6, 0x43,
+	0xef, 0x3d, 0x77, 0xe3, 0x1b, 0x4a, 0x13, 0xe6, 0x72, 0x39, 0xb0, 0x53, 0x77, 0x61, 0xac, 0xc9,
+	0xa9, 0x75, 0xca, 0xc9, 0xd8, 0xa3, 0xb3, 0xba, 0x1e, 0x45, 0xc2, 0xea, 0xe2, 0xd6, 0x8c, 0xb4,
+	0x59, 0xdb, 0x50, 0x4e, 0xf3, 0xe4, 0xdf, 0xdb, 0xb8, 0x1d, 0xbc, 0xb8, 0xa9, 0x7b, 0x1b, 0x27,
+	0xc9, 0x8b, 0x5b, 0xc4, 0xb0, 0x4d, 0xdd, 0xe6, 0x76, 0x28, 0x7c, 0xdc, 0x2f, 0x19, 0x1e, 0x08,
+	0x8a, 0x35, 0x8b, 0x61, 0xe2, 0x23, 0xfe, 0xb5, 0xda, 0x72, 0xa9, 0x17, 0x6e, 0x84, 0xf1, 0xa9,
+	0x67, 0xfd, 0x76, 0x3f, 0x9c, 0xcd, 0x61, 0xc0, 0x1e, 0x9f, 0x84, 0x21, 0xd4, 0x6e, 0x08, 0xed,
+	0xf8, 0x95, 0x38, 0x82, 0xfb, 0x0a, 0x1f, 0xc1, 0x9a, 0x2b, 0x77, 0xff, 0xff, 0xd1, 0x95, 0x1b,
+	0x5f, 0x18, 0xd4, 0x50, 0x0e, 0xc4, 0x2f, 0x0c, 0x38, 0x94, 0x67, 0x01, 0x58, 0xe8, 0x07, 0xb4,
+	0xee, 0xd9, 0x6d, 0x8a, 0x7b, 0xf5, 0xa8, 0xa0, 0xfc, 0x92, 0xdd, 0xa6, 0xe4, 0x1a, 0x90, 0xf4,
+	0x1e, 0xb3, 0x43, 0x5f, 0xb0, 0xca, 0xd0, 0x7c, 0xff, 0xc2, 0x78, 0x6d, 0x32, 0x69, 0xea, 0x21,
+	0x7d, 0xc1, 0xc8, 0x4d, 0x38, 0x91, 0xb0, 0x56, 0x77, 0x5a, 0xbe, 0xb3, 0xc3, 0x25, 0xc4, 0xa6,
+	0x3d, 0x5e, 0x23, 0xb1, 0xdd, 0x55, 0xde, 0xf4, 0x90, 0xbe, 0xb0, 0x9e, 0x82, 0x25, 0x4f, 0xbc,
+	0xe8, 0x98, 0x14, 0x9b, 0xd5, 0xae, 0xfb, 0xe9, 0x6e, 0xb9, 0x2e, 0x9c, 0xdf, 0x57, 0x2d, 0x7a,
+	0x79, 0x05, 0xa0, 0xa1, 0x88, 0xf1, 0x3b, 0x48, 0xda, 0xa3, 0x29, 0x49, 0x35, 0xab, 0x63, 0x29,
+	0xeb, 0x6f, 0xfb, 0xa0, 0x94, 0xe2, 0xc9, 0x99, 0xd5, 0x8f, 0x60, 0x94, 0x75, 0x37, 0xdb, 0x6e,
+	0x18, 0x52, 0x39, 0xa7, 0x0f, 0xff, 0x80, 0x14, 0x2b, 0xe0, 0xda, 0xb6, 0x5c, 0xcf, 0x6e, 0x89,
+	0xdd, 0xb2, 0xff, 0x68, 0xda, 0x22, 0x05, 0xe4, 0x4d, 0x18, 0xef, 0xd0, 0xc0, 0xe1, 0x27, 0x55,
+	0xc3, 0xdd, 0xda, 0xaa, 0x0c, 0x1c, 0x49, 0xe1, 0x18, 0xea, 0xb8, 0xeb, 0x6e, 0x6d, 0x91, 0x0b,
+	0x50, 0x76, 0x3d, 0x0c, 0xaf, 0xea, 0x9b, 0xb6, 0xd7, 0x10, 0x93, 0x6b, 0xa4, 0x36, 0xee, 0x7a,
+	0x32, 0x12, 0x5a, 0xb1, 0xbd, 0x46, 0xaf, 0xfb, 0xf9, 0x65, 0xcf, 0xf5, 0x9a, 0x62, 0x9f, 0x60,
+	0x47, 0x76, 0xff, 0x23, 0x38, 0xbf, 0xaf, 0x5a, 0x74, 0xff, 0x45, 0x28, 0xb7, 0x65, 0x83, 0x7c,
+	0xfe, 0x53, 0x2f, 0x30, 0xa5, 0x76, 0x92, 0xdd, 0x5a, 0x85, 0x73, 0xf1, 0xa6, 0xff, 0xc4, 0x6e,
+	0xb5, 0x5e, 0x6c, 0x74, 0x1d, 0x87, 0x32, 0x76, 0x98, 0xe7, 0xd4, 0x2e, 0x58, 0xfb, 0x29, 0x41,
+	0x44, 0x8f, 0xa1, 0xc4, 0x24, 0x39, 0xf5, 0x36, 0x77, 0x41, 0xb7, 0xd5, 0x66, 0x95, 0xa8, 0x27,
+	0x02, 0x16, 0x93, 0x98, 0xf5, 0x01, 0x9c, 0xd0, 0x32, 0xe7, 0x4c, 0xd2, 0xcb, 0x30, 0xa1, 0xec,
+	0xa7, 0x9f, 0xcd, 0xca, 0x48, 0x56, 0xef, 0xa6, 0x17, 0xa1, 0xbc, 0x65, 0xbb, 0xad, 0x9e, 0x07,
+	0xd8, 0x92, 0xa4, 0x22, 0x5b, 0x74, 0xe9, 0x5a, 0xa7, 0x1e, 0x8f, 0x8a, 0x6a, 0xe2, 0x42, 0x1f,
+	0x9d, 0x3c, 0xef, 0xc2, 0x8c, 0xb6, 0x35, 0x7a, 0x2b, 0x99, 0xe8, 0xc8, 0x96, 0xba, 0x7c, 0x09,
+	0xc8, 0x5b, 0xa2, 0x29, 0x79, 0x75, 0xd1, 0xea, 0xa4, 0x94, 0x5a, 0x0c, 0x4a, 0x29, 0x36, 0x3e,
+	0x00, 0x22, 0x3c, 0x54, 0x03, 0x20, 0x3e, 0xf8, 0x63, 0x86, 0x5c, 0x64, 0xf5, 0x4d, 0xbe, 0x45,
+	0xa9, 0xc7, 0x0c, 0x49, 0x5b, 0xe1, 0x24, 0x72, 0x85, 0xdf, 0x70, 0xda, 0xb6, 0x2b, 0xae, 0x19,
+	0x82, 0x4b, 0x75, 0x7e, 0x22, 0xa2, 0x0b, 0xce, 0xb8, 0xfb, 0xbc, 0xc3, 0x6e, 0x40, 0x1b, 0xa9,
+	0x69, 0x1d, 0x75, 0x3f, 0xdb, 0x1a, 0x77, 0x3f, 0xc0, 0x96, 0xe4, 0xf4, 0xd4, 0xec, 0x50, 0x49,
+	0x79, 0xd5, 0xfd, 0x20, 0xa5, 0xd4, 0x7a, 0x15, 0x4a, 0x29, 0xb6, 0x1c, 0xff, 0x57, 0x60, 0xb8,
+	0xed, 0x37, 0xba, 0x2d, 0xaa, 0xee, 0x0e, 0xea, 0xd3, 0x7a, 0x05, 0xaf, 0x26, 0x42, 0x7a, 0xc3,
+	0xd9, 0xa6, 0x9c, 0x5c, 0x74, 0xf2, 0x7f, 0x43, 0x3d, 0x49, 0x67, 0xa4, 0xe3, 0x75, 0xe8, 0x74,
+	0x83, 0x80, 0x6f, 0x3f, 0x78, 0x50, 0xc9, 0xb7, 0xbe, 0x12, 0x52, 0xf1, 0xac, 0x7a, 0x0d, 0x46,
+	0x19, 0x8a, 0xaa, 0xd7, 0xe3, 0x33, 0xba, 0x85, 0xa1, 0xf4, 0xe3, 0x50, 0xc4, 0x42, 0xd6, 0xef,
+	0xf5, 0x41, 0x29, 0xc5, 0x92, 0x33, 0x0c, 0x2f, 0xc1, 0xc9, 0xe4, 0x41, 0xd6, 0xee, 0xb6, 0x42,
+	0xb7, 0xd3, 0x72, 0xa3, 0xc7, 0xad, 0xe9, 0xf8, 0x24, 0x5b, 0x8b, 0xda, 0xf8, 0x61, 0xeb, 0xd1,
+	0xe7, 0x51, 0x1f, 0xe4, 0x9c, 0x00, 0x4e, 0xc2, 0x0e, 0x9c, 0x86, 0x11, 0xd7, 0xab, 0x8b, 0x88,
+	0x48, 0x6c, 0xb1, 0x23, 0xb5, 0x61, 0xd7, 0x13, 0xd1, 0x90, 0x76, 0x52, 0x0d, 0x6a, 0x27, 0x15,
+	0x79, 0x03, 0xca, 0x31, 0x6b, 0xe8, 0xb6, 0x65, 0x3a, 0x62, 0xec, 0xd6, 0xe9, 0x25, 0x99, 0x0d,
+	0x5a, 0x52, 0xd9, 0xa0, 0xa5, 0xbb, 0x98, 0x0d, 0x5a, 0x19, 0xe1, 0x03, 0xf1, 0x87, 0xbf, 0x98,
+	0x33, 0x6a, 0xa5, 0x48, 0xf4, 0x89, 0xdb, 0xa6, 0xd6, 0x29, 0x38, 0x21, 0xfc, 0xf2, 0x78, 0x93,
+	0xd1, 0x60, 0x37, 0x7e, 0x0d, 0xb5, 0x9e, 0xc2, 0xc9, 0x6c, 0x03, 0x3a, 0xeb, 0x15, 0x18, 0xf5,
+	0x15, 0x11, 0x27, 0xe4, 0xa9, 0x8c, 0x17, 0x94, 0x90, 0x72, 0x40, 0xc4, 0x6f, 0xbd, 0x0d, 0x23,
+	0xaa, 0x91, 0x9c, 0x81, 0xd1, 0x68, 0xff, 0xc6, 0xe1, 0x8f, 0x09, 0xf2, 0x36, 0x44, 0xdb, 0x9d,
+	0xb0, 0xde, 0xf5, 0x42, 0xb7, 0xa5, 0x62, 0x3d, 0x19, 0xdb, 0x4e, 0xc9, 0xa6, 0xa7, 0xbc, 0x05,
+	0x43, 0xbe, 0x65, 0x8c, 0x62, 0xf9, 0xb1, 0xb2, 0x46, 0xdb, 0x9b, 0x34, 0x60, 0xdb, 0x6e, 0x87,
+	0x07, 0x75, 0xac, 0xe8, 0x2c, 0xdd, 0x84, 0xf9, 0x7c, 0x15, 0xd8, 0xfb, 0x2f, 0xc1, 0x20, 0xe3,
+	0x04, 0xec, 0xb9, 0x95, 0xe9, 0xb9, 0x46, 0x14, 0x07, 0x41, 0x8a, 0x59, 0xff, 0x64, 0xc0, 0x71,
+	0x0d, 0x53, 0x7e, 0x24, 0x1c, 0xd8, 0x21, 0xdf, 0x64, 0x13, 0x81, 0x3d, 0x08, 0x92, 0xbc, 0x09,
+	0x58, 0x50, 0x72, 0x3d, 0x71, 0xbc, 0x22, 0x8b, 0x8c, 0x85, 0xc7, 0x5c, 0x8f, 0x1b, 0x91, 0x3c,
+	0x6f, 0xc3, 0xa4, 0xe2, 0xd9, 0x0a, 0x78, 0xc6, 0xc2, 0xf7, 0x8e, 0x78, 0xc0, 0x97, 0xa5, 0xda,
+	0xfb, 0xa8, 0xc5, 0x6a, 0xc0, 0x85, 0xf4, 0x31, 0xbb, 0xec, 0x38, 0xdd, 0xc0, 0x76, 0x5e, 0xd4,
+	0x6c, 0x6f, 0x47, 0xec, 0xb4, 0xd1, 0xc0, 0xb7, 0xdc, 0xb6, 0x1b, 0xe2, 0xb2, 0x96, 0x1f, 0xdc,
+	0xff, 0x36, 0x73, 0xe4, 0x9e, 0x8c, 0x79, 0xbe, 0x98, 0x90, 0x8a, 0xe5, 0x2e, 0x1e, 0x60, 0x05,
+	0x7d, 0xf3, 0x1a, 0x0c, 0x07, 0x92, 0x94, 0x73, 0xe7, 0xea, 0xd1, 0x80, 0xbe, 0x51, 0x62, 0xd6,
+	0x7f, 0x1b, 0x30, 0xd5, 0xc3, 0x54, 0xf4, 0x42, 0x3c, 0x0f, 0xf2, 0x98, 0x60, 0x4c, 0x44, 0x93,
+	0xc9, 0x93, 0x43, 0x92, 0xf8, 0x9c, 0x56, 0x9e, 0x48, 0x72, 0xca, 0x8d, 0x62, 0x4a, 0x0e, 0xee,
+	0x46, 0x82, 0xff, 0xb3, 0xf3, 0x9c, 0x5a, 0x2d, 0x71, 0x6c, 0x70, 0xd7, 0xb5, 0x9b, 0x9e, 0xcf,
+	0xdc, 0xc2, 0xab, 0xa5, 0x01, 0xf3, 0xf9, 0x2a, 0x62, 0x8f, 0xf8, 0xdd, 0xd0, 0xf1, 0xdb, 0xea,
+	0x0d, 0x77, 0x3e, 0x37, 0x90, 0x79, 0x2c, 0xf9, 0x94, 0x47, 0x50, 0xcc, 0xb2, 0xd0, 0xca, 0xba,
+	0x1d, 0x84, 0xae, 0xe3, 0x76, 0xc4, 0x7e, 0xb6, 0xd1, 0x6d, 0xb7, 0xed, 0xe0, 0x85, 0xda, 0xab,
+	0xbe, 0xd9, 0x07, 0xe7, 0xf6, 0x61, 0x8a, 0xd3, 0x49, 0x9b, 0xbe, 0xd7, 0x88, 0x16, 0x93, 0xbc,
+	0xd7, 0x8d, 0x49, 0x9a, 0x5c, 0x29, 0x57, 0x61, 0x0a, 0x59, 0x22, 0xcf, 0x2a, 0x3f, 0x4e, 0xca,
+	0x86, 0x68, 0x72, 0x44, 0x57, 0xab, 0xf4, 0xc2, 0x13, 0x57, 0x2b, 0xd4, 0x76, 0x12, 0x86, 0xf8,
+	0x57, 0xa0, 0xd2, 0xce, 0xf8, 0x45, 0xea, 0x70, 0xbc, 0x93, 0x04, 0x5a, 0x17, 0x9b, 0x74, 0x65,
+	0xf0, 0x48, 0x8e, 0x25, 0x29, 0x55, 0x35, 0xfe, 0xdf, 0xe8, 0xa8, 0xae, 0xd9, 0x7b, 0xf2, 0xb0,
+	0x0b, 0x0f, 0x11, 0xa7, 0xbe, 0x03, 0xa6, 0x4e, 0x18, 0x07, 0xf1, 0x0b, 0x30, 0x4c, 0xbd, 0x30,
+	0x70, 0x69, 0xfe, 0x6d, 0x69, 0x6f, 0x23, 0xf4, 0x03, 0x7a, 0xcf, 0x0b, 0x83, 0x68, 0x79, 0xa1,
+	0x88, 0xf5, 0x10, 0x4a, 0xa9, 0x76, 0x42, 0x60, 0x40, 0xdc, 0x3b, 0x25, 0x16, 0xf1, 0x9b, 0x4c,
+	0x42, 0x3f, 0xbf, 0x32, 0xca, 0xa7, 0x1d, 0xfe, 0x53, 0x44, 0x6a, 0x76, 0xab, 0x4b, 0xf1, 0x31,
+	0x47, 0x7e, 0x58, 0xeb, 0x08, 0x74, 0x8d, 0x36, 0x5c, 0xdb, 0xbb, 0xdf, 0x72, 0x3b, 0xab, 0x3e,
+	0x0b, 0xf7, 0xed, 0x26, 0xb7, 0xd7, 0xf6, 0x77, 0x29, 0x2a, 0x17, 0xbf, 0x13, 0x5d, 0xff, 0x53,
+	0x03, 0x66, 0xb4, 0x2a, 0xa3, 0xdb, 0xa2, 0x94, 0x3e, 0x5a, 0xa9, 0x83, 0x90, 0xe5, 0x37, 0xce,
+	0xad, 0x96, 0xdb, 0xa9, 0x3b, 0x3e, 0x0b, 0x55, 0x10, 0x93, 0x7d, 0x48, 0x49, 0x9b, 0x57, 0x87,
+	0xe8, 0x16, 0x7e, 0x33, 0xeb, 0x67, 0x06, 0x94, 0xd3, 0x3c, 0x39, 0xdd, 0xbd, 0x0f, 0x43, 0x6d,
+	0xc1, 0x77, 0xc4, 0xfb, 0x26, 0x4a, 0x8b, 0xa5, 0x63, 0xb7, 0x5a, 0x7e, 0x98, 0x3e, 0x64, 0x24,
+	0x4d, 0x4e, 0x76, 0x71, 0x52, 0xb9, 0x8c, 0x22, 0xc7, 0x80, 0x3a, 0xa9, 0x5c, 0x46, 0x23, 0x86,
+	0x16, 0xff, 0x81, 0x0c, 0x83, 0x92, 0x41, 0x90, 0x04, 0x83, 0xb5, 0x8e, 0x4f, 0x32, 0x8f, 0xc5,
+	0x20, 0x2c, 0xb7, 0x68, 0x10, 0xae, 0xfa, 0xde, 0x96, 0xdb, 0x3c, 0xf2, 0x2d, 0xf0, 0x1f, 0x55,
+	0xe6, 0x4c, 0xa3, 0x12, 0x5d, 0x5a, 0x83, 0x52, 0xdb, 0x7e, 0x2e, 0x93, 0x8f, 0x9f, 0xa2, 0x8c,
+	0x65, 0xac, 0x6d, 0x3f, 0x5f, 0x73, 0xf1, 0x66, 0xf5, 0x10, 0x46, 0x63, 0x7d, 0x47, 0x1b, 0xf8,
+	0x91, 0x36, 0x2a, 0xb3, 0x2a, 0x18, 0x87, 0xad, 0x89, 0x30, 0xfc, 0xcb, 0xde, 0x96, 0xaf, 0x76,
+	0xbd, 0x7f, 0x31, 0xe0, 0x54, 0x4f, 0x13, 0x76, 0xeb, 0x2a, 0x4c, 0x39, 0xfc, 0x87, 0xc7, 0xba,
+	0xac, 0xce, 0x03, 0x2f, 0x95, 0xd2, 0x1e, 0xa8, 0x4d, 0x46, 0x0d, 0xcf, 0x24, 0x9d, 0xac, 0xc3,
+	0xc8, 0x16, 0xb5, 0xc3, 0x6e, 0x10, 0x45, 0xd5, 0x2f, 0x65, 0x26, 0x64, 0x8e, 0x99, 0xa5, 0xfb,
+	0x28, 0x26, 0x16, 0x73, 0x2d, 0xd2, 0x62, 0xbe, 0x02, 0xa5, 0x54, 0x93, 0x5a, 0xd3, 0x86, 0x66,
+	0x4d, 0xf7, 0x25, 0xd6, 0xf4, 0x9d, 0xbe, 0x97, 0x0d, 0xab, 0xa9, 0x0a, 0x14, 0x02, 0xca, 0xb6,
+	0x0b, 0x17, 0x2e, 0x91, 0x4b, 0x30, 0xc1, 0x3d, 0xd9, 0x5b, 0xf0, 0xc1, 0x1d, 0xbc, 0x1c, 0xd5,
+	0x7c, 0x24, 0xa6, 0xc7, 0xf7, 0xd4, 0xf4, 0xd0, 0x58, 0xfa, 0x2c, 0xab, 0x9c, 0x0e, 0x2c, 0x4b,
+	0x59, 0xc1, 0xd7, 0xcb, 0xb7, 0xb6, 0xdd, 0x90, 0xb6, 0x5c, 0x16, 0xae, 0x0a, 0xe1, 0xe8, 0x64,
+	0xae, 0xc0, 0xf0, 0x9e, 0xeb, 0x35, 0xfc, 0x3d, 0x86, 0x3e, 0x55, 0x9f, 0x89, 0xce, 0xfd, 0x91,
+	0x01, 0x67, 0x73, 0x94, 0x60, 0xdf, 0xee, 0xc0, 0xa0, 0xdd, 0x68, 0x88, 0xb7, 0x76, 0x5d, 0x1d,
+	0x4e, 0x46, 0x4e, 0x45, 0xb1, 0x42, 0x84, 0x7c, 0x09, 0x86, 0x03, 0xca, 0xf7, 0xb3, 0x46, 0xa5,
+	0xef, 0x10, 0xd2, 0x4a, 0x28, 0x91, 0x93, 0x7c, 0x97, 0x3a, 0x21, 0x6d, 0x3c, 0xe9, 0x76, 0x5a,
+	0xf4, 0xe8, 0xcf, 0x3d, 0x5f, 0x85, 0x19, 0xad, 0xba, 0xb8, 0xa2, 0x25, 0xf9, 0x08, 0x6a, 0xf4,
+	0x3c, 0x82, 0xde, 0x81, 0xa1, 0x50, 0x88, 0xe4, 0xdc, 0x2a, 0x53, 0x7a, 0xd5, 0xdb, 0xae, 0x94,
+	0xb0, 0xde, 0xc4, 0x49, 0x24, 0x5f, 0x15, 0xde, 0x12, 0x8e, 0x90, 0xf5, 0x13, 0x47, 0xee, 0xce,
+	0x0f, 0xfa, 0x60, 0x2e, 0x57, 0x67, 0xd1, 0x3e, 0xc9, 0x2c, 0x56, 0x54, 0xb1, 0x20, 0xe3, 0x6b,
+	0x9e, 0xc5, 0xc2, 0x9c, 0x7e, 0xcf, 0x4b, 0x47, 0x7f, 0xef, 0x4b, 0xc7, 0x22, 0x60, 0x09, 0x46,
+	0xdd, 0xef, 0x50, 0x0f, 0xf9, 0x06, 0xd4, 0xad, 0x94, 0x37, 0x3c, 0xee, 0x50, 0x4f, 0xf2, 0x5e,
+	0x03, 0x82, 0xbc, 0x4e, 0xcb, 0x67, 0x14, 0x99, 0xe5, 0x15, 0x76, 0x52, 0xb6, 0xac, 0xf2, 0x06,
+	0xc9, 0x3d, 0x0b, 0x20, 0x69, 0xf6, 0x66, 0x4b, 0xde, 0x5f, 0x47, 0x6a, 0x09, 0x0a, 0x31, 0x61,
+	0x44, 0x7e, 0xd1, 0x86, 0x78, 0x3c, 0x1e, 0xa9, 0x45, 0xdf, 0xd6, 0x5b, 0xe8, 0xed, 0x15, 0x71,
+	0xfc, 0x3c, 0x70, 0x59, 0xe8, 0x37, 0x03, 0xbb, 0xbd, 0xff, 0xf6, 0x50, 0x81, 0xe1, 0xcd, 0xae,
+	0xb3, 0x43, 0x43, 0xb9, 0xe0, 0x4a, 0x35, 0xf5, 0x99, 0x18, 0xf7, 0xbf, 0x31, 0xe0, 0x8c, 0x5e,
+	0x73, 0x94, 0x06, 0x19, 0xa4, 0x8d, 0xa6, 0x2a, 0xff, 0x3a, 0xf4, 0x36, 0x20, 0x85, 0x79, 0x5c,
+	0x88, 0x99, 0x21, 0x3e, 0xdb, 0xfa, 0x6b, 0xf8, 0xa5, 0x1e, 0xa4, 0x64, 0x72, 0xa0, 0x24, 0x1f,
+	0xa4, 0x58, 0xcf, 0xd9, 0x3b, 0xd0, 0x73, 0xf6, 0x46, 0xd5, 0x80, 0x1b, 0xdb, 0x76, 0xa0, 0x52,
+	0x60, 0xd1, 0x45, 0xfe, 0x19, 0x98, 0xba, 0x46, 0xec, 0xd1, 0xcb, 0x30, 0xd4, 0x0c, 0xfc, 0x6e,
+	0x47, 0x85, 0x73, 0x66, 0x66, 0xe6, 0x4b, 0xfe, 0xd7, 0x39, 0x8b, 0x9a, 0xf7, 0x92, 0xdf, 0xba,
+	0x07, 0x63, 0x89, 0x46, 0x91, 0x73, 0x16, 0x9f, 0x38, 0xec, 0xf8, 0xc5, 0x1d, 0x9d, 0x8a, 0xa5,
+	0xf9, 0x9b, 0x52, 0x82, 0x12, 0xbd, 0x90, 0x3d, 0xb2, 0x59, 0x28, 0xdf, 0x28, 0x13, 0x37, 0x76,
+	0xeb, 0x6b, 0x30, 0xa3, 0x6d, 0x2d, 0xba, 0x08, 0xbe, 0x00, 0x43, 0xf8, 0x72, 0xa6, 0xdf, 0xa6,
+	0x12, 0x4f, 0xa3, 0x89, 0xab, 0x3a, 0xca, 0x44, 0xa5, 0x39, 0x35, 0xca, 0xb3, 0xb5, 0x94, 0xc7,
+	0xff, 0xe9, 0x07, 0xbc, 0x5f, 0x81, 0xd9, 0x3c, 0x86, 0x38, 0x8d, 0x94, 0x7a, 0x59, 0xc6, 0x2f,
+	0xee, 0x55, 0x99, 0x50, 0x4b, 0xc0, 0x1b, 0xad, 0xc9, 0x24, 0x1b, 0xbe, 0xd8, 0xcd, 0xa4, 0x1e,
+	0xdc, 0xc4, 0xea, 0x8f, 0xcb, 0x55, 0x7e, 0x19, 0xa6, 0x12, 0xf4, 0x15, 0x31, 0x95, 0xb9, 0x31,
+	0x26, 0xbe, 0x95, 0x0f, 0xe4, 0x17, 0x9f, 0x58, 0xb2, 0xc0, 0x54, 0x1e, 0x35, 0xf2, 0x23, 0x01,
+	0xad, 0x3f, 0x09, 0xcd, 0xfa, 0x4a, 0xea, 0xa9, 0x2e, 0xb2, 0x1b, 0xdf, 0xe8, 0xd4, 0x3a, 0xda,
+	0x27, 0xaf, 0x99, 0x84, 0xa5, 0xf6, 0x7e, 0x14, 0xb3, 0x3e, 0x0f, 0x73, 0x9a, 0xcb, 0x1a, 0x0d,
+	0x5c, 0xca, 0xf6, 0x7d, 0x2f, 0xb0, 0x1c, 0x98, 0xcf, 0x17, 0x44, 0x78, 0xaf, 0xf2, 0xb5, 0xe5,
+	0x7a, 0x11, 0xba, 0x73, 0xbd, 0xe9, 0xb9, 0x58, 0x76, 0x9d, 0x73, 0x46, 0xa9, 0x3a, 0x21, 0x16,
+	0xdd, 0x9d, 0xd6, 0xec, 0xe7, 0xf8, 0xbe, 0xe7, 0xef, 0x16, 0xbe, 0x3b, 0xfd, 0xb3, 0x7a, 0xe6,
+	0xcc, 0x48, 0x23, 0xb8, 0xb7, 0x61, 0x52, 0x04, 0x9b, 0xfe, 0x2e, 0xad, 0x63, 0xaa, 0xe4, 0x88,
+	0x01, 0x45, 0x99, 0xc7, 0x9b, 0xfe, 0x2e, 0x5d, 0x97, 0x5a, 0xb2, 0x0b, 0xa1, 0xaf, 0x67, 0x21,
+	0x9c, 0x83, 0x71, 0x19, 0x23, 0xd4, 0x59, 0x68, 0x07, 0xa1, 0xda, 0xec, 0xf7, 0xd4, 0xd1, 0x12,
+	0x88, 0xf9, 0x20, 0x3f, 0xd5, 0x75, 0x55, 0x7e, 0xdd, 0xfa, 0xf1, 0x6d, 0x18, 0x14, 0x9d, 0x22,
+	0xdf, 0x32, 0x60, 0x3c, 0x55, 0xbf, 0x7c, 0x59, 0x17, 0x27, 0x6a, 0x42, 0x36, 0x73, 0xe1, 0x60,
+	0x46, 0x39, 0x46, 0xd6, 0xb5, 0xaf, 0xff, 0xec, 0xbf, 0xbe, 0xd3, 0x77, 0x89, 0x5c, 0x50, 0xb5,
+	0xf3, 0x72, 0x56, 0x56, 0xdf, 0x17, 0x7f, 0x3f, 0xa8, 0xa6, 0xc2, 0x31, 0xf2, 0xbb, 0x06, 0x94,
+	0xee, 0xa5, 0x12, 0x9e, 0x07, 0x5a, 0x52, 0x93, 0xcc, 0xbc, 0x52, 0x80, 0x13, 0x41, 0x5d, 0x14,
+	0xa0, 0xe6, 0xc8, 0xd9, 0x0c, 0xa8, 0x14, 0x18, 0x46, 0x02, 0x18, 0xc6, 0x92, 0x61, 0x62, 0xe9,
+	0x94, 0xa7, 0xcb, 0x8c, 0xcd, 0xf3, 0xfb, 0xf2, 0xa0, 0xe9, 0x59, 0x61, 0xba, 0x42, 0x4e, 0x66,
+	0x4c, 0x63, 0xe5, 0x31, 0xf9, 0x13, 0x03, 0x26, 0xb3, 0xa5, 0xbc, 0xe4, 0xaa, 0x4e, 0x73, 0x4e,
+	0x05, 0xb1, 0x79, 0xad, 0x18, 0x33, 0xe2, 0xb9, 0x25, 0xf0, 0x5c, 0x23, 0x8b, 0x0a, 0x4f, 0xbc,
+	0x97, 0x57, 0xdf, 0x4f, 0x87, 0x39, 0x1f, 0x54, 0xf1, 0x0c, 0xf8, 0xb6, 0x01, 0x63, 0x89, 0x22,
+	0x4e, 0x72, 0x49, 0x7b, 0xbd, 0xe8, 0xa9, 0x26, 0x36, 0x2f, 0x1f, 0xc8, 0x87, 0xa0, 0x6e, 0x08,
+	0x50, 0x8b, 0x64, 0xa1, 0x08, 0x28, 0x7e, 0xb5, 0xe2, 0x13, 0x67, 0x7c, 0x2d, 0x59, 0x4a, 0x7b,
+	0x90, 0x2d, 0xb6, 0xef, 0x54, 0xd6, 0x95, 0xfa, 0x5a, 0x0b, 0x02, 0x95, 0x45, 0xe6, 0x35, 0xa8,
+	0x52, 0x35, 0xc0, 0xe4, 0x2f, 0x0d, 0x98, 0xcc, 0x56, 0x77, 0xea, 0x9d, 0x98, 0x53, 0xf7, 0x6a,
+	0x5e, 0x2b, 0xc6, 0x8c, 0xc8, 0xbe, 0x28, 0x90, 0x7d, 0x9e, 0x7c, 0xae, 0xc8, 0x78, 0xf5, 0x54,
+	0x96, 0x92, 0x3f, 0x36, 0x60, 0x2a, 0xab, 0x9b, 0x91, 0x42, 0x10, 0xa2, 0x61, 0xbc, 0x5e, 0x90,
+	0x1b, 0x11, 0x5f, 0x17, 0x88, 0x2f, 0x93, 0x8b, 0x1a, 0xc4, 0x3d, 0x00, 0x19, 0xf9, 0xc8, 0x80,
+	0x52, 0xaa, 0x92, 0x53, 0xbf, 0x2f, 0xe8, 0xaa, 0x59, 0xcd, 0x2b, 0x05, 0x38, 0x11, 0xd5, 0x1d,
+	0x81, 0xea, 0x25, 0x72, 0x2b, 0x81, 0xaa, 0xe1, 0x1e, 0x38, 0x8e, 0x62, 0x10, 0xbf, 0x63, 0x40,
+	0x39, 0xa5, 0x95, 0x91, 0x83, 0x2d, 0x47, 0xc3, 0xb7, 0x58, 0x84, 0x15, 0x51, 0x2e, 0x0a, 0x94,
+	0x17, 0x88, 0xb5, 0xef, 0xd8, 0xc9, 0x81, 0x6b, 0xc2, 0x90, 0xac, 0x60, 0x21, 0xe7, 0x74, 0x16,
+	0x52, 0x55, 0xaa, 0xa6, 0xb5, 0x1f, 0x0b, 0x1a, 0x3f, 0x29, 0x8c, 0x4f, 0x92, 0xb2, 0x32, 0x8e,
+	0x25, 0x31, 0x1f, 0x1a, 0x50, 0x4e, 0x57, 0x90, 0xea, 0xbb, 0xaf, 0xad, 0x5a, 0x35, 0x17, 0x8b,
+	0xb0, 0x22, 0x82, 0x39, 0x81, 0xe0, 0x34, 0x39, 0xa5, 0x10, 0x60, 0x4d, 0x02, 0x55, 0x76, 0x7f,
+	0xc3, 0x80, 0xf1, 0x64, 0xc1, 0xa5, 0x7e, 0x2f, 0xd0, 0xd4, 0x6b, 0x9a, 0x0b, 0x07, 0x33, 0xe6,
+	0x6d, 0xe3, 0xe2, 0xb8, 0x16, 0x55, 0x81, 0x8c, 0x9b, 0xfc, 0x07, 0x03, 0x48, 0x6f, 0x71, 0x1c,
+	0xd1, 0xae, 0x92, 0xdc, 0xca, 0x3d, 0x73, 0xa9, 0x28, 0x3b, 0xa2, 0x7a, 0x28, 0x50, 0xdd, 0x23,
+	0xab, 0xc5, 0x37, 0xf3, 0xea, 0xfb, 0x89, 0xa2, 0xbf, 0x0f, 0xaa, 0x89, 0x02, 0xbd, 0xef, 0x19,
+	0xba, 0x52, 0x35, 0xed, 0xae, 0x90, 0x57, 0x7e, 0x67, 0x5e, 0x2f, 0xc8, 0x8d, 0xf8, 0x2f, 0x08,
+	0xfc, 0xb3, 0xe4, 0x4c, 0xe6, 0x70, 0x4c, 0x15, 0xe0, 0x91, 0x3f, 0x30, 0x80, 0xf4, 0xd6, 0xb6,
+	0xe9, 0xc7, 0x36, 0xb7, 0x4a, 0xce, 0x5c, 0x2a, 0xca, 0x8e, 0xd8, 0x2c, 0x81, 0xed, 0x0c, 0x31,
+	0x33, 0xd8, 0x12, 0x75, 0x74, 0xe4, 0xf7, 0x0d, 0x98, 0xcc, 0x56, 0xa0, 0xe9, 0xf7, 0xfd, 0x9c,
+	0x42, 0x36, 0xf3, 0x5a, 0x31, 0xe6, 0x3c, 0x4c, 0x2d, 0xce, 0x59, 0x77, 0x04, 0x2b, 0x8f, 0x0c,
+	0x43, 0x4a, 0xfe, 0xce, 0x80, 0x93, 0xfa, 0xaa, 0x29, 0x72, 0x53, 0x3b, 0xdd, 0xf7, 0x2b, 0xdc,
+	0x32, 0x6f, 0x1d, 0x46, 0x64, 0x9f, 0x5d, 0x35, 0x77, 0x56, 0x62, 0xe1, 0xab, 0x82, 0x98, 0x42,
+	0x9f, 0x2a, 0xfa, 0x39, 0x00, 0xbd, 0xae, 0xee, 0xc8, 0xbc, 0x75, 0x18, 0x91, 0xa3, 0xa0, 0x4f,
+	0x57, 0x1f, 0x91, 0x3f, 0x37, 0xf2, 0xaa, 0x75, 0x6e, 0xe4, 0x2e, 0x8c, 0x9c, 0x7a, 0x24, 0xf3,
+	0xe6, 0x21, 0x24, 0x10, 0xfa, 0x15, 0x01, 0xfd, 0x3c, 0x39, 0x97, 0x99, 0xb2, 0x21, 0x17, 0xa8,
+	0x27, 0xeb, 0x92, 0xc4, 0xe9, 0x95, 0xae, 0xda, 0xd1, 0x6f, 0xdf, 0xda, 0xba, 0x1f, 0x73, 0xb1,
+	0x08, 0x6b, 0x81, 0xd3, 0x2b, 0x53, 0x1d, 0x84, 0x87, 0x4a, 0xb2, 0xee, 0x25, 0xef, 0x50, 0xd1,
+	0x94, 0xe3, 0x98, 0x8b, 0x45, 0x58, 0xf3, 0x0e, 0x15, 0x1c, 0x2a, 0x55, 0x75, 0x43, 0xbe, 0x61,
+	0x64, 0x2b, 0x4d, 0x16, 0x72, 0x1d, 0x92, 0xa9, 0xa6, 0x31, 0xaf, 0x14, 0xe0, 0x3c, 0x00, 0x87,
+	0x2a, 0x79, 0x21, 0xdf, 0xcf, 0xa9, 0x37, 0xd0, 0x6e, 0x67, 0xf9, 0xb5, 0x13, 0x66, 0xb5, 0x30,
+	0x3f, 0x22, 0x3b, 0x27, 0x90, 0xcd, 0x90, 0xd3, 0x3d, 0x7b, 0x33, 0xcf, 0x7e, 0x0b, 0x0c, 0xbf,
+	0x06, 0xa3, 0x51, 0x79, 0x09, 0xb9, 0xa0, 0x33, 0x90, 0x2d, 0x4b, 0x31, 0x2f, 0x1e, 0xc0, 0x95,
+	0x77, 0x30, 0x24, 0x26, 0x4d, 0x54, 0x8c, 0xc2, 0xa3, 0xc4, 0xe3, 0x9a, 0xec, 0xb5, 0x7e, 0x6c,
+	0xf2, 0x33, 0xe5, 0x66, 0xb5, 0x30, 0x7f, 0xde, 0xcd, 0x20, 0x73, 0xc9, 0x6d, 0x44, 0x50, 0x7e,
+	0x6c, 0x40, 0x25, 0xaf, 0xee, 0x81, 0xdc, 0xde, 0x77, 0x7b, 0xd2, 0xd7, 0x62, 0x98, 0x2f, 0x1d,
+	0x4e, 0x08, 0x11, 0x5f, 0x15, 0x88, 0x2f, 0x92, 0xf3, 0xba, 0x18, 0x12, 0x65, 0xea, 0x58, 0x45,
+	0x41, 0xfe, 0xc2, 0x80, 0x69, 0x5d, 0x2a, 0x9e, 0x54, 0x73, 0x02, 0xc6, 0xbc, 0xcc, 0xbe, 0x79,
+	0xa3, 0xb8, 0x40, 0x81, 0xab, 0x60, 0x3a, 0xeb, 0xce, 0x10, 0xd4, 0x87, 0x86, 0xc8, 0x4a, 0xc7,
+	0xc9, 0x6e, 0xfd, 0x4a, 0xd5, 0x25, 0xd3, 0xcd, 0x2b, 0x05, 0x38, 0x0f, 0x88, 0x07, 0x94, 0xcf,
+	0x03, 0x7b, 0x8f, 0xfc, 0x4e, 0x6f, 0x62, 0x57, 0x6b, 0x41, 0x9b, 0xf2, 0x36, 0x17, 0x8b, 0xb0,
+	0x22, 0x9a, 0x79, 0x81, 0xc6, 0x24, 0x95, 0x0c, 0x9a, 0x28, 0x37, 0x4d, 0x7e, 0x64, 0xc0, 0x54,
+	0x4f, 0xde, 0x54, 0x1f, 0xce, 0xe5, 0x65, 0x6c, 0xcd, 0xeb, 0x05, 0xb9, 0x11, 0xd4, 0xcb, 0x02,
+	0xd4, 0x2d, 0x72, 0xa3, 0xd0, 0xb5, 0x94, 0x2b, 0xa8, 0x3b, 0x12, 0xd6, 0x73, 0x80, 0x38, 0x3d,
+	0x49, 0x2e, 0x1e, 0x94, 0xbe, 0x94, 0xe8, 0x2e, 0x15, 0xcb, 0x72, 0x5a, 0x33, 0x02, 0xd6, 0x09,
+	0x72, 0x5c, 0xc1, 0x92, 0x25, 0x91, 0x75, 0x97, 0xdb, 0xfa, 0xa1, 0x01, 0x53, 0x3d, 0xf9, 0x43,
+	0xfd, 0x30, 0xe5, 0x25, 0x34, 0xcd, 0xeb, 0x05, 0xb9, 0xf3, 0x9e, 0x60, 0x32, 0x33, 0x69, 0x8b,
+	0x4b, 0xa6, 0xff, 0xc1, 0x02, 0x1e, 0x03, 0x4f, 0x66, 0x33, 0x81, 0xfa, 0x48, 0x33, 0x27, 0xe9,
+	0x68, 0x5e, 0x2b, 0xc6, 0x7c, 0xc0, 0x0e, 0xb7, 0xa7, 0x04, 0xea, 0x0e, 0x82, 0xf8, 0xa1, 0x38,
+	0xb3, 0x93, 0x79, 0xbb, 0xbc, 0x33, 0x5b, 0x93, 0x2a, 0x34, 0x17, 0x8b, 0xb0, 0x22, 0xa6, 0x57,
+	0x04, 0xa6, 0xcf, 0x91, 0xdb, 0x85, 0xe2, 0x4a, 0xd4, 0x51, 0x97, 0x69, 0x3e, 0xf2, 0x57, 0x06,
+	0x90, 0xde, 0x74, 0x9c, 0xfe, 0x12, 0x91, 0x9b, 0x0a, 0x34, 0x97, 0x8a, 0xb2, 0x23, 0xe4, 0xff,
+	0x2f, 0x20, 0xdf, 0x26, 0x37, 0x8b, 0x41, 0x16, 0xe9, 0x37, 0x7c, 0xf4, 0xff, 0xae, 0x01, 0x13,
+	0x99, 0x3c, 0x16, 0x59, 0xd4, 0x1f, 0xe2, 0xba, 0x34, 0x9a, 0x79, 0xb5, 0x10, 0x6f, 0xc1, 0x03,
+	0x6d, 0x3b, 0x82, 0xf0, 0x2d, 0x03, 0x4a, 0xa9, 0x54, 0x94, 0x7e, 0xb7, 0xd5, 0xa5, 0xb2, 0xcc,
+	0x2b, 0x05, 0x38, 0xf3, 0x42, 0xd9, 0xc4, 0xc0, 0x31, 0x21, 0x81, 0xff, 0x0b, 0x19, 0x23, 0xbf,
+	0x69, 0x40, 0x39, 0x9d, 0x5f, 0xd2, 0x4f, 0x40, 0x6d, 0x86, 0xca, 0x5c, 0x2c, 0xc2, 0x9a, 0xb7,
+	0x91, 0x60, 0x60, 0x2d, 0x6c, 0x7e, 0xd7, 0x80, 0xa9, 0x9e, 0x3c, 0x92, 0x7e, 0x23, 0xc9, 0xcb,
+	0x47, 0x99, 0xd7, 0x0b, 0x72, 0x1f, 0x70, 0x24, 0x05, 0xb1, 0x44, 0x22, 0x8e, 0xc5, 0x4c, 0xd0,
+	0x7e, 0x71, 0x6c, 0x3a, 0x49, 0x65, 0x5e, 0x29, 0xc0, 0x79, 0x50, 0x1c, 0xab, 0xac, 0xfe, 0xc0,
+	0x80, 0xe3, 0x9a, 0xc4, 0x8f, 0x3e, 0x56, 0xcb, 0x4f, 0x2d, 0x99, 0xd5, 0xc2, 0xfc, 0x79, 0xa1,
+	0x64, 0x2a, 0x8a, 0xa8, 0x32, 0x09, 0xe3, 0x9b, 0x06, 0x94, 0x52, 0x49, 0x1f, 0xfd, 0x30, 0xe9,
+	0xb2, 0x4a, 0xe6, 0x95, 0x02, 0x9c, 0x08, 0xe6, 0xb2, 0x00, 0x73, 0x8e, 0xcc, 0xe5, 0xac, 0x33,
+	0x95, 0x5e, 0x5a, 0xb9, 0xfb, 0x93, 0x8f, 0x67, 0x8d, 0x9f, 0x7e, 0x3c, 0x6b, 0xfc, 0xc7, 0xc7,
+	0xb3, 0xc6, 0xb7, 0x3f, 0x99, 0x3d, 0xf6, 0xd3, 0x4f, 0x66, 0x8f, 0xfd, 0xeb, 0x27, 0xb3, 0xc7,
+	0xde, 0x59, 0x4c, 0xa4, 0x98, 0x9e, 0x50, 0xbb, 0x7d, 0xfd, 0xa1, 0x30, 0x5e, 0x75, 0xfc, 0x80,
+	0x56, 0x9f, 0x47, 0x33, 0x93, 0xa7, 0x9a, 0x36, 0x87, 0x44, 0x25, 0xf9, 0xed, 0xff, 0x1d, 0x00,
+	0x2a, 0xf6, 0xe5, 0x70, 0x2d, 0x49, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ExchangeRate returns exchange rate of a denom
+	ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
+	// ExchangeRates returns exchange rates of all denoms
+	ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
+	// Actives returns all active denoms
+	Actives(ctx context.Context, in *QueryActivesRequest, opts ...grpc.CallOption) (*QueryActivesResponse, error)
+	// FeederDelegation returns feeder delegation of a validator
+	FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error)
+	// MissCounter returns oracle miss counter of a validator
+	MissCounter(ctx context.Context, in *QueryMissCounterRequest, opts ...grpc.CallOption) (*QueryMissCounterResponse, error)
+	// MissCounters returns the miss counters of all validators with missed votes in the current slash window
+	MissCounters(ctx context.Context, in *QueryMissCountersRequest, opts ...grpc.CallOption) (*QueryMissCountersResponse, error)
+	// AggregatePrevote returns an aggregate prevote of a validator
+	AggregatePrevote(ctx context.Context, in *QueryAggregatePrevoteRequest, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error)
+	// AggregatePrevotes returns aggregate prevotes of all validators
+	AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotesRequest, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error)
+	// AggregateVote returns an aggregate vote of a validator
+	AggregateVote(ctx context.Context, in *QueryAggregateVoteRequest, opts ...grpc.CallOption) (*QueryAggregateVoteResponse, error)
+	// AggregateVotes returns aggregate votes of all validators
+	AggregateVotes(ctx context.Context, in *QueryAggregateVotesRequest, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error)
+	// Params queries all parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// RewardEstimate returns the projected reward paid to the ballot winners at the end of the next vote
+	// period per unit of voting power
+	RewardEstimate(ctx context.Context, in *QueryRewardEstimateRequest, opts ...grpc.CallOption) (*QueryRewardEstimateResponse, error)
+	// VoteHashSpec returns the format of the aggregate vote hash preimage
+	VoteHashSpec(ctx context.Context, in *QueryVoteHashSpecRequest, opts ...grpc.CallOption) (*QueryVoteHashSpecResponse, error)
+	// IsFeederAuthorized returns whether an account may submit votes on behalf of a validator
+	IsFeederAuthorized(ctx context.Context, in *QueryIsFeederAuthorizedRequest, opts ...grpc.CallOption) (*QueryIsFeederAuthorizedResponse, error)
+	// DenomBackingPower returns the voting power of the votes submitted for each denom in the current vote period
+	DenomBackingPower(ctx context.Context, in *QueryDenomBackingPowerRequest, opts ...grpc.CallOption) (*QueryDenomBackingPowerResponse, error)
+	// UpcomingGraceExits returns the denoms in their grace window and the vote period each becomes miss-eligible
+	UpcomingGraceExits(ctx context.Context, in *QueryUpcomingGraceExitsRequest, opts ...grpc.CallOption) (*QueryUpcomingGraceExitsResponse, error)
+	// LightClientState returns the params, exchange rates and current vote period read from a single height
+	LightClientState(ctx context.Context, in *QueryLightClientStateRequest, opts ...grpc.CallOption) (*QueryLightClientStateResponse, error)
+	// ValidatorRateDeviation returns how far the rates a validator submitted in the last vote period were from the tallied rates
+	ValidatorRateDeviation(ctx context.Context, in *QueryValidatorRateDeviationRequest, opts ...grpc.CallOption) (*QueryValidatorRateDeviationResponse, error)
+	// ValidatorMissingDenoms returns the active denoms a validator has not voted on in the current vote period
+	ValidatorMissingDenoms(ctx context.Context, in *QueryValidatorMissingDenomsRequest, opts ...grpc.CallOption) (*QueryValidatorMissingDenomsResponse, error)
+	// DenomTallySuccessRate returns the number of vote periods each denom tallied and failed to tally in the current slash window
+	DenomTallySuccessRate(ctx context.Context, in *QueryDenomTallySuccessRateRequest, opts ...grpc.CallOption) (*QueryDenomTallySuccessRateResponse, error)
+	// PendingReveals returns the prevotes which can be revealed in the current vote period and have no vote yet
+	PendingReveals(ctx context.Context, in *QueryPendingRevealsRequest, opts ...grpc.CallOption) (*QueryPendingRevealsResponse, error)
+	// RequiredDenoms returns the denoms other modules registered as required, with the modules requiring them
+	RequiredDenoms(ctx context.Context, in *QueryRequiredDenomsRequest, opts ...grpc.CallOption) (*QueryRequiredDenomsResponse, error)
+	// DenomSchedule returns the vote period each whitelisted denom is next tallied with votes required
+	DenomSchedule(ctx context.Context, in *QueryDenomScheduleRequest, opts ...grpc.CallOption) (*QueryDenomScheduleResponse, error)
+	// BandMembershipStats returns the share of the rating power of the last vote period within the reward band of each denom
+	BandMembershipStats(ctx context.Context, in *QueryBandMembershipStatsRequest, opts ...grpc.CallOption) (*QueryBandMembershipStatsResponse, error)
+	// Observers returns the validators exempt from slashing as observers, with their exemption expiry
+	Observers(ctx context.Context, in *QueryObserversRequest, opts ...grpc.CallOption) (*QueryObserversResponse, error)
+	// DenomTallyDiagnosis returns the outcome of the last vote period of a denom and why it did not tally
+	DenomTallyDiagnosis(ctx context.Context, in *QueryDenomTallyDiagnosisRequest, opts ...grpc.CallOption) (*QueryDenomTallyDiagnosisResponse, error)
+	// ValidatorAccuracyRanking returns the validators ranked by the share of their submissions within the reward band
+	ValidatorAccuracyRanking(ctx context.Context, in *QueryValidatorAccuracyRankingRequest, opts ...grpc.CallOption) (*QueryValidatorAccuracyRankingResponse, error)
+	// ParticipationSummary returns the bonded power and validators, and how much of them voted in the last vote period
+	ParticipationSummary(ctx context.Context, in *QueryParticipationSummaryRequest, opts ...grpc.CallOption) (*QueryParticipationSummaryResponse, error)
+	// RawDenomState returns the raw store entries of a denom, for debugging. The output format is not stable.
+	RawDenomState(ctx context.Context, in *QueryRawDenomStateRequest, opts ...grpc.CallOption) (*QueryRawDenomStateResponse, error)
+	// MedianFlipCost returns the additional voting power needed to move the median of each denom
+	// by a relative amount, estimated from the last ballots.
+	MedianFlipCost(ctx context.Context, in *QueryMedianFlipCostRequest, opts ...grpc.CallOption) (*QueryMedianFlipCostResponse, error)
+	// OracleAlertConfig returns the miss rate a validator declared to accept, along with its
+	// miss rate in the current slash window
+	OracleAlertConfig(ctx context.Context, in *QueryOracleAlertConfigRequest, opts ...grpc.CallOption) (*QueryOracleAlertConfigResponse, error)
+	// ModuleInfo returns the consensus version of the module and its optional features enabled
+	// on the chain
+	ModuleInfo(ctx context.Context, in *QueryModuleInfoRequest, opts ...grpc.CallOption) (*QueryModuleInfoResponse, error)
+	// FreshExchangeRate returns the exchange rate of a denom, failing if it is older than a max age
+	FreshExchangeRate(ctx context.Context, in *QueryFreshExchangeRateRequest, opts ...grpc.CallOption) (*QueryFreshExchangeRateResponse, error)
+	// WhitelistChanges returns the denoms added to or removed from the whitelist in the last vote periods
+	WhitelistChanges(ctx context.Context, in *QueryWhitelistChangesRequest, opts ...grpc.CallOption) (*QueryWhitelistChangesResponse, error)
+	// RejectedTuples returns the exchange rates of the last vote of a validator left out of the tally
+	RejectedTuples(ctx context.Context, in *QueryRejectedTuplesRequest, opts ...grpc.CallOption) (*QueryRejectedTuplesResponse, error)
+	// RevealWindowStatus returns the prevote of a validator and the blocks its vote can be revealed in
+	RevealWindowStatus(ctx context.Context, in *QueryRevealWindowStatusRequest, opts ...grpc.CallOption) (*QueryRevealWindowStatusResponse, error)
+	// BallotHistogram returns the votes of the last ballot of a denom bucketed by exchange rate,
+	// weighted by power
+	BallotHistogram(ctx context.Context, in *QueryBallotHistogramRequest, opts ...grpc.CallOption) (*QueryBallotHistogramResponse, error)
+	// SharedFeeders returns the groups of validators voting through the same feeder account
+	SharedFeeders(ctx context.Context, in *QuerySharedFeedersRequest, opts ...grpc.CallOption) (*QuerySharedFeedersResponse, error)
+	// LastTallyStats returns the structural counts of the ballots of the last tally
+	LastTallyStats(ctx context.Context, in *QueryLastTallyStatsRequest, opts ...grpc.CallOption) (*QueryLastTallyStatsResponse, error)
+	// RecommendedDenoms returns the denoms a feeder must report to avoid being
+	// counted as missing, along with the ones still in their activation grace
+	RecommendedDenoms(ctx context.Context, in *QueryRecommendedDenomsRequest, opts ...grpc.CallOption) (*QueryRecommendedDenomsResponse, error)
+	// DenomStatuses returns the whitelisted denoms grouped by the state of their feed
+	DenomStatuses(ctx context.Context, in *QueryDenomStatusesRequest, opts ...grpc.CallOption) (*QueryDenomStatusesResponse, error)
+	// ParticipationSeries returns the participation ratio of the last vote periods
+	ParticipationSeries(ctx context.Context, in *QueryParticipationSeriesRequest, opts ...grpc.CallOption) (*QueryParticipationSeriesResponse, error)
+	// MaxPeriodMove returns the largest change of the exchange rate of a denom between
+	// consecutive vote periods in the current max move window
+	MaxPeriodMove(ctx context.Context, in *QueryMaxPeriodMoveRequest, opts ...grpc.CallOption) (*QueryMaxPeriodMoveResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error) {
+	out := new(QueryExchangeRateResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/ExchangeRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error) {
+	out := new(QueryExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/ExchangeRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Actives(ctx context.Context, in *QueryActivesRequest, opts ...grpc.CallOption) (*QueryActivesResponse, error) {
+	out := new(QueryActivesResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/Actives", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeederDelegation(ctx context.Context, in *QueryFeederDelegationRequest, opts ...grpc.CallOption) (*QueryFeederDelegationResponse, error) {
+	out := new(QueryFeederDelegationResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/FeederDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MissCounter(ctx context.Context, in *QueryMissCounterRequest, opts ...grpc.CallOption) (*QueryMissCounterResponse, error) {
+	out := new(QueryMissCounterResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/MissCounter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MissCounters(ctx context.Context, in *QueryMissCountersRequest, opts ...grpc.CallOption) (*QueryMissCountersResponse, error) {
+	out := new(QueryMissCountersResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/MissCounters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AggregatePrevote(ctx context.Context, in *QueryAggregatePrevoteRequest, opts ...grpc.CallOption) (*QueryAggregatePrevoteResponse, error) {
+	out := new(QueryAggregatePrevoteResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/AggregatePrevote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AggregatePrevotes(ctx context.Context, in *QueryAggregatePrevotesRequest, opts ...grpc.CallOption) (*QueryAggregatePrevotesResponse, error) {
+	out := new(QueryAggregatePrevotesResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/AggregatePrevotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AggregateVote(ctx context.Context, in *QueryAggregateVoteRequest, opts ...grpc.CallOption) (*QueryAggregateVoteResponse, error) {
+	out := new(QueryAggregateVoteResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/AggregateVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AggregateVotes(ctx context.Context, in *QueryAggregateVotesRequest, opts ...grpc.CallOption) (*QueryAggregateVotesResponse, error) {
+	out := new(QueryAggregateVotesResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/AggregateVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardEstimate(ctx context.Context, in *QueryRewardEstimateRequest, opts ...grpc.CallOption) (*QueryRewardEstimateResponse, error) {
+	out := new(QueryRewardEstimateResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/RewardEstimate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VoteHashSpec(ctx context.Context, in *QueryVoteHashSpecRequest, opts ...grpc.CallOption) (*QueryVoteHashSpecResponse, error) {
+	out := new(QueryVoteHashSpecResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/VoteHashSpec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IsFeederAuthorized(ctx context.Context, in *QueryIsFeederAuthorizedRequest, opts ...grpc.CallOption) (*QueryIsFeederAuthorizedResponse, error) {
+	out := new(QueryIsFeederAuthorizedResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/IsFeederAuthorized", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomBackingPower(ctx context.Context, in *QueryDenomBackingPowerRequest, opts ...grpc.CallOption) (*QueryDenomBackingPowerResponse, error) {
+	out := new(QueryDenomBackingPowerResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/DenomBackingPower", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UpcomingGraceExits(ctx context.Context, in *QueryUpcomingGraceExitsRequest, opts ...grpc.CallOption) (*QueryUpcomingGraceExitsResponse, error) {
+	out := new(QueryUpcomingGraceExitsResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/UpcomingGraceExits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LightClientState(ctx context.Context, in *QueryLightClientStateRequest, opts ...grpc.CallOption) (*QueryLightClientStateResponse, error) {
+	out := new(QueryLightClientStateResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/LightClientState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorRateDeviation(ctx context.Context, in *QueryValidatorRateDeviationRequest, opts ...grpc.CallOption) (*QueryValidatorRateDeviationResponse, error) {
+	out := new(QueryValidatorRateDeviationResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/ValidatorRateDeviation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorMissingDenoms(ctx context.Context, in *QueryValidatorMissingDenomsRequest, opts ...grpc.CallOption) (*QueryValidatorMissingDenomsResponse, error) {
+	out := new(QueryValidatorMissingDenomsResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/ValidatorMissingDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomTallySuccessRate(ctx context.Context, in *QueryDenomTallySuccessRateRequest, opts ...grpc.CallOption) (*QueryDenomTallySuccessRateResponse, error) {
+	out := new(QueryDenomTallySuccessRateResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/DenomTallySuccessRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingReveals(ctx context.Context, in *QueryPendingRevealsRequest, opts ...grpc.CallOption) (*QueryPendingRevealsResponse, error) {
+	out := new(QueryPendingRevealsResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/PendingReveals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RequiredDenoms(ctx context.Context, in *QueryRequiredDenomsRequest, opts ...grpc.CallOption) (*QueryRequiredDenomsResponse, error) {
+	out := new(QueryRequiredDenomsResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/RequiredDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomSchedule(ctx context.Context, in *QueryDenomScheduleRequest, opts ...grpc.CallOption) (*QueryDenomScheduleResponse, error) {
+	out := new(QueryDenomScheduleResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/DenomSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BandMembershipStats(ctx context.Context, in *QueryBandMembershipStatsRequest, opts ...grpc.CallOption) (*QueryBandMembershipStatsResponse, error) {
+	out := new(QueryBandMembershipStatsResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/BandMembershipStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Observers(ctx context.Context, in *QueryObserversRequest, opts ...grpc.CallOption) (*QueryObserversResponse, error) {
+	out := new(QueryObserversResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/Observers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomTallyDiagnosis(ctx context.Context, in *QueryDenomTallyDiagnosisRequest, opts ...grpc.CallOption) (*QueryDenomTallyDiagnosisResponse, error) {
+	out := new(QueryDenomTallyDiagnosisResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/DenomTallyDiagnosis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorAccuracyRanking(ctx context.Context, in *QueryValidatorAccuracyRankingRequest, opts ...grpc.CallOption) (*QueryValidatorAccuracyRankingResponse, error) {
+	out := new(QueryValidatorAccuracyRankingResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/ValidatorAccuracyRanking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParticipationSummary(ctx context.Context, in *QueryParticipationSummaryRequest, opts ...grpc.CallOption) (*QueryParticipationSummaryResponse, error) {
+	out := new(QueryParticipationSummaryResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/ParticipationSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RawDenomState(ctx context.Context, in *QueryRawDenomStateRequest, opts ...grpc.CallOption) (*QueryRawDenomStateResponse, error) {
+	out := new(QueryRawDenomStateResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/RawDenomState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MedianFlipCost(ctx context.Context, in *QueryMedianFlipCostRequest, opts ...grpc.CallOption) (*QueryMedianFlipCostResponse, error) {
+	out := new(QueryMedianFlipCostResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/MedianFlipCost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OracleAlertConfig(ctx context.Context, in *QueryOracleAlertConfigRequest, opts ...grpc.CallOption) (*QueryOracleAlertConfigResponse, error) {
+	out := new(QueryOracleAlertConfigResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/OracleAlertConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModuleInfo(ctx context.Context, in *QueryModuleInfoRequest, opts ...grpc.CallOption) (*QueryModuleInfoResponse, error) {
+	out := new(QueryModuleInfoResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/ModuleInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FreshExchangeRate(ctx context.Context, in *QueryFreshExchangeRateRequest, opts ...grpc.CallOption) (*QueryFreshExchangeRateResponse, error) {
+	out := new(QueryFreshExchangeRateResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/FreshExchangeRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) WhitelistChanges(ctx context.Context, in *QueryWhitelistChangesRequest, opts ...grpc.CallOption) (*QueryWhitelistChangesResponse, error) {
+	out := new(QueryWhitelistChangesResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/WhitelistChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RejectedTuples(ctx context.Context, in *QueryRejectedTuplesRequest, opts ...grpc.CallOption) (*QueryRejectedTuplesResponse, error) {
+	out := new(QueryRejectedTuplesResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/RejectedTuples", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RevealWindowStatus(ctx context.Context, in *QueryRevealWindowStatusRequest, opts ...grpc.CallOption) (*QueryRevealWindowStatusResponse, error) {
+	out := new(QueryRevealWindowStatusResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/RevealWindowStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BallotHistogram(ctx context.Context, in *QueryBallotHistogramRequest, opts ...grpc.CallOption) (*QueryBallotHistogramResponse, error) {
+	out := new(QueryBallotHistogramResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/BallotHistogram", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SharedFeeders(ctx context.Context, in *QuerySharedFeedersRequest, opts ...grpc.CallOption) (*QuerySharedFeedersResponse, error) {
+	out := new(QuerySharedFeedersResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/SharedFeeders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LastTallyStats(ctx context.Context, in *QueryLastTallyStatsRequest, opts ...grpc.CallOption) (*QueryLastTallyStatsResponse, error) {
+	out := new(QueryLastTallyStatsResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/LastTallyStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecommendedDenoms(ctx context.Context, in *QueryRecommendedDenomsRequest, opts ...grpc.CallOption) (*QueryRecommendedDenomsResponse, error) {
+	out := new(QueryRecommendedDenomsResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/RecommendedDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomStatuses(ctx context.Context, in *QueryDenomStatusesRequest, opts ...grpc.CallOption) (*QueryDenomStatusesResponse, error) {
+	out := new(QueryDenomStatusesResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/DenomStatuses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ParticipationSeries(ctx context.Context, in *QueryParticipationSeriesRequest, opts ...grpc.CallOption) (*QueryParticipationSeriesResponse, error) {
+	out := new(QueryParticipationSeriesResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/ParticipationSeries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MaxPeriodMove(ctx context.Context, in *QueryMaxPeriodMoveRequest, opts ...grpc.CallOption) (*QueryMaxPeriodMoveResponse, error) {
+	out := new(QueryMaxPeriodMoveResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Query/MaxPeriodMove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ExchangeRate returns exchange rate of a denom
+	ExchangeRate(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
+	// ExchangeRates returns exchange rates of all denoms
+	ExchangeRates(context.Context, *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error)
+	// Actives returns all active denoms
+	Actives(context.Context, *QueryActivesRequest) (*QueryActivesResponse, error)
+	// FeederDelegation returns feeder delegation of a validator
+	FeederDelegation(context.Context, *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error)
+	// MissCounter returns oracle miss counter of a validator
+	MissCounter(context.Context, *QueryMissCounterRequest) (*QueryMissCounterResponse, error)
+	// MissCounters returns the miss counters of all validators with missed votes in the current slash window
+	MissCounters(context.Context, *QueryMissCountersRequest) (*QueryMissCountersResponse, error)
+	// AggregatePrevote returns an aggregate prevote of a validator
+	AggregatePrevote(context.Context, *QueryAggregatePrevoteRequest) (*QueryAggregatePrevoteResponse, error)
+	// AggregatePrevotes returns aggregate prevotes of all validators
+	AggregatePrevotes(context.Context, *QueryAggregatePrevotesRequest) (*QueryAggregatePrevotesResponse, error)
+	// AggregateVote returns an aggregate vote of a validator
+	AggregateVote(context.Context, *QueryAggregateVoteRequest) (*QueryAggregateVoteResponse, error)
+	// AggregateVotes returns aggregate votes of all validators
+	AggregateVotes(context.Context, *QueryAggregateVotesRequest) (*QueryAggregateVotesResponse, error)
+	// Params queries all parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// RewardEstimate returns the projected reward paid to the ballot winners at the end of the next vote
+	// period per unit of voting power
+	RewardEstimate(context.Context, *QueryRewardEstimateRequest) (*QueryRewardEstimateResponse, error)
+	// VoteHashSpec returns the format of the aggregate vote hash preimage
+	VoteHashSpec(context.Context, *QueryVoteHashSpecRequest) (*QueryVoteHashSpecResponse, error)
+	// IsFeederAuthorized returns whether an account may submit votes on behalf of a validator
+	IsFeederAuthorized(context.Context, *QueryIsFeederAuthorizedRequest) (*QueryIsFeederAuthorizedResponse, error)
+	// DenomBackingPower returns the voting power of the votes submitted for each denom in the current vote period
+	DenomBackingPower(context.Context, *QueryDenomBackingPowerRequest) (*QueryDenomBackingPowerResponse, error)
+	// UpcomingGraceExits returns the denoms in their grace window and the vote period each becomes miss-eligible
+	UpcomingGraceExits(context.Context, *QueryUpcomingGraceExitsRequest) (*QueryUpcomingGraceExitsResponse, error)
+	// LightClientState returns the params, exchange rates and current vote period read from a single height
+	LightClientState(context.Context, *QueryLightClientStateRequest) (*QueryLightClientStateResponse, error)
+	// ValidatorRateDeviation returns how far the rates a validator submitted in the last vote period were from the tallied rates
+	ValidatorRateDeviation(context.Context, *QueryValidatorRateDeviationRequest) (*QueryValidatorRateDeviationResponse, error)
+	// ValidatorMissingDenoms returns the active denoms a validator has not voted on in the current vote period
+	ValidatorMissingDenoms(context.Context, *QueryValidatorMissingDenomsRequest) (*QueryValidatorMissingDenomsResponse, error)
+	// DenomTallySuccessRate returns the number of vote periods each denom tallied and failed to tally in the current slash window
+	DenomTallySuccessRate(context.Context, *QueryDenomTallySuccessRateRequest) (*QueryDenomTallySuccessRateResponse, error)
+	// PendingReveals returns the prevotes which can be revealed in the current vote period and have no vote yet
+	PendingReveals(context.Context, *QueryPendingRevealsRequest) (*QueryPendingRevealsResponse, error)
+	// RequiredDenoms returns the denoms other modules registered as required, with the modules requiring them
+	RequiredDenoms(context.Context, *QueryRequiredDenomsRequest) (*QueryRequiredDenomsResponse, error)
+	// DenomSchedule returns the vote period each whitelisted denom is next tallied with votes required
+	DenomSchedule(context.Context, *QueryDenomScheduleRequest) (*QueryDenomScheduleResponse, error)
+	// BandMembershipStats returns the share of the rating power of the last vote period within the reward band of each denom
+	BandMembershipStats(context.Context, *QueryBandMembershipStatsRequest) (*QueryBandMembershipStatsResponse, error)
+	// Observers returns the validators exempt from slashing as observers, with their exemption expiry
+	Observers(context.Context, *QueryObserversRequest) (*QueryObserversResponse, error)
+	// DenomTallyDiagnosis returns the outcome of the last vote period of a denom and why it did not tally
+	DenomTallyDiagnosis(context.Context, *QueryDenomTallyDiagnosisRequest) (*QueryDenomTallyDiagnosisResponse, error)
+	// ValidatorAccuracyRanking returns the validators ranked by the share of their submissions within the reward band
+	ValidatorAccuracyRanking(context.Context, *QueryValidatorAccuracyRankingRequest) (*QueryValidatorAccuracyRankingResponse, error)
+	// ParticipationSummary returns the bonded power and validators, and how much of them voted in the last vote period
+	ParticipationSummary(context.Context, *QueryParticipationSummaryRequest) (*QueryParticipationSummaryResponse, error)
+	// RawDenomState returns the raw store entries of a denom, for debugging. The output format is not stable.
+	RawDenomState(context.Context, *QueryRawDenomStateRequest) (*QueryRawDenomStateResponse, error)
+	// MedianFlipCost returns the additional voting power needed to move the median of each denom
+	// by a relative amount, estimated from the last ballots.
+	MedianFlipCost(context.Context, *QueryMedianFlipCostRequest) (*QueryMedianFlipCostResponse, error)
+	// OracleAlertConfig returns the miss rate a validator declared to accept, along with its
+	// miss rate in the current slash window
+	OracleAlertConfig(context.Context, *QueryOracleAlertConfigRequest) (*QueryOracleAlertConfigResponse, error)
+	// ModuleInfo returns the consensus version of the module and its optional features enabled
+	// on the chain
+	ModuleInfo(context.Context, *QueryModuleInfoRequest) (*QueryModuleInfoResponse, error)
+	// FreshExchangeRate returns the exchange rate of a denom, failing if it is older than a max age
+	FreshExchangeRate(context.Context, *QueryFreshExchangeRateRequest) (*QueryFreshExchangeRateResponse, error)
+	// WhitelistChanges returns the denoms added to or removed from the whitelist in the last vote periods
+	WhitelistChanges(context.Context, *QueryWhitelistChangesRequest) (*QueryWhitelistChangesResponse, error)
+	// RejectedTuples returns the exchange rates of the last vote of a validator left out of the tally
+	RejectedTuples(context.Context, *QueryRejectedTuplesRequest) (*QueryRejectedTuplesResponse, error)
+	// RevealWindowStatus returns the prevote of a validator and the blocks its vote can be revealed in
+	RevealWindowStatus(context.Context, *QueryRevealWindowStatusRequest) (*QueryRevealWindowStatusResponse, error)
+	// BallotHistogram returns the votes of the last ballot of a denom bucketed by exchange rate,
+	// weighted by power
+	BallotHistogram(context.Context, *QueryBallotHistogramRequest) (*QueryBallotHistogramResponse, error)
+	// SharedFeeders returns the groups of validators voting through the same feeder account
+	SharedFeeders(context.Context, *QuerySharedFeedersRequest) (*QuerySharedFeedersResponse, error)
+	// LastTallyStats returns the structural counts of the ballots of the last tally
+	LastTallyStats(context.Context, *QueryLastTallyStatsRequest) (*QueryLastTallyStatsResponse, error)
+	// RecommendedDenoms returns the denoms a feeder must report to avoid being
+	// counted as missing, along with the ones still in their activation grace
+	RecommendedDenoms(context.Context, *QueryRecommendedDenomsRequest) (*QueryRecommendedDenomsResponse, error)
+	// DenomStatuses returns the whitelisted denoms grouped by the state of their feed
+	DenomStatuses(context.Context, *QueryDenomStatusesRequest) (*QueryDenomStatusesResponse, error)
+	// ParticipationSeries returns the participation ratio of the last vote periods
+	ParticipationSeries(context.Context, *QueryParticipationSeriesRequest) (*QueryParticipationSeriesResponse, error)
+	// MaxPeriodMove returns the largest change of the exchange rate of a denom between
+	// consecutive vote periods in the current max move window
+	MaxPeriodMove(context.Context, *QueryMaxPeriodMoveRequest) (*QueryMaxPeriodMoveResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ExchangeRate(ctx context.Context, req *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRate not implemented")
+}
+func (*UnimplementedQueryServer) ExchangeRates(ctx context.Context, req *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRates not implemented")
+}
+func (*UnimplementedQueryServer) Actives(ctx context.Context, req *QueryActivesRequest) (*QueryActivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Actives not implemented")
+}
+func (*UnimplementedQueryServer) FeederDelegation(ctx context.Context, req *QueryFeederDelegationRequest) (*QueryFeederDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeederDelegation not implemented")
+}
+func (*UnimplementedQueryServer) MissCounter(ctx context.Context, req *QueryMissCounterRequest) (*QueryMissCounterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MissCounter not implemented")
+}
+func (*UnimplementedQueryServer) MissCounters(ctx context.Context, req *QueryMissCountersRequest) (*QueryMissCountersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MissCounters not implemented")
+}
+func (*UnimplementedQueryServer) AggregatePrevote(ctx context.Context, req *QueryAggregatePrevoteRequest) (*QueryAggregatePrevoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevote not implemented")
+}
+func (*UnimplementedQueryServer) AggregatePrevotes(ctx context.Context, req *QueryAggregatePrevotesRequest) (*QueryAggregatePrevotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregatePrevotes not implemented")
+}
+func (*UnimplementedQueryServer) AggregateVote(ctx context.Context, req *QueryAggregateVoteRequest) (*QueryAggregateVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateVote not implemented")
+}
+func (*UnimplementedQueryServer) AggregateVotes(ctx context.Context, req *QueryAggregateVotesRequest) (*QueryAggregateVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateVotes not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) RewardEstimate(ctx context.Context, req *QueryRewardEstimateRequest) (*QueryRewardEstimateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardEstimate not implemented")
+}
+func (*UnimplementedQueryServer) VoteHashSpec(ctx context.Context, req *QueryVoteHashSpecRequest) (*QueryVoteHashSpecResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteHashSpec not implemented")
+}
+func (*UnimplementedQueryServer) IsFeederAuthorized(ctx context.Context, req *QueryIsFeederAuthorizedRequest) (*QueryIsFeederAuthorizedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsFeederAuthorized not implemented")
+}
+func (*UnimplementedQueryServer) DenomBackingPower(ctx context.Context, req *QueryDenomBackingPowerRequest) (*QueryDenomBackingPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomBackingPower not implemented")
+}
+func (*UnimplementedQueryServer) UpcomingGraceExits(ctx context.Context, req *QueryUpcomingGraceExitsRequest) (*QueryUpcomingGraceExitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpcomingGraceExits not implemented")
+}
+func (*UnimplementedQueryServer) LightClientState(ctx context.Context, req *QueryLightClientStateRequest) (*QueryLightClientStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LightClientState not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorRateDeviation(ctx context.Context, req *QueryValidatorRateDeviationRequest) (*QueryValidatorRateDeviationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorRateDeviation not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorMissingDenoms(ctx context.Context, req *QueryValidatorMissingDenomsRequest) (*QueryValidatorMissingDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorMissingDenoms not implemented")
+}
+func (*UnimplementedQueryServer) DenomTallySuccessRate(ctx context.Context, req *QueryDenomTallySuccessRateRequest) (*QueryDenomTallySuccessRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomTallySuccessRate not implemented")
+}
+func (*UnimplementedQueryServer) PendingReveals(ctx context.Context, req *QueryPendingRevealsRequest) (*QueryPendingRevealsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingReveals not implemented")
+}
+func (*UnimplementedQueryServer) RequiredDenoms(ctx context.Context, req *QueryRequiredDenomsRequest) (*QueryRequiredDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequiredDenoms not implemented")
+}
+func (*UnimplementedQueryServer) DenomSchedule(ctx context.Context, req *QueryDenomScheduleRequest) (*QueryDenomScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomSchedule not implemented")
+}
+func (*UnimplementedQueryServer) BandMembershipStats(ctx context.Context, req *QueryBandMembershipStatsRequest) (*QueryBandMembershipStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BandMembershipStats not implemented")
+}
+func (*UnimplementedQueryServer) Observers(ctx context.Context, req *QueryObserversRequest) (*QueryObserversResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Observers not implemented")
+}
+func (*UnimplementedQueryServer) DenomTallyDiagnosis(ctx context.Context, req *QueryDenomTallyDiagnosisRequest) (*QueryDenomTallyDiagnosisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomTallyDiagnosis not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorAccuracyRanking(ctx context.Context, req *QueryValidatorAccuracyRankingRequest) (*QueryValidatorAccuracyRankingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorAccuracyRanking not implemented")
+}
+func (*UnimplementedQueryServer) ParticipationSummary(ctx context.Context, req *QueryParticipationSummaryRequest) (*QueryParticipationSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParticipationSummary not implemented")
+}
+func (*UnimplementedQueryServer) RawDenomState(ctx context.Context, req *QueryRawDenomStateRequest) (*QueryRawDenomStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RawDenomState not implemented")
+}
+func (*UnimplementedQueryServer) MedianFlipCost(ctx context.Context, req *QueryMedianFlipCostRequest) (*QueryMedianFlipCostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MedianFlipCost not implemented")
+}
+func (*UnimplementedQueryServer) OracleAlertConfig(ctx context.Context, req *QueryOracleAlertConfigRequest) (*QueryOracleAlertConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleAlertConfig not implemented")
+}
+func (*UnimplementedQueryServer) ModuleInfo(ctx context.Context, req *QueryModuleInfoRequest) (*QueryModuleInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleInfo not implemented")
+}
+func (*UnimplementedQueryServer) FreshExchangeRate(ctx context.Context, req *QueryFreshExchangeRateRequest) (*QueryFreshExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreshExchangeRate not implemented")
+}
+func (*UnimplementedQueryServer) WhitelistChanges(ctx context.Context, req *QueryWhitelistChangesRequest) (*QueryWhitelistChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhitelistChanges not implemented")
+}
+func (*UnimplementedQueryServer) RejectedTuples(ctx context.Context, req *QueryRejectedTuplesRequest) (*QueryRejectedTuplesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectedTuples not implemented")
+}
+func (*UnimplementedQueryServer) RevealWindowStatus(ctx context.Context, req *QueryRevealWindowStatusRequest) (*QueryRevealWindowStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealWindowStatus not implemented")
+}
+func (*UnimplementedQueryServer) BallotHistogram(ctx context.Context, req *QueryBallotHistogramRequest) (*QueryBallotHistogramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BallotHistogram not implemented")
+}
+func (*UnimplementedQueryServer) SharedFeeders(ctx context.Context, req *QuerySharedFeedersRequest) (*QuerySharedFeedersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SharedFeeders not implemented")
+}
+func (*UnimplementedQueryServer) LastTallyStats(ctx context.Context, req *QueryLastTallyStatsRequest) (*QueryLastTallyStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastTallyStats not implemented")
+}
+func (*UnimplementedQueryServer) RecommendedDenoms(ctx context.Context, req *QueryRecommendedDenomsRequest) (*QueryRecommendedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecommendedDenoms not implemented")
+}
+func (*UnimplementedQueryServer) DenomStatuses(ctx context.Context, req *QueryDenomStatusesRequest) (*QueryDenomStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomStatuses not implemented")
+}
+func (*UnimplementedQueryServer) ParticipationSeries(ctx context.Context, req *QueryParticipationSeriesRequest) (*QueryParticipationSeriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParticipationSeries not implemented")
+}
+func (*UnimplementedQueryServer) MaxPeriodMove(ctx context.Context, req *QueryMaxPeriodMoveRequest) (*QueryMaxPeriodMoveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaxPeriodMove not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/ExchangeRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRate(ctx, req.(*QueryExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/ExchangeRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRates(ctx, req.(*QueryExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Actives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActivesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Actives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/Actives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Actives(ctx, req.(*QueryActivesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeederDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeederDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeederDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/FeederDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeederDelegation(ctx, req.(*QueryFeederDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MissCounter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMissCounterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MissCounter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/MissCounter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MissCounter(ctx, req.(*QueryMissCounterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MissCounters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMissCountersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MissCounters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/MissCounters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MissCounters(ctx, req.(*QueryMissCountersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AggregatePrevote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregatePrevoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregatePrevote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/AggregatePrevote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregatePrevote(ctx, req.(*QueryAggregatePrevoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AggregatePrevotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregatePrevotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregatePrevotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/AggregatePrevotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregatePrevotes(ctx, req.(*QueryAggregatePrevotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AggregateVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregateVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregateVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/AggregateVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregateVote(ctx, req.(*QueryAggregateVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AggregateVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregateVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregateVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/AggregateVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregateVotes(ctx, req.(*QueryAggregateVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardEstimate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardEstimateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardEstimate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/RewardEstimate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardEstimate(ctx, req.(*QueryRewardEstimateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VoteHashSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVoteHashSpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VoteHashSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/VoteHashSpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VoteHashSpec(ctx, req.(*QueryVoteHashSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IsFeederAuthorized_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsFeederAuthorizedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsFeederAuthorized(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/IsFeederAuthorized",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsFeederAuthorized(ctx, req.(*QueryIsFeederAuthorizedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomBackingPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomBackingPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomBackingPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/DenomBackingPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomBackingPower(ctx, req.(*QueryDenomBackingPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UpcomingGraceExits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUpcomingGraceExitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UpcomingGraceExits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/UpcomingGraceExits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UpcomingGraceExits(ctx, req.(*QueryUpcomingGraceExitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LightClientState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLightClientStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LightClientState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/LightClientState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LightClientState(ctx, req.(*QueryLightClientStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorRateDeviation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorRateDeviationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorRateDeviation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/ValidatorRateDeviation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorRateDeviation(ctx, req.(*QueryValidatorRateDeviationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorMissingDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorMissingDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorMissingDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/ValidatorMissingDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorMissingDenoms(ctx, req.(*QueryValidatorMissingDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomTallySuccessRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomTallySuccessRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomTallySuccessRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/DenomTallySuccessRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomTallySuccessRate(ctx, req.(*QueryDenomTallySuccessRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingReveals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRevealsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingReveals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/PendingReveals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingReveals(ctx, req.(*QueryPendingRevealsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RequiredDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequiredDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RequiredDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/RequiredDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RequiredDenoms(ctx, req.(*QueryRequiredDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/DenomSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomSchedule(ctx, req.(*QueryDenomScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BandMembershipStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBandMembershipStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BandMembershipStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/BandMembershipStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BandMembershipStats(ctx, req.(*QueryBandMembershipStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Observers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryObserversRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Observers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/Observers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Observers(ctx, req.(*QueryObserversRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomTallyDiagnosis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomTallyDiagnosisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomTallyDiagnosis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/DenomTallyDiagnosis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomTallyDiagnosis(ctx, req.(*QueryDenomTallyDiagnosisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorAccuracyRanking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorAccuracyRankingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorAccuracyRanking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/ValidatorAccuracyRanking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorAccuracyRanking(ctx, req.(*QueryValidatorAccuracyRankingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParticipationSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParticipationSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParticipationSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/ParticipationSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParticipationSummary(ctx, req.(*QueryParticipationSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RawDenomState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRawDenomStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RawDenomState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/RawDenomState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RawDenomState(ctx, req.(*QueryRawDenomStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MedianFlipCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMedianFlipCostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MedianFlipCost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/MedianFlipCost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MedianFlipCost(ctx, req.(*QueryMedianFlipCostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OracleAlertConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleAlertConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OracleAlertConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/OracleAlertConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleAlertConfig(ctx, req.(*QueryOracleAlertConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModuleInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/ModuleInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleInfo(ctx, req.(*QueryModuleInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FreshExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFreshExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FreshExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/FreshExchangeRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FreshExchangeRate(ctx, req.(*QueryFreshExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WhitelistChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWhitelistChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WhitelistChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/WhitelistChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WhitelistChanges(ctx, req.(*QueryWhitelistChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RejectedTuples_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRejectedTuplesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RejectedTuples(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/RejectedTuples",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RejectedTuples(ctx, req.(*QueryRejectedTuplesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RevealWindowStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRevealWindowStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RevealWindowStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/RevealWindowStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RevealWindowStatus(ctx, req.(*QueryRevealWindowStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BallotHistogram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBallotHistogramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BallotHistogram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/BallotHistogram",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BallotHistogram(ctx, req.(*QueryBallotHistogramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SharedFeeders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySharedFeedersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SharedFeeders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/SharedFeeders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SharedFeeders(ctx, req.(*QuerySharedFeedersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LastTallyStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastTallyStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LastTallyStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/LastTallyStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastTallyStats(ctx, req.(*QueryLastTallyStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecommendedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecommendedDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecommendedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/RecommendedDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecommendedDenoms(ctx, req.(*QueryRecommendedDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/DenomStatuses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomStatuses(ctx, req.(*QueryDenomStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ParticipationSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParticipationSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ParticipationSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/ParticipationSeries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParticipationSeries(ctx, req.(*QueryParticipationSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MaxPeriodMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMaxPeriodMoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MaxPeriodMove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Query/MaxPeriodMove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MaxPeriodMove(ctx, req.(*QueryMaxPeriodMoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kujira.oracle.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExchangeRate",
+			Handler:    _Query_ExchangeRate_Handler,
+		},
+		{
+			MethodName: "ExchangeRates",
+			Handler:    _Query_ExchangeRates_Handler,
+		},
+		{
+			MethodName: "Actives",
+			Handler:    _Query_Actives_Handler,
+		},
+		{
+			MethodName: "FeederDelegation",
+			Handler:    _Query_FeederDelegation_Handler,
+		},
+		{
+			MethodName: "MissCounter",
+			Handler:    _Query_MissCounter_Handler,
+		},
+		{
+			MethodName: "MissCounters",
+			Handler:    _Query_MissCounters_Handler,
+		},
+		{
+			MethodName: "AggregatePrevote",
+			Handler:    _Query_AggregatePrevote_Handler,
+		},
+		{
+			MethodName: "AggregatePrevotes",
+			Handler:    _Query_AggregatePrevotes_Handler,
+		},
+		{
+			MethodName: "AggregateVote",
+			Handler:    _Query_AggregateVote_Handler,
+		},
+		{
+			MethodName: "AggregateVotes",
+			Handler:    _Query_AggregateVotes_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "RewardEstimate",
+			Handler:    _Query_RewardEstimate_Handler,
+		},
+		{
+			MethodName: "VoteHashSpec",
+			Handler:    _Query_VoteHashSpec_Handler,
+		},
+		{
+			MethodName: "IsFeederAuthorized",
+			Handler:    _Query_IsFeederAuthorized_Handler,
+		},
+		{
+			MethodName: "DenomBackingPower",
+			Handler:    _Query_DenomBackingPower_Handler,
+		},
+		{
+			MethodName: "UpcomingGraceExits",
+			Handler:    _Query_UpcomingGraceExits_Handler,
+		},
+		{
+			MethodName: "LightClientState",
+			Handler:    _Query_LightClientState_Handler,
+		},
+		{
+			MethodName: "ValidatorRateDeviation",
+			Handler:    _Query_ValidatorRateDeviation_Handler,
+		},
+		{
+			MethodName: "ValidatorMissingDenoms",
+			Handler:    _Query_ValidatorMissingDenoms_Handler,
+		},
+		{
+			MethodName: "DenomTallySuccessRate",
+			Handler:    _Query_DenomTallySuccessRate_Handler,
+		},
+		{
+			MethodName: "PendingReveals",
+			Handler:    _Query_PendingReveals_Handler,
+		},
+		{
+			MethodName: "RequiredDenoms",
+			Handler:    _Query_RequiredDenoms_Handler,
+		},
+		{
+			MethodName: "DenomSchedule",
+			Handler:    _Query_DenomSchedule_Handler,
+		},
+		{
+			MethodName: "BandMembershipStats",
+			Handler:    _Query_BandMembershipStats_Handler,
+		},
+		{
+			MethodName: "Observers",
+			Handler:    _Query_Observers_Handler,
+		},
+		{
+			MethodName: "DenomTallyDiagnosis",
+			Handler:    _Query_DenomTallyDiagnosis_Handler,
+		},
+		{
+			MethodName: "ValidatorAccuracyRanking",
+			Handler:    _Query_ValidatorAccuracyRanking_Handler,
+		},
+		{
+			MethodName: "ParticipationSummary",
+			Handler:    _Query_ParticipationSummary_Handler,
+		},
+		{
+			MethodName: "RawDenomState",
+			Handler:    _Query_RawDenomState_Handler,
+		},
+		{
+			MethodName: "MedianFlipCost",
+			Handler:    _Query_MedianFlipCost_Handler,
+		},
+		{
+			MethodName: "OracleAlertConfig",
+			Handler:    _Query_OracleAlertConfig_Handler,
+		},
+		{
+			MethodName: "ModuleInfo",
+			Handler:    _Query_ModuleInfo_Handler,
+		},
+		{
+			MethodName: "FreshExchangeRate",
+			Handler:    _Query_FreshExchangeRate_Handler,
+		},
+		{
+			MethodName: "WhitelistChanges",
+			Handler:    _Query_WhitelistChanges_Handler,
+		},
+		{
+			MethodName: "RejectedTuples",
+			Handler:    _Query_RejectedTuples_Handler,
+		},
+		{
+			MethodName: "RevealWindowStatus",
+			Handler:    _Query_RevealWindowStatus_Handler,
+		},
+		{
+			MethodName: "BallotHistogram",
+			Handler:    _Query_BallotHistogram_Handler,
+		},
+		{
+			MethodName: "SharedFeeders",
+			Handler:    _Query_SharedFeeders_Handler,
+		},
+		{
+			MethodName: "LastTallyStats",
+			Handler:    _Query_LastTallyStats_Handler,
+		},
+		{
+			MethodName: "RecommendedDenoms",
+			Handler:    _Query_RecommendedDenoms_Handler,
+		},
+		{
+			MethodName: "DenomStatuses",
+			Handler:    _Query_DenomStatuses_Handler,
+		},
+		{
+			MethodName: "ParticipationSeries",
+			Handler:    _Query_ParticipationSeries_Handler,
+		},
+		{
+			MethodName: "MaxPeriodMove",
+			Handler:    _Query_MaxPeriodMove_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kujira/oracle/query.proto",
+}
+
+func (m *QueryExchangeRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tracks) > 0 {
+		i -= len(m.Tracks)
+		copy(dAtA[i:], m.Tracks)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tracks)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.VoterCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VoterCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.UsdExchangeRate.Size()
+		i -= size
+		if _, err := m.UsdExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.AgePeriods != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AgePeriods))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CarriedPeriods != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CarriedPeriods))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CarriedForward {
+		i--
+		if m.CarriedForward {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AgePeriods) > 0 {
+		for iNdEx := len(m.AgePeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AgePeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ExchangeRates) > 0 {
+		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExchangeRateAge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeRateAge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeRateAge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AgePeriods != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AgePeriods))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActivesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActivesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActivesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActivesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actives) > 0 {
+		for iNdEx := len(m.Actives) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Actives[iNdEx])
+			copy(dAtA[i:], m.Actives[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Actives[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteTargetsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteTargetsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteTargetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteTargetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteTargetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteTargetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VoteTargets) > 0 {
+		for iNdEx := len(m.VoteTargets) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.VoteTargets[iNdEx])
+			copy(dAtA[i:], m.VoteTargets[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.VoteTargets[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeederDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeederDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeederDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeederDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeederDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeederDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeederAddr) > 0 {
+		i -= len(m.FeederAddr)
+		copy(dAtA[i:], m.FeederAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeederAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMissCounterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMissCounterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMissCounterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMissCounterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMissCounterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMissCounterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RevealMissCounter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RevealMissCounter))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MissCounter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissCounter))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMissCountersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMissCountersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMissCountersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMissCountersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMissCountersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMissCountersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MissCounters) > 0 {
+		for iNdEx := len(m.MissCounters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissCounters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MissCounterStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MissCounterStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MissCounterStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RevealMissCounter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RevealMissCounter))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.AtRisk {
+		i--
+		if m.AtRisk {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MissCounter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissCounter))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregatePrevoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregatePrevoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatePrevoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregatePrevoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregatePrevoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatePrevoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AggregatePrevote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregatePrevotesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregatePrevotesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatePrevotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregatePrevotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregatePrevotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregatePrevotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AggregatePrevotes) > 0 {
+		for iNdEx := len(m.AggregatePrevotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregatePrevotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregateVoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregateVoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateVoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregateVoteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregateVoteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateVoteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AggregateVote.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregateVotesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregateVotesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregateVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregateVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AggregateVotes) > 0 {
+		for iNdEx := len(m.AggregateVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AggregateVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardEstimateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardEstimateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardEstimateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardEstimateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardEstimateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardEstimateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorReward) > 0 {
+		for iNdEx := len(m.ValidatorReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.WinningPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WinningPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RewardPerPower) > 0 {
+		for iNdEx := len(m.RewardPerPower) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPerPower[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteHashSpecRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteHashSpecRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteHashSpecRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteHashSpecResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteHashSpecResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteHashSpecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExchangeRateDecimals != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExchangeRateDecimals))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.ExchangeRateOrder) > 0 {
+		i -= len(m.ExchangeRateOrder)
+		copy(dAtA[i:], m.ExchangeRateOrder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExchangeRateOrder)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.CommitmentHashAlgo) > 0 {
+		i -= len(m.CommitmentHashAlgo)
+		copy(dAtA[i:], m.CommitmentHashAlgo)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CommitmentHashAlgo)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.HashEncoding) > 0 {
+		i -= len(m.HashEncoding)
+		copy(dAtA[i:], m.HashEncoding)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.HashEncoding)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.HashLength != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HashLength))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.HashAlgorithm) > 0 {
+		i -= len(m.HashAlgorithm)
+		copy(dAtA[i:], m.HashAlgorithm)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.HashAlgorithm)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.VoterEncoding) > 0 {
+		i -= len(m.VoterEncoding)
+		copy(dAtA[i:], m.VoterEncoding)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VoterEncoding)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.SaltEncoding) > 0 {
+		i -= len(m.SaltEncoding)
+		copy(dAtA[i:], m.SaltEncoding)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SaltEncoding)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SaltLength != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SaltLength))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ExchangeRateSeparator) > 0 {
+		i -= len(m.ExchangeRateSeparator)
+		copy(dAtA[i:], m.ExchangeRateSeparator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExchangeRateSeparator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ExchangeRateFormat) > 0 {
+		i -= len(m.ExchangeRateFormat)
+		copy(dAtA[i:], m.ExchangeRateFormat)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExchangeRateFormat)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FieldSeparator) > 0 {
+		i -= len(m.FieldSeparator)
+		copy(dAtA[i:], m.FieldSeparator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FieldSeparator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Fields) > 0 {
+		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Fields[iNdEx])
+			copy(dAtA[i:], m.Fields[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Fields[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PreimageFormat) > 0 {
+		i -= len(m.PreimageFormat)
+		copy(dAtA[i:], m.PreimageFormat)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PreimageFormat)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsFeederAuthorizedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsFeederAuthorizedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsFeederAuthorizedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeederAddr) > 0 {
+		i -= len(m.FeederAddr)
+		copy(dAtA[i:], m.FeederAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeederAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsFeederAuthorizedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsFeederAuthorizedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsFeederAuthorizedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AuthorizedFeeder) > 0 {
+		i -= len(m.AuthorizedFeeder)
+		copy(dAtA[i:], m.AuthorizedFeeder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AuthorizedFeeder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Authorized {
+		i--
+		if m.Authorized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomBackingPowerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomBackingPowerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomBackingPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomBackingPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomBackingPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomBackingPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BackingPowers) > 0 {
+		for iNdEx := len(m.BackingPowers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BackingPowers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomBackingPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomBackingPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomBackingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpcomingGraceExitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpcomingGraceExitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpcomingGraceExitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUpcomingGraceExitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUpcomingGraceExitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUpcomingGraceExitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GraceExits) > 0 {
+		for iNdEx := len(m.GraceExits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GraceExits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomGraceExit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomGraceExit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomGraceExit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExitHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExitHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExitPeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExitPeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLightClientStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLightClientStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLightClientStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLightClientStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLightClientStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLightClientStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VotePeriodClockKey) > 0 {
+		i -= len(m.VotePeriodClockKey)
+		copy(dAtA[i:], m.VotePeriodClockKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VotePeriodClockKey)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ExchangeRateKeys) > 0 {
+		for iNdEx := len(m.ExchangeRateKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExchangeRateKeys[iNdEx])
+			copy(dAtA[i:], m.ExchangeRateKeys[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ExchangeRateKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.StoreName) > 0 {
+		i -= len(m.StoreName)
+		copy(dAtA[i:], m.StoreName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StoreName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.VotePeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotePeriod))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ExchangeRates) > 0 {
+		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorRateDeviationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorRateDeviationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorRateDeviationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorRateDeviationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorRateDeviationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorRateDeviationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deviations) > 0 {
+		for iNdEx := len(m.Deviations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deviations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateDeviation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateDeviation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateDeviation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InRewardBand {
+		i--
+		if m.InRewardBand {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.PercentDiff.Size()
+		i -= size
+		if _, err := m.PercentDiff.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Finalized.Size()
+		i -= size
+		if _, err := m.Finalized.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Submitted.Size()
+		i -= size
+		if _, err := m.Submitted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorMissingDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorMissingDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorMissingDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorMissingDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorMissingDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorMissingDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MissingDenoms) > 0 {
+		for iNdEx := len(m.MissingDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MissingDenoms[iNdEx])
+			copy(dAtA[i:], m.MissingDenoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.MissingDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomTallySuccessRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomTallySuccessRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomTallySuccessRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomTallySuccessRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomTallySuccessRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomTallySuccessRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SuccessRates) > 0 {
+		for iNdEx := len(m.SuccessRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SuccessRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomTallySuccessRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomTallySuccessRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomTallySuccessRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailedPeriods != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FailedPeriods))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SuccessPeriods != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SuccessPeriods))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRevealsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRevealsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRevealsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRevealsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRevealsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRevealsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingReveals) > 0 {
+		for iNdEx := len(m.PendingReveals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingReveals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingReveal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingReveal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingReveal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SubmitBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubmitBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRequiredDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequiredDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequiredDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRequiredDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequiredDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequiredDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RequiredDenoms) > 0 {
+		for iNdEx := len(m.RequiredDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RequiredDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RequiredDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequiredDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RequiredDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Modules) > 0 {
+		for iNdEx := len(m.Modules) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Modules[iNdEx])
+			copy(dAtA[i:], m.Modules[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Modules[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.CurrentPeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentPeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RemainingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RemainingTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x32
+	if m.RemainingBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.InGrace {
+		i--
+		if m.InGrace {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NextPeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextPeriod))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VotePeriodMultiplier != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotePeriodMultiplier))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryObserversRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryObserversRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryObserversRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryObserversResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryObserversResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryObserversResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Observers) > 0 {
+		for iNdEx := len(m.Observers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Observers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Observer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Observer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Observer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExemptUntilHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExemptUntilHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBandMembershipStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBandMembershipStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBandMembershipStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBandMembershipStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBandMembershipStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBandMembershipStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BandMembershipStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BandMembershipStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BandMembershipStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.InBandFraction.Size()
+		i -= size
+		if _, err := m.InBandFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.InBandPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InBandPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RatedPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RatedPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorAccuracyRankingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorAccuracyRankingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorAccuracyRankingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ascending {
+		i--
+		if m.Ascending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorAccuracyRankingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorAccuracyRankingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorAccuracyRankingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ranking) > 0 {
+		for iNdEx := len(m.Ranking) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ranking[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorAccuracy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorAccuracy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorAccuracy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.InBandFraction.Size()
+		i -= size
+		if _, err := m.InBandFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.InBandSubmissions != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.InBandSubmissions))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Submissions != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Submissions))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomTallyDiagnosisRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomTallyDiagnosisRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomTallyDiagnosisRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomTallyDiagnosisResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomTallyDiagnosisResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomTallyDiagnosisResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Outcome.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParticipationSummaryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParticipationSummaryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParticipationSummaryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParticipationSummaryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParticipationSummaryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParticipationSummaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ParticipationRatio.Size()
+		i -= size
+		if _, err := m.ParticipationRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Voters != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Voters))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.VotedPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotedPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BondedValidators != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BondedValidators))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BondedPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BondedPower))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRawDenomStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRawDenomStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRawDenomStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRawDenomStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRawDenomStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRawDenomStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RawStoreEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RawStoreEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RawStoreEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMedianFlipCostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMedianFlipCostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMedianFlipCostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Move) > 0 {
+		i -= len(m.Move)
+		copy(dAtA[i:], m.Move)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Move)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMedianFlipCostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMedianFlipCostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMedianFlipCostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FlipCosts) > 0 {
+		for iNdEx := len(m.FlipCosts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FlipCosts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.Move.Size()
+		i -= size
+		if _, err := m.Move.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MedianFlipCost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MedianFlipCost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MedianFlipCost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LowerPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LowerPower))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RaisePower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RaisePower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BallotPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BallotPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Median.Size()
+		i -= size
+		if _, err := m.Median.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleAlertConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleAlertConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleAlertConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleAlertConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleAlertConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleAlertConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MissRate.Size()
+		i -= size
+		if _, err := m.MissRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxMissRate.Size()
+		i -= size
+		if _, err := m.MaxMissRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Features) > 0 {
+		for k := range m.Features {
+			v := m.Features[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintQuery(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ConsensusVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConsensusVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFreshExchangeRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFreshExchangeRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFreshExchangeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxAgePeriods != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxAgePeriods))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFreshExchangeRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFreshExchangeRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFreshExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AgePeriods != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AgePeriods))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWhitelistChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWhitelistChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWhitelistChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Windows != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Windows))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWhitelistChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWhitelistChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWhitelistChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Removed) > 0 {
+		for iNdEx := len(m.Removed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Removed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Added) > 0 {
+		for iNdEx := len(m.Added) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Added[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRejectedTuplesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRejectedTuplesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRejectedTuplesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRejectedTuplesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRejectedTuplesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRejectedTuplesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tuples) > 0 {
+		for iNdEx := len(m.Tuples) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tuples[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.VotePeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotePeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRevealWindowStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRevealWindowStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRevealWindowStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRevealWindowStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRevealWindowStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRevealWindowStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revealed {
+		i--
+		if m.Revealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Revealable {
+		i--
+		if m.Revealable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RevealCloseBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RevealCloseBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RevealOpenBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RevealOpenBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SubmitBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SubmitBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.HasPrevote {
+		i--
+		if m.HasPrevote {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.VotePeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotePeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBallotHistogramRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBallotHistogramRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBallotHistogramRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Buckets != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Buckets))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBallotHistogramResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBallotHistogramResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBallotHistogramResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BallotPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BallotPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Votes) > 0 {
+		dAtA10 := make([]byte, len(m.Votes)*10)
+		var j9 int
+		for _, num := range m.Votes {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintQuery(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Powers) > 0 {
+		dAtA12 := make([]byte, len(m.Powers)*10)
+		var j11 int
+		for _, num1 := range m.Powers {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintQuery(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Edges) > 0 {
+		for iNdEx := len(m.Edges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Edges[iNdEx].Size()
+				i -= size
+				if _, err := m.Edges[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySharedFeedersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySharedFeedersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySharedFeedersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySharedFeedersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySharedFeedersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySharedFeedersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Groups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeederGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeederGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeederGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Feeder) > 0 {
+		i -= len(m.Feeder)
+		copy(dAtA[i:], m.Feeder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Feeder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastTallyStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastTallyStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastTallyStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastTallyStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastTallyStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastTallyStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Denoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.VotePeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotePeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecommendedDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecommendedDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecommendedDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecommendedDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecommendedDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecommendedDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GraceDenoms) > 0 {
+		for iNdEx := len(m.GraceDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GraceDenoms[iNdEx])
+			copy(dAtA[i:], m.GraceDenoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.GraceDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomStatusesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomStatusesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomStatusesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomStatusBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomStatusBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomStatusBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomStatusesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomStatusesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomStatusesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParticipationSeriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParticipationSeriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParticipationSeriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParticipationSeriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParticipationSeriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParticipationSeriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		for iNdEx := len(m.Points) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Points[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMaxPeriodMoveRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMaxPeriodMoveRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMaxPeriodMoveRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMaxPeriodMoveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMaxPeriodMoveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMaxPeriodMoveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WindowStart != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowStart))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VotePeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VotePeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MaxMovePercent.Size()
+		i -= size
+		if _, err := m.MaxMovePercent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryExchangeRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExchangeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.CarriedForward {
+		n += 2
+	}
+	if m.CarriedPeriods != 0 {
+		n += 1 + sovQuery(uint64(m.CarriedPeriods))
+	}
+	if m.AgePeriods != 0 {
+		n += 1 + sovQuery(uint64(m.AgePeriods))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.UsdExchangeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.VoterCount != 0 {
+		n += 1 + sovQuery(uint64(m.VoterCount))
+	}
+	l = len(m.Tracks)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExchangeRatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryExchangeRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExchangeRates) > 0 {
+		for _, e := range m.ExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.AgePeriods) > 0 {
+		for _, e := range m.AgePeriods {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExchangeRateAge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AgePeriods != 0 {
+		n += 1 + sovQuery(uint64(m.AgePeriods))
+	}
+	return n
+}
+
+func (m *QueryActivesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryActivesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Actives) > 0 {
+		for _, s := range m.Actives {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVoteTargetsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryVoteTargetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VoteTargets) > 0 {
+		for _, s := range m.VoteTargets {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeederDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeederDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeederAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMissCounterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMissCounterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MissCounter != 0 {
+		n += 1 + sovQuery(uint64(m.MissCounter))
+	}
+	if m.RevealMissCounter != 0 {
+		n += 1 + sovQuery(uint64(m.RevealMissCounter))
+	}
+	return n
+}
+
+func (m *QueryMissCountersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMissCountersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MissCounters) > 0 {
+		for _, e := range m.MissCounters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MissCounterStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MissCounter != 0 {
+		n += 1 + sovQuery(uint64(m.MissCounter))
+	}
+	if m.AtRisk {
+		n += 2
+	}
+	if m.RevealMissCounter != 0 {
+		n += 1 + sovQuery(uint64(m.RevealMissCounter))
+	}
+	return n
+}
+
+func (m *QueryAggregatePrevoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregatePrevoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AggregatePrevote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAggregatePrevotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAggregatePrevotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AggregatePrevotes) > 0 {
+		for _, e := range m.AggregatePrevotes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAggregateVoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregateVoteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AggregateVote.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAggregateVotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAggregateVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AggregateVotes) > 0 {
+		for _, e := range m.AggregateVotes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRewardEstimateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardEstimateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RewardPerPower) > 0 {
+		for _, e := range m.RewardPerPower {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.WinningPower != 0 {
+		n += 1 + sovQuery(uint64(m.WinningPower))
+	}
+	if len(m.ValidatorReward) > 0 {
+		for _, e := range m.ValidatorReward {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryVoteHashSpecRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryVoteHashSpecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PreimageFormat)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Fields) > 0 {
+		for _, s := range m.Fields {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.FieldSeparator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ExchangeRateFormat)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ExchangeRateSeparator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SaltLength != 0 {
+		n += 1 + sovQuery(uint64(m.SaltLength))
+	}
+	l = len(m.SaltEncoding)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.VoterEncoding)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.HashAlgorithm)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.HashLength != 0 {
+		n += 1 + sovQuery(uint64(m.HashLength))
+	}
+	l = len(m.HashEncoding)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CommitmentHashAlgo)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ExchangeRateOrder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ExchangeRateDecimals != 0 {
+		n += 1 + sovQuery(uint64(m.ExchangeRateDecimals))
+	}
+	return n
+}
+
+func (m *QueryIsFeederAuthorizedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.FeederAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIsFeederAuthorizedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Authorized {
+		n += 2
+	}
+	l = len(m.AuthorizedFeeder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomBackingPowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomBackingPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BackingPowers) > 0 {
+		for _, e := range m.BackingPowers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomBackingPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovQuery(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *QueryUpcomingGraceExitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUpcomingGraceExitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GraceExits) > 0 {
+		for _, e := range m.GraceExits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomGraceExit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ExitPeriod != 0 {
+		n += 1 + sovQuery(uint64(m.ExitPeriod))
+	}
+	if m.ExitHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ExitHeight))
+	}
+	return n
+}
+
+func (m *QueryLightClientStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLightClientStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.ExchangeRates) > 0 {
+		for _, e := range m.ExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.VotePeriod != 0 {
+		n += 1 + sovQuery(uint64(m.VotePeriod))
+	}
+	l = len(m.StoreName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.ExchangeRateKeys) > 0 {
+		for _, b := range m.ExchangeRateKeys {
+			l = len(b)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.VotePeriodClockKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorRateDeviationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorRateDeviationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deviations) > 0 {
+		for _, e := range m.Deviations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RateDeviation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Submitted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Finalized.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PercentDiff.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.InRewardBand {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryValidatorMissingDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorMissingDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MissingDenoms) > 0 {
+		for _, s := range m.MissingDenoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDenomTallySuccessRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomTallySuccessRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SuccessRates) > 0 {
+		for _, e := range m.SuccessRates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomTallySuccessRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SuccessPeriods != 0 {
+		n += 1 + sovQuery(uint64(m.SuccessPeriods))
+	}
+	if m.FailedPeriods != 0 {
+		n += 1 + sovQuery(uint64(m.FailedPeriods))
+	}
+	return n
+}
+
+func (m *QueryPendingRevealsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingRevealsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingReveals) > 0 {
+		for _, e := range m.PendingReveals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingReveal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SubmitBlock != 0 {
+		n += 1 + sovQuery(uint64(m.SubmitBlock))
+	}
+	if m.RemainingBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingBlocks))
+	}
+	return n
+}
+
+func (m *QueryRequiredDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRequiredDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RequiredDenoms) > 0 {
+		for _, e := range m.RequiredDenoms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RequiredDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Modules) > 0 {
+		for _, s := range m.Modules {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDenomScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentPeriod != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentPeriod))
+	}
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.VotePeriodMultiplier != 0 {
+		n += 1 + sovQuery(uint64(m.VotePeriodMultiplier))
+	}
+	if m.NextPeriod != 0 {
+		n += 1 + sovQuery(uint64(m.NextPeriod))
+	}
+	if m.InGrace {
+		n += 2
+	}
+	if m.RemainingBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingBlocks))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RemainingTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryObserversRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryObserversResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Observers) > 0 {
+		for _, e := range m.Observers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Observer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ExemptUntilHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ExemptUntilHeight))
+	}
+	return n
+}
+
+func (m *QueryBandMembershipStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBandMembershipStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BandMembershipStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RatedPower != 0 {
+		n += 1 + sovQuery(uint64(m.RatedPower))
+	}
+	if m.InBandPower != 0 {
+		n += 1 + sovQuery(uint64(m.InBandPower))
+	}
+	l = m.InBandFraction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorAccuracyRankingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	if m.Ascending {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryValidatorAccuracyRankingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ranking) > 0 {
+		for _, e := range m.Ranking {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorAccuracy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Submissions != 0 {
+		n += 1 + sovQuery(uint64(m.Submissions))
+	}
+	if m.InBandSubmissions != 0 {
+		n += 1 + sovQuery(uint64(m.InBandSubmissions))
+	}
+	l = m.InBandFraction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomTallyDiagnosisRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomTallyDiagnosisResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Outcome.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParticipationSummaryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParticipationSummaryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BondedPower != 0 {
+		n += 1 + sovQuery(uint64(m.BondedPower))
+	}
+	if m.BondedValidators != 0 {
+		n += 1 + sovQuery(uint64(m.BondedValidators))
+	}
+	if m.VotedPower != 0 {
+		n += 1 + sovQuery(uint64(m.VotedPower))
+	}
+	if m.Voters != 0 {
+		n += 1 + sovQuery(uint64(m.Voters))
+	}
+	l = m.ParticipationRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRawDenomStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRawDenomStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RawStoreEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMedianFlipCostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Move)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMedianFlipCostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Move.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.FlipCosts) > 0 {
+		for _, e := range m.FlipCosts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MedianFlipCost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Median.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.BallotPower != 0 {
+		n += 1 + sovQuery(uint64(m.BallotPower))
+	}
+	if m.RaisePower != 0 {
+		n += 1 + sovQuery(uint64(m.RaisePower))
+	}
+	if m.LowerPower != 0 {
+		n += 1 + sovQuery(uint64(m.LowerPower))
+	}
+	return n
+}
+
+func (m *QueryOracleAlertConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOracleAlertConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxMissRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MissRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryModuleInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModuleInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConsensusVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ConsensusVersion))
+	}
+	if len(m.Features) > 0 {
+		for k, v := range m.Features {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + len(v) + sovQuery(uint64(len(v)))
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryFreshExchangeRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxAgePeriods != 0 {
+		n += 1 + sovQuery(uint64(m.MaxAgePeriods))
+	}
+	return n
+}
+
+func (m *QueryFreshExchangeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.AgePeriods != 0 {
+		n += 1 + sovQuery(uint64(m.AgePeriods))
+	}
+	return n
+}
+
+func (m *QueryWhitelistChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Windows != 0 {
+		n += 1 + sovQuery(uint64(m.Windows))
+	}
+	return n
+}
+
+func (m *QueryWhitelistChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Added) > 0 {
+		for _, e := range m.Added {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Removed) > 0 {
+		for _, e := range m.Removed {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRejectedTuplesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRejectedTuplesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotePeriod != 0 {
+		n += 1 + sovQuery(uint64(m.VotePeriod))
+	}
+	if len(m.Tuples) > 0 {
+		for _, e := range m.Tuples {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRevealWindowStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRevealWindowStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotePeriod != 0 {
+		n += 1 + sovQuery(uint64(m.VotePeriod))
+	}
+	if m.HasPrevote {
+		n += 2
+	}
+	if m.SubmitBlock != 0 {
+		n += 1 + sovQuery(uint64(m.SubmitBlock))
+	}
+	if m.RevealOpenBlock != 0 {
+		n += 1 + sovQuery(uint64(m.RevealOpenBlock))
+	}
+	if m.RevealCloseBlock != 0 {
+		n += 1 + sovQuery(uint64(m.RevealCloseBlock))
+	}
+	if m.Revealable {
+		n += 2
+	}
+	if m.Revealed {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryBallotHistogramRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Buckets != 0 {
+		n += 1 + sovQuery(uint64(m.Buckets))
+	}
+	return n
+}
+
+func (m *QueryBallotHistogramResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Edges) > 0 {
+		for _, e := range m.Edges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Powers) > 0 {
+		l = 0
+		for _, e := range m.Powers {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if len(m.Votes) > 0 {
+		l = 0
+		for _, e := range m.Votes {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.BallotPower != 0 {
+		n += 1 + sovQuery(uint64(m.BallotPower))
+	}
+	return n
+}
+
+func (m *QuerySharedFeedersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySharedFeedersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Groups) > 0 {
+		for _, e := range m.Groups {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeederGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Feeder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLastTallyStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLastTallyStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VotePeriod != 0 {
+		n += 1 + sovQuery(uint64(m.VotePeriod))
+	}
+	if len(m.Denoms) > 0 {
+		for _, e := range m.Denoms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRecommendedDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRecommendedDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.GraceDenoms) > 0 {
+		for _, s := range m.GraceDenoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDenomStatusesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DenomStatusBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDenomStatusesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParticipationSeriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryParticipationSeriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMaxPeriodMoveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMaxPeriodMoveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxMovePercent.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.VotePeriod != 0 {
+		n += 1 + sovQuery(uint64(m.VotePeriod))
+	}
+	if m.WindowStart != 0 {
+		n += 1 + sovQuery(uint64(m.WindowStart))
+	}
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CarriedForward", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CarriedForward = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CarriedPeriods", wireType)
+			}
+			m.CarriedPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CarriedPeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgePeriods", wireType)
+			}
+			m.AgePeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgePeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UsdExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterCount", wireType)
+			}
+			m.VoterCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoterCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tracks", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tracks = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = append(m.ExchangeRates, types.DecCoin{})
+			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgePeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgePeriods = append(m.AgePeriods, ExchangeRateAge{})
+			if err := m.AgePeriods[len(m.AgePeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeRateAge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRateAge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRateAge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgePeriods", wireType)
+			}
+			m.AgePeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgePeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActivesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActivesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actives", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actives = append(m.Actives, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteTargetsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteTargetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteTargetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteTargetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteTargets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoteTargets = append(m.VoteTargets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeederDelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeederDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeederAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissCounterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissCounterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissCounterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
+			}
+			m.MissCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealMissCounter", wireType)
+			}
+			m.RevealMissCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealMissCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMissCountersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissCountersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissCountersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMissCountersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissCountersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissCountersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCounters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissCounters = append(m.MissCounters, MissCounterStatus{})
+			if err := m.MissCounters[len(m.MissCounters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MissCounterStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MissCounterStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MissCounterStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
+			}
+			m.MissCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtRisk", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AtRisk = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealMissCounter", wireType)
+			}
+			m.RevealMissCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealMissCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregatePrevoteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregatePrevoteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregatePrevoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AggregatePrevote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregatePrevotesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregatePrevotesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregatePrevotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregatePrevotes = append(m.AggregatePrevotes, AggregateExchangeRatePrevote{})
+			if err := m.AggregatePrevotes[len(m.AggregatePrevotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregateVoteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregateVoteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregateVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregateVoteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVote", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AggregateVote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregateVotesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregateVotesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregateVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregateVotesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregateVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AggregateVotes = append(m.AggregateVotes, AggregateExchangeRateVote{})
+			if err := m.AggregateVotes[len(m.AggregateVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardEstimateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardEstimateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardEstimateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardEstimateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardEstimateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardEstimateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerPower", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPerPower = append(m.RewardPerPower, types.DecCoin{})
+			if err := m.RewardPerPower[len(m.RewardPerPower)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WinningPower", wireType)
+			}
+			m.WinningPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WinningPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorReward = append(m.ValidatorReward, types.DecCoin{})
+			if err := m.ValidatorReward[len(m.ValidatorReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteHashSpecRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteHashSpecRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteHashSpecRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVoteHashSpecResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVoteHashSpecResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVoteHashSpecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreimageFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreimageFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fields = append(m.Fields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FieldSeparator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FieldSeparator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateSeparator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateSeparator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SaltLength", wireType)
+			}
+			m.SaltLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SaltLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SaltEncoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SaltEncoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterEncoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoterEncoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashAlgorithm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HashAlgorithm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashLength", wireType)
+			}
+			m.HashLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashEncoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HashEncoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitmentHashAlgo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitmentHashAlgo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateOrder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateOrder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateDecimals", wireType)
+			}
+			m.ExchangeRateDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExchangeRateDecimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsFeederAuthorizedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsFeederAuthorizedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsFeederAuthorizedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeederAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsFeederAuthorizedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsFeederAuthorizedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsFeederAuthorizedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Authorized = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedFeeder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorizedFeeder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomBackingPowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomBackingPowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomBackingPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomBackingPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomBackingPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomBackingPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingPowers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BackingPowers = append(m.BackingPowers, DenomBackingPower{})
+			if err := m.BackingPowers[len(m.BackingPowers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomBackingPower) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomBackingPower: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomBackingPower: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpcomingGraceExitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpcomingGraceExitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpcomingGraceExitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUpcomingGraceExitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUpcomingGraceExitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUpcomingGraceExitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GraceExits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GraceExits = append(m.GraceExits, DenomGraceExit{})
+			if err := m.GraceExits[len(m.GraceExits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomGraceExit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomGraceExit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomGraceExit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitPeriod", wireType)
+			}
+			m.ExitPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitHeight", wireType)
+			}
+			m.ExitHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExitHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLightClientStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLightClientStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLightClientStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLightClientStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLightClientStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLightClientStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = append(m.ExchangeRates, types.DecCoin{})
+			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateKeys = append(m.ExchangeRateKeys, make([]byte, postIndex-iNdEx))
+			copy(m.ExchangeRateKeys[len(m.ExchangeRateKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriodClockKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotePeriodClockKey = append(m.VotePeriodClockKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.VotePeriodClockKey == nil {
+				m.VotePeriodClockKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorRateDeviationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorRateDeviationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorRateDeviationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorRateDeviationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorRateDeviationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorRateDeviationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deviations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deviations = append(m.Deviations, RateDeviation{})
+			if err := m.Deviations[len(m.Deviations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateDeviation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateDeviation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateDeviation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Submitted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Finalized.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PercentDiff", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PercentDiff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InRewardBand", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InRewardBand = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorMissingDenomsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorMissingDenomsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorMissingDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorMissingDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorMissingDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorMissingDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissingDenoms = append(m.MissingDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomTallySuccessRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomTallySuccessRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomTallySuccessRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomTallySuccessRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomTallySuccessRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomTallySuccessRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SuccessRates = append(m.SuccessRates, DenomTallySuccessRate{})
+			if err := m.SuccessRates[len(m.SuccessRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomTallySuccessRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomTallySuccessRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomTallySuccessRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessPeriods", wireType)
+			}
+			m.SuccessPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuccessPeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedPeriods", wireType)
+			}
+			m.FailedPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedPeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingRevealsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingRevealsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingRevealsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingRevealsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingRevealsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingRevealsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingReveals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingReveals = append(m.PendingReveals, PendingReveal{})
+			if err := m.PendingReveals[len(m.PendingReveals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingReveal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingReveal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingReveal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
+			}
+			m.SubmitBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBlocks", wireType)
+			}
+			m.RemainingBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRequiredDenomsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRequiredDenomsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRequiredDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRequiredDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRequiredDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRequiredDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredDenoms = append(m.RequiredDenoms, RequiredDenom{})
+			if err := m.RequiredDenoms[len(m.RequiredDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequiredDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequiredDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequiredDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Modules", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Modules = append(m.Modules, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPeriod", wireType)
+			}
+			m.CurrentPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schedules = append(m.Schedules, DenomSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriodMultiplier", wireType)
+			}
+			m.VotePeriodMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriodMultiplier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPeriod", wireType)
+			}
+			m.NextPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InGrace", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InGrace = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBlocks", wireType)
+			}
+			m.RemainingBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RemainingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryObserversRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryObserversRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryObserversRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryObserversResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryObserversResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryObserversResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Observers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Observers = append(m.Observers, Observer{})
+			if err := m.Observers[len(m.Observers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Observer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Observer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Observer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptUntilHeight", wireType)
+			}
+			m.ExemptUntilHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExemptUntilHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBandMembershipStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBandMembershipStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBandMembershipStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBandMembershipStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBandMembershipStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBandMembershipStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stats = append(m.Stats, BandMembershipStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BandMembershipStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BandMembershipStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BandMembershipStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RatedPower", wireType)
+			}
+			m.RatedPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RatedPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InBandPower", wireType)
+			}
+			m.InBandPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InBandPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InBandFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InBandFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorAccuracyRankingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorAccuracyRankingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorAccuracyRankingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ascending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ascending = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorAccuracyRankingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorAccuracyRankingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorAccuracyRankingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ranking", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ranking = append(m.Ranking, ValidatorAccuracy{})
+			if err := m.Ranking[len(m.Ranking)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorAccuracy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorAccuracy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorAccuracy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submissions", wireType)
+			}
+			m.Submissions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Submissions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InBandSubmissions", wireType)
+			}
+			m.InBandSubmissions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InBandSubmissions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InBandFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InBandFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomTallyDiagnosisRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomTallyDiagnosisRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomTallyDiagnosisRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomTallyDiagnosisResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomTallyDiagnosisResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomTallyDiagnosisResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outcome.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParticipationSummaryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParticipationSummaryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParticipationSummaryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParticipationSummaryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParticipationSummaryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParticipationSummaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedPower", wireType)
+			}
+			m.BondedPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BondedPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedValidators", wireType)
+			}
+			m.BondedValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BondedValidators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotedPower", wireType)
+			}
+			m.VotedPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotedPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voters", wireType)
+			}
+			m.Voters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Voters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipationRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ParticipationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRawDenomStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRawDenomStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRawDenomStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRawDenomStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRawDenomStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRawDenomStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, RawStoreEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RawStoreEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RawStoreEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RawStoreEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMedianFlipCostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMedianFlipCostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMedianFlipCostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Move", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Move = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMedianFlipCostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMedianFlipCostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMedianFlipCostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Move", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Move.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlipCosts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlipCosts = append(m.FlipCosts, MedianFlipCost{})
+			if err := m.FlipCosts[len(m.FlipCosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MedianFlipCost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MedianFlipCost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MedianFlipCost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Median", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Median.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotPower", wireType)
+			}
+			m.BallotPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BallotPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaisePower", wireType)
+			}
+			m.RaisePower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RaisePower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerPower", wireType)
+			}
+			m.LowerPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleAlertConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleAlertConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleAlertConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleAlertConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleAlertConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleAlertConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMissRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MissRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusVersion", wireType)
+			}
+			m.ConsensusVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsensusVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Features == nil {
+				m.Features = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Features[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFreshExchangeRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFreshExchangeRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFreshExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgePeriods", wireType)
+			}
+			m.MaxAgePeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAgePeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFreshExchangeRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFreshExchangeRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFreshExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgePeriods", wireType)
+			}
+			m.AgePeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgePeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWhitelistChangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWhitelistChangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWhitelistChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			m.Windows = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Windows |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWhitelistChangesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWhitelistChangesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWhitelistChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Added", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Added = append(m.Added, WhitelistChange{})
+			if err := m.Added[len(m.Added)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Removed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Removed = append(m.Removed, WhitelistChange{})
+			if err := m.Removed[len(m.Removed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRejectedTuplesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2435,15 +21674,15 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRejectedTuplesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRejectedTuplesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2471,7 +21710,7 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2494,7 +21733,7 @@ func (m *QueryExchangeRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRejectedTuplesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2517,17 +21756,36 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRejectedTuplesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRejectedTuplesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tuples", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2537,23 +21795,23 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tuples = append(m.Tuples, RejectedTuple{})
+			if err := m.Tuples[len(m.Tuples)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2578,7 +21836,7 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRevealWindowStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2601,12 +21859,44 @@ func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRatesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRevealWindowStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRevealWindowStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2628,7 +21918,7 @@ func (m *QueryExchangeRatesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRevealWindowStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2651,17 +21941,17 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryExchangeRatesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRevealWindowStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRevealWindowStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
 			}
-			var msglen int
+			m.VotePeriod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2671,26 +21961,128 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.VotePeriod |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasPrevote", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.HasPrevote = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitBlock", wireType)
 			}
-			m.ExchangeRates = append(m.ExchangeRates, types.DecCoin{})
-			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SubmitBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealOpenBlock", wireType)
+			}
+			m.RevealOpenBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealOpenBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealCloseBlock", wireType)
+			}
+			m.RevealCloseBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealCloseBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revealable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revealable = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revealed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revealed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2712,7 +22104,7 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBallotHistogramRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2731,16 +22123,67 @@ func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBallotHistogramRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBallotHistogramRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			m.Buckets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Buckets |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2762,7 +22205,7 @@ func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBallotHistogramResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2785,15 +22228,15 @@ func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryActivesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBallotHistogramResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryActivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBallotHistogramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actives", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Edges", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2821,8 +22264,183 @@ func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Actives = append(m.Actives, string(dAtA[iNdEx:postIndex]))
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.Edges = append(m.Edges, v)
+			if err := m.Edges[len(m.Edges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Powers = append(m.Powers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Powers) == 0 {
+					m.Powers = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Powers = append(m.Powers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Powers", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Votes = append(m.Votes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Votes) == 0 {
+					m.Votes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Votes = append(m.Votes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BallotPower", wireType)
+			}
+			m.BallotPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BallotPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2844,7 +22462,7 @@ func (m *QueryActivesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySharedFeedersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2867,10 +22485,10 @@ func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteTargetsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySharedFeedersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteTargetsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySharedFeedersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2894,7 +22512,7 @@ func (m *QueryVoteTargetsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySharedFeedersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2917,17 +22535,17 @@ func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVoteTargetsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySharedFeedersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVoteTargetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySharedFeedersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteTargets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2937,23 +22555,25 @@ func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VoteTargets = append(m.VoteTargets, string(dAtA[iNdEx:postIndex]))
+			m.Groups = append(m.Groups, FeederGroup{})
+			if err := m.Groups[len(m.Groups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2976,7 +22596,7 @@ func (m *QueryVoteTargetsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *FeederGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2999,15 +22619,15 @@ func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeederGroup: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeederGroup: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3035,61 +22655,11 @@ func (m *QueryFeederDelegationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			m.Feeder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeederDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeederAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3117,7 +22687,7 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeederAddr = string(dAtA[iNdEx:postIndex])
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3140,7 +22710,7 @@ func (m *QueryFeederDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLastTallyStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3163,44 +22733,12 @@ func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMissCounterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastTallyStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMissCounterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastTallyStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3222,7 +22760,7 @@ func (m *QueryMissCounterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLastTallyStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3245,17 +22783,17 @@ func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMissCounterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastTallyStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMissCounterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastTallyStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissCounter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
 			}
-			m.MissCounter = 0
+			m.VotePeriod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3265,11 +22803,45 @@ func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissCounter |= uint64(b&0x7F) << shift
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, DenomTallyStats{})
+			if err := m.Denoms[len(m.Denoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3291,7 +22863,7 @@ func (m *QueryMissCounterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePrevoteRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRecommendedDenomsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3314,44 +22886,12 @@ func (m *QueryAggregatePrevoteRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevoteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecommendedDenomsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecommendedDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3373,7 +22913,7 @@ func (m *QueryAggregatePrevoteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRecommendedDenomsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3396,17 +22936,17 @@ func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecommendedDenomsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecommendedDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3416,24 +22956,55 @@ func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AggregatePrevote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GraceDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GraceDenoms = append(m.GraceDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3456,7 +23027,7 @@ func (m *QueryAggregatePrevoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePrevotesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomStatusesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3479,10 +23050,10 @@ func (m *QueryAggregatePrevotesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevotesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomStatusesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomStatusesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3506,7 +23077,7 @@ func (m *QueryAggregatePrevotesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
+func (m *DenomStatusBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3529,17 +23100,17 @@ func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: DenomStatusBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregatePrevotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DenomStatusBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregatePrevotes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3549,79 +23120,46 @@ func (m *QueryAggregatePrevotesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AggregatePrevotes = append(m.AggregatePrevotes, AggregateExchangeRatePrevote{})
-			if err := m.AggregatePrevotes[len(m.AggregatePrevotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAggregateVoteRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVoteRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3649,7 +23187,7 @@ func (m *QueryAggregateVoteRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3672,7 +23210,7 @@ func (m *QueryAggregateVoteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomStatusesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3695,15 +23233,15 @@ func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVoteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomStatusesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVoteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomStatusesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3730,7 +23268,8 @@ func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AggregateVote.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Buckets = append(m.Buckets, DenomStatusBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3755,7 +23294,7 @@ func (m *QueryAggregateVoteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregateVotesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParticipationSeriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3778,12 +23317,31 @@ func (m *QueryAggregateVotesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVotesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParticipationSeriesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParticipationSeriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3805,7 +23363,7 @@ func (m *QueryAggregateVotesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryParticipationSeriesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3828,15 +23386,15 @@ func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAggregateVotesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryParticipationSeriesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAggregateVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryParticipationSeriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregateVotes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3863,8 +23421,8 @@ func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AggregateVotes = append(m.AggregateVotes, AggregateExchangeRateVote{})
-			if err := m.AggregateVotes[len(m.AggregateVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Points = append(m.Points, ParticipationPoint{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3889,7 +23447,7 @@ func (m *QueryAggregateVotesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMaxPeriodMoveRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3912,12 +23470,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMaxPeriodMoveRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMaxPeriodMoveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3939,7 +23529,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMaxPeriodMoveResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3962,17 +23552,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMaxPeriodMoveResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMaxPeriodMoveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMovePercent", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3982,25 +23572,83 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxMovePercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			m.WindowStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStart |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,32 @@
+package types
+
+// Reasons of the outcome of the last vote period of a denom
+const (
+	// TallyOutcomeSuccess is the reason of a denom which tallied
+	TallyOutcomeSuccess = "success"
+	// TallyOutcomeBelowThreshold is the reason of a denom whose votes did not reach the vote threshold
+	TallyOutcomeBelowThreshold = "below_threshold"
+	// TallyOutcomeFailed is the reason of a denom whose ballot failed to tally, e.g. overflowing sdk.Dec
+	TallyOutcomeFailed = "failed"
+	// TallyOutcomeStale is the reason of a denom nobody voted on
+	TallyOutcomeStale = "stale"
+	// TallyOutcomeResting is the reason of a denom which was not due, see Denom.IsDue
+	TallyOutcomeResting = "resting"
+	// TallyOutcomeTracking is the reason of a denom mirroring the exchange rate of another one, see Denom.Tracks
+	TallyOutcomeTracking = "tracking"
+)
+
+// Statuses of the feed of a denom reported by the DenomStatuses query
+const (
+	// DenomStatusActive is the status of a denom with a fresh exchange rate
+	DenomStatusActive = "active"
+	// DenomStatusStale is the status of a denom whose exchange rate is carried forward
+	DenomStatusStale = "stale"
+	// DenomStatusPending is the status of a denom in its activation grace
+	DenomStatusPending = "pending"
+	// DenomStatusFailing is the status of a denom without an exchange rate
+	DenomStatusFailing = "failing"
+)
+
+// DenomStatuses are the statuses of a denom, in the order they are reported
+var DenomStatuses = []string{DenomStatusActive, DenomStatusStale, DenomStatusPending, DenomStatusFailing}
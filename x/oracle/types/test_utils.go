@@ -110,6 +110,15 @@ func (sk DummyStakingKeeper) GetLastValidatorPower(ctx sdk.Context, operator sdk
 	return sk.Validator(ctx, operator).GetConsensusPower(sdk.DefaultPowerReduction)
 }
 
+// IterateLastValidators nolint
+func (sk DummyStakingKeeper) IterateLastValidators(_ sdk.Context, fn func(index int64, validator stakingtypes.ValidatorI) (stop bool)) {
+	for i, validator := range sk.validators {
+		if fn(int64(i), validator) {
+			break
+		}
+	}
+}
+
 // MaxValidators returns the maximum amount of bonded validators
 func (DummyStakingKeeper) MaxValidators(sdk.Context) uint32 {
 	return 100
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -115,6 +116,14 @@ type MsgAggregateExchangeRateVote struct {
 	ExchangeRates string `protobuf:"bytes,2,opt,name=exchange_rates,json=exchangeRates,proto3" json:"exchange_rates,omitempty" yaml:"exchange_rates"`
 	Feeder        string `protobuf:"bytes,3,opt,name=feeder,proto3" json:"feeder,omitempty" yaml:"feeder"`
 	Validator     string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty" yaml:"validator"`
+	// attestation is an optional detached signature over the vote payload by the
+	// feeder, emitted in the aggregate_vote event for auditing. It is not
+	// verified and does not affect the tally.
+	Attestation string `protobuf:"bytes,5,opt,name=attestation,proto3" json:"attestation,omitempty" yaml:"attestation,omitempty"`
+	// vote_period binds the vote to the vote period the feeder expects to reveal
+	// it in, so that a signed vote cannot be held for a later period. Zero leaves
+	// the vote unbound, which is rejected once max_vote_future_drift is set.
+	VotePeriod uint64 `protobuf:"varint,6,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty" yaml:"vote_period,omitempty"`
 }
 
 func (m *MsgAggregateExchangeRateVote) Reset()         { *m = MsgAggregateExchangeRateVote{} }
@@ -264,6 +273,84 @@ func (m *MsgDelegateFeedConsentResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDelegateFeedConsentResponse proto.InternalMessageInfo
 
+// MsgSetOracleAlertConfig represents a message to declare the miss rate a
+// validator accepts within a slash window. It is purely informational, zero
+// removes the declaration.
+type MsgSetOracleAlertConfig struct {
+	Operator    string                                 `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty" yaml:"operator"`
+	MaxMissRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_miss_rate,json=maxMissRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_miss_rate" yaml:"max_miss_rate"`
+}
+
+func (m *MsgSetOracleAlertConfig) Reset()         { *m = MsgSetOracleAlertConfig{} }
+func (m *MsgSetOracleAlertConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOracleAlertConfig) ProtoMessage()    {}
+func (*MsgSetOracleAlertConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15c3977432059018, []int{6}
+}
+func (m *MsgSetOracleAlertConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOracleAlertConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOracleAlertConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetOracleAlertConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOracleAlertConfig.Merge(m, src)
+}
+func (m *MsgSetOracleAlertConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOracleAlertConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOracleAlertConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetOracleAlertConfig proto.InternalMessageInfo
+
+// MsgSetOracleAlertConfigResponse defines the Msg/SetOracleAlertConfig response type.
+type MsgSetOracleAlertConfigResponse struct {
+}
+
+func (m *MsgSetOracleAlertConfigResponse) Reset()         { *m = MsgSetOracleAlertConfigResponse{} }
+func (m *MsgSetOracleAlertConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetOracleAlertConfigResponse) ProtoMessage()    {}
+func (*MsgSetOracleAlertConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15c3977432059018, []int{7}
+}
+func (m *MsgSetOracleAlertConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetOracleAlertConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetOracleAlertConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetOracleAlertConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetOracleAlertConfigResponse.Merge(m, src)
+}
+func (m *MsgSetOracleAlertConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetOracleAlertConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetOracleAlertConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetOracleAlertConfigResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAggregateExchangeRatePrevote)(nil), "kujira.oracle.MsgAggregateExchangeRatePrevote")
 	proto.RegisterType((*MsgAggregateExchangeRatePrevoteResponse)(nil), "kujira.oracle.MsgAggregateExchangeRatePrevoteResponse")
@@ -271,43 +358,56 @@ func init() {
 	proto.RegisterType((*MsgAggregateExchangeRateVoteResponse)(nil), "kujira.oracle.MsgAggregateExchangeRateVoteResponse")
 	proto.RegisterType((*MsgDelegateFeedConsent)(nil), "kujira.oracle.MsgDelegateFeedConsent")
 	proto.RegisterType((*MsgDelegateFeedConsentResponse)(nil), "kujira.oracle.MsgDelegateFeedConsentResponse")
+	proto.RegisterType((*MsgSetOracleAlertConfig)(nil), "kujira.oracle.MsgSetOracleAlertConfig")
+	proto.RegisterType((*MsgSetOracleAlertConfigResponse)(nil), "kujira.oracle.MsgSetOracleAlertConfigResponse")
 }
 
 func init() { proto.RegisterFile("kujira/oracle/tx.proto", fileDescriptor_15c3977432059018) }
 
 var fileDescriptor_15c3977432059018 = []byte{
-	// 492 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x7d, 0x4d, 0x55, 0xb5, 0x87, 0x42, 0xc1, 0x2d, 0x55, 0x1a, 0x55, 0x76, 0x75, 0xfc,
-	0x2c, 0xa8, 0xb6, 0xd4, 0x4a, 0x0c, 0x9d, 0xa0, 0x14, 0x16, 0x14, 0x09, 0x9d, 0x10, 0x03, 0x0b,
-	0xba, 0x26, 0x8f, 0x4b, 0xa8, 0x93, 0x8b, 0xee, 0xae, 0x55, 0x3a, 0xb0, 0x21, 0xc4, 0xc8, 0x9f,
-	0xd0, 0xff, 0x80, 0x7f, 0x83, 0xb1, 0x23, 0x93, 0x85, 0x92, 0x85, 0x89, 0xc1, 0x23, 0x13, 0xf2,
-	0x9d, 0xed, 0xa6, 0x6a, 0xda, 0xc6, 0x9b, 0xf5, 0xbe, 0x9f, 0x7b, 0x3f, 0xbe, 0x7e, 0x7a, 0x78,
-	0xe5, 0xe0, 0xf0, 0x53, 0x47, 0xb2, 0x50, 0x48, 0xd6, 0x8c, 0x20, 0xd4, 0x83, 0xa0, 0x2f, 0x85,
-	0x16, 0x6e, 0xd5, 0xc6, 0x03, 0x1b, 0xaf, 0x2f, 0x73, 0xc1, 0x85, 0x51, 0xc2, 0xf4, 0xcb, 0x42,
-	0xe4, 0x07, 0xc2, 0x7e, 0x43, 0xf1, 0xe7, 0x9c, 0x4b, 0xe0, 0x4c, 0xc3, 0xcb, 0x41, 0xb3, 0xcd,
-	0x7a, 0x1c, 0x28, 0xd3, 0xf0, 0x46, 0xc2, 0x91, 0xd0, 0xe0, 0xde, 0xc5, 0xb3, 0x6d, 0xa6, 0xda,
-	0x35, 0xb4, 0x8e, 0x1e, 0x2d, 0xec, 0x2e, 0x26, 0xb1, 0x7f, 0xe3, 0x98, 0x75, 0xa3, 0x1d, 0x92,
-	0x46, 0x09, 0x35, 0xa2, 0xbb, 0x81, 0xe7, 0x3e, 0x02, 0xb4, 0x40, 0xd6, 0x66, 0x0c, 0x76, 0x3b,
-	0x89, 0xfd, 0xaa, 0xc5, 0x6c, 0x9c, 0xd0, 0x0c, 0x70, 0xb7, 0xf0, 0xc2, 0x11, 0x8b, 0x3a, 0x2d,
-	0xa6, 0x85, 0xac, 0x55, 0x0c, 0xbd, 0x9c, 0xc4, 0xfe, 0x2d, 0x4b, 0x17, 0x12, 0xa1, 0x67, 0xd8,
-	0xce, 0xfc, 0xb7, 0x13, 0xdf, 0xf9, 0x73, 0xe2, 0x3b, 0x64, 0x03, 0x3f, 0xbc, 0xa6, 0x61, 0x0a,
-	0xaa, 0x2f, 0x7a, 0x0a, 0xc8, 0x5f, 0x84, 0xd7, 0x2e, 0x63, 0xdf, 0x65, 0x93, 0x29, 0x16, 0xe9,
-	0x8b, 0x93, 0xa5, 0x51, 0x42, 0x8d, 0xe8, 0x3e, 0xc3, 0x37, 0x21, 0x7b, 0xf8, 0x41, 0x32, 0x0d,
-	0x2a, 0x9b, 0x70, 0x35, 0x89, 0xfd, 0x3b, 0x16, 0x3f, 0xaf, 0x13, 0x5a, 0x85, 0xb1, 0x4a, 0x6a,
-	0xcc, 0x9b, 0x4a, 0x29, 0x6f, 0x66, 0xcb, 0x7a, 0xf3, 0x00, 0xdf, 0xbb, 0x6a, 0xde, 0xc2, 0x98,
-	0x2f, 0x08, 0xaf, 0x34, 0x14, 0xdf, 0x83, 0xc8, 0x70, 0xaf, 0x00, 0x5a, 0x2f, 0x52, 0xa1, 0xa7,
-	0xdd, 0x10, 0xcf, 0x8b, 0x3e, 0x48, 0x53, 0xdf, 0xda, 0xb2, 0x94, 0xc4, 0xfe, 0xa2, 0xad, 0x9f,
-	0x2b, 0x84, 0x16, 0x50, 0xfa, 0xa0, 0x95, 0xe5, 0xc9, 0x8c, 0x19, 0x7b, 0x90, 0x2b, 0x84, 0x16,
-	0xd0, 0x58, 0xbb, 0xeb, 0xd8, 0x9b, 0xdc, 0x45, 0xde, 0xe8, 0xd6, 0xbf, 0x19, 0x5c, 0x69, 0x28,
-	0xee, 0x7e, 0x45, 0x78, 0xed, 0xca, 0x1d, 0x0d, 0x82, 0x73, 0xdb, 0x1e, 0x5c, 0xb3, 0x22, 0xf5,
-	0xa7, 0xe5, 0xf8, 0xbc, 0x21, 0xf7, 0x33, 0x5e, 0xbd, 0x7c, 0x9d, 0x9e, 0x4c, 0x99, 0x34, 0x85,
-	0xeb, 0xdb, 0x25, 0xe0, 0xa2, 0xfc, 0x01, 0x5e, 0x9a, 0xf4, 0xd3, 0xee, 0x5f, 0xcc, 0x35, 0x01,
-	0xab, 0x6f, 0x4e, 0x85, 0xe5, 0xc5, 0x76, 0xf7, 0x7e, 0x0e, 0x3d, 0x74, 0x3a, 0xf4, 0xd0, 0xef,
-	0xa1, 0x87, 0xbe, 0x8f, 0x3c, 0xe7, 0x74, 0xe4, 0x39, 0xbf, 0x46, 0x9e, 0xf3, 0xfe, 0x31, 0xef,
-	0xe8, 0xf6, 0xe1, 0x7e, 0xd0, 0x14, 0xdd, 0xf0, 0x2d, 0xb0, 0xee, 0xe6, 0x6b, 0x7b, 0x82, 0x9a,
-	0x42, 0x42, 0x38, 0x28, 0x2e, 0xd1, 0x71, 0x1f, 0xd4, 0xfe, 0x9c, 0x39, 0x34, 0xdb, 0xff, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0xe7, 0xd7, 0x8e, 0xe4, 0xa7, 0x04, 0x00, 0x00,
+	// 657 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0x4d, 0x4f, 0x13, 0x41,
+	0x18, 0xc7, 0xbb, 0x14, 0x09, 0x4c, 0x53, 0xd1, 0xa5, 0x62, 0x69, 0xc8, 0x4e, 0x1d, 0x15, 0x41,
+	0x65, 0x37, 0x81, 0xc4, 0x03, 0x27, 0x79, 0x91, 0x8b, 0x69, 0x24, 0xab, 0xf1, 0xe0, 0xa5, 0x19,
+	0xda, 0x87, 0xed, 0x42, 0xb7, 0xd3, 0xcc, 0x0c, 0xa4, 0x1c, 0xbc, 0x19, 0xe3, 0xd1, 0x8f, 0xc0,
+	0x37, 0xf0, 0x2b, 0x78, 0xe4, 0xc8, 0xd1, 0x78, 0xd8, 0x18, 0xb8, 0x18, 0x8f, 0x9b, 0x78, 0x37,
+	0xbb, 0xb3, 0x5d, 0xb6, 0xd2, 0x42, 0x7b, 0x6a, 0xf3, 0xfc, 0x7f, 0xcf, 0xeb, 0x3c, 0xb3, 0x83,
+	0x66, 0x0f, 0x0e, 0xf7, 0x5d, 0x4e, 0x2d, 0xc6, 0x69, 0xad, 0x09, 0x96, 0xec, 0x98, 0x6d, 0xce,
+	0x24, 0xd3, 0xf3, 0xca, 0x6e, 0x2a, 0x7b, 0xa9, 0xe0, 0x30, 0x87, 0x45, 0x8a, 0x15, 0xfe, 0x53,
+	0x10, 0xf9, 0xa6, 0x21, 0x5c, 0x11, 0xce, 0xba, 0xe3, 0x70, 0x70, 0xa8, 0x84, 0x57, 0x9d, 0x5a,
+	0x83, 0xb6, 0x1c, 0xb0, 0xa9, 0x84, 0x1d, 0x0e, 0x47, 0x4c, 0x82, 0xfe, 0x10, 0x8d, 0x37, 0xa8,
+	0x68, 0x14, 0xb5, 0xb2, 0xb6, 0x38, 0xb5, 0x31, 0x1d, 0xf8, 0x38, 0x77, 0x4c, 0xbd, 0xe6, 0x1a,
+	0x09, 0xad, 0xc4, 0x8e, 0x44, 0x7d, 0x09, 0x4d, 0xec, 0x01, 0xd4, 0x81, 0x17, 0xc7, 0x22, 0xec,
+	0x6e, 0xe0, 0xe3, 0xbc, 0xc2, 0x94, 0x9d, 0xd8, 0x31, 0xa0, 0xaf, 0xa0, 0xa9, 0x23, 0xda, 0x74,
+	0xeb, 0x54, 0x32, 0x5e, 0xcc, 0x46, 0x74, 0x21, 0xf0, 0xf1, 0x1d, 0x45, 0x27, 0x12, 0xb1, 0x2f,
+	0xb1, 0xb5, 0xc9, 0x2f, 0x27, 0x38, 0xf3, 0xfb, 0x04, 0x67, 0xc8, 0x12, 0x7a, 0x72, 0x43, 0xc1,
+	0x36, 0x88, 0x36, 0x6b, 0x09, 0x20, 0x7f, 0xc7, 0xd0, 0xfc, 0x20, 0xf6, 0x7d, 0xdc, 0x99, 0xa0,
+	0x4d, 0x79, 0xb5, 0xb3, 0xd0, 0x4a, 0xec, 0x48, 0xd4, 0x5f, 0xa2, 0xdb, 0x10, 0x3b, 0x56, 0x39,
+	0x95, 0x20, 0xe2, 0x0e, 0xe7, 0x02, 0x1f, 0xdf, 0x53, 0x78, 0xaf, 0x4e, 0xec, 0x3c, 0xa4, 0x32,
+	0x89, 0xd4, 0x6c, 0xb2, 0x23, 0xcd, 0x66, 0x7c, 0xa8, 0xd9, 0xe8, 0x1b, 0x28, 0x47, 0xa5, 0x04,
+	0x21, 0xa9, 0x74, 0x59, 0xab, 0x78, 0x2b, 0xf2, 0x2a, 0x07, 0x3e, 0x9e, 0x57, 0x5e, 0x29, 0xf1,
+	0x39, 0xf3, 0x5c, 0x09, 0x5e, 0x5b, 0x1e, 0x13, 0x3b, 0xed, 0xa4, 0xaf, 0xa3, 0x5c, 0x38, 0xba,
+	0x6a, 0x1b, 0xb8, 0xcb, 0xea, 0xc5, 0x89, 0xb2, 0xb6, 0x38, 0x9e, 0x8e, 0x91, 0x12, 0xd3, 0x31,
+	0x50, 0x68, 0xdf, 0x89, 0xcc, 0xa9, 0x23, 0x5a, 0x40, 0x8f, 0xae, 0x1b, 0x7b, 0x72, 0x3e, 0x9f,
+	0x34, 0x34, 0x5b, 0x11, 0xce, 0x16, 0x34, 0x23, 0x6e, 0x1b, 0xa0, 0xbe, 0x19, 0x0a, 0x2d, 0xa9,
+	0x5b, 0x68, 0x92, 0xb5, 0x81, 0x47, 0x63, 0x50, 0xa7, 0x33, 0x13, 0xf8, 0x78, 0x5a, 0x15, 0xd3,
+	0x55, 0x88, 0x9d, 0x40, 0xa1, 0x43, 0x3d, 0x8e, 0x53, 0x1c, 0xfb, 0xdf, 0xa1, 0xab, 0x10, 0x3b,
+	0x81, 0x52, 0xe5, 0x96, 0x91, 0xd1, 0xbf, 0x8a, 0xa4, 0xd0, 0xef, 0x1a, 0xba, 0x5f, 0x11, 0xce,
+	0x5b, 0x90, 0x6f, 0xa2, 0xcb, 0xb4, 0xde, 0x04, 0x2e, 0x37, 0x59, 0x6b, 0xcf, 0x75, 0x46, 0xaf,
+	0x74, 0x1f, 0xe5, 0x3d, 0xda, 0xa9, 0x7a, 0xae, 0x10, 0x55, 0x7e, 0x59, 0xee, 0xf6, 0xa9, 0x8f,
+	0x33, 0x3f, 0x7d, 0xbc, 0xe0, 0xb8, 0xb2, 0x71, 0xb8, 0x6b, 0xd6, 0x98, 0x67, 0xd5, 0x98, 0xf0,
+	0x98, 0x88, 0x7f, 0x96, 0x45, 0xfd, 0xc0, 0x92, 0xc7, 0x6d, 0x10, 0xe6, 0x16, 0xd4, 0x02, 0x1f,
+	0x17, 0x54, 0x8e, 0x9e, 0x60, 0xc4, 0xce, 0x79, 0xb4, 0x53, 0x71, 0x85, 0xb0, 0x7b, 0x9b, 0x7c,
+	0x80, 0xf0, 0x80, 0x0e, 0xba, 0x5d, 0xae, 0xfc, 0xc9, 0xa2, 0x6c, 0x45, 0x38, 0xfa, 0x67, 0x0d,
+	0xcd, 0x5f, 0xfb, 0x41, 0x30, 0xcd, 0x9e, 0x4f, 0x8b, 0x79, 0xc3, 0x7d, 0x2c, 0xbd, 0x18, 0x8d,
+	0xef, 0x16, 0xa4, 0x7f, 0x44, 0x73, 0x83, 0xef, 0xee, 0xb3, 0x21, 0x83, 0x86, 0x70, 0x69, 0x75,
+	0x04, 0x38, 0x49, 0x7f, 0x80, 0x66, 0xfa, 0xad, 0xe6, 0xe3, 0xab, 0xb1, 0xfa, 0x60, 0xa5, 0xe5,
+	0xa1, 0xb0, 0x24, 0x59, 0x0b, 0x15, 0xfa, 0xae, 0xd7, 0xc2, 0xd5, 0x30, 0xfd, 0xb8, 0x92, 0x39,
+	0x1c, 0xd7, 0xcd, 0xb7, 0xb1, 0x75, 0x7a, 0x6e, 0x68, 0x67, 0xe7, 0x86, 0xf6, 0xeb, 0xdc, 0xd0,
+	0xbe, 0x5e, 0x18, 0x99, 0xb3, 0x0b, 0x23, 0xf3, 0xe3, 0xc2, 0xc8, 0x7c, 0x78, 0x9a, 0x5a, 0xc0,
+	0x77, 0x40, 0xbd, 0xe5, 0xd7, 0xea, 0x7d, 0xa9, 0x31, 0x0e, 0x56, 0x27, 0x79, 0x66, 0xc2, 0x45,
+	0xdc, 0x9d, 0x88, 0x5e, 0x91, 0xd5, 0x7f, 0x03, 0x00, 0x6c, 0xa7, 0x5a, 0x0a, 0x84, 0x06, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -330,6 +430,9 @@ type MsgClient interface {
 	AggregateExchangeRateVote(ctx context.Context, in *MsgAggregateExchangeRateVote, opts ...grpc.CallOption) (*MsgAggregateExchangeRateVoteResponse, error)
 	// DelegateFeedConsent defines a method for setting the feeder delegation
 	DelegateFeedConsent(ctx context.Context, in *MsgDelegateFeedConsent, opts ...grpc.CallOption) (*MsgDelegateFeedConsentResponse, error)
+	// SetOracleAlertConfig defines a method for declaring the miss rate a
+	// validator accepts, for monitoring services to alert on
+	SetOracleAlertConfig(ctx context.Context, in *MsgSetOracleAlertConfig, opts ...grpc.CallOption) (*MsgSetOracleAlertConfigResponse, error)
 }
 
 type msgClient struct {
@@ -367,6 +470,15 @@ func (c *msgClient) DelegateFeedConsent(ctx context.Context, in *MsgDelegateFeed
 	return out, nil
 }
 
+func (c *msgClient) SetOracleAlertConfig(ctx context.Context, in *MsgSetOracleAlertConfig, opts ...grpc.CallOption) (*MsgSetOracleAlertConfigResponse, error) {
+	out := new(MsgSetOracleAlertConfigResponse)
+	err := c.cc.Invoke(ctx, "/kujira.oracle.Msg/SetOracleAlertConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AggregateExchangeRatePrevote defines a method for submitting
@@ -377,6 +489,9 @@ type MsgServer interface {
 	AggregateExchangeRateVote(context.Context, *MsgAggregateExchangeRateVote) (*MsgAggregateExchangeRateVoteResponse, error)
 	// DelegateFeedConsent defines a method for setting the feeder delegation
 	DelegateFeedConsent(context.Context, *MsgDelegateFeedConsent) (*MsgDelegateFeedConsentResponse, error)
+	// SetOracleAlertConfig defines a method for declaring the miss rate a
+	// validator accepts, for monitoring services to alert on
+	SetOracleAlertConfig(context.Context, *MsgSetOracleAlertConfig) (*MsgSetOracleAlertConfigResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -392,6 +507,9 @@ func (*UnimplementedMsgServer) AggregateExchangeRateVote(ctx context.Context, re
 func (*UnimplementedMsgServer) DelegateFeedConsent(ctx context.Context, req *MsgDelegateFeedConsent) (*MsgDelegateFeedConsentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelegateFeedConsent not implemented")
 }
+func (*UnimplementedMsgServer) SetOracleAlertConfig(ctx context.Context, req *MsgSetOracleAlertConfig) (*MsgSetOracleAlertConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOracleAlertConfig not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -451,6 +569,24 @@ func _Msg_DelegateFeedConsent_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetOracleAlertConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetOracleAlertConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetOracleAlertConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kujira.oracle.Msg/SetOracleAlertConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetOracleAlertConfig(ctx, req.(*MsgSetOracleAlertConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kujira.oracle.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -467,6 +603,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelegateFeedConsent",
 			Handler:    _Msg_DelegateFeedConsent_Handler,
 		},
+		{
+			MethodName: "SetOracleAlertConfig",
+			Handler:    _Msg_SetOracleAlertConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kujira/oracle/tx.proto",
@@ -559,6 +699,18 @@ func (m *MsgAggregateExchangeRateVote) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if m.VotePeriod != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.VotePeriod))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Attestation) > 0 {
+		i -= len(m.Attestation)
+		copy(dAtA[i:], m.Attestation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Attestation)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Validator) > 0 {
 		i -= len(m.Validator)
 		copy(dAtA[i:], m.Validator)
@@ -673,6 +825,69 @@ func (m *MsgDelegateFeedConsentResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetOracleAlertConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOracleAlertConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOracleAlertConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxMissRate.Size()
+		i -= size
+		if _, err := m.MaxMissRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetOracleAlertConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOracleAlertConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOracleAlertConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -736,6 +951,13 @@ func (m *MsgAggregateExchangeRateVote) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Attestation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.VotePeriod != 0 {
+		n += 1 + sovTx(uint64(m.VotePeriod))
+	}
 	return n
 }
 
@@ -774,6 +996,30 @@ func (m *MsgDelegateFeedConsentResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetOracleAlertConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxMissRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetOracleAlertConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1133,6 +1379,57 @@ func (m *MsgAggregateExchangeRateVote) Unmarshal(dAtA []byte) error {
 			}
 			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1368,6 +1665,172 @@ func (m *MsgDelegateFeedConsentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetOracleAlertConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetOracleAlertConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetOracleAlertConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMissRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMissRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetOracleAlertConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetOracleAlertConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetOracleAlertConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -61,14 +61,23 @@ func (tuples ExchangeRateTuples) String() string {
 	return string(out)
 }
 
-// ParseExchangeRateTuples ExchangeRateTuple parser
+// ParseExchangeRateTuples ExchangeRateTuple parser. It accepts the grammar
+//
+//	tuples = [ tuple { "," tuple } ]
+//	tuple  = { space } rate { space } denom { space }
+//	rate   = digit { digit } [ "." digit { digit } ] | "." digit { digit }
+//	denom  = letter ( letter | digit | "/" | ":" | "." | "_" | "-" ){2,127}
+//
+// where a rate has at most 18 decimal places, and each denom appears at most once.
+// A blank string parses into no tuples without error, any other string either into
+// one tuple per entry or an error.
 func ParseExchangeRateTuples(tuplesStr string) (ExchangeRateTuples, error) {
 	tuplesStr = strings.TrimSpace(tuplesStr)
 	if len(tuplesStr) == 0 {
 		return nil, nil
 	}
 
-	tupleStrs := strings.Split(tuplesStr, ",")
+	tupleStrs := strings.Split(tuplesStr, ExchangeRateSeparator)
 	tuples := make(ExchangeRateTuples, len(tupleStrs))
 	duplicateCheckMap := make(map[string]bool)
 	for i, tupleStr := range tupleStrs {
@@ -91,3 +100,12 @@ func ParseExchangeRateTuples(tuplesStr string) (ExchangeRateTuples, error) {
 
 	return tuples, nil
 }
+
+// Ratio returns the share of the bonded power which voted, zero without bonded power
+func (p VotePeriodParticipation) Ratio() sdk.Dec {
+	if p.BondedPower <= 0 {
+		return sdk.ZeroDec()
+	}
+
+	return sdk.NewDec(p.VotedPower).QuoInt64(p.BondedPower)
+}
@@ -1,11 +1,14 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/Team-Kujira/core/x/oracle/types"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestParseExchangeRateTuples(t *testing.T) {
@@ -29,3 +32,63 @@ func TestParseExchangeRateTuples(t *testing.T) {
 	_, err = types.ParseExchangeRateTuples(abstainCoinsWithValid)
 	require.NoError(t, err)
 }
+
+func FuzzParseExchangeRateTuples(f *testing.F) {
+	for _, seed := range []string{
+		"123.0ukuji,123.123demo",
+		"0.0ukuji,123.1demo",
+		"100.0ukuji,123.123demo,121233.123demo",
+		"123.123",
+		"123.0ukuji,",
+		",123.0ukuji",
+		"123.0ukuji,,1.0demo",
+		" 1.0ukuji , 2.0demo ",
+		"1ukuji",
+		".5ukuji",
+		"1.ukuji",
+		"-1.0ukuji",
+		"1.0 ukuji",
+		"1e5ukuji",
+		"1.0ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		"1000000000000000000000000000000000000000000000000000000000000000000000000000000.0ukuji",
+		"1.0000000000000000001ukuji",
+		"1.0ukuji:salt",
+		"",
+		" ",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, tuplesStr string) {
+		tuples, err := types.ParseExchangeRateTuples(tuplesStr)
+		if err != nil {
+			require.Nil(t, tuples)
+			return
+		}
+
+		if strings.TrimSpace(tuplesStr) == "" {
+			require.Empty(t, tuples)
+			return
+		}
+
+		// Every entry parsed into a distinct tuple of a valid denom and a non-negative rate
+		require.Len(t, tuples, strings.Count(tuplesStr, types.ExchangeRateSeparator)+1)
+		denoms := map[string]bool{}
+		for _, tuple := range tuples {
+			require.NoError(t, sdk.ValidateDenom(tuple.Denom))
+			require.False(t, denoms[tuple.Denom], "duplicated denom %s", tuple.Denom)
+			denoms[tuple.Denom] = true
+			require.False(t, tuple.ExchangeRate.IsNil())
+			require.False(t, tuple.ExchangeRate.IsNegative())
+		}
+
+		// and formatting the tuples back parses into the same tuples
+		entries := make([]string, len(tuples))
+		for i, tuple := range tuples {
+			entries[i] = tuple.ExchangeRate.String() + tuple.Denom
+		}
+		reparsed, err := types.ParseExchangeRateTuples(strings.Join(entries, types.ExchangeRateSeparator))
+		require.NoError(t, err)
+		require.Equal(t, tuples, reparsed)
+	})
+}
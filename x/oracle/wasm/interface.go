@@ -41,7 +41,8 @@ type ExchangeRateQueryResponse struct {
 // QueryCustom implements custom query interface
 func Handle(keeper keeper.Keeper, ctx sdk.Context, q *OracleQuery) (any, error) {
 	if q.ExchangeRate != nil {
-		rate, err := keeper.GetExchangeRate(ctx, q.ExchangeRate.Denom)
+		// Contracts expect USD rates, whatever the denom is quoted in
+		rate, err := keeper.GetUSDExchangeRate(ctx, q.ExchangeRate.Denom)
 		if err != nil {
 			return nil, err
 		}